@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	achievementusecase "zen-connect/internal/achievement/application/usecase"
+	achievementinfra "zen-connect/internal/achievement/infrastructure"
+	experienceinfra "zen-connect/internal/experience/infrastructure"
+	"zen-connect/internal/infrastructure/config"
+	"zen-connect/internal/infrastructure/postgres"
+	notificationinfra "zen-connect/internal/notification/infrastructure"
+)
+
+// runAchievements handles `achievements rebuild`
+func runAchievements(cfg *config.Config, args []string) error {
+	if len(args) == 0 || args[0] != "rebuild" {
+		return fmt.Errorf("usage: achievements rebuild")
+	}
+
+	ctx := context.Background()
+	pgClient, err := postgres.NewClient(ctx, cfg.PostgresConfig())
+	if err != nil {
+		return err
+	}
+	defer pgClient.Close()
+
+	// Badges already earned are never awarded again, so this is safe to run
+	// while the server is up
+	award := achievementusecase.NewAwardUseCase(
+		achievementinfra.NewPracticeAdapter(
+			experienceinfra.NewPostgresExperienceRepository(pgClient.Pool),
+			notificationinfra.NewPostgresReminderRepository(pgClient.Pool),
+		),
+		achievementinfra.NewPostgresAchievementRepository(pgClient.Pool),
+	)
+	awarded, err := award.Rebuild(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Awarded %d badges\n", awarded)
+	return nil
+}
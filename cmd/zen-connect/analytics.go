@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	analyticsusecase "zen-connect/internal/analytics/application/usecase"
+	analyticsinfra "zen-connect/internal/analytics/infrastructure"
+	experienceinfra "zen-connect/internal/experience/infrastructure"
+	"zen-connect/internal/infrastructure/config"
+	"zen-connect/internal/infrastructure/postgres"
+)
+
+// runAnalytics handles `analytics rebuild`
+func runAnalytics(cfg *config.Config, args []string) error {
+	if len(args) == 0 || args[0] != "rebuild" {
+		return fmt.Errorf("usage: analytics rebuild")
+	}
+
+	ctx := context.Background()
+	pgClient, err := postgres.NewClient(ctx, cfg.PostgresConfig())
+	if err != nil {
+		return err
+	}
+	defer pgClient.Close()
+
+	// Applying an experience whose current state is already rolled up changes
+	// nothing, so this is safe to run while the server is up
+	rollup := analyticsusecase.NewRollupUseCase(
+		analyticsinfra.NewExperienceAdapter(experienceinfra.NewPostgresExperienceRepository(pgClient.Pool)),
+		analyticsinfra.NewPostgresEmotionRollupRepository(pgClient.Pool),
+	)
+	applied, err := rollup.Rebuild(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Updated the emotion rollups of %d experiences\n", applied)
+	return nil
+}
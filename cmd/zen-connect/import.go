@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"zen-connect/internal/experience/application/dto"
+	experienceservice "zen-connect/internal/experience/application/service"
+	experienceusecase "zen-connect/internal/experience/application/usecase"
+	experienceinfra "zen-connect/internal/experience/infrastructure"
+	"zen-connect/internal/infrastructure/config"
+	"zen-connect/internal/infrastructure/postgres"
+	moderationservice "zen-connect/internal/moderation/application/service"
+)
+
+const importUsage = `Usage: zen-connect import [flags] <user-id> <file>
+
+Imports a meditation history exported from another app for a user, in the
+same way as POST /experiences/import.
+
+Flags:
+  -preset name       column mapping of a known app (insight_timer, zen_connect)
+  -mapping file      JSON column mapping, as the mapping field of the API
+  -format csv|json   file format (default: from the file extension)
+  -time-zone zone    time zone of times without an offset (default UTC)
+  -dry-run           report what would be imported without saving
+  -skip-invalid      save the valid rows even if some rows are invalid
+`
+
+// runImport handles `import`
+func runImport(cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	flags.Usage = func() { fmt.Fprint(os.Stderr, importUsage) }
+	preset := flags.String("preset", "", "")
+	mappingPath := flags.String("mapping", "", "")
+	format := flags.String("format", "", "")
+	timeZone := flags.String("time-zone", "", "")
+	dryRun := flags.Bool("dry-run", false, "")
+	skipInvalid := flags.Bool("skip-invalid", false, "")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		return fmt.Errorf("missing user ID or file\n\n%s", importUsage)
+	}
+	userID, path := flags.Arg(0), flags.Arg(1)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	req := &dto.ImportHistoryRequest{
+		UserID:      userID,
+		Format:      *format,
+		Data:        string(data),
+		Preset:      *preset,
+		TimeZone:    *timeZone,
+		DryRun:      *dryRun,
+		SkipInvalid: *skipInvalid,
+	}
+	if req.Format == "" {
+		req.Format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+	if *mappingPath != "" {
+		mappingData, err := os.ReadFile(*mappingPath)
+		if err != nil {
+			return err
+		}
+		req.Mapping = &dto.ImportMappingDTO{}
+		if err := json.Unmarshal(mappingData, req.Mapping); err != nil {
+			return fmt.Errorf("invalid mapping file: %w", err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	pgClient, err := postgres.NewClient(ctx, cfg.PostgresConfig())
+	if err != nil {
+		return err
+	}
+	defer pgClient.Close()
+
+	// Rollups, goals, badges and webhooks for the imported experiences are
+	// processed by the server's import_batches job
+	importHistory := experienceusecase.NewImportHistoryUseCase(
+		experienceinfra.NewPostgresExperienceRepository(pgClient.Pool),
+		experienceservice.NewMeditationTypeCatalogService(
+			experienceinfra.NewPostgresMeditationTypeRepository(pgClient.Pool), cfg.Meditation.CatalogCacheTTL),
+		moderationservice.NewContentFilterService(cfg.Moderation.BlockedKeywords),
+		experienceinfra.NewHistoryDecoder(),
+	)
+	report, err := importHistory.Execute(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	printImportReport(report)
+	if !report.DryRun && !report.Committed {
+		return fmt.Errorf("nothing was imported because of invalid rows (use -skip-invalid to import the rest)")
+	}
+	return nil
+}
+
+// printImportReport prints the counts and every invalid row
+func printImportReport(report *dto.ImportHistoryResponse) {
+	for _, rowErr := range report.Errors {
+		if rowErr.Column != "" {
+			fmt.Printf("row %d (%s): %s\n", rowErr.Row, rowErr.Column, rowErr.Message)
+		} else {
+			fmt.Printf("row %d: %s\n", rowErr.Row, rowErr.Message)
+		}
+	}
+
+	verb := "Imported"
+	if !report.Committed {
+		verb = "Would import"
+	}
+	fmt.Printf("%s %d of %d rows (%d drafts without emotions); %d duplicates, %d invalid\n",
+		verb, report.Imported, report.Rows, report.Drafts, report.Duplicates, report.Invalid)
+}
@@ -1,270 +1,161 @@
 package main
 
 import (
-	"context"
+	"flag"
+	"fmt"
 	"log"
 	"os"
-	"os/signal"
-	"syscall"
-	"time"
-	"zen-connect/internal/infrastructure/auth0"
-	"zen-connect/internal/infrastructure/logger"
-	"zen-connect/internal/infrastructure/postgres"
-	"zen-connect/internal/infrastructure/session"
-	"zen-connect/internal/shared/interfaces"
-	"zen-connect/internal/user/infrastructure"
-	userservice "zen-connect/internal/user/application/service"
-	userusecase "zen-connect/internal/user/application/usecase"
-	userinterfaces "zen-connect/internal/user/interfaces"
-	authinterfaces "zen-connect/internal/auth/interfaces"
+	// Reminders run in users' time zones; embed the zone database so hosts without one work
+	_ "time/tzdata"
+	"zen-connect/internal/infrastructure/config"
+	"zen-connect/internal/infrastructure/webpush"
 
-	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/joho/godotenv"
-	"github.com/labstack/echo/v4"
-	echoMiddleware "github.com/labstack/echo/v4/middleware"
-	"go.uber.org/zap"
 )
 
+const usage = `Usage: zen-connect [-config file.yaml] [command]
+
+Commands:
+  serve                    Start the API server (default)
+  migrate up [N]           Apply all (or N) pending migrations
+  migrate down [N]         Roll back the last (or last N) migrations
+  migrate status           Show applied and pending migrations
+  migrate create <name>    Create a new up/down migration pair
+  config check             Validate the configuration and print it with secrets redacted
+  push keys                Generate a VAPID key pair for Web Push notifications
+  import <user-id> <file>  Import a meditation history exported from another app
+                           (see import -h)
+  analytics rebuild        Roll up the emotions of experiences recorded before
+                           the analytics or missed by their events
+  achievements rebuild     Award the badges users qualify for but have not
+                           received, such as newly added ones
+
+The config file can also be given with the CONFIG_FILE environment variable.
+Environment variables always take precedence over the config file.
+`
+
 func main() {
 	// Load environment variables from .env file
 	if err := godotenv.Load(); err != nil {
 		log.Println("Warning: .env file not found, using system environment variables")
 	}
 
-	// Initialize logger
-	loggerConfig := logger.NewConfig()
-	if err := logger.Initialize(loggerConfig); err != nil {
-		log.Fatal("Failed to initialize logger:", err)
-	}
-	defer logger.Close()
-
-	// Use structured logging from here on
-	logger.Info("Starting zen-connect application",
-		zap.String("service", loggerConfig.ServiceName),
-		zap.String("version", loggerConfig.ServiceVersion),
-		zap.String("environment", loggerConfig.Environment),
-	)
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML config file")
+	flag.Parse()
 
-	ctx := context.Background()
-
-	// Initialize PostgreSQL client
-	logger.Info("Initializing PostgreSQL client")
-	pgClient, err := postgres.NewClient(ctx)
-	if err != nil {
-		logger.Fatal("Failed to create PostgreSQL client", zap.Error(err))
+	args := flag.Args()
+	command := "serve"
+	if len(args) > 0 {
+		command = args[0]
+		args = args[1:]
 	}
-	defer func() {
-		logger.Info("Closing PostgreSQL client")
-		pgClient.Close()
-	}()
-	logger.Info("PostgreSQL client initialized successfully")
-
-	// Initialize repositories
-	logger.Info("Initializing repositories")
-	userRepo := infrastructure.NewPostgresUserRepository(pgClient.Pool)
 
-	// Initialize session store
-	logger.Info("Initializing session store")
-	sessionStore, err := session.NewCookieStore()
-	if err != nil {
-		logger.Fatal("Failed to create session store", zap.Error(err))
+	if command == "help" {
+		fmt.Print(usage)
+		return
 	}
-	logger.Info("Session store initialized successfully")
 
-	// Initialize Auth0 configuration
-	logger.Info("Initializing Auth0 configuration")
-	auth0Config, err := auth0.NewConfig()
-	if err != nil {
-		logger.Fatal("Failed to create Auth0 config", zap.Error(err))
+	if command == "migrate" && len(args) > 0 && args[0] == "create" {
+		// create only touches the filesystem, so it needs no configuration
+		if err := runMigrateCreate(args[1:]); err != nil {
+			exitWithError(err)
+		}
+		return
 	}
-	logger.Info("Auth0 configuration loaded",
-		zap.String("domain", auth0Config.Domain),
-		zap.String("audience", auth0Config.Audience),
-	)
 
-	// Initialize OIDC provider
-	logger.Info("Initializing OIDC provider")
-	provider, err := oidc.NewProvider(ctx, auth0Config.IssuerURL())
-	if err != nil {
-		logger.Fatal("Failed to create OIDC provider", zap.Error(err))
+	if command == "push" {
+		// key generation needs no configuration either
+		if len(args) == 0 || args[0] != "keys" {
+			exitWithError(fmt.Errorf("usage: push keys"))
+		}
+		if err := runPushKeys(); err != nil {
+			exitWithError(err)
+		}
+		return
 	}
-	logger.Info("OIDC provider initialized successfully")
 
-	// Initialize Auth0 service
-	logger.Info("Initializing Auth0 service")
-	authService, err := auth0.NewAuthService(auth0Config)
+	cfg, err := config.Load(*configPath)
 	if err != nil {
-		logger.Fatal("Failed to create Auth0 service", zap.Error(err))
+		exitWithError(err)
 	}
-	logger.Info("Auth0 service initialized successfully")
-
-	// Initialize session middleware
-	logger.Info("Initializing session middleware")
-	sessionMiddleware := session.NewMiddleware(sessionStore)
-
-	// Create Echo instance
-	e := echo.New()
-	e.HideBanner = true
-	e.HidePort = true
 
-	// Global middleware with structured logging
-	e.Use(logger.RequestLoggerMiddleware())
-	e.Use(logger.SessionLoggerMiddleware())
-	e.Use(logger.ErrorLoggerMiddleware())
-	e.Use(logger.RecoveryLoggerMiddleware())
-	
-	// CORS middleware with proper configuration for authentication
-	frontendURL := os.Getenv("FRONTEND_URL")
-	if frontendURL == "" {
-		frontendURL = "http://localhost:3000" // デフォルト値
+	switch command {
+	case "serve":
+		if err := cfg.Validate(); err != nil {
+			exitWithError(err)
+		}
+		runServer(cfg)
+	case "migrate":
+		if err := cfg.ValidateDatabase(); err != nil {
+			exitWithError(err)
+		}
+		if err := runMigrate(cfg, args); err != nil {
+			exitWithError(err)
+		}
+	case "import":
+		if err := cfg.ValidateDatabase(); err != nil {
+			exitWithError(err)
+		}
+		if err := runImport(cfg, args); err != nil {
+			exitWithError(err)
+		}
+	case "analytics":
+		if err := cfg.ValidateDatabase(); err != nil {
+			exitWithError(err)
+		}
+		if err := runAnalytics(cfg, args); err != nil {
+			exitWithError(err)
+		}
+	case "achievements":
+		if err := cfg.ValidateDatabase(); err != nil {
+			exitWithError(err)
+		}
+		if err := runAchievements(cfg, args); err != nil {
+			exitWithError(err)
+		}
+	case "config":
+		if len(args) == 0 || args[0] != "check" {
+			exitWithError(fmt.Errorf("usage: config check"))
+		}
+		if err := runConfigCheck(cfg, *configPath); err != nil {
+			os.Exit(1)
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command: %s\n\n%s", command, usage)
+		os.Exit(2)
 	}
-	logger.Info("CORS configuration", zap.String("frontend_url", frontendURL))
-	
-	// CORS設定（開発環境用）
-	e.Use(echoMiddleware.CORSWithConfig(echoMiddleware.CORSConfig{
-		AllowOrigins: []string{
-			"http://localhost:3000",
-		},
-		AllowMethods: []string{
-			"GET", 
-			"POST", 
-			"OPTIONS",
-		},
-		AllowHeaders: []string{
-			"Accept",
-			"Authorization",
-			"Content-Type",
-			"X-Requested-With",
-		},
-		AllowCredentials: true,
-		MaxAge:           86400,
-	}))
-
-	// Initialize new architecture components
-	logger.Info("Initializing new architecture components")
-	
-	// User service
-	userService := userservice.NewUserService(userRepo)
+}
 
-	// Initialize new auth handler with UserService
-	logger.Info("Initializing new auth handler")
-	newAuthHandler, err := authinterfaces.NewAuthHandler(authService, userService, sessionStore, provider, auth0Config)
+// runPushKeys prints a new VAPID key pair in environment variable form
+func runPushKeys() error {
+	publicKey, privateKey, err := webpush.GenerateVAPIDKeys()
 	if err != nil {
-		logger.Fatal("Failed to create new auth handler", zap.Error(err))
+		return err
 	}
-	logger.Info("New auth handler initialized successfully")
-
-	// Setup routes
-	logger.Info("Setting up application routes")
-	// Use new auth handler
-	newAuthHandler.SetupRoutes(e)
-
-	// User use cases
-	getUserProfileUseCase := userusecase.NewGetUserProfileUseCase(userService)
-	
-	// User handler (only keeping GetCurrentUser endpoint)
-	userHandler := userinterfaces.NewUserHandler(nil, nil, getUserProfileUseCase)
-	userHandler.SetupRoutes(e, sessionMiddleware)
-
-	// Setup API documentation
-	routesHandler := interfaces.NewRoutesHandler()
-	routesHandler.SetupRoutes(e)
-	
-	logger.Info("Routes configured successfully")
-
-	// Health check endpoints
-	e.GET("/health", func(c echo.Context) error {
-		logCtx := logger.WithContext(c.Request().Context())
-		logCtx.Debug("Health check requested")
-		return c.JSON(200, map[string]string{
-			"status":  "OK",
-			"service": "zen-connect-api",
-		})
-	})
-
-	// Protected health check endpoint to test session-based auth
-	e.GET("/health/protected", func(c echo.Context) error {
-		logCtx := logger.WithContext(c.Request().Context())
-		userID, ok := session.GetUserIDFromContext(c.Request().Context())
-		if !ok {
-			logCtx.Warn("Protected health check accessed without authentication")
-			return c.JSON(401, map[string]string{
-				"error": "User not authenticated",
-			})
-		}
-
-		email, _ := session.GetUserEmailFromContext(c.Request().Context())
-		name, _ := session.GetUserNameFromContext(c.Request().Context())
-		auth0UserID, _ := session.GetAuth0UserIDFromContext(c.Request().Context())
-
-		logCtx.Info("Protected health check accessed by authenticated user",
-			zap.String("user_id", userID),
-			zap.String("auth0_user_id", auth0UserID),
-		)
-
-		return c.JSON(200, map[string]interface{}{
-			"status":        "OK",
-			"service":       "zen-connect-api",
-			"user_id":       userID,
-			"auth0_user_id": auth0UserID,
-			"email":         email,
-			"name":          name,
-		})
-	}, sessionMiddleware.RequireAuth())
-
-	// Database health check
-	e.GET("/health/db", func(c echo.Context) error {
-		logCtx := logger.WithContext(c.Request().Context())
-		logCtx.Debug("Database health check requested")
-		
-		start := time.Now()
-		if err := pgClient.Health(c.Request().Context()); err != nil {
-			logCtx.Error("Database health check failed",
-				zap.Error(err),
-				zap.Duration("duration", time.Since(start)),
-			)
-			return c.JSON(500, map[string]string{
-				"status": "ERROR",
-				"error":  err.Error(),
-			})
-		}
-		
-		logCtx.Info("Database health check successful",
-			zap.Duration("duration", time.Since(start)),
-		)
-		return c.JSON(200, map[string]string{
-			"status": "OK",
-			"db":     "connected",
-		})
-	})
+	fmt.Printf("# Public key (served to browsers by GET /notifications/push/key): %s\n", publicKey)
+	fmt.Printf("PUSH_VAPID_PRIVATE_KEY=%s\n", privateKey)
+	return nil
+}
+
+// runConfigCheck prints the effective configuration and every validation problem
+func runConfigCheck(cfg *config.Config, configPath string) error {
+	source := "environment"
+	if configPath != "" {
+		source = configPath + " + environment"
+	}
+	fmt.Printf("# Effective configuration (%s)\n%s\n", source, cfg.Redacted())
 
-	// Start server with graceful shutdown
-	go func() {
-		logger.Info("Starting zen-connect API server",
-			zap.String("port", "8080"),
-			zap.String("auth0_domain", auth0Config.Domain),
-			zap.String("auth0_audience", auth0Config.Audience),
-			zap.String("database_url", "***MASKED***"),
-			zap.String("environment", loggerConfig.Environment),
-		)
-		if err := e.Start(":8080"); err != nil {
-			logger.Info("Server stopped", zap.Error(err))
-		}
-	}()
+	if err := cfg.Validate(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
 
-	// Wait for interrupt signal to gracefully shutdown the server
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
-	logger.Info("Shutdown signal received, starting graceful shutdown")
+	fmt.Println("Configuration is valid")
+	return nil
+}
 
-	// Graceful shutdown with timeout
-	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-	
-	if err := e.Shutdown(shutdownCtx); err != nil {
-		logger.Fatal("Server forced to shutdown", zap.Error(err))
-	}
-	
-	logger.Info("Server shutdown completed successfully")
-}
\ No newline at end of file
+func exitWithError(err error) {
+	fmt.Fprintln(os.Stderr, "Error:", err)
+	os.Exit(1)
+}
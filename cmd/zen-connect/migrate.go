@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+	"zen-connect/internal/infrastructure/config"
+	"zen-connect/internal/infrastructure/postgres"
+	"zen-connect/migrations"
+)
+
+// migrationsDir is where `migrate create` writes new migration files
+const migrationsDir = "migrations"
+
+// runMigrateCreate handles `migrate create <name>`
+func runMigrateCreate(args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("usage: migrate create <name>")
+	}
+	upPath, downPath, err := postgres.CreateMigration(migrationsDir, args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Created %s\nCreated %s\n", upPath, downPath)
+	return nil
+}
+
+// runMigrate handles the database `migrate` subcommands
+func runMigrate(cfg *config.Config, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing migrate subcommand\n\n%s", usage)
+	}
+
+	steps := 0
+	if len(args) > 1 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 1 {
+			return fmt.Errorf("invalid step count: %s", args[1])
+		}
+		steps = n
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	pgClient, err := postgres.NewClient(ctx, cfg.PostgresConfig())
+	if err != nil {
+		return err
+	}
+	defer pgClient.Close()
+
+	migrator, err := postgres.NewMigrator(pgClient.Pool, migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx, steps)
+		for _, m := range applied {
+			fmt.Printf("Applied  %03d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Println("No pending migrations")
+		}
+	case "down":
+		reverted, err := migrator.Down(ctx, steps)
+		for _, m := range reverted {
+			fmt.Printf("Reverted %03d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(reverted) == 0 {
+			fmt.Println("No applied migrations")
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.Applied() {
+				appliedAt = s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(os.Stdout, "%03d_%-40s %s\n", s.Migration.Version, s.Migration.Name, appliedAt)
+		}
+	default:
+		return fmt.Errorf("unknown migrate subcommand: %s\n\n%s", args[0], usage)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	achievementinterfaces "zen-connect/internal/achievement/interfaces"
+	analyticsinterfaces "zen-connect/internal/analytics/interfaces"
+	authinterfaces "zen-connect/internal/auth/interfaces"
+	experienceservice "zen-connect/internal/experience/application/service"
+	experienceinterfaces "zen-connect/internal/experience/interfaces"
+	goalinterfaces "zen-connect/internal/goal/interfaces"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/infrastructure/validation"
+	moderationinterfaces "zen-connect/internal/moderation/interfaces"
+	notificationinterfaces "zen-connect/internal/notification/interfaces"
+	roominterfaces "zen-connect/internal/room/interfaces"
+	"zen-connect/internal/shared/interfaces"
+	"zen-connect/internal/shared/openapi"
+	userinterfaces "zen-connect/internal/user/interfaces"
+	webhookinterfaces "zen-connect/internal/webhook/interfaces"
+
+	"github.com/labstack/echo/v4"
+)
+
+// apiHandlers groups the HTTP handlers and route middleware of the API
+type apiHandlers struct {
+	auth           *authinterfaces.AuthHandler
+	user           *userinterfaces.UserHandler
+	experience     *experienceinterfaces.ExperienceHandler
+	sharing        *experienceinterfaces.SharingHandler
+	meditationType *experienceinterfaces.MeditationTypeHandler
+	timerSession   *experienceinterfaces.TimerSessionHandler
+	history        *experienceinterfaces.HistoryHandler
+	analytics      *analyticsinterfaces.AnalyticsHandler
+	goal           *goalinterfaces.GoalHandler
+	badge          *achievementinterfaces.BadgeHandler
+	room           *roominterfaces.RoomHandler
+	moderation     *moderationinterfaces.ModerationHandler
+	notification   *notificationinterfaces.NotificationHandler
+	webhook        *webhookinterfaces.WebhookHandler
+	health         *interfaces.HealthHandler
+	routes         *interfaces.RoutesHandler
+
+	sessionMiddleware *session.Middleware
+	idempotency       echo.MiddlewareFunc
+	requireAdmin      echo.MiddlewareFunc
+}
+
+// registerRoutes registers every API route and builds the OpenAPI document
+// from them. It returns the routes that are missing from the document (or
+// documented but not registered) so drift is visible.
+func registerRoutes(e *echo.Echo, h apiHandlers, options openapi.Options) (*openapi.Document, []string) {
+	h.auth.SetupRoutes(e)
+	h.user.SetupRoutes(e, h.sessionMiddleware, h.idempotency)
+	h.experience.SetupRoutes(e, h.sessionMiddleware, h.idempotency)
+	h.sharing.SetupRoutes(e, h.sessionMiddleware, h.idempotency)
+	h.meditationType.SetupRoutes(e, h.sessionMiddleware, h.requireAdmin)
+	h.timerSession.SetupRoutes(e, h.sessionMiddleware, h.idempotency)
+	h.history.SetupRoutes(e, h.sessionMiddleware)
+	h.analytics.SetupRoutes(e, h.sessionMiddleware)
+	h.goal.SetupRoutes(e, h.sessionMiddleware)
+	h.badge.SetupRoutes(e, h.sessionMiddleware)
+	h.room.SetupRoutes(e, h.sessionMiddleware, h.idempotency)
+	h.moderation.SetupRoutes(e, h.sessionMiddleware, h.idempotency, h.requireAdmin)
+	h.notification.SetupRoutes(e, h.sessionMiddleware)
+	h.webhook.SetupRoutes(e, h.sessionMiddleware, h.requireAdmin)
+	h.health.SetupRoutes(e, h.sessionMiddleware)
+	h.routes.SetupRoutes(e)
+
+	var endpoints []openapi.Endpoint
+	endpoints = append(endpoints, h.auth.Endpoints()...)
+	endpoints = append(endpoints, h.user.Endpoints()...)
+	endpoints = append(endpoints, h.experience.Endpoints()...)
+	endpoints = append(endpoints, h.sharing.Endpoints()...)
+	endpoints = append(endpoints, h.meditationType.Endpoints()...)
+	endpoints = append(endpoints, h.timerSession.Endpoints()...)
+	endpoints = append(endpoints, h.history.Endpoints()...)
+	endpoints = append(endpoints, h.analytics.Endpoints()...)
+	endpoints = append(endpoints, h.goal.Endpoints()...)
+	endpoints = append(endpoints, h.badge.Endpoints()...)
+	endpoints = append(endpoints, h.room.Endpoints()...)
+	endpoints = append(endpoints, h.moderation.Endpoints()...)
+	endpoints = append(endpoints, h.notification.Endpoints()...)
+	endpoints = append(endpoints, h.webhook.Endpoints()...)
+	endpoints = append(endpoints, h.health.Endpoints()...)
+	endpoints = append(endpoints, h.routes.Endpoints()...)
+
+	options.ErrorResponse = problem.Details{}
+	options.ErrorContentType = problem.MIMEProblemJSON
+	document, problems := openapi.Build(options, e.Routes(), endpoints)
+	h.routes.SetDocument(document)
+
+	return document, problems
+}
+
+// errorMappings collects the domain error mappings of every context
+func errorMappings() []problem.Mapping {
+	var mappings []problem.Mapping
+	mappings = append(mappings, authinterfaces.ErrorMappings()...)
+	mappings = append(mappings, userinterfaces.ErrorMappings()...)
+	mappings = append(mappings, experienceinterfaces.ErrorMappings()...)
+	mappings = append(mappings, analyticsinterfaces.ErrorMappings()...)
+	mappings = append(mappings, goalinterfaces.ErrorMappings()...)
+	mappings = append(mappings, roominterfaces.ErrorMappings()...)
+	mappings = append(mappings, moderationinterfaces.ErrorMappings()...)
+	mappings = append(mappings, notificationinterfaces.ErrorMappings()...)
+	mappings = append(mappings, webhookinterfaces.ErrorMappings()...)
+	return mappings
+}
+
+// validationRules collects the custom request validation rules of every context
+func validationRules(meditationTypeCatalog *experienceservice.MeditationTypeCatalogService) []validation.Rule {
+	var rules []validation.Rule
+	rules = append(rules, experienceinterfaces.ValidationRules(meditationTypeCatalog)...)
+	return rules
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	achievementinterfaces "zen-connect/internal/achievement/interfaces"
+	analyticsinterfaces "zen-connect/internal/analytics/interfaces"
+	authinterfaces "zen-connect/internal/auth/interfaces"
+	experienceinterfaces "zen-connect/internal/experience/interfaces"
+	goalinterfaces "zen-connect/internal/goal/interfaces"
+	"zen-connect/internal/infrastructure/session"
+	moderationinterfaces "zen-connect/internal/moderation/interfaces"
+	notificationinterfaces "zen-connect/internal/notification/interfaces"
+	roominterfaces "zen-connect/internal/room/interfaces"
+	"zen-connect/internal/shared/interfaces"
+	"zen-connect/internal/shared/openapi"
+	"zen-connect/internal/user/application/usecase"
+	userinterfaces "zen-connect/internal/user/interfaces"
+	webhookinterfaces "zen-connect/internal/webhook/interfaces"
+
+	"github.com/labstack/echo/v4"
+)
+
+// newTestRoutes registers every route with handlers that are never invoked
+func newTestRoutes(t *testing.T) (*echo.Echo, *openapi.Document, []string) {
+	t.Helper()
+	e := echo.New()
+	passThrough := func(next echo.HandlerFunc) echo.HandlerFunc { return next }
+
+	document, problems := registerRoutes(e, apiHandlers{
+		auth:              &authinterfaces.AuthHandler{},
+		user:              userinterfaces.NewUserHandler(&usecase.RegisterUserUseCase{}, nil, nil, nil),
+		experience:        experienceinterfaces.NewExperienceHandler(nil, nil, nil, nil, nil),
+		sharing:           experienceinterfaces.NewSharingHandler(nil, nil, nil),
+		meditationType:    experienceinterfaces.NewMeditationTypeHandler(nil, nil, nil),
+		timerSession:      experienceinterfaces.NewTimerSessionHandler(nil, nil, nil),
+		history:           experienceinterfaces.NewHistoryHandler(nil, nil),
+		analytics:         analyticsinterfaces.NewAnalyticsHandler(nil),
+		goal:              goalinterfaces.NewGoalHandler(nil),
+		badge:             achievementinterfaces.NewBadgeHandler(nil),
+		room:              roominterfaces.NewRoomHandler(nil, nil, nil, nil, nil),
+		moderation:        moderationinterfaces.NewModerationHandler(nil, nil, nil, nil),
+		notification:      notificationinterfaces.NewNotificationHandler(nil, nil, nil, nil, nil),
+		webhook:           webhookinterfaces.NewWebhookHandler(nil),
+		health:            interfaces.NewHealthHandler(nil),
+		routes:            interfaces.NewRoutesHandler(),
+		sessionMiddleware: session.NewMiddleware(nil),
+		idempotency:       passThrough,
+		requireAdmin:      passThrough,
+	}, openapi.Options{Info: openapi.Info{Title: "ZenConnect API", Version: "test"}})
+
+	return e, document, problems
+}
+
+func TestRegisterRoutes_EveryRouteShouldBeDocumented(t *testing.T) {
+	// given
+	e, document, problems := newTestRoutes(t)
+
+	// then
+	for _, problem := range problems {
+		t.Errorf("OpenAPI document out of sync: %s", problem)
+	}
+	for _, route := range e.Routes() {
+		if route.Method == echo.RouteNotFound {
+			continue
+		}
+		path := route.Path
+		for _, segment := range strings.Split(path, "/") {
+			if strings.HasPrefix(segment, ":") {
+				path = strings.Replace(path, segment, "{"+segment[1:]+"}", 1)
+			}
+		}
+		path = strings.Replace(path, "*", "{path}", 1)
+
+		item, ok := document.Paths[path]
+		if !ok || (*item)[strings.ToLower(route.Method)] == nil {
+			t.Errorf("Expected %s %s to be in the OpenAPI document", route.Method, route.Path)
+		}
+	}
+}
+
+func TestRegisterRoutes_DocumentShouldBeValidJSON(t *testing.T) {
+	// given
+	_, document, _ := newTestRoutes(t)
+
+	// when
+	data, err := json.Marshal(document)
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected document to marshal, got %v", err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Expected valid JSON, got %v", err)
+	}
+	if decoded["openapi"] != openapi.Version {
+		t.Errorf("Expected openapi %s, got %v", openapi.Version, decoded["openapi"])
+	}
+	if !strings.Contains(string(data), `"#/components/schemas/ExperienceDTO"`) {
+		t.Error("Expected experience DTO to be referenced from components")
+	}
+}
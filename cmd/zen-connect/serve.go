@@ -0,0 +1,603 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+	"zen-connect/migrations"
+	"zen-connect/internal/infrastructure/auth0"
+	"zen-connect/internal/infrastructure/config"
+	"zen-connect/internal/infrastructure/idempotency"
+	"zen-connect/internal/infrastructure/logger"
+	"zen-connect/internal/infrastructure/mail"
+	"zen-connect/internal/infrastructure/postgres"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/ratelimit"
+	"zen-connect/internal/infrastructure/scheduler"
+	"zen-connect/internal/infrastructure/security"
+	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/infrastructure/validation"
+	"zen-connect/internal/infrastructure/webpush"
+	"zen-connect/internal/shared/interfaces"
+	"zen-connect/internal/shared/openapi"
+	"zen-connect/internal/user/infrastructure"
+	userdomain "zen-connect/internal/user/domain"
+	userservice "zen-connect/internal/user/application/service"
+	userusecase "zen-connect/internal/user/application/usecase"
+	userinterfaces "zen-connect/internal/user/interfaces"
+	authinterfaces "zen-connect/internal/auth/interfaces"
+	experiencedomain "zen-connect/internal/experience/domain"
+	experienceservice "zen-connect/internal/experience/application/service"
+	experienceusecase "zen-connect/internal/experience/application/usecase"
+	experienceinfra "zen-connect/internal/experience/infrastructure"
+	experienceinterfaces "zen-connect/internal/experience/interfaces"
+	roomservice "zen-connect/internal/room/application/service"
+	roomusecase "zen-connect/internal/room/application/usecase"
+	roominfra "zen-connect/internal/room/infrastructure"
+	roominterfaces "zen-connect/internal/room/interfaces"
+	moderationdomain "zen-connect/internal/moderation/domain"
+	moderationservice "zen-connect/internal/moderation/application/service"
+	moderationusecase "zen-connect/internal/moderation/application/usecase"
+	moderationinfra "zen-connect/internal/moderation/infrastructure"
+	moderationinterfaces "zen-connect/internal/moderation/interfaces"
+	notificationservice "zen-connect/internal/notification/application/service"
+	notificationusecase "zen-connect/internal/notification/application/usecase"
+	notificationinfra "zen-connect/internal/notification/infrastructure"
+	notificationinterfaces "zen-connect/internal/notification/interfaces"
+	webhookdomain "zen-connect/internal/webhook/domain"
+	webhookusecase "zen-connect/internal/webhook/application/usecase"
+	webhookinfra "zen-connect/internal/webhook/infrastructure"
+	analyticsusecase "zen-connect/internal/analytics/application/usecase"
+	analyticsinfra "zen-connect/internal/analytics/infrastructure"
+	analyticsinterfaces "zen-connect/internal/analytics/interfaces"
+	goaldomain "zen-connect/internal/goal/domain"
+	goalusecase "zen-connect/internal/goal/application/usecase"
+	goalinfra "zen-connect/internal/goal/infrastructure"
+	goalinterfaces "zen-connect/internal/goal/interfaces"
+	achievementusecase "zen-connect/internal/achievement/application/usecase"
+	achievementinfra "zen-connect/internal/achievement/infrastructure"
+	achievementinterfaces "zen-connect/internal/achievement/interfaces"
+	webhookinterfaces "zen-connect/internal/webhook/interfaces"
+	"zen-connect/internal/shared/event"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// runServer starts the API server and blocks until a shutdown signal is received
+func runServer(cfg *config.Config) {
+	// Initialize logger
+	loggerConfig := cfg.LoggerConfig()
+	if err := logger.Initialize(loggerConfig); err != nil {
+		log.Fatal("Failed to initialize logger:", err)
+	}
+	defer logger.Close()
+
+	// Use structured logging from here on
+	logger.Info("Starting zen-connect application",
+		zap.String("service", loggerConfig.ServiceName),
+		zap.String("version", loggerConfig.ServiceVersion),
+		zap.String("environment", loggerConfig.Environment),
+	)
+
+	ctx := context.Background()
+
+	// Initialize PostgreSQL client
+	logger.Info("Initializing PostgreSQL client")
+	pgClient, err := postgres.NewClient(ctx, cfg.PostgresConfig())
+	if err != nil {
+		logger.Fatal("Failed to create PostgreSQL client", zap.Error(err))
+	}
+	defer func() {
+		logger.Info("Closing PostgreSQL client")
+		pgClient.Close()
+	}()
+	logger.Info("PostgreSQL client initialized successfully")
+
+	// Apply pending migrations before any repository uses the pool
+	if cfg.Database.AutoMigrate {
+		logger.Info("Applying database migrations")
+		migrator, err := postgres.NewMigrator(pgClient.Pool, migrations.FS)
+		if err != nil {
+			logger.Fatal("Failed to load database migrations", zap.Error(err))
+		}
+		applied, err := migrator.Up(ctx, 0)
+		if err != nil {
+			logger.Fatal("Failed to apply database migrations", zap.Error(err))
+		}
+		logger.Info("Database migrations applied", zap.Int("applied", len(applied)))
+	}
+
+	// Initialize repositories
+	logger.Info("Initializing repositories")
+	userRepo := infrastructure.NewPostgresUserRepository(pgClient.Pool)
+	experienceRepo := experienceinfra.NewPostgresExperienceRepository(pgClient.Pool)
+	meditationTypeRepo := experienceinfra.NewPostgresMeditationTypeRepository(pgClient.Pool)
+	timerSessionRepo := experienceinfra.NewPostgresTimerSessionRepository(pgClient.Pool)
+	reactionRepo := experienceinfra.NewPostgresReactionRepository(pgClient.Pool)
+	commentRepo := experienceinfra.NewPostgresCommentRepository(pgClient.Pool)
+	roomRepo := roominfra.NewPostgresRoomRepository(pgClient.Pool)
+	reportRepo := moderationinfra.NewPostgresReportRepository(pgClient.Pool)
+	moderationActionRepo := moderationinfra.NewPostgresActionRepository(pgClient.Pool)
+	suspensionRepo := moderationinfra.NewPostgresSuspensionRepository(pgClient.Pool)
+	notificationRepo := notificationinfra.NewPostgresNotificationRepository(pgClient.Pool)
+	notificationPreferenceRepo := notificationinfra.NewPostgresPreferenceRepository(pgClient.Pool)
+	notificationDigestRepo := notificationinfra.NewPostgresDigestRepository(pgClient.Pool)
+	reminderRepo := notificationinfra.NewPostgresReminderRepository(pgClient.Pool)
+	pushSubscriptionRepo := notificationinfra.NewPostgresPushSubscriptionRepository(pgClient.Pool)
+
+	// Background jobs run on the one instance holding the scheduler's
+	// advisory lock; their state survives restarts and failed runs are retried
+	jobs := scheduler.New(scheduler.NewPostgresStore(pgClient.Pool),
+		scheduler.NewAdvisoryLockLeader(pgClient.Pool, cfg.Scheduler.LockKey), scheduler.Config{
+			PollInterval: cfg.Scheduler.PollInterval,
+			RetryBackoff: cfg.Scheduler.RetryBackoff,
+			OnError: func(job string, err error) {
+				logger.Error("Scheduled job failed", zap.String("job", job), zap.Error(err))
+			},
+		})
+
+	// Meditation types are managed by admins, so the catalog is loaded from
+	// the database and cached for request validation and use cases
+	meditationTypeCatalog := experienceservice.NewMeditationTypeCatalogService(meditationTypeRepo, cfg.Meditation.CatalogCacheTTL)
+
+	// Notes, journals and comments are checked against the blocked keywords
+	// before they are saved; suspended accounts are rejected on every
+	// authenticated request
+	contentFilter := moderationservice.NewContentFilterService(cfg.Moderation.BlockedKeywords)
+	accountStatus := moderationservice.NewAccountStatusService(suspensionRepo, cfg.Moderation.SuspensionCacheTTL)
+
+	// Domain events are delivered in process to the handlers registered on the bus
+	eventBus := event.NewInMemoryEventBus()
+	experienceEvents := event.NewPublisher[experiencedomain.DomainEvent](eventBus, "experience")
+	userEvents := event.NewPublisher[userdomain.DomainEvent](eventBus, "user")
+
+	// Notifications are created from domain events and delivered to the
+	// channels each user has turned on; the inbox is always the first channel
+	notificationDispatcher := notificationservice.NewDispatcher(notificationPreferenceRepo,
+		notificationservice.NewInboxChannel(notificationRepo))
+	notifyUseCase := notificationusecase.NewNotifyUseCase(notificationDispatcher)
+	notificationinfra.NewEventHandler(notifyUseCase, cfg.Admin.UserIDs).Subscribe(eventBus)
+
+	// Unsubscribe links are signed with their own secret; without it (mail
+	// disabled) no token is accepted
+	unsubscribeTokens := notificationservice.NewUnsubscribeTokens(cfg.Mail.UnsubscribeSecret.Value())
+
+	// Users' practice as seen by the notification context: weekly summaries,
+	// and reminders that are skipped once the user has meditated that day
+	practiceSummaries := notificationinfra.NewPracticeAdapter(experienceusecase.NewPracticeSummaryUseCase(experienceRepo))
+	sendRemindersUseCase := notificationusecase.NewSendRemindersUseCase(reminderRepo, practiceSummaries, notifyUseCase)
+	jobs.Register("meditation_reminders", scheduler.Every(time.Minute), countedJob("Sent meditation reminders", sendRemindersUseCase.Execute))
+
+	// Initialize session store
+	logger.Info("Initializing session store")
+	sessionStore, err := session.NewCookieStore(cfg.SessionConfig())
+	if err != nil {
+		logger.Fatal("Failed to create session store", zap.Error(err))
+	}
+	logger.Info("Session store initialized successfully")
+
+	// Initialize Auth0 configuration
+	auth0Config := cfg.Auth0Config()
+	logger.Info("Auth0 configuration loaded",
+		zap.String("domain", auth0Config.Domain),
+		zap.String("audience", auth0Config.Audience),
+	)
+
+	// Initialize OIDC provider
+	logger.Info("Initializing OIDC provider")
+	provider, err := oidc.NewProvider(ctx, auth0Config.IssuerURL())
+	if err != nil {
+		logger.Fatal("Failed to create OIDC provider", zap.Error(err))
+	}
+	logger.Info("OIDC provider initialized successfully")
+
+	// Initialize Auth0 service
+	logger.Info("Initializing Auth0 service")
+	authService, err := auth0.NewAuthService(auth0Config)
+	if err != nil {
+		logger.Fatal("Failed to create Auth0 service", zap.Error(err))
+	}
+	logger.Info("Auth0 service initialized successfully")
+
+	// Initialize session middleware
+	logger.Info("Initializing session middleware")
+	sessionMiddleware := session.NewMiddleware(sessionStore)
+	sessionMiddleware.UseAccountGuard(accountStatus)
+
+	// Create Echo instance
+	e := echo.New()
+	e.HideBanner = true
+	e.HidePort = true
+	e.IPExtractor = cfg.IPExtractor()
+
+	// Render every error as an RFC 7807 problem with a stable code
+	e.HTTPErrorHandler = problem.NewErrorHandler(problem.Config{
+		Mappings: errorMappings(),
+	})
+
+	// Validate request DTOs through c.Validate
+	requestValidator, err := validation.New(validationRules(meditationTypeCatalog)...)
+	if err != nil {
+		logger.Fatal("Failed to create request validator", zap.Error(err))
+	}
+	e.Validator = requestValidator
+
+	// Global middleware with structured logging
+	e.Use(logger.RequestLoggerMiddleware())
+	e.Use(logger.SessionLoggerMiddleware())
+	e.Use(logger.ErrorLoggerMiddleware())
+	e.Use(logger.RecoveryLoggerMiddleware())
+	
+	// CORS middleware with proper configuration for authentication
+	logger.Info("CORS configuration",
+		zap.String("frontend_url", cfg.Server.FrontendURL),
+		zap.Strings("allow_origins", cfg.Server.CORS.AllowOrigins),
+		zap.Strings("allow_methods", cfg.Server.CORS.AllowMethods),
+	)
+	
+	corsMiddleware, err := security.CORS(cfg.CORSConfig())
+	if err != nil {
+		logger.Fatal("Invalid CORS configuration", zap.Error(err))
+	}
+	e.Use(corsMiddleware)
+	e.Use(security.SecurityHeaders(cfg.SecurityHeadersConfig()))
+
+	// Rate limiting: general limits per route and user/IP, stricter limits
+	// with lockout for the login flow
+	if cfg.RateLimit.Enabled {
+		rateLimitStore := ratelimit.NewMemoryStore(time.Hour)
+		loginPaths := map[string]bool{
+			"/auth/login":         true,
+			"/auth/callback":      true,
+			"/api/auth/login-url": true,
+		}
+		e.Use(ratelimit.Middleware(ratelimit.Config{
+			Name:               "login",
+			Store:              rateLimitStore,
+			Limit:              cfg.LoginRateLimit(),
+			Lockout:            cfg.RateLimit.LoginLockout,
+			SharedAcrossRoutes: true,
+			Skipper: func(c echo.Context) bool {
+				return !loginPaths[c.Path()]
+			},
+		}))
+		e.Use(ratelimit.Middleware(ratelimit.Config{
+			Name:  "api",
+			Store: rateLimitStore,
+			Limit: cfg.APIRateLimit(),
+			UserID: func(c echo.Context) (string, bool) {
+				sessionData, err := sessionStore.GetSession(c)
+				if err != nil {
+					return "", false
+				}
+				return sessionData.UserID, true
+			},
+			Skipper: func(c echo.Context) bool {
+				return loginPaths[c.Path()] || c.Path() == "/health"
+			},
+		}))
+	}
+
+	// CSRF protection for cookie-authenticated state-changing requests
+	e.Use(sessionMiddleware.RequireCSRF())
+
+	// Initialize new architecture components
+	logger.Info("Initializing new architecture components")
+	
+	// User service
+	userService := userservice.NewUserService(userRepo, userEvents)
+
+	// Email: notifications are collected into digests, account emails follow
+	// registration and address confirmation, and a weekly practice summary
+	// goes out every Monday. Everything is queued in the database and sent by
+	// a background worker that retries failures.
+	if cfg.Mail.Enabled {
+		mailSender, err := newMailSender(cfg)
+		if err != nil {
+			logger.Fatal("Failed to create mail sender", zap.Error(err))
+		}
+		mailFrom, err := mail.ParseAddress(cfg.Mail.From)
+		if err != nil {
+			logger.Fatal("Invalid mail sender address", zap.Error(err))
+		}
+		mailTemplates, err := notificationinfra.LoadMailTemplates(cfg.Mail.DefaultLanguage)
+		if err != nil {
+			logger.Fatal("Failed to load mail templates", zap.Error(err))
+		}
+		mailStore := mail.NewPostgresStore(pgClient.Pool)
+		mailer := notificationinfra.NewTemplateMailer(mail.NewOutbox(mailStore, mailFrom), mailTemplates, notificationinfra.MailLinks{
+			AppURL:         cfg.Server.FrontendURL,
+			UnsubscribeURL: strings.TrimRight(cfg.Server.APIURL, "/") + "/notifications/unsubscribe",
+		})
+		mailRecipients := notificationinfra.NewRecipientAdapter(userService)
+
+		notificationDispatcher.Register(notificationservice.NewEmailChannel(notificationDigestRepo))
+		notificationinfra.NewAccountMailHandler(
+			notificationusecase.NewAccountMailUseCase(notificationPreferenceRepo, mailRecipients, mailer)).Subscribe(eventBus)
+		digestUseCase := notificationusecase.NewDigestUseCase(notificationDigestRepo, notificationPreferenceRepo,
+			mailRecipients, mailer, unsubscribeTokens, cfg.Mail.DigestInterval)
+		weeklySummaryUseCase := notificationusecase.NewWeeklySummaryUseCase(
+			practiceSummaries, notificationPreferenceRepo, mailRecipients, mailer, unsubscribeTokens, cfg.WeeklySummaryLocation())
+		mailWorker := mail.NewWorker(mailStore, mailSender, cfg.MailWorkerConfig())
+
+		jobs.Register("mail_queue", scheduler.Every(cfg.Mail.PollInterval), countedJob("Sent queued mail", mailWorker.ProcessDue))
+		jobs.Register("notification_digests", scheduler.Every(cfg.Mail.PollInterval), countedJob("Queued notification digests", digestUseCase.SendDue))
+		// Checked hourly so that the summary goes out soon after the week ends
+		// in its time zone; each week is summarized once
+		jobs.Register("weekly_summaries", scheduler.Every(time.Hour), countedJob("Queued weekly summaries", weeklySummaryUseCase.Execute))
+		logger.Info("Mail delivery enabled", zap.String("sender", cfg.Mail.Sender))
+	}
+
+	// Web Push: notifications are sent to every browser the user subscribed;
+	// subscriptions the push service reports gone are dropped on delivery and
+	// expired ones once a day
+	pushPublicKey := ""
+	if cfg.Push.Enabled {
+		vapid, err := cfg.PushVAPID()
+		if err != nil {
+			logger.Fatal("Invalid VAPID key", zap.Error(err))
+		}
+		pushPublicKey = vapid.PublicKey()
+		pushSender := notificationinfra.NewWebPushSender(webpush.NewSender(vapid, nil), cfg.Push.TTL)
+		notificationDispatcher.Register(notificationservice.NewPushChannel(pushSubscriptionRepo, pushSender))
+		logger.Info("Push notifications enabled")
+	}
+	pushSubscriptionUseCase := notificationusecase.NewPushSubscriptionUseCase(pushSubscriptionRepo, pushPublicKey)
+	jobs.Register("prune_push_subscriptions", scheduler.Every(24*time.Hour),
+		countedJob("Deleted expired push subscriptions", pushSubscriptionUseCase.PruneExpired))
+
+	// Outgoing webhooks: events are queued as deliveries while they are
+	// published and sent in the background, retrying failed ones with backoff
+	webhookRepo := webhookinfra.NewPostgresWebhookRepository(pgClient.Pool)
+	webhookDeliveryRepo := webhookinfra.NewPostgresDeliveryRepository(pgClient.Pool)
+	webhookSender := webhookinfra.NewHTTPSender(webhookinfra.HTTPSenderConfig{
+		Timeout:              cfg.Webhook.Timeout,
+		AllowPrivateNetworks: cfg.Webhook.AllowPrivateNetworks,
+		UserAgent:            "ZenConnect-Webhook/" + cfg.Service.Version,
+	})
+	webhookRetryPolicy := webhookdomain.RetryPolicy{
+		MaxAttempts: cfg.Webhook.MaxAttempts,
+		Backoff:     cfg.Webhook.RetryBackoff,
+		MaxBackoff:  6 * time.Hour,
+	}
+	deliverWebhooksUseCase := webhookusecase.NewDeliverUseCase(
+		webhookRepo, webhookDeliveryRepo, webhookSender, webhookRetryPolicy, cfg.Webhook.DeliveryRetention)
+	webhookinfra.NewEventHandler(webhookusecase.NewPublishEventUseCase(webhookRepo, webhookDeliveryRepo)).Subscribe(eventBus)
+	webhookUseCase := webhookusecase.NewWebhookUseCase(webhookRepo, webhookDeliveryRepo, deliverWebhooksUseCase)
+	jobs.Register("webhook_deliveries", scheduler.Every(cfg.Webhook.PollInterval),
+		countedJob("Sent webhook deliveries", deliverWebhooksUseCase.ProcessDue))
+	jobs.Register("purge_webhook_deliveries", scheduler.Every(24*time.Hour),
+		countedJob("Deleted old webhook deliveries", deliverWebhooksUseCase.PurgeCompleted))
+
+	// Emotion analytics: rollups follow the experience events, and
+	// `analytics rebuild` fills them from experiences recorded before
+	emotionRollupRepo := analyticsinfra.NewPostgresEmotionRollupRepository(pgClient.Pool)
+	analyticsinfra.NewEventHandler(analyticsusecase.NewRollupUseCase(
+		analyticsinfra.NewExperienceAdapter(experienceRepo), emotionRollupRepo)).Subscribe(eventBus)
+	getEmotionTrendUseCase := analyticsusecase.NewGetEmotionTrendUseCase(emotionRollupRepo)
+
+	// Practice goals are checked as experiences are recorded and changed
+	goalUseCase := goalusecase.NewGoalUseCase(goalinfra.NewPostgresGoalRepository(pgClient.Pool),
+		goalinfra.NewExperienceAdapter(experienceRepo), event.NewPublisher[goaldomain.DomainEvent](eventBus, "goal"))
+	goalinfra.NewEventHandler(goalUseCase).Subscribe(eventBus)
+
+	// Badges are awarded on experiences and goal achievements; `achievements
+	// rebuild` awards those earned before a badge was added
+	achievementRepo := achievementinfra.NewPostgresAchievementRepository(pgClient.Pool)
+	achievementinfra.NewEventHandler(achievementusecase.NewAwardUseCase(
+		achievementinfra.NewPracticeAdapter(experienceRepo, reminderRepo), achievementRepo)).Subscribe(eventBus)
+	badgeUseCase := achievementusecase.NewBadgeUseCase(achievementRepo)
+
+	// Initialize new auth handler with UserService
+	logger.Info("Initializing new auth handler")
+	newAuthHandler, err := authinterfaces.NewAuthHandler(authService, userService, sessionStore, provider, auth0Config, cfg.Server.FrontendURL)
+	if err != nil {
+		logger.Fatal("Failed to create new auth handler", zap.Error(err))
+	}
+	logger.Info("New auth handler initialized successfully")
+
+	// Idempotency-Key support for creation endpoints, scoped per user (or client IP)
+	idempotencyStore := idempotency.NewPostgresStore(pgClient.Pool)
+	idempotencyMiddleware := idempotency.Middleware(idempotency.Config{
+		Store: idempotencyStore,
+		TTL:   cfg.Idempotency.TTL,
+		Scope: func(c echo.Context) string {
+			if userID, ok := session.GetUserIDFromContext(c.Request().Context()); ok {
+				return "user:" + userID
+			}
+			return "ip:" + c.RealIP()
+		},
+	})
+	jobs.Register("purge_idempotency_keys", scheduler.Every(time.Hour), func(ctx context.Context) error {
+		deleted, err := idempotencyStore.DeleteExpired(ctx)
+		if err == nil {
+			logger.Debug("Purged expired idempotency keys", zap.Int64("deleted", deleted))
+		}
+		return err
+	})
+
+	// User use cases
+	getUserProfileUseCase := userusecase.NewGetUserProfileUseCase(userService)
+	getPublicProfileUseCase := userusecase.NewGetPublicProfileUseCase(userService, infrastructure.NewBadgeAdapter(badgeUseCase))
+
+	// Experience use cases
+	createExperienceUseCase := experienceusecase.NewCreateExperienceUseCase(experienceRepo, meditationTypeCatalog, contentFilter, experienceEvents)
+	listMeditationTypesUseCase := experienceusecase.NewListMeditationTypesUseCase(meditationTypeCatalog)
+	createMeditationTypeUseCase := experienceusecase.NewCreateMeditationTypeUseCase(meditationTypeRepo, meditationTypeCatalog)
+	updateMeditationTypeUseCase := experienceusecase.NewUpdateMeditationTypeUseCase(meditationTypeRepo, meditationTypeCatalog)
+	completeExperienceUseCase := experienceusecase.NewCompleteExperienceUseCase(experienceRepo, contentFilter, experienceEvents)
+	updateJournalUseCase := experienceusecase.NewUpdateJournalUseCase(experienceRepo, contentFilter)
+	importHistoryUseCase := experienceusecase.NewImportHistoryUseCase(
+		experienceRepo, meditationTypeCatalog, contentFilter, experienceinfra.NewHistoryDecoder())
+	// Imports only store their experiences; what follows runs once per import here
+	processImportBatchesUseCase := experienceusecase.NewProcessImportBatchesUseCase(
+		experienceinfra.NewPostgresImportBatchRepository(pgClient.Pool), experienceEvents)
+	jobs.Register("import_batches", scheduler.Every(time.Minute),
+		countedJob("Processed history imports", processImportBatchesUseCase.Execute))
+	exportHistoryUseCase := experienceusecase.NewExportHistoryUseCase(experienceRepo, meditationTypeCatalog, experienceinfra.NewHistoryEncoder())
+	searchExperiencesUseCase := experienceusecase.NewSearchExperiencesUseCase(experienceRepo, meditationTypeCatalog)
+	listTagsUseCase := experienceusecase.NewListTagsUseCase(experienceRepo)
+	visibilityUseCase := experienceusecase.NewExperienceVisibilityUseCase(experienceRepo, experienceEvents)
+	reactionUseCase := experienceusecase.NewReactionUseCase(experienceRepo, reactionRepo, experienceEvents)
+	commentUseCase := experienceusecase.NewCommentUseCase(experienceRepo, commentRepo, contentFilter, experienceEvents)
+
+	// Live timer use cases; timers without activity are finished in the background
+	abandonTimeout := cfg.Meditation.TimerAbandonTimeout
+	startTimerSessionUseCase := experienceusecase.NewStartTimerSessionUseCase(timerSessionRepo, meditationTypeCatalog, abandonTimeout)
+	controlTimerSessionUseCase := experienceusecase.NewControlTimerSessionUseCase(timerSessionRepo, abandonTimeout)
+	finishTimerSessionUseCase := experienceusecase.NewFinishTimerSessionUseCase(timerSessionRepo, experienceEvents, abandonTimeout)
+	finishAbandonedTimersUseCase := experienceusecase.NewFinishAbandonedTimerSessionsUseCase(timerSessionRepo, experienceEvents, abandonTimeout)
+	jobs.Register("finish_abandoned_timers", scheduler.Every(cfg.Meditation.TimerSweepInterval),
+		countedJob("Finished abandoned timer sessions", finishAbandonedTimersUseCase.Execute))
+
+	// Group meditation rooms run in this process; participants' sessions are
+	// recorded as draft experiences when a room ends
+	roomExperienceAdapter := roominfra.NewExperienceAdapter(meditationTypeCatalog,
+		experienceusecase.NewRecordDraftExperienceUseCase(experienceRepo, meditationTypeCatalog, experienceEvents))
+	roomHub := roomservice.NewHub(roomRepo, roomExperienceAdapter, roomservice.HubConfig{
+		StartCountdown:  cfg.Rooms.StartCountdown,
+		MaxParticipants: cfg.Rooms.MaxParticipants,
+		OnError: func(roomID string, err error) {
+			logger.Error("Group meditation room failed", zap.String("room_id", roomID), zap.Error(err))
+		},
+	})
+	defer roomHub.Shutdown()
+	createRoomUseCase := roomusecase.NewCreateRoomUseCase(roomRepo, roomExperienceAdapter)
+	listRoomsUseCase := roomusecase.NewListRoomsUseCase(roomRepo)
+	getRoomUseCase := roomusecase.NewGetRoomUseCase(roomRepo)
+
+	// Moderation: users report content, admins work through the queue. Reports
+	// and decisions are published so moderators and owners can be notified.
+	moderationContent := moderationinfra.NewContentAdapter(
+		experienceusecase.NewModerateContentUseCase(experienceRepo, commentRepo), userService)
+	moderationEvents := event.NewPublisher[moderationdomain.DomainEvent](eventBus, "moderation")
+	submitReportUseCase := moderationusecase.NewSubmitReportUseCase(reportRepo, moderationContent, moderationEvents)
+	moderationQueueUseCase := moderationusecase.NewModerationQueueUseCase(reportRepo, moderationActionRepo, suspensionRepo)
+	takeActionUseCase := moderationusecase.NewTakeActionUseCase(reportRepo, moderationActionRepo, moderationContent, accountStatus, moderationEvents)
+	liftSuspensionUseCase := moderationusecase.NewLiftSuspensionUseCase(suspensionRepo, accountStatus, moderationEvents)
+
+	// Notification inbox and preferences
+	inboxUseCase := notificationusecase.NewInboxUseCase(notificationRepo)
+	notificationPreferencesUseCase := notificationusecase.NewPreferencesUseCase(notificationPreferenceRepo, notificationDispatcher)
+	unsubscribeUseCase := notificationusecase.NewUnsubscribeUseCase(notificationPreferenceRepo, unsubscribeTokens)
+	reminderUseCase := notificationusecase.NewReminderUseCase(reminderRepo)
+
+	// WebSocket connections are accepted from the same origins as CORS requests
+	allowedOrigins, err := security.NewOriginMatcher(cfg.CORSConfig().AllowOrigins)
+	if err != nil {
+		logger.Fatal("Invalid CORS configuration", zap.Error(err))
+	}
+
+	// Setup routes
+	logger.Info("Setting up application routes")
+	_, undocumented := registerRoutes(e, apiHandlers{
+		auth: newAuthHandler,
+		// Users are registered through the Auth0 callback, so the password
+		// registration use case is not wired. The callback is a browser
+		// redirect that cannot send Idempotency-Key; registration is instead
+		// idempotent on the Auth0 user ID.
+		user:              userinterfaces.NewUserHandler(nil, nil, getUserProfileUseCase, getPublicProfileUseCase),
+		experience: experienceinterfaces.NewExperienceHandler(createExperienceUseCase, completeExperienceUseCase,
+			updateJournalUseCase, searchExperiencesUseCase, listTagsUseCase),
+		sharing: experienceinterfaces.NewSharingHandler(visibilityUseCase, reactionUseCase, commentUseCase),
+		meditationType: experienceinterfaces.NewMeditationTypeHandler(
+			listMeditationTypesUseCase, createMeditationTypeUseCase, updateMeditationTypeUseCase),
+		timerSession: experienceinterfaces.NewTimerSessionHandler(
+			startTimerSessionUseCase, controlTimerSessionUseCase, finishTimerSessionUseCase),
+		history: experienceinterfaces.NewHistoryHandler(importHistoryUseCase, exportHistoryUseCase),
+		analytics: analyticsinterfaces.NewAnalyticsHandler(getEmotionTrendUseCase),
+		goal:      goalinterfaces.NewGoalHandler(goalUseCase),
+		badge:     achievementinterfaces.NewBadgeHandler(badgeUseCase),
+		room: roominterfaces.NewRoomHandler(
+			createRoomUseCase, listRoomsUseCase, getRoomUseCase, roomHub, allowedOrigins.Match),
+		moderation: moderationinterfaces.NewModerationHandler(
+			submitReportUseCase, moderationQueueUseCase, takeActionUseCase, liftSuspensionUseCase),
+		notification: notificationinterfaces.NewNotificationHandler(
+			inboxUseCase, notificationPreferencesUseCase, unsubscribeUseCase, reminderUseCase, pushSubscriptionUseCase),
+		webhook:           webhookinterfaces.NewWebhookHandler(webhookUseCase),
+		health:            interfaces.NewHealthHandler(pgClient.Health),
+		routes:            interfaces.NewRoutesHandler(),
+		sessionMiddleware: sessionMiddleware,
+		idempotency:       idempotencyMiddleware,
+		requireAdmin:      sessionMiddleware.RequireAdmin(cfg.Admin.UserIDs),
+	}, openapi.Options{
+		Info: openapi.Info{
+			Title:   "ZenConnect API",
+			Version: cfg.Service.Version,
+		},
+		ServerURL:         cfg.Server.APIURL,
+		SessionCookieName: cfg.Session.CookieName,
+	})
+	for _, problem := range undocumented {
+		logger.Warn("OpenAPI document is out of sync with routes", zap.String("problem", problem))
+	}
+
+	logger.Info("Routes configured successfully")
+
+	// Every job is registered; start running them
+	schedulerCtx, stopScheduler := context.WithCancel(ctx)
+	schedulerDone := make(chan struct{})
+	go func() {
+		defer close(schedulerDone)
+		jobs.Run(schedulerCtx)
+	}()
+
+	// Start server with graceful shutdown
+	go func() {
+		logger.Info("Starting zen-connect API server",
+			zap.Int("port", cfg.Server.Port),
+			zap.String("auth0_domain", auth0Config.Domain),
+			zap.String("auth0_audience", auth0Config.Audience),
+			zap.String("database_url", "***MASKED***"),
+			zap.String("environment", loggerConfig.Environment),
+		)
+		if err := e.Start(cfg.Server.Addr()); err != nil {
+			logger.Info("Server stopped", zap.Error(err))
+		}
+	}()
+
+	// Wait for interrupt signal to gracefully shutdown the server
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	logger.Info("Shutdown signal received, starting graceful shutdown")
+
+	// Graceful shutdown with timeout
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+	
+	if err := e.Shutdown(shutdownCtx); err != nil {
+		logger.Fatal("Server forced to shutdown", zap.Error(err))
+	}
+
+	// Let the running job finish and hand leadership to another instance
+	stopScheduler()
+	<-schedulerDone
+	
+	logger.Info("Server shutdown completed successfully")
+}
+
+// newMailSender creates the sender configured by MAIL_SENDER
+func newMailSender(cfg *config.Config) (mail.Sender, error) {
+	if cfg.Mail.Sender == "file" {
+		return mail.NewFileSender(cfg.Mail.FileDir)
+	}
+	return mail.NewSMTPSender(cfg.MailSMTPConfig()), nil
+}
+
+// countedJob turns a use case that returns how much it did into a scheduled job that logs it
+func countedJob(message string, run func(ctx context.Context) (int, error)) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		count, err := run(ctx)
+		if count > 0 {
+			logger.Info(message, zap.Int("count", count))
+		}
+		return err
+	}
+}
@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+// BadgeDTO バッジとその獲得日時
+type BadgeDTO struct {
+	BadgeID      string            `json:"badge_id"`
+	Names        map[string]string `json:"names"`
+	Descriptions map[string]string `json:"descriptions"`
+	// Measure 獲得の条件になる記録（sessions、minutes、streak_days、meditation_types、goals_achieved）
+	Measure   string `json:"measure"`
+	Threshold int    `json:"threshold"`
+	// EarnedAt 獲得していないバッジには含まれない
+	EarnedAt *time.Time `json:"earned_at,omitempty"`
+}
+
+// ListBadgesResponse すべてのバッジ（表示順）
+type ListBadgesResponse struct {
+	Badges []BadgeDTO `json:"badges"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"time"
+
+	"zen-connect/internal/achievement/domain"
+)
+
+// FromBadge converts a badge to DTO; earnedAt is nil for badges not earned
+func FromBadge(badge domain.Badge, earnedAt *time.Time) BadgeDTO {
+	return BadgeDTO{
+		BadgeID:      badge.ID,
+		Names:        badge.Names,
+		Descriptions: badge.Descriptions,
+		Measure:      string(badge.Rule.Measure),
+		Threshold:    badge.Rule.Threshold,
+		EarnedAt:     earnedAt,
+	}
+}
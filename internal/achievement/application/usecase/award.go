@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/achievement/domain"
+)
+
+// AwardUseCase 練習の記録と目標の達成からバッジを授与するユースケース
+// 記録から毎回集計し直し、授与済みのバッジは授与しないため、イベントを何度処理しても結果は変わらない
+type AwardUseCase struct {
+	source          PracticeSource
+	achievementRepo domain.AchievementRepository
+}
+
+// NewAwardUseCase コンストラクタ
+func NewAwardUseCase(source PracticeSource, achievementRepo domain.AchievementRepository) *AwardUseCase {
+	return &AwardUseCase{
+		source:          source,
+		achievementRepo: achievementRepo,
+	}
+}
+
+// RecordExperience 体験記録の作成・更新の後に、記録したユーザーのバッジを確認する
+func (uc *AwardUseCase) RecordExperience(ctx context.Context, experienceID string) error {
+	userID, err := uc.source.Owner(ctx, experienceID)
+	if err != nil {
+		return err
+	}
+	_, err = uc.Evaluate(ctx, userID)
+	return err
+}
+
+// RecordImport 履歴の取り込みの後に、取り込んだユーザーのバッジを一度だけ確認する
+func (uc *AwardUseCase) RecordImport(ctx context.Context, userID string) error {
+	_, err := uc.Evaluate(ctx, userID)
+	return err
+}
+
+// RecordGoalAchieved 目標の達成を記録し、ユーザーのバッジを確認する
+func (uc *AwardUseCase) RecordGoalAchieved(ctx context.Context, userID, goalID string, periodStart time.Time) error {
+	if _, err := uc.achievementRepo.AddGoalAchievement(ctx, userID, goalID, periodStart); err != nil {
+		return err
+	}
+	_, err := uc.Evaluate(ctx, userID)
+	return err
+}
+
+// Evaluate 条件を満たしたまだ持っていないバッジを授与し、授与した数を返す
+// 一度授与したバッジは、体験記録を変更して条件を満たさなくなっても取り消さない
+func (uc *AwardUseCase) Evaluate(ctx context.Context, userID string) (int, error) {
+	earnedBadges, err := uc.achievementRepo.Earned(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if len(earnedBadges) == len(domain.Badges()) {
+		return 0, nil
+	}
+	earned := make(map[string]bool, len(earnedBadges))
+	for _, badge := range earnedBadges {
+		earned[badge.BadgeID] = true
+	}
+
+	stats, err := uc.source.Stats(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if stats.GoalsAchieved, err = uc.achievementRepo.CountGoalAchievements(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	awarded := 0
+	now := time.Now()
+	for _, badge := range domain.Earnable(stats, earned) {
+		ok, err := uc.achievementRepo.Award(ctx, userID, badge.ID, now)
+		if err != nil {
+			return awarded, err
+		}
+		if ok {
+			awarded++
+		}
+	}
+	return awarded, nil
+}
+
+// Rebuild 体験記録のあるすべてのユーザーのバッジを確認し、授与した数を返す
+// バッジを追加したときや、イベントの処理に失敗したときの修復に使う
+func (uc *AwardUseCase) Rebuild(ctx context.Context) (int, error) {
+	userIDs, err := uc.source.Practitioners(ctx)
+	if err != nil {
+		return 0, err
+	}
+	total := 0
+	for _, userID := range userIDs {
+		awarded, err := uc.Evaluate(ctx, userID)
+		total += awarded
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
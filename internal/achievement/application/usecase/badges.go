@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/achievement/application/dto"
+	"zen-connect/internal/achievement/domain"
+)
+
+// BadgeUseCase バッジの一覧と獲得したバッジのユースケース
+type BadgeUseCase struct {
+	achievementRepo domain.AchievementRepository
+}
+
+// NewBadgeUseCase コンストラクタ
+func NewBadgeUseCase(achievementRepo domain.AchievementRepository) *BadgeUseCase {
+	return &BadgeUseCase{
+		achievementRepo: achievementRepo,
+	}
+}
+
+// List すべてのバッジと、ユーザーが獲得した日時
+func (uc *BadgeUseCase) List(ctx context.Context, userID string) (*dto.ListBadgesResponse, error) {
+	earnedBadges, err := uc.achievementRepo.Earned(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	earned := make(map[string]*domain.EarnedBadge, len(earnedBadges))
+	for i := range earnedBadges {
+		earned[earnedBadges[i].BadgeID] = &earnedBadges[i]
+	}
+
+	response := &dto.ListBadgesResponse{
+		Badges: make([]dto.BadgeDTO, 0, len(domain.Badges())),
+	}
+	for _, badge := range domain.Badges() {
+		var earnedAt *time.Time
+		if e := earned[badge.ID]; e != nil {
+			earnedAt = &e.EarnedAt
+		}
+		response.Badges = append(response.Badges, dto.FromBadge(badge, earnedAt))
+	}
+	return response, nil
+}
+
+// Earned ユーザーが獲得したバッジ（獲得した順）
+// カタログから外れたバッジは表示しない
+func (uc *BadgeUseCase) Earned(ctx context.Context, userID string) ([]dto.BadgeDTO, error) {
+	earnedBadges, err := uc.achievementRepo.Earned(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]dto.BadgeDTO, 0, len(earnedBadges))
+	for _, earned := range earnedBadges {
+		badge, err := domain.FindBadge(earned.BadgeID)
+		if err != nil {
+			continue
+		}
+		earnedAt := earned.EarnedAt
+		result = append(result, dto.FromBadge(badge, &earnedAt))
+	}
+	return result, nil
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	"zen-connect/internal/achievement/domain"
+)
+
+// PracticeSource 体験記録コンテキストからユーザーの練習の記録を読むポート
+type PracticeSource interface {
+	// Stats ユーザーのすべてのセッションの集計（GoalsAchieved は含まない）
+	Stats(ctx context.Context, userID string) (domain.Stats, error)
+	// Owner 体験記録を記録したユーザー
+	Owner(ctx context.Context, experienceID string) (string, error)
+	// Practitioners 体験記録のあるすべてのユーザー
+	Practitioners(ctx context.Context) ([]string, error)
+}
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Domain errors for badges
+var (
+	ErrBadgeNotFound = errors.New("badge not found")
+)
+
+// Language codes of badge texts
+const (
+	LanguageJapanese = "ja"
+	LanguageEnglish  = "en"
+)
+
+// LocalizedText holds a text per language code
+type LocalizedText map[string]string
+
+// Measure is a figure of a user's practice that badges are awarded on
+type Measure string
+
+const (
+	// MeasureSessions counts every session recorded, drafts included
+	MeasureSessions Measure = "sessions"
+	// MeasureMinutes is the total length of the sessions in minutes
+	MeasureMinutes Measure = "minutes"
+	// MeasureStreakDays is the longest run of consecutive days with a session,
+	// in the time zone of the user's reminder (UTC without one)
+	MeasureStreakDays Measure = "streak_days"
+	// MeasureMeditationTypes counts the distinct meditation types practised
+	MeasureMeditationTypes Measure = "meditation_types"
+	// MeasureGoalsAchieved counts the goals achieved, once per period or streak
+	MeasureGoalsAchieved Measure = "goals_achieved"
+)
+
+// Rule is the condition of a badge: the measure has reached the threshold
+type Rule struct {
+	Measure   Measure
+	Threshold int
+}
+
+// SatisfiedBy reports whether the stats meet the rule
+func (r Rule) SatisfiedBy(stats Stats) bool {
+	return stats.Value(r.Measure) >= r.Threshold
+}
+
+// Badge is an achievement users earn once, when its rule is first met
+type Badge struct {
+	ID           string
+	Names        LocalizedText
+	Descriptions LocalizedText
+	Rule         Rule
+}
+
+// badges is the badge catalog. A badge is awarded as soon as its rule is
+// met; users who already qualify for a new badge get it with their next
+// session or `achievements rebuild`. IDs are stored with the awards and must
+// not change.
+var badges = []Badge{
+	{
+		ID:           "first_sit",
+		Names:        LocalizedText{LanguageJapanese: "はじめの一座", LanguageEnglish: "First Sit"},
+		Descriptions: LocalizedText{LanguageJapanese: "初めて瞑想を記録した", LanguageEnglish: "Recorded your first session"},
+		Rule:         Rule{Measure: MeasureSessions, Threshold: 1},
+	},
+	{
+		ID:           "sessions_100",
+		Names:        LocalizedText{LanguageJapanese: "百座", LanguageEnglish: "Hundred Sits"},
+		Descriptions: LocalizedText{LanguageJapanese: "瞑想を100回記録した", LanguageEnglish: "Recorded 100 sessions"},
+		Rule:         Rule{Measure: MeasureSessions, Threshold: 100},
+	},
+	{
+		ID:           "streak_7",
+		Names:        LocalizedText{LanguageJapanese: "七日連続", LanguageEnglish: "7-Day Streak"},
+		Descriptions: LocalizedText{LanguageJapanese: "7日続けて瞑想した", LanguageEnglish: "Meditated 7 days in a row"},
+		Rule:         Rule{Measure: MeasureStreakDays, Threshold: 7},
+	},
+	{
+		ID:           "streak_30",
+		Names:        LocalizedText{LanguageJapanese: "三十日連続", LanguageEnglish: "30-Day Streak"},
+		Descriptions: LocalizedText{LanguageJapanese: "30日続けて瞑想した", LanguageEnglish: "Meditated 30 days in a row"},
+		Rule:         Rule{Measure: MeasureStreakDays, Threshold: 30},
+	},
+	{
+		ID:           "minutes_1000",
+		Names:        LocalizedText{LanguageJapanese: "千分の静寂", LanguageEnglish: "A Thousand Minutes"},
+		Descriptions: LocalizedText{LanguageJapanese: "合計1000分瞑想した", LanguageEnglish: "Meditated 1000 minutes in total"},
+		Rule:         Rule{Measure: MeasureMinutes, Threshold: 1000},
+	},
+	{
+		ID:           "minutes_10000",
+		Names:        LocalizedText{LanguageJapanese: "万分の静寂", LanguageEnglish: "Ten Thousand Minutes"},
+		Descriptions: LocalizedText{LanguageJapanese: "合計10000分瞑想した", LanguageEnglish: "Meditated 10000 minutes in total"},
+		Rule:         Rule{Measure: MeasureMinutes, Threshold: 10000},
+	},
+	{
+		ID:           "five_types",
+		Names:        LocalizedText{LanguageJapanese: "探求者", LanguageEnglish: "Explorer"},
+		Descriptions: LocalizedText{LanguageJapanese: "5種類の瞑想を試した", LanguageEnglish: "Tried five meditation types"},
+		Rule:         Rule{Measure: MeasureMeditationTypes, Threshold: 5},
+	},
+	{
+		ID:           "first_goal",
+		Names:        LocalizedText{LanguageJapanese: "目標達成", LanguageEnglish: "Goal Getter"},
+		Descriptions: LocalizedText{LanguageJapanese: "初めて目標を達成した", LanguageEnglish: "Achieved a goal for the first time"},
+		Rule:         Rule{Measure: MeasureGoalsAchieved, Threshold: 1},
+	},
+	{
+		ID:           "goals_10",
+		Names:        LocalizedText{LanguageJapanese: "有言実行", LanguageEnglish: "True to Your Word"},
+		Descriptions: LocalizedText{LanguageJapanese: "目標を10回達成した", LanguageEnglish: "Achieved goals 10 times"},
+		Rule:         Rule{Measure: MeasureGoalsAchieved, Threshold: 10},
+	},
+}
+
+// Badges returns the badge catalog in display order
+func Badges() []Badge {
+	return badges
+}
+
+// FindBadge returns a badge of the catalog
+func FindBadge(id string) (Badge, error) {
+	for _, badge := range badges {
+		if badge.ID == id {
+			return badge, nil
+		}
+	}
+	return Badge{}, ErrBadgeNotFound
+}
+
+// Stats is a user's practice as a whole, which badge rules are checked against
+type Stats struct {
+	Sessions          int
+	Minutes           int
+	LongestStreakDays int
+	MeditationTypes   int
+	GoalsAchieved     int
+}
+
+// Value returns the figure of a measure
+func (s Stats) Value(measure Measure) int {
+	switch measure {
+	case MeasureSessions:
+		return s.Sessions
+	case MeasureMinutes:
+		return s.Minutes
+	case MeasureStreakDays:
+		return s.LongestStreakDays
+	case MeasureMeditationTypes:
+		return s.MeditationTypes
+	case MeasureGoalsAchieved:
+		return s.GoalsAchieved
+	}
+	return 0
+}
+
+// Earnable returns the badges whose rules the stats meet and that are not
+// earned yet, in catalog order
+func Earnable(stats Stats, earned map[string]bool) []Badge {
+	var result []Badge
+	for _, badge := range badges {
+		if !earned[badge.ID] && badge.Rule.SatisfiedBy(stats) {
+			result = append(result, badge)
+		}
+	}
+	return result
+}
+
+// EarnedBadge is a badge a user has earned
+type EarnedBadge struct {
+	BadgeID  string
+	EarnedAt time.Time
+}
@@ -0,0 +1,46 @@
+package domain
+
+import "testing"
+
+func TestEarnable_ShouldReturnTheUnearnedBadgesWhoseRulesAreMet(t *testing.T) {
+	// given
+	stats := Stats{Sessions: 12, Minutes: 1000, LongestStreakDays: 7, MeditationTypes: 3}
+	earned := map[string]bool{"first_sit": true}
+
+	// when
+	badges := Earnable(stats, earned)
+
+	// then
+	var ids []string
+	for _, badge := range badges {
+		ids = append(ids, badge.ID)
+	}
+	if len(ids) != 2 || ids[0] != "streak_7" || ids[1] != "minutes_1000" {
+		t.Errorf("Expected streak_7 and minutes_1000, got %v", ids)
+	}
+}
+
+func TestBadges_ShouldHaveUniqueIDsAndTextsInEveryLanguage(t *testing.T) {
+	// given
+	seen := map[string]bool{}
+	ones := Stats{Sessions: 1, Minutes: 1, LongestStreakDays: 1, MeditationTypes: 1, GoalsAchieved: 1}
+
+	for _, badge := range Badges() {
+		// when
+		found, err := FindBadge(badge.ID)
+
+		// then
+		if seen[badge.ID] || err != nil || found.ID != badge.ID {
+			t.Errorf("Expected %s to be found once, got %v", badge.ID, err)
+		}
+		seen[badge.ID] = true
+		for _, language := range []string{LanguageJapanese, LanguageEnglish} {
+			if badge.Names[language] == "" || badge.Descriptions[language] == "" {
+				t.Errorf("Expected %s to have a %s name and description", badge.ID, language)
+			}
+		}
+		if badge.Rule.Threshold < 1 || ones.Value(badge.Rule.Measure) != 1 {
+			t.Errorf("Expected %s to have a known measure and a positive threshold", badge.ID)
+		}
+	}
+}
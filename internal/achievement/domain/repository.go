@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// AchievementRepository persists the badges users have earned and the goal
+// achievements they are awarded on
+type AchievementRepository interface {
+	// Award stores an earned badge; false when the user already has it, so
+	// replayed events never award a badge twice
+	Award(ctx context.Context, userID, badgeID string, earnedAt time.Time) (bool, error)
+	// Earned returns the user's badges, earliest first
+	Earned(ctx context.Context, userID string) ([]EarnedBadge, error)
+	// AddGoalAchievement stores that a goal was achieved in the period
+	// starting at periodStart; false when it is already stored
+	AddGoalAchievement(ctx context.Context, userID, goalID string, periodStart time.Time) (bool, error)
+	// CountGoalAchievements returns how many goal achievements the user has
+	CountGoalAchievements(ctx context.Context, userID string) (int, error)
+}
@@ -0,0 +1,48 @@
+package infrastructure
+
+import (
+	"context"
+
+	"zen-connect/internal/achievement/application/usecase"
+	experiencedomain "zen-connect/internal/experience/domain"
+	goaldomain "zen-connect/internal/goal/domain"
+	"zen-connect/internal/shared/event"
+)
+
+// EventHandler subscribes to the event bus and awards badges as users
+// record experiences and achieve goals
+type EventHandler struct {
+	award *usecase.AwardUseCase
+}
+
+// NewEventHandler creates a new achievement event handler
+func NewEventHandler(award *usecase.AwardUseCase) *EventHandler {
+	return &EventHandler{
+		award: award,
+	}
+}
+
+// Subscribe registers the handler for the events badges are awarded on
+func (h *EventHandler) Subscribe(bus event.EventBus) {
+	for _, name := range []string{
+		"ExperienceCreated",
+		"ExperienceUpdated",
+		"HistoryImported",
+		"GoalAchieved",
+	} {
+		bus.Register(name, h)
+	}
+}
+
+// Handle implements event.EventHandler
+func (h *EventHandler) Handle(ctx context.Context, e event.DomainEvent) error {
+	switch e := e.(type) {
+	case *experiencedomain.ExperienceCreated, *experiencedomain.ExperienceUpdated:
+		return h.award.RecordExperience(ctx, e.AggregateID())
+	case *experiencedomain.HistoryImported:
+		return h.award.RecordImport(ctx, e.UserID())
+	case *goaldomain.GoalAchieved:
+		return h.award.RecordGoalAchieved(ctx, e.UserID(), e.AggregateID(), e.PeriodStart())
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"zen-connect/internal/achievement/domain"
+)
+
+// PostgresAchievementRepository implements AchievementRepository interface
+type PostgresAchievementRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresAchievementRepository creates a new PostgreSQL achievement repository
+func NewPostgresAchievementRepository(pool *pgxpool.Pool) *PostgresAchievementRepository {
+	return &PostgresAchievementRepository{
+		pool: pool,
+	}
+}
+
+// Award inserts the badge unless the user already has it
+func (r *PostgresAchievementRepository) Award(ctx context.Context, userID, badgeID string, earnedAt time.Time) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `
+		INSERT INTO user_badges (user_id, badge_id, earned_at) VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, badge_id) DO NOTHING
+	`, userID, badgeID, earnedAt)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// Earned returns the user's badges, earliest first
+func (r *PostgresAchievementRepository) Earned(ctx context.Context, userID string) ([]domain.EarnedBadge, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT badge_id, earned_at FROM user_badges WHERE user_id = $1 ORDER BY earned_at, badge_id
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var badges []domain.EarnedBadge
+	for rows.Next() {
+		var badge domain.EarnedBadge
+		if err := rows.Scan(&badge.BadgeID, &badge.EarnedAt); err != nil {
+			return nil, err
+		}
+		badges = append(badges, badge)
+	}
+	return badges, rows.Err()
+}
+
+// AddGoalAchievement inserts the achievement unless it is already stored
+func (r *PostgresAchievementRepository) AddGoalAchievement(ctx context.Context, userID, goalID string, periodStart time.Time) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `
+		INSERT INTO goal_achievements (goal_id, period_start, user_id) VALUES ($1, $2, $3)
+		ON CONFLICT (goal_id, period_start) DO NOTHING
+	`, goalID, periodStart, userID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// CountGoalAchievements returns how many goal achievements the user has
+func (r *PostgresAchievementRepository) CountGoalAchievements(ctx context.Context, userID string) (int, error) {
+	var count int
+	err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM goal_achievements WHERE user_id = $1`, userID).Scan(&count)
+	return count, err
+}
@@ -0,0 +1,70 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"zen-connect/internal/achievement/domain"
+	experiencedomain "zen-connect/internal/experience/domain"
+	notificationdomain "zen-connect/internal/notification/domain"
+)
+
+// PracticeAdapter reads users' practice totals from the experience context
+type PracticeAdapter struct {
+	experienceRepo experiencedomain.ExperienceRepository
+	reminderRepo   notificationdomain.ReminderRepository
+}
+
+// NewPracticeAdapter creates a new practice adapter; the time zone of a
+// user's daily reminder is the one their streak days are counted in
+func NewPracticeAdapter(experienceRepo experiencedomain.ExperienceRepository, reminderRepo notificationdomain.ReminderRepository) *PracticeAdapter {
+	return &PracticeAdapter{
+		experienceRepo: experienceRepo,
+		reminderRepo:   reminderRepo,
+	}
+}
+
+// Stats totals every session of the user, drafts included
+func (a *PracticeAdapter) Stats(ctx context.Context, userID string) (domain.Stats, error) {
+	location, err := a.location(ctx, userID)
+	if err != nil {
+		return domain.Stats{}, err
+	}
+	totals, err := a.experienceRepo.PracticeTotals(ctx, userID, location)
+	if err != nil {
+		return domain.Stats{}, err
+	}
+	return domain.Stats{
+		Sessions:          totals.Sessions,
+		Minutes:           int(totals.TotalDuration / time.Minute),
+		LongestStreakDays: totals.LongestStreakDays,
+		MeditationTypes:   totals.MeditationTypes,
+	}, nil
+}
+
+// location is the time zone of the user's reminder, or UTC without one
+func (a *PracticeAdapter) location(ctx context.Context, userID string) (*time.Location, error) {
+	reminder, err := a.reminderRepo.FindByUserID(ctx, userID)
+	if errors.Is(err, notificationdomain.ErrReminderNotFound) {
+		return time.UTC, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return time.LoadLocation(reminder.TimeZone())
+}
+
+// Owner returns the user who recorded the experience
+func (a *PracticeAdapter) Owner(ctx context.Context, experienceID string) (string, error) {
+	experience, err := a.experienceRepo.FindByID(ctx, experienceID)
+	if err != nil {
+		return "", err
+	}
+	return experience.UserID(), nil
+}
+
+// Practitioners returns every user with an experience, in the order they first meditated
+func (a *PracticeAdapter) Practitioners(ctx context.Context) ([]string, error) {
+	return a.experienceRepo.Practitioners(ctx)
+}
@@ -0,0 +1,66 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/achievement/application/dto"
+	"zen-connect/internal/achievement/application/usecase"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/shared/openapi"
+)
+
+// BadgeHandler バッジのHTTPハンドラー
+type BadgeHandler struct {
+	badgeUseCase *usecase.BadgeUseCase
+}
+
+// NewBadgeHandler コンストラクタ
+func NewBadgeHandler(badgeUseCase *usecase.BadgeUseCase) *BadgeHandler {
+	return &BadgeHandler{
+		badgeUseCase: badgeUseCase,
+	}
+}
+
+// SetupRoutes バッジ関連のルーティング設定
+func (h *BadgeHandler) SetupRoutes(e *echo.Echo, sessionMiddleware *session.Middleware) {
+	badgeGroup := e.Group("/badges", sessionMiddleware.RequireAuth())
+
+	// すべてのバッジと自分が獲得した日時（他のユーザーのバッジは公開プロフィールで見る）
+	badgeGroup.GET("", h.ListBadges)
+}
+
+// Endpoints OpenAPIドキュメント用のエンドポイント定義
+func (h *BadgeHandler) Endpoints() []openapi.Endpoint {
+	return []openapi.Endpoint{
+		{
+			Method: http.MethodGet, Path: "/badges", Tags: []string{"badges"},
+			Summary: "List every badge and when you earned it",
+			Description: "A badge is earned once, when measure first reaches threshold: sessions and minutes over all your sessions (drafts included), " +
+				"streak_days as the longest run of consecutive days (UTC) with a session, meditation_types as the distinct types practised " +
+				"and goals_achieved as the goal achievements. Earned badges are kept even if experiences change later. " +
+				"earned_at is left out for badges not earned yet.",
+			Security: []string{openapi.SecuritySession},
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.ListBadgesResponse{},
+				http.StatusUnauthorized: nil,
+			},
+		},
+	}
+}
+
+// ListBadges バッジの一覧
+func (h *BadgeHandler) ListBadges(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.badgeUseCase.List(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,60 @@
+package dto
+
+import "time"
+
+// EmotionTrendRequest 感情の推移の集計条件（クエリパラメータ）
+type EmotionTrendRequest struct {
+	UserID string `query:"-"`
+	// From と To は瞑想の開始時刻の範囲（期間の区切りに広げる。既定は直近90日）
+	From time.Time `query:"from"`
+	To   time.Time `query:"to"`
+	// Interval day（既定）、week（月曜始まり）、month
+	Interval string `query:"interval" validate:"omitempty,oneof=day week month"`
+	// TimeZone 期間と時間帯を区切るタイムゾーン（既定はUTC）
+	TimeZone string `query:"time_zone" validate:"max=64"`
+}
+
+// EmotionTotalsDTO 瞑想前後の感情のスコアの平均
+// スコアは 非常に不安 の -1 から 非常に穏やか の 5 まで
+type EmotionTotalsDTO struct {
+	Sessions      int     `json:"sessions"`
+	AverageBefore float64 `json:"average_before"`
+	AverageAfter  float64 `json:"average_after"`
+	// AverageDelta 瞑想後のスコアから瞑想前のスコアを引いた値の平均（大きいほど落ち着いた）
+	AverageDelta float64 `json:"average_delta"`
+}
+
+// TrendPointDTO 一つの期間の平均
+type TrendPointDTO struct {
+	PeriodStart   time.Time `json:"period_start"`
+	Sessions      int       `json:"sessions"`
+	AverageBefore float64   `json:"average_before"`
+	AverageAfter  float64   `json:"average_after"`
+	AverageDelta  float64   `json:"average_delta"`
+}
+
+// TrendBreakdownDTO 瞑想タイプ・時間帯・長さごとの平均
+type TrendBreakdownDTO struct {
+	Key           string  `json:"key"`
+	Sessions      int     `json:"sessions"`
+	AverageBefore float64 `json:"average_before"`
+	AverageAfter  float64 `json:"average_after"`
+	AverageDelta  float64 `json:"average_delta"`
+}
+
+// EmotionTrendResponse 感情の推移と、どの瞑想が効果的だったか
+type EmotionTrendResponse struct {
+	Interval string           `json:"interval"`
+	TimeZone string           `json:"time_zone"`
+	From     time.Time        `json:"from"`
+	To       time.Time        `json:"to"`
+	Overall  EmotionTotalsDTO `json:"overall"`
+	// Series 瞑想した期間ごとの平均（古い順）
+	Series []TrendPointDTO `json:"series"`
+	// ByMeditationType 効果（average_delta）の大きい順
+	ByMeditationType []TrendBreakdownDTO `json:"by_meditation_type"`
+	// ByTimeOfDay night（0-5時）、morning（5-12時）、afternoon（12-17時）、evening（17-24時）
+	ByTimeOfDay []TrendBreakdownDTO `json:"by_time_of_day"`
+	// ByLength under_10、10_to_20、20_to_40、40_plus（分）
+	ByLength []TrendBreakdownDTO `json:"by_length"`
+}
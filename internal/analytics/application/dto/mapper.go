@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"math"
+
+	"zen-connect/internal/analytics/domain"
+)
+
+// FromEmotionTrend 集計結果をレスポンスに変換する
+func FromEmotionTrend(query *domain.TrendQuery, trend *domain.EmotionTrend) *EmotionTrendResponse {
+	response := &EmotionTrendResponse{
+		Interval:         string(query.Interval),
+		TimeZone:         query.Location.String(),
+		From:             query.From,
+		To:               query.To,
+		Overall:          fromTotals(trend.Overall),
+		Series:           make([]TrendPointDTO, 0, len(trend.Series)),
+		ByMeditationType: fromBreakdowns(trend.ByMeditationType),
+		ByTimeOfDay:      fromBreakdowns(trend.ByTimeOfDay),
+		ByLength:         fromBreakdowns(trend.ByLength),
+	}
+	for _, point := range trend.Series {
+		totals := fromTotals(point.Totals)
+		response.Series = append(response.Series, TrendPointDTO{
+			PeriodStart:   point.PeriodStart,
+			Sessions:      totals.Sessions,
+			AverageBefore: totals.AverageBefore,
+			AverageAfter:  totals.AverageAfter,
+			AverageDelta:  totals.AverageDelta,
+		})
+	}
+	return response
+}
+
+func fromBreakdowns(breakdowns []domain.TrendBreakdown) []TrendBreakdownDTO {
+	dtos := make([]TrendBreakdownDTO, 0, len(breakdowns))
+	for _, breakdown := range breakdowns {
+		totals := fromTotals(breakdown.Totals)
+		dtos = append(dtos, TrendBreakdownDTO{
+			Key:           breakdown.Key,
+			Sessions:      totals.Sessions,
+			AverageBefore: totals.AverageBefore,
+			AverageAfter:  totals.AverageAfter,
+			AverageDelta:  totals.AverageDelta,
+		})
+	}
+	return dtos
+}
+
+// fromTotals 平均を小数第2位までにする
+func fromTotals(totals domain.EmotionTotals) EmotionTotalsDTO {
+	round := func(v float64) float64 { return math.Round(v*100) / 100 }
+	return EmotionTotalsDTO{
+		Sessions:      totals.Sessions,
+		AverageBefore: round(totals.AverageBefore()),
+		AverageAfter:  round(totals.AverageAfter()),
+		AverageDelta:  round(totals.AverageDelta()),
+	}
+}
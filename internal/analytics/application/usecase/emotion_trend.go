@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/analytics/application/dto"
+	"zen-connect/internal/analytics/domain"
+)
+
+// GetEmotionTrendUseCase 瞑想前後の感情の推移を集計するユースケース
+type GetEmotionTrendUseCase struct {
+	rollupRepo domain.EmotionRollupRepository
+}
+
+// NewGetEmotionTrendUseCase コンストラクタ
+func NewGetEmotionTrendUseCase(rollupRepo domain.EmotionRollupRepository) *GetEmotionTrendUseCase {
+	return &GetEmotionTrendUseCase{
+		rollupRepo: rollupRepo,
+	}
+}
+
+// Execute 期間内の集計を読み、期間・瞑想タイプ・時間帯・長さごとにまとめる
+func (uc *GetEmotionTrendUseCase) Execute(ctx context.Context, req *dto.EmotionTrendRequest) (*dto.EmotionTrendResponse, error) {
+	location := time.UTC
+	if req.TimeZone != "" {
+		var err error
+		if location, err = time.LoadLocation(req.TimeZone); err != nil {
+			return nil, domain.ErrInvalidTrendTimeZone
+		}
+	}
+	query, err := domain.NewTrendQuery(req.UserID, req.From, req.To, domain.TrendInterval(req.Interval), location, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// 集計は UTC の1時間ごとなので、時差が1時間単位でない地域のために1時間前から読む
+	rollups, err := uc.rollupRepo.Rollups(ctx, query.UserID, query.From.Add(-time.Hour), query.To)
+	if err != nil {
+		return nil, err
+	}
+	return dto.FromEmotionTrend(query, domain.Summarize(query, rollups)), nil
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"zen-connect/internal/analytics/domain"
+)
+
+// ExperienceSource 体験記録コンテキストから感情のサンプルを読むポート
+type ExperienceSource interface {
+	// Sample 体験記録の現在の内容のサンプル
+	Sample(ctx context.Context, experienceID string) (*domain.EmotionSample, error)
+	// EachSample すべての体験記録のサンプルを順に渡す
+	EachSample(ctx context.Context, each func(*domain.EmotionSample) error) error
+}
+
+// RollupUseCase 体験記録の変更を感情の集計に反映するユースケース
+type RollupUseCase struct {
+	source     ExperienceSource
+	rollupRepo domain.EmotionRollupRepository
+}
+
+// NewRollupUseCase コンストラクタ
+func NewRollupUseCase(source ExperienceSource, rollupRepo domain.EmotionRollupRepository) *RollupUseCase {
+	return &RollupUseCase{
+		source:     source,
+		rollupRepo: rollupRepo,
+	}
+}
+
+// Record 体験記録の現在の内容で集計を更新する
+// 同じイベントを何度処理しても結果は変わらない
+func (uc *RollupUseCase) Record(ctx context.Context, experienceID string) error {
+	sample, err := uc.source.Sample(ctx, experienceID)
+	if err != nil {
+		return err
+	}
+	_, err = uc.rollupRepo.Apply(ctx, sample)
+	return err
+}
+
+// RecordAll 取り込んだ体験記録をまとめて集計に反映する
+// 反映できなかった記録があっても残りの記録は反映する
+func (uc *RollupUseCase) RecordAll(ctx context.Context, experienceIDs []string) error {
+	var errs []error
+	for _, experienceID := range experienceIDs {
+		if err := uc.Record(ctx, experienceID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Rebuild すべての体験記録を集計に反映し、更新した件数を返す
+// 導入前の記録の取り込みや、イベントの処理に失敗した記録の修復に使う
+func (uc *RollupUseCase) Rebuild(ctx context.Context) (int, error) {
+	applied := 0
+	err := uc.source.EachSample(ctx, func(sample *domain.EmotionSample) error {
+		ok, err := uc.rollupRepo.Apply(ctx, sample)
+		if ok {
+			applied++
+		}
+		return err
+	})
+	return applied, err
+}
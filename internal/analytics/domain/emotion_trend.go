@@ -0,0 +1,317 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// Errors raised by emotion trend queries
+var (
+	ErrInvalidTrendInterval = errors.New("trend interval must be day, week or month")
+	ErrInvalidTrendRange    = errors.New("trend range must end after it starts")
+	ErrTrendRangeTooLong    = errors.New("trend range has too many periods")
+	ErrInvalidTrendTimeZone = errors.New("trend time zone is not valid")
+)
+
+// MaxTrendPeriods is the most periods one trend may have
+const MaxTrendPeriods = 400
+
+// DefaultTrendRange is how far back a trend without a start goes
+const DefaultTrendRange = 90 * 24 * time.Hour
+
+// LengthBucket groups sessions by how long they were
+type LengthBucket string
+
+const (
+	LengthUnder10 LengthBucket = "under_10"
+	Length10To20  LengthBucket = "10_to_20"
+	Length20To40  LengthBucket = "20_to_40"
+	Length40Plus  LengthBucket = "40_plus"
+)
+
+// LengthBuckets returns the buckets from shortest to longest
+func LengthBuckets() []LengthBucket {
+	return []LengthBucket{LengthUnder10, Length10To20, Length20To40, Length40Plus}
+}
+
+// LengthBucketOf returns the bucket of a session length in minutes
+func LengthBucketOf(length time.Duration) LengthBucket {
+	switch {
+	case length < 10*time.Minute:
+		return LengthUnder10
+	case length < 20*time.Minute:
+		return Length10To20
+	case length < 40*time.Minute:
+		return Length20To40
+	}
+	return Length40Plus
+}
+
+// TimeOfDay groups sessions by the local hour they started
+type TimeOfDay string
+
+const (
+	// Night is 0:00-5:00
+	Night TimeOfDay = "night"
+	// Morning is 5:00-12:00
+	Morning TimeOfDay = "morning"
+	// Afternoon is 12:00-17:00
+	Afternoon TimeOfDay = "afternoon"
+	// Evening is 17:00-24:00
+	Evening TimeOfDay = "evening"
+)
+
+// TimesOfDay returns the times of day in the order of a day
+func TimesOfDay() []TimeOfDay {
+	return []TimeOfDay{Night, Morning, Afternoon, Evening}
+}
+
+// TimeOfDayOf returns the time of day of t in its location
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	switch hour := t.Hour(); {
+	case hour < 5:
+		return Night
+	case hour < 12:
+		return Morning
+	case hour < 17:
+		return Afternoon
+	}
+	return Evening
+}
+
+// TrendInterval is the length of the periods of a trend
+type TrendInterval string
+
+const (
+	IntervalDay   TrendInterval = "day"
+	IntervalWeek  TrendInterval = "week"
+	IntervalMonth TrendInterval = "month"
+)
+
+// start returns the start of the period holding t, in t's location; weeks
+// start on Monday
+func (i TrendInterval) start(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	switch i {
+	case IntervalWeek:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case IntervalMonth:
+		return day.AddDate(0, 0, 1-day.Day())
+	}
+	return day
+}
+
+// next returns the start of the period after the one starting at start
+func (i TrendInterval) next(start time.Time) time.Time {
+	switch i {
+	case IntervalWeek:
+		return start.AddDate(0, 0, 7)
+	case IntervalMonth:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// EmotionSample is what one experience adds to the rollups. Scores are nil
+// for drafts, which have no emotions yet.
+type EmotionSample struct {
+	ExperienceID   string
+	UserID         string
+	StartTime      time.Time
+	MeditationType string
+	Length         LengthBucket
+	BeforeScore    *int
+	AfterScore     *int
+	// Version orders snapshots of the same experience; an older one is not applied
+	Version time.Time
+}
+
+// Hour is the UTC hour the sample is rolled up in
+func (s *EmotionSample) Hour() time.Time {
+	return s.StartTime.UTC().Truncate(time.Hour)
+}
+
+// HasScores reports whether the sample counts towards the trends
+func (s *EmotionSample) HasScores() bool {
+	return s.BeforeScore != nil && s.AfterScore != nil
+}
+
+// EmotionTotals sums the scores of sessions
+type EmotionTotals struct {
+	Sessions    int
+	BeforeTotal int
+	AfterTotal  int
+}
+
+func (t *EmotionTotals) add(other EmotionTotals) {
+	t.Sessions += other.Sessions
+	t.BeforeTotal += other.BeforeTotal
+	t.AfterTotal += other.AfterTotal
+}
+
+// AverageBefore is the average score before the sessions
+func (t EmotionTotals) AverageBefore() float64 {
+	return t.average(t.BeforeTotal)
+}
+
+// AverageAfter is the average score after the sessions
+func (t EmotionTotals) AverageAfter() float64 {
+	return t.average(t.AfterTotal)
+}
+
+// AverageDelta is how much calmer the sessions left the user on average
+func (t EmotionTotals) AverageDelta() float64 {
+	return t.average(t.AfterTotal - t.BeforeTotal)
+}
+
+func (t EmotionTotals) average(total int) float64 {
+	if t.Sessions == 0 {
+		return 0
+	}
+	return float64(total) / float64(t.Sessions)
+}
+
+// EmotionRollup is the totals of a user's sessions in one UTC hour with the
+// same meditation type and length bucket
+type EmotionRollup struct {
+	Hour           time.Time
+	MeditationType string
+	Length         LengthBucket
+	Totals         EmotionTotals
+}
+
+// TrendQuery selects the rollups of a trend. From and To are the start of
+// the first period and the end of the last one in Location.
+type TrendQuery struct {
+	UserID   string
+	From     time.Time
+	To       time.Time
+	Interval TrendInterval
+	Location *time.Location
+}
+
+// NewTrendQuery validates a trend query. A zero from goes back
+// DefaultTrendRange from to, and a zero to is now; both are widened to
+// whole periods.
+func NewTrendQuery(userID string, from, to time.Time, interval TrendInterval, location *time.Location, now time.Time) (*TrendQuery, error) {
+	switch interval {
+	case IntervalDay, IntervalWeek, IntervalMonth:
+	case "":
+		interval = IntervalDay
+	default:
+		return nil, ErrInvalidTrendInterval
+	}
+	if to.IsZero() {
+		to = now
+	}
+	if from.IsZero() {
+		from = to.Add(-DefaultTrendRange)
+	}
+	if !to.After(from) {
+		return nil, ErrInvalidTrendRange
+	}
+
+	query := &TrendQuery{UserID: userID, Interval: interval, Location: location}
+	query.From = interval.start(from.In(location))
+	// The last period is the one holding the instant before to
+	query.To = interval.next(interval.start(to.Add(-time.Nanosecond).In(location)))
+	periods := 0
+	for start := query.From; start.Before(query.To); start = interval.next(start) {
+		if periods++; periods > MaxTrendPeriods {
+			return nil, ErrTrendRangeTooLong
+		}
+	}
+	return query, nil
+}
+
+// TrendPoint is the totals of one period
+type TrendPoint struct {
+	PeriodStart time.Time
+	Totals      EmotionTotals
+}
+
+// TrendBreakdown is the totals of the sessions sharing a key, such as a
+// meditation type
+type TrendBreakdown struct {
+	Key    string
+	Totals EmotionTotals
+}
+
+// EmotionTrend is how the user's emotions around sessions changed over the
+// periods of a query, and which practices helped most
+type EmotionTrend struct {
+	// Series has a point for every period with sessions, oldest first
+	Series           []TrendPoint
+	Overall          EmotionTotals
+	ByMeditationType []TrendBreakdown
+	ByTimeOfDay      []TrendBreakdown
+	ByLength         []TrendBreakdown
+}
+
+// Summarize groups the rollups of a query into periods and breakdowns. Hours
+// are placed by their start in the query's location, so in locations whose
+// offset is not a whole number of hours a session may count towards the
+// neighbouring period or time of day.
+func Summarize(query *TrendQuery, rollups []EmotionRollup) *EmotionTrend {
+	periods := map[time.Time]*EmotionTotals{}
+	byType := map[string]*EmotionTotals{}
+	byTimeOfDay := map[string]*EmotionTotals{}
+	byLength := map[string]*EmotionTotals{}
+	add := func(totals map[string]*EmotionTotals, key string, rollup EmotionRollup) {
+		if totals[key] == nil {
+			totals[key] = &EmotionTotals{}
+		}
+		totals[key].add(rollup.Totals)
+	}
+
+	trend := &EmotionTrend{}
+	for _, rollup := range rollups {
+		local := rollup.Hour.In(query.Location)
+		if local.Before(query.From) || !local.Before(query.To) {
+			continue
+		}
+		start := query.Interval.start(local)
+		if periods[start] == nil {
+			periods[start] = &EmotionTotals{}
+		}
+		periods[start].add(rollup.Totals)
+		add(byType, rollup.MeditationType, rollup)
+		add(byTimeOfDay, string(TimeOfDayOf(local)), rollup)
+		add(byLength, string(rollup.Length), rollup)
+		trend.Overall.add(rollup.Totals)
+	}
+
+	for start, totals := range periods {
+		trend.Series = append(trend.Series, TrendPoint{PeriodStart: start, Totals: *totals})
+	}
+	sort.Slice(trend.Series, func(i, j int) bool {
+		return trend.Series[i].PeriodStart.Before(trend.Series[j].PeriodStart)
+	})
+
+	// Meditation types are listed by how much they helped, then by sessions
+	for key, totals := range byType {
+		trend.ByMeditationType = append(trend.ByMeditationType, TrendBreakdown{Key: key, Totals: *totals})
+	}
+	sort.Slice(trend.ByMeditationType, func(i, j int) bool {
+		a, b := trend.ByMeditationType[i], trend.ByMeditationType[j]
+		if a.Totals.AverageDelta() != b.Totals.AverageDelta() {
+			return a.Totals.AverageDelta() > b.Totals.AverageDelta()
+		}
+		if a.Totals.Sessions != b.Totals.Sessions {
+			return a.Totals.Sessions > b.Totals.Sessions
+		}
+		return a.Key < b.Key
+	})
+	for _, timeOfDay := range TimesOfDay() {
+		if totals := byTimeOfDay[string(timeOfDay)]; totals != nil {
+			trend.ByTimeOfDay = append(trend.ByTimeOfDay, TrendBreakdown{Key: string(timeOfDay), Totals: *totals})
+		}
+	}
+	for _, length := range LengthBuckets() {
+		if totals := byLength[string(length)]; totals != nil {
+			trend.ByLength = append(trend.ByLength, TrendBreakdown{Key: string(length), Totals: *totals})
+		}
+	}
+	return trend
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewTrendQuery_ShouldWidenTheRangeToWholePeriods(t *testing.T) {
+	// given
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	from := time.Date(2024, 3, 13, 20, 0, 0, 0, time.UTC) // Thursday 05:00 in Tokyo
+	to := time.Date(2024, 4, 2, 3, 0, 0, 0, tokyo)
+
+	// when
+	weekly, err := NewTrendQuery("user-1", from, to, IntervalWeek, tokyo, time.Now())
+	monthly, monthErr := NewTrendQuery("user-1", from, to, IntervalMonth, tokyo, time.Now())
+
+	// then
+	if err != nil || monthErr != nil {
+		t.Fatalf("Expected no error, got %v and %v", err, monthErr)
+	}
+	if !weekly.From.Equal(time.Date(2024, 3, 11, 0, 0, 0, 0, tokyo)) || !weekly.To.Equal(time.Date(2024, 4, 8, 0, 0, 0, 0, tokyo)) {
+		t.Errorf("Expected Monday-to-Monday weeks in Tokyo, got %v to %v", weekly.From, weekly.To)
+	}
+	if !monthly.From.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, tokyo)) || !monthly.To.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, tokyo)) {
+		t.Errorf("Expected whole months in Tokyo, got %v to %v", monthly.From, monthly.To)
+	}
+}
+
+func TestNewTrendQuery_ShouldRejectInvalidQueries(t *testing.T) {
+	// given
+	now := time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	// when
+	_, intervalErr := NewTrendQuery("user-1", time.Time{}, time.Time{}, "year", time.UTC, now)
+	_, rangeErr := NewTrendQuery("user-1", now, now.Add(-time.Hour), IntervalDay, time.UTC, now)
+	_, lengthErr := NewTrendQuery("user-1", now.AddDate(-2, 0, 0), now, IntervalDay, time.UTC, now)
+	defaulted, err := NewTrendQuery("user-1", time.Time{}, time.Time{}, "", time.UTC, now)
+
+	// then
+	if !errors.Is(intervalErr, ErrInvalidTrendInterval) || !errors.Is(rangeErr, ErrInvalidTrendRange) || !errors.Is(lengthErr, ErrTrendRangeTooLong) {
+		t.Errorf("Expected the query errors, got %v, %v and %v", intervalErr, rangeErr, lengthErr)
+	}
+	if err != nil || defaulted.Interval != IntervalDay || !defaulted.From.Equal(now.Add(-DefaultTrendRange)) {
+		t.Errorf("Expected daily periods over the default range, got %+v and %v", defaulted, err)
+	}
+}
+
+func TestSummarize_ShouldGroupRollupsInTheQueryLocation(t *testing.T) {
+	// given
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	query, _ := NewTrendQuery("user-1", time.Date(2024, 3, 11, 0, 0, 0, 0, tokyo), time.Date(2024, 3, 18, 0, 0, 0, 0, tokyo), IntervalDay, tokyo, time.Now())
+	rollups := []EmotionRollup{
+		// 2024-03-13 07:00 in Tokyo
+		{Hour: time.Date(2024, 3, 12, 22, 0, 0, 0, time.UTC), MeditationType: "zazen", Length: Length20To40, Totals: EmotionTotals{Sessions: 2, BeforeTotal: 2, AfterTotal: 8}},
+		// 2024-03-13 21:00 in Tokyo
+		{Hour: time.Date(2024, 3, 13, 12, 0, 0, 0, time.UTC), MeditationType: "mindfulness", Length: LengthUnder10, Totals: EmotionTotals{Sessions: 1, BeforeTotal: 1, AfterTotal: 2}},
+		// 2024-03-15 08:00 in Tokyo
+		{Hour: time.Date(2024, 3, 14, 23, 0, 0, 0, time.UTC), MeditationType: "zazen", Length: Length10To20, Totals: EmotionTotals{Sessions: 1, BeforeTotal: 0, AfterTotal: 4}},
+		// Outside the range
+		{Hour: time.Date(2024, 3, 10, 14, 0, 0, 0, time.UTC), MeditationType: "zazen", Length: Length10To20, Totals: EmotionTotals{Sessions: 5, BeforeTotal: 0, AfterTotal: 0}},
+	}
+
+	// when
+	trend := Summarize(query, rollups)
+
+	// then
+	if len(trend.Series) != 2 || !trend.Series[0].PeriodStart.Equal(time.Date(2024, 3, 13, 0, 0, 0, 0, tokyo)) {
+		t.Fatalf("Expected 2 days with sessions, got %+v", trend.Series)
+	}
+	if day := trend.Series[0].Totals; day.Sessions != 3 || day.AverageBefore() != 1 || day.AverageAfter() != 10.0/3 {
+		t.Errorf("Expected the sessions of 13 March together, got %+v", day)
+	}
+	if trend.Overall.Sessions != 4 || trend.Overall.AverageDelta() != 2.75 {
+		t.Errorf("Expected 4 sessions improving by 2.75, got %+v", trend.Overall)
+	}
+	if len(trend.ByMeditationType) != 2 || trend.ByMeditationType[0].Key != "zazen" || trend.ByMeditationType[0].Totals.AverageDelta() != 10.0/3 {
+		t.Errorf("Expected zazen first as it helped most, got %+v", trend.ByMeditationType)
+	}
+	if len(trend.ByTimeOfDay) != 2 || trend.ByTimeOfDay[0].Key != string(Morning) || trend.ByTimeOfDay[0].Totals.Sessions != 3 ||
+		trend.ByTimeOfDay[1].Key != string(Evening) {
+		t.Errorf("Expected morning then evening in Tokyo time, got %+v", trend.ByTimeOfDay)
+	}
+	if len(trend.ByLength) != 3 || trend.ByLength[0].Key != string(LengthUnder10) || trend.ByLength[2].Key != string(Length20To40) {
+		t.Errorf("Expected lengths from shortest, got %+v", trend.ByLength)
+	}
+}
+
+func TestLengthBucketOf_ShouldBucketByMinutes(t *testing.T) {
+	// given
+	cases := map[time.Duration]LengthBucket{
+		9*time.Minute + 59*time.Second: LengthUnder10,
+		10 * time.Minute:               Length10To20,
+		39 * time.Minute:               Length20To40,
+		40 * time.Minute:               Length40Plus,
+	}
+	for length, want := range cases {
+		// when
+		got := LengthBucketOf(length)
+
+		// then
+		if got != want {
+			t.Errorf("Expected %s for %v, got %s", want, length, got)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// EmotionRollupRepository persists the emotion rollups
+type EmotionRollupRepository interface {
+	// Apply replaces what the sample's experience added to the rollups with
+	// the sample, atomically; false when a snapshot at least as new was
+	// already applied, so replayed events change nothing
+	Apply(ctx context.Context, sample *EmotionSample) (bool, error)
+	// Rollups returns the user's rollups for hours starting in [from, to)
+	Rollups(ctx context.Context, userID string, from, to time.Time) ([]EmotionRollup, error)
+}
@@ -0,0 +1,45 @@
+package infrastructure
+
+import (
+	"context"
+
+	"zen-connect/internal/analytics/application/usecase"
+	experiencedomain "zen-connect/internal/experience/domain"
+	"zen-connect/internal/shared/event"
+)
+
+// EventHandler subscribes to the event bus and keeps the emotion rollups up
+// to date as experiences are recorded and changed
+type EventHandler struct {
+	rollup *usecase.RollupUseCase
+}
+
+// NewEventHandler creates a new analytics event handler
+func NewEventHandler(rollup *usecase.RollupUseCase) *EventHandler {
+	return &EventHandler{
+		rollup: rollup,
+	}
+}
+
+// Subscribe registers the handler for the events that change emotions
+func (h *EventHandler) Subscribe(bus event.EventBus) {
+	for _, name := range []string{
+		"ExperienceCreated",
+		"ExperienceUpdated",
+		"HistoryImported",
+	} {
+		bus.Register(name, h)
+	}
+}
+
+// Handle implements event.EventHandler. The experience is read again rather
+// than taken from the event, so late or repeated events apply its latest state.
+func (h *EventHandler) Handle(ctx context.Context, e event.DomainEvent) error {
+	switch e := e.(type) {
+	case *experiencedomain.ExperienceCreated, *experiencedomain.ExperienceUpdated:
+		return h.rollup.Record(ctx, e.AggregateID())
+	case *experiencedomain.HistoryImported:
+		return h.rollup.RecordAll(ctx, e.ExperienceIDs())
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/analytics/domain"
+	experiencedomain "zen-connect/internal/experience/domain"
+)
+
+// ExperienceAdapter reads the emotion samples of experiences from the
+// experience context
+type ExperienceAdapter struct {
+	experienceRepo experiencedomain.ExperienceRepository
+}
+
+// NewExperienceAdapter creates a new experience adapter
+func NewExperienceAdapter(experienceRepo experiencedomain.ExperienceRepository) *ExperienceAdapter {
+	return &ExperienceAdapter{
+		experienceRepo: experienceRepo,
+	}
+}
+
+// Sample returns the sample of an experience as it is stored now
+func (a *ExperienceAdapter) Sample(ctx context.Context, experienceID string) (*domain.EmotionSample, error) {
+	experience, err := a.experienceRepo.FindByID(ctx, experienceID)
+	if err != nil {
+		return nil, err
+	}
+	return sampleOf(experience), nil
+}
+
+// EachSample streams the samples of every user's experiences
+func (a *ExperienceAdapter) EachSample(ctx context.Context, each func(*domain.EmotionSample) error) error {
+	return a.experienceRepo.Export(ctx, "", time.Time{}, time.Time{}, func(experience *experiencedomain.Experience) error {
+		return each(sampleOf(experience))
+	})
+}
+
+// sampleOf scores an experience; emotions outside the predefined levels are
+// left out like drafts
+func sampleOf(experience *experiencedomain.Experience) *domain.EmotionSample {
+	session := experience.Content().Session()
+	sample := &domain.EmotionSample{
+		ExperienceID:   experience.ID(),
+		UserID:         experience.UserID(),
+		StartTime:      session.StartTime(),
+		MeditationType: session.MeditationType(),
+		Length:         domain.LengthBucketOf(session.Duration()),
+		// The precision of timestamptz, so a stored version compares equal
+		Version: experience.UpdatedAt().Truncate(time.Microsecond),
+	}
+	if emotionalState := experience.Content().EmotionalState(); emotionalState != nil {
+		before, beforeOK := experiencedomain.EmotionScore(emotionalState.Before())
+		after, afterOK := experiencedomain.EmotionScore(emotionalState.After())
+		if beforeOK && afterOK {
+			sample.BeforeScore, sample.AfterScore = &before, &after
+		}
+	}
+	return sample
+}
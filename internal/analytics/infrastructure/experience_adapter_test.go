@@ -0,0 +1,52 @@
+package infrastructure
+
+import (
+	"testing"
+	"time"
+
+	"zen-connect/internal/analytics/domain"
+	experiencedomain "zen-connect/internal/experience/domain"
+)
+
+func newExperience(emotionalState *experiencedomain.EmotionalState, updatedAt time.Time) *experiencedomain.Experience {
+	start := time.Date(2024, 3, 13, 22, 40, 0, 0, time.UTC)
+	content := experiencedomain.NewExperienceContent(
+		experiencedomain.ReconstructMeditationSession(start, start.Add(25*time.Minute), "zazen", "", ""),
+		emotionalState, start, updatedAt)
+	return experiencedomain.FromSnapshot("exp-1", "user-1", content, experiencedomain.EmptyJournal(), false, time.Time{}, start, updatedAt)
+}
+
+func TestSampleOf_ShouldScoreTheEmotionsOfAnExperience(t *testing.T) {
+	// given
+	updatedAt := time.Date(2024, 3, 14, 8, 0, 0, 123456789, time.UTC)
+	experience := newExperience(experiencedomain.NewEmotionalState("やや不安", "非常に穏やか"), updatedAt)
+
+	// when
+	sample := sampleOf(experience)
+
+	// then
+	if !sample.HasScores() || *sample.BeforeScore != 1 || *sample.AfterScore != 5 {
+		t.Fatalf("Expected scores 1 and 5, got %+v", sample)
+	}
+	if !sample.Hour().Equal(time.Date(2024, 3, 13, 22, 0, 0, 0, time.UTC)) || sample.Length != domain.Length20To40 || sample.MeditationType != "zazen" {
+		t.Errorf("Expected the 22:00 UTC hour, 20 to 40 minutes and zazen, got %v %s %s", sample.Hour(), sample.Length, sample.MeditationType)
+	}
+	if sample.Version.Nanosecond() != 123456000 {
+		t.Errorf("Expected the version in microseconds, got %v", sample.Version)
+	}
+}
+
+func TestSampleOf_ShouldLeaveDraftsWithoutScores(t *testing.T) {
+	// given
+	draft := newExperience(nil, time.Now())
+	freeText := newExperience(experiencedomain.NewEmotionalState("眠い", "穏やか"), time.Now())
+
+	// when
+	draftSample := sampleOf(draft)
+	freeTextSample := sampleOf(freeText)
+
+	// then
+	if draftSample.HasScores() || freeTextSample.HasScores() {
+		t.Errorf("Expected no scores for drafts and unknown levels, got %+v and %+v", draftSample, freeTextSample)
+	}
+}
@@ -0,0 +1,122 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"zen-connect/internal/analytics/domain"
+)
+
+// PostgresEmotionRollupRepository stores the emotion rollups in PostgreSQL
+type PostgresEmotionRollupRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresEmotionRollupRepository creates a new emotion rollup repository
+func NewPostgresEmotionRollupRepository(pool *pgxpool.Pool) *PostgresEmotionRollupRepository {
+	return &PostgresEmotionRollupRepository{pool: pool}
+}
+
+// Apply takes back what the experience's previous sample added to the
+// rollups and adds the new one, in one transaction
+func (r *PostgresEmotionRollupRepository) Apply(ctx context.Context, sample *domain.EmotionSample) (bool, error) {
+	applied := false
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		// Events and a rebuild may apply the same experience at once
+		if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('emotion_sample:' || $1))`, sample.ExperienceID); err != nil {
+			return err
+		}
+
+		var hour, version time.Time
+		var meditationType, length string
+		var before, after *int
+		err := tx.QueryRow(ctx, `
+			SELECT hour, meditation_type, length_bucket, before_score, after_score, version
+			FROM emotion_samples WHERE experience_id = $1
+		`, sample.ExperienceID).Scan(&hour, &meditationType, &length, &before, &after, &version)
+		found := err == nil
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		if found && !sample.Version.After(version) {
+			return nil
+		}
+
+		if found && before != nil && after != nil {
+			if err := addToRollup(ctx, tx, sample.UserID, hour, meditationType, length, -1, -*before, -*after); err != nil {
+				return err
+			}
+		}
+		if sample.HasScores() {
+			if err := addToRollup(ctx, tx, sample.UserID, sample.Hour(), sample.MeditationType, string(sample.Length), 1, *sample.BeforeScore, *sample.AfterScore); err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO emotion_samples (experience_id, user_id, hour, meditation_type, length_bucket, before_score, after_score, version)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (experience_id) DO UPDATE SET
+				hour = EXCLUDED.hour,
+				meditation_type = EXCLUDED.meditation_type,
+				length_bucket = EXCLUDED.length_bucket,
+				before_score = EXCLUDED.before_score,
+				after_score = EXCLUDED.after_score,
+				version = EXCLUDED.version
+		`, sample.ExperienceID, sample.UserID, sample.Hour(), sample.MeditationType, string(sample.Length),
+			sample.BeforeScore, sample.AfterScore, sample.Version)
+		applied = err == nil
+		return err
+	})
+	return applied, err
+}
+
+// addToRollup adds sessions and scores to a rollup row (negative to take
+// them back) and drops the row once it has no sessions
+func addToRollup(ctx context.Context, tx pgx.Tx, userID string, hour time.Time, meditationType, length string, sessions, before, after int) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO emotion_rollups (user_id, hour, meditation_type, length_bucket, sessions, before_total, after_total)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id, hour, meditation_type, length_bucket) DO UPDATE SET
+			sessions = emotion_rollups.sessions + EXCLUDED.sessions,
+			before_total = emotion_rollups.before_total + EXCLUDED.before_total,
+			after_total = emotion_rollups.after_total + EXCLUDED.after_total
+	`, userID, hour, meditationType, length, sessions, before, after)
+	if err != nil || sessions > 0 {
+		return err
+	}
+	_, err = tx.Exec(ctx, `
+		DELETE FROM emotion_rollups
+		WHERE user_id = $1 AND hour = $2 AND meditation_type = $3 AND length_bucket = $4 AND sessions <= 0
+	`, userID, hour, meditationType, length)
+	return err
+}
+
+// Rollups returns a user's rollups in a range of hours
+func (r *PostgresEmotionRollupRepository) Rollups(ctx context.Context, userID string, from, to time.Time) ([]domain.EmotionRollup, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT hour, meditation_type, length_bucket, sessions, before_total, after_total
+		FROM emotion_rollups
+		WHERE user_id = $1 AND hour >= $2 AND hour < $3
+		ORDER BY hour
+	`, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rollups []domain.EmotionRollup
+	for rows.Next() {
+		var rollup domain.EmotionRollup
+		var length string
+		if err := rows.Scan(&rollup.Hour, &rollup.MeditationType, &length, &rollup.Totals.Sessions, &rollup.Totals.BeforeTotal, &rollup.Totals.AfterTotal); err != nil {
+			return nil, err
+		}
+		rollup.Length = domain.LengthBucket(length)
+		rollups = append(rollups, rollup)
+	}
+	return rollups, rows.Err()
+}
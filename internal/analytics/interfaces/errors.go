@@ -0,0 +1,43 @@
+package interfaces
+
+import (
+	"net/http"
+	"strconv"
+
+	"zen-connect/internal/analytics/domain"
+	"zen-connect/internal/infrastructure/problem"
+)
+
+// ErrorMappings 集計ドメインのエラーとHTTPレスポンスの対応
+func ErrorMappings() []problem.Mapping {
+	return []problem.Mapping{
+		{
+			Err: domain.ErrInvalidTrendInterval, Status: http.StatusBadRequest, Code: "invalid_trend_interval",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "集計の単位は day、week、month のいずれかを指定してください。",
+				problem.LanguageEnglish:  "The interval must be day, week or month.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidTrendRange, Status: http.StatusBadRequest, Code: "invalid_trend_range",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "集計する期間の終わりは始まりより後にしてください。",
+				problem.LanguageEnglish:  "The end of the range must be after its start.",
+			},
+		},
+		{
+			Err: domain.ErrTrendRangeTooLong, Status: http.StatusBadRequest, Code: "trend_range_too_long",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "集計する期間が長すぎます。" + strconv.Itoa(domain.MaxTrendPeriods) + "期間以内になるよう、期間を短くするか集計の単位を大きくしてください。",
+				problem.LanguageEnglish:  "The range is too long. Shorten it or use a longer interval to stay within " + strconv.Itoa(domain.MaxTrendPeriods) + " periods.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidTrendTimeZone, Status: http.StatusBadRequest, Code: "invalid_time_zone",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "タイムゾーンが正しくありません。",
+				problem.LanguageEnglish:  "The time zone is not valid.",
+			},
+		},
+	}
+}
@@ -0,0 +1,86 @@
+package interfaces
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/analytics/application/dto"
+	"zen-connect/internal/analytics/application/usecase"
+	"zen-connect/internal/analytics/domain"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/shared/openapi"
+)
+
+// AnalyticsHandler 瞑想の振り返りのための集計のHTTPハンドラー
+type AnalyticsHandler struct {
+	getEmotionTrendUseCase *usecase.GetEmotionTrendUseCase
+}
+
+// NewAnalyticsHandler コンストラクタ
+func NewAnalyticsHandler(getEmotionTrendUseCase *usecase.GetEmotionTrendUseCase) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		getEmotionTrendUseCase: getEmotionTrendUseCase,
+	}
+}
+
+// SetupRoutes 集計関連のルーティング設定
+func (h *AnalyticsHandler) SetupRoutes(e *echo.Echo, sessionMiddleware *session.Middleware) {
+	analyticsGroup := e.Group("/analytics", sessionMiddleware.RequireAuth())
+
+	// 自分の瞑想前後の感情の推移（体験記録のイベントで更新される集計から読む）
+	analyticsGroup.GET("/emotions", h.GetEmotionTrend)
+}
+
+// Endpoints OpenAPIドキュメント用のエンドポイント定義
+func (h *AnalyticsHandler) Endpoints() []openapi.Endpoint {
+	tags := []string{"analytics"}
+	return []openapi.Endpoint{
+		{
+			Method: http.MethodGet, Path: "/analytics/emotions", Tags: tags,
+			Summary: "See how your emotions around sessions change over time",
+			Description: "Averages the emotion scores before and after your sessions, from -1 (非常に不安) to 5 (非常に穏やか), and the delta " +
+				"(after minus before, higher is calmer). series has every period with sessions; by_meditation_type is ordered by delta, " +
+				"by_time_of_day and by_length by time and length. Drafts without emotions are not counted. " +
+				"The range is widened to whole periods in time_zone, at most " + strconv.Itoa(domain.MaxTrendPeriods) + " of them. " +
+				"Sessions are totalled per UTC hour, so in time zones not a whole number of hours from UTC they may fall into the neighbouring period or time of day.",
+			Security: []string{openapi.SecuritySession},
+			Query: []openapi.Parameter{
+				{Name: "from", Description: "Sessions starting at or after (RFC 3339), default 90 days before to", Schema: &openapi.Schema{Type: "string", Format: "date-time"}},
+				{Name: "to", Description: "Sessions starting before (RFC 3339), default now", Schema: &openapi.Schema{Type: "string", Format: "date-time"}},
+				{Name: "interval", Description: "day (default), week (from Monday) or month", Schema: &openapi.Schema{Type: "string", Enum: []interface{}{"day", "week", "month"}}},
+				{Name: "time_zone", Description: "IANA time zone of the periods and times of day, default UTC", Schema: &openapi.Schema{Type: "string"}},
+			},
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.EmotionTrendResponse{},
+				http.StatusBadRequest:   nil,
+				http.StatusUnauthorized: nil,
+			},
+		},
+	}
+}
+
+// GetEmotionTrend 自分の瞑想前後の感情の推移を取得
+func (h *AnalyticsHandler) GetEmotionTrend(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.EmotionTrendRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeBadRequest)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.UserID = userID
+
+	response, err := h.getEmotionTrendUseCase.Execute(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -44,4 +44,25 @@ type LogoutRequest struct {
 // LogoutResponse ログアウトレスポンスDTO
 type LogoutResponse struct {
 	RedirectURL string `json:"redirect_url"`
-}
\ No newline at end of file
+}
+// CurrentUserResponse ログイン中ユーザー情報のレスポンスDTO
+type CurrentUserResponse struct {
+	UserID        string    `json:"user_id"`
+	Auth0UserID   string    `json:"auth0_user_id"`
+	Email         string    `json:"email"`
+	Name          string    `json:"name"`
+	ExpiresAt     time.Time `json:"expires_at"`
+	Authenticated bool      `json:"authenticated"`
+}
+
+// CSRFTokenResponse CSRFトークンのレスポンスDTO
+type CSRFTokenResponse struct {
+	CSRFToken  string `json:"csrf_token"`
+	HeaderName string `json:"header_name"`
+}
+
+// LoginURLResponse ログインURLのレスポンスDTO
+type LoginURLResponse struct {
+	LoginURL string `json:"login_url"`
+	State    string `json:"state"`
+}
@@ -0,0 +1,56 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"zen-connect/internal/auth/domain"
+	"zen-connect/internal/infrastructure/problem"
+)
+
+// ErrorMappings 認証ドメインのエラーとHTTPレスポンスの対応
+func ErrorMappings() []problem.Mapping {
+	return []problem.Mapping{
+		{
+			Err: domain.ErrInvalidToken, Status: http.StatusUnauthorized, Code: "invalid_token",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "トークンが無効です。",
+				problem.LanguageEnglish:  "The token is invalid.",
+			},
+		},
+		{
+			Err: domain.ErrTokenExpired, Status: http.StatusUnauthorized, Code: "token_expired",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "トークンの有効期限が切れています。",
+				problem.LanguageEnglish:  "The token has expired.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidClaims, Status: http.StatusUnauthorized, Code: "invalid_claims",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "トークンのクレームが不正です。",
+				problem.LanguageEnglish:  "The token claims are invalid.",
+			},
+		},
+		{
+			Err: domain.ErrSessionNotFound, Status: http.StatusUnauthorized, Code: "session_not_found",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "セッションが見つかりません。再度ログインしてください。",
+				problem.LanguageEnglish:  "No session was found. Please log in again.",
+			},
+		},
+		{
+			Err: domain.ErrSessionExpired, Status: http.StatusUnauthorized, Code: "session_expired",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "セッションの有効期限が切れています。再度ログインしてください。",
+				problem.LanguageEnglish:  "The session has expired. Please log in again.",
+			},
+		},
+		{
+			Err: domain.ErrUnauthorized, Status: http.StatusUnauthorized, Code: problem.CodeUnauthenticated,
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "認証が必要です。",
+				problem.LanguageEnglish:  "Authentication is required.",
+			},
+		},
+	}
+}
@@ -2,7 +2,6 @@ package interfaces
 
 import (
 	"context"
-	"net/http"
 	"strings"
 	
 	"github.com/labstack/echo/v4"
@@ -29,30 +28,20 @@ func (m *AuthMiddleware) RequireAuth() echo.MiddlewareFunc {
 			// Authorizationヘッダーからトークンを取得
 			authHeader := c.Request().Header.Get("Authorization")
 			if authHeader == "" {
-				return c.JSON(http.StatusUnauthorized, map[string]string{
-					"error": "authorization header required",
-				})
+				return domain.ErrUnauthorized
 			}
 			
 			// "Bearer "プレフィックスを除去
 			token := strings.TrimPrefix(authHeader, "Bearer ")
 			if token == authHeader {
-				return c.JSON(http.StatusUnauthorized, map[string]string{
-					"error": "invalid authorization format",
-				})
+				return domain.ErrInvalidToken
 			}
 			
 			// トークンを検証
 			user, err := m.authService.VerifyToken(c.Request().Context(), token)
 			if err != nil {
-				if err == domain.ErrInvalidToken || err == domain.ErrTokenExpired {
-					return c.JSON(http.StatusUnauthorized, map[string]string{
-						"error": "invalid or expired token",
-					})
-				}
-				return c.JSON(http.StatusInternalServerError, map[string]string{
-					"error": "authentication failed",
-				})
+				// ErrInvalidToken / ErrTokenExpired は401、それ以外は500に変換される
+				return err
 			}
 			
 			// ユーザー情報をコンテキストに設定
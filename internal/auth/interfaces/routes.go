@@ -3,15 +3,19 @@ package interfaces
 import (
 	"fmt"
 	"net/http"
-	"os"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
+	"zen-connect/internal/auth/application/dto"
 	"zen-connect/internal/infrastructure/auth0"
 	"zen-connect/internal/infrastructure/logger"
+	"zen-connect/internal/infrastructure/problem"
 	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/shared/openapi"
 	userservice "zen-connect/internal/user/application/service"
 )
 
@@ -20,10 +24,12 @@ type AuthHandler struct {
 	authService     *auth0.AuthService
 	callbackHandler *auth0.CallbackHandler
 	sessionStore    *session.CookieStore
+	auth0Config     *auth0.Config
+	frontendURL     string
 }
 
 // NewAuthHandler コンストラクタ
-func NewAuthHandler(authService *auth0.AuthService, userService userservice.UserService, sessionStore *session.CookieStore, provider *oidc.Provider, config *auth0.Config) (*AuthHandler, error) {
+func NewAuthHandler(authService *auth0.AuthService, userService userservice.UserService, sessionStore *session.CookieStore, provider *oidc.Provider, config *auth0.Config, frontendURL string) (*AuthHandler, error) {
 	// Create OAuth2 config
 	oauth2Config := authService.GetOAuth2Config()
 
@@ -34,6 +40,8 @@ func NewAuthHandler(authService *auth0.AuthService, userService userservice.User
 		authService:     authService,
 		callbackHandler: callbackHandler,
 		sessionStore:    sessionStore,
+		auth0Config:     config,
+		frontendURL:     strings.TrimRight(frontendURL, "/"),
 	}, nil
 }
 
@@ -46,12 +54,66 @@ func (h *AuthHandler) SetupRoutes(e *echo.Echo) {
 	auth.GET("/callback", h.Callback) // Handles Auth0 callback
 	auth.GET("/logout", h.Logout)     // Clears session and redirects to Auth0 logout
 	auth.GET("/me", h.Me)             // Returns current user information
+	auth.GET("/csrf", h.CSRFToken)    // Returns the CSRF token for the current session
 	
 	// Keep API endpoints too
 	apiAuth := e.Group("/api/auth")
 	apiAuth.GET("/login-url", h.GetLoginURL) // Returns login URL for AJAX
 }
 
+// Endpoints describes the authentication routes for the OpenAPI document
+func (h *AuthHandler) Endpoints() []openapi.Endpoint {
+	tags := []string{"auth"}
+	return []openapi.Endpoint{
+		{
+			Method: http.MethodGet, Path: "/auth/login", Tags: tags,
+			Summary:   "Redirect to Auth0 Universal Login",
+			Responses: map[int]interface{}{http.StatusTemporaryRedirect: nil},
+		},
+		{
+			Method: http.MethodGet, Path: "/auth/callback", Tags: tags,
+			Summary:     "Handle the Auth0 callback",
+			Description: "Exchanges the authorization code, creates or updates the user and sets the session cookie, then redirects to the frontend.",
+			Query: []openapi.Parameter{
+				{Name: "code", Schema: &openapi.Schema{Type: "string"}},
+				{Name: "state", Schema: &openapi.Schema{Type: "string"}},
+				{Name: "error", Schema: &openapi.Schema{Type: "string"}},
+				{Name: "error_description", Schema: &openapi.Schema{Type: "string"}},
+			},
+			Responses: map[int]interface{}{http.StatusTemporaryRedirect: nil},
+		},
+		{
+			Method: http.MethodGet, Path: "/auth/logout", Tags: tags,
+			Summary:   "Clear the session and redirect to Auth0 logout",
+			Responses: map[int]interface{}{http.StatusTemporaryRedirect: nil},
+		},
+		{
+			Method: http.MethodGet, Path: "/auth/me", Tags: tags,
+			Summary:  "Get the user of the current session",
+			Security: []string{openapi.SecuritySession},
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.CurrentUserResponse{},
+				http.StatusUnauthorized: nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/auth/csrf", Tags: tags,
+			Summary:     "Get the CSRF token of the current session",
+			Description: "Send the token in the X-CSRF-Token header on state-changing requests authenticated by the session cookie.",
+			Security:    []string{openapi.SecuritySession},
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.CSRFTokenResponse{},
+				http.StatusUnauthorized: nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/api/auth/login-url", Tags: tags,
+			Summary:   "Get the Auth0 login URL",
+			Responses: map[int]interface{}{http.StatusOK: dto.LoginURLResponse{}},
+		},
+	}
+}
+
 // SignIn handles GET /auth/login - redirects to Auth0 Universal Login
 func (h *AuthHandler) SignIn(c echo.Context) error {
 	logCtx := logger.WithContext(c.Request().Context()).WithComponent(logger.ComponentAuth)
@@ -99,11 +161,7 @@ func (h *AuthHandler) Callback(c echo.Context) error {
 			zap.Duration("duration", time.Since(start)),
 		)
 		// Redirect to frontend with error
-		frontendURL := os.Getenv("FRONTEND_URL")
-		if frontendURL == "" {
-			frontendURL = "http://localhost:3000"
-		}
-		errorURL := fmt.Sprintf("%s/?error=%s&error_description=%s", frontendURL, errorParam, errorDesc)
+		errorURL := fmt.Sprintf("%s/?error=%s&error_description=%s", h.frontendURL, url.QueryEscape(errorParam), url.QueryEscape(errorDesc))
 		return c.Redirect(http.StatusTemporaryRedirect, errorURL)
 	}
 
@@ -112,11 +170,7 @@ func (h *AuthHandler) Callback(c echo.Context) error {
 		logCtx.Warn("Auth0 callback missing authorization code",
 			zap.Duration("duration", time.Since(start)),
 		)
-		frontendURL := os.Getenv("FRONTEND_URL")
-		if frontendURL == "" {
-			frontendURL = "http://localhost:3000"
-		}
-		errorURL := fmt.Sprintf("%s/?error=no_code", frontendURL)
+		errorURL := fmt.Sprintf("%s/?error=no_code", h.frontendURL)
 		return c.Redirect(http.StatusTemporaryRedirect, errorURL)
 	}
 
@@ -129,11 +183,7 @@ func (h *AuthHandler) Callback(c echo.Context) error {
 			zap.Duration("duration", time.Since(start)),
 		)
 		// Redirect to frontend with error
-		frontendURL := os.Getenv("FRONTEND_URL")
-		if frontendURL == "" {
-			frontendURL = "http://localhost:3000"
-		}
-		errorURL := fmt.Sprintf("%s/?error=auth_failed", frontendURL)
+		errorURL := fmt.Sprintf("%s/?error=auth_failed", h.frontendURL)
 		return c.Redirect(http.StatusTemporaryRedirect, errorURL)
 	}
 
@@ -149,11 +199,7 @@ func (h *AuthHandler) Callback(c echo.Context) error {
 			zap.Duration("duration", time.Since(start)),
 		)
 		// Redirect to frontend with error
-		frontendURL := os.Getenv("FRONTEND_URL")
-		if frontendURL == "" {
-			frontendURL = "http://localhost:3000"
-		}
-		errorURL := fmt.Sprintf("%s/?error=session_failed", frontendURL)
+		errorURL := fmt.Sprintf("%s/?error=session_failed", h.frontendURL)
 		return c.Redirect(http.StatusTemporaryRedirect, errorURL)
 	}
 
@@ -166,11 +212,7 @@ func (h *AuthHandler) Callback(c echo.Context) error {
 	)
 
 	// Redirect to frontend with success
-	frontendURL := os.Getenv("FRONTEND_URL")
-	if frontendURL == "" {
-		frontendURL = "http://localhost:3000"
-	}
-	successURL := fmt.Sprintf("%s/", frontendURL)
+	successURL := fmt.Sprintf("%s/", h.frontendURL)
 	return c.Redirect(http.StatusTemporaryRedirect, successURL)
 }
 
@@ -181,9 +223,9 @@ func (h *AuthHandler) GetLoginURL(c echo.Context) error {
 	
 	loginURL := h.authService.GetLoginURL(state)
 
-	return c.JSON(http.StatusOK, map[string]string{
-		"login_url": loginURL,
-		"state":     state,
+	return c.JSON(http.StatusOK, dto.LoginURLResponse{
+		LoginURL: loginURL,
+		State:    state,
 	})
 }
 
@@ -199,9 +241,7 @@ func (h *AuthHandler) Me(c echo.Context) error {
 			zap.String("remote_addr", c.RealIP()),
 			zap.Error(err),
 		)
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": "User not authenticated",
-		})
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
 	}
 
 	logCtx.Debug("User info request successful",
@@ -211,18 +251,40 @@ func (h *AuthHandler) Me(c echo.Context) error {
 	)
 
 	// Return user information
-	response := map[string]interface{}{
-		"user_id":       sessionData.UserID,
-		"auth0_user_id": sessionData.Auth0UserID,
-		"email":         sessionData.Email,
-		"name":          sessionData.Name,
-		"expires_at":    sessionData.ExpiresAt,
-		"authenticated": true,
+	response := dto.CurrentUserResponse{
+		UserID:        sessionData.UserID,
+		Auth0UserID:   sessionData.Auth0UserID,
+		Email:         sessionData.Email,
+		Name:          sessionData.Name,
+		ExpiresAt:     sessionData.ExpiresAt,
+		Authenticated: true,
 	}
 
 	return c.JSON(http.StatusOK, response)
 }
 
+// CSRFToken handles GET /auth/csrf - returns the CSRF token bound to the session.
+// The SPA sends it back in the X-CSRF-Token header on state-changing requests.
+func (h *AuthHandler) CSRFToken(c echo.Context) error {
+	logCtx := logger.WithContext(c.Request().Context()).WithComponent(logger.ComponentAuth)
+
+	token, err := h.sessionStore.CSRFToken(c)
+	if err != nil {
+		logCtx.Info("CSRF token request without valid session",
+			zap.String("action", "csrf_token_unauthenticated"),
+			zap.String("remote_addr", c.RealIP()),
+			zap.Error(err),
+		)
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.JSON(http.StatusOK, dto.CSRFTokenResponse{
+		CSRFToken:  token,
+		HeaderName: session.CSRFHeaderName,
+	})
+}
+
 // Logout handles GET /auth/logout - clears session and redirects to Auth0 logout
 func (h *AuthHandler) Logout(c echo.Context) error {
 	logCtx := logger.WithContext(c.Request().Context()).WithComponent(logger.ComponentAuth)
@@ -242,18 +304,11 @@ func (h *AuthHandler) Logout(c echo.Context) error {
 	// Clear session cookie
 	h.sessionStore.ClearSession(c)
 
-	// Get Auth0 logout URL
-	frontendURL := os.Getenv("FRONTEND_URL")
-	if frontendURL == "" {
-		frontendURL = "http://localhost:3000"
-	}
-
 	// Construct Auth0 logout URL
-	auth0Config, _ := auth0.NewConfig()
 	logoutURL := fmt.Sprintf("https://%s/v2/logout?client_id=%s&returnTo=%s",
-		auth0Config.Domain,
-		auth0Config.ClientID,
-		frontendURL,
+		h.auth0Config.Domain,
+		h.auth0Config.ClientID,
+		url.QueryEscape(h.frontendURL),
 	)
 	
 	logCtx.Info("User logout completed",
@@ -0,0 +1,43 @@
+package dto
+
+import "time"
+
+// CreateExperienceRequest 体験記録作成リクエスト
+type CreateExperienceRequest struct {
+	UserID         string    `json:"-"`
+	StartTime      time.Time `json:"start_time" validate:"required"`
+	EndTime        time.Time `json:"end_time" validate:"required,meditation_time_range=StartTime"`
+	MeditationType string    `json:"meditation_type" validate:"required,meditation_type"`
+	CustomType     string    `json:"custom_meditation_type,omitempty" validate:"max=100"`
+	Note           string    `json:"note,omitempty" validate:"max=2000"`
+	EmotionBefore  string    `json:"emotion_before" validate:"required,emotion_level"`
+	EmotionAfter   string    `json:"emotion_after" validate:"required,emotion_level"`
+	// Journal Markdownで書く振り返り
+	Journal  string   `json:"journal,omitempty" validate:"max=20000"`
+	Tags     []string `json:"tags,omitempty" validate:"max=10,dive,experience_tag"`
+	IsPublic bool     `json:"is_public,omitempty"`
+}
+
+// ExperienceDTO 体験記録のDTO
+type ExperienceDTO struct {
+	ExperienceID    string    `json:"experience_id"`
+	UserID          string    `json:"user_id"`
+	StartTime       time.Time `json:"start_time"`
+	EndTime         time.Time `json:"end_time"`
+	DurationSeconds int64     `json:"duration_seconds"`
+	MeditationType  string    `json:"meditation_type"`
+	CustomType      string    `json:"custom_meditation_type,omitempty"`
+	Note            string    `json:"note"`
+	EmotionBefore   string    `json:"emotion_before"`
+	EmotionAfter    string    `json:"emotion_after"`
+	// Journal Markdownの振り返り（表示時にクライアントでレンダリングする）
+	Journal string   `json:"journal"`
+	Tags    []string `json:"tags"`
+	// IsDraft ライブタイマーから作成され、感情の入力を待っているか
+	IsDraft  bool `json:"is_draft"`
+	IsPublic bool `json:"is_public"`
+	// IsHidden モデレーターが非表示にしたか（本人以外には公開中でも見えない）
+	IsHidden  bool      `json:"is_hidden"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "time"
+
+// ExportHistoryRequest 体験記録の書き出し条件（クエリパラメータ）
+type ExportHistoryRequest struct {
+	UserID string `query:"-"`
+	// Format csv、json、ics（iCalendar）
+	Format string `query:"format" validate:"required,oneof=csv json ics"`
+	// From と To は瞑想の開始時刻の範囲（省略時は制限なし）
+	From time.Time `query:"from"`
+	To   time.Time `query:"to"`
+	// TimeZone CSV と JSON の時刻のタイムゾーン（既定はUTC、ics は常にUTC）
+	TimeZone string `query:"time_zone" validate:"max=64"`
+	// Language ics の予定の件名に使う瞑想タイプ名の言語（既定は日本語）
+	Language string `query:"lang" validate:"omitempty,oneof=ja en"`
+}
@@ -0,0 +1,65 @@
+package dto
+
+// ImportMappingDTO 書き出されたファイルのどの列に瞑想の記録があるか
+type ImportMappingDTO struct {
+	StartColumn string `json:"start_column" validate:"required,max=100"`
+	// EndColumn と DurationColumn のどちらかが必要
+	EndColumn      string `json:"end_column,omitempty" validate:"max=100"`
+	DurationColumn string `json:"duration_column,omitempty" validate:"max=100"`
+	// DurationUnit seconds、minutes、hms（H:MM:SS または MM:SS）
+	DurationUnit string `json:"duration_unit,omitempty" validate:"omitempty,oneof=seconds minutes hms"`
+	// TimeFormat rfc3339（既定）、unix、または Go のレイアウト（"2006-01-02 15:04" など）
+	TimeFormat string `json:"time_format,omitempty" validate:"max=50"`
+	TypeColumn string `json:"type_column,omitempty" validate:"max=100"`
+	// CustomTypeColumn 種類が other の行の自由記述の列
+	CustomTypeColumn string `json:"custom_type_column,omitempty" validate:"max=100"`
+	// DefaultType 瞑想の種類の列がない、または空の行に使う種類
+	DefaultType         string `json:"default_type,omitempty" validate:"max=100"`
+	NoteColumn          string `json:"note_column,omitempty" validate:"max=100"`
+	EmotionBeforeColumn string `json:"emotion_before_column,omitempty" validate:"max=100"`
+	EmotionAfterColumn  string `json:"emotion_after_column,omitempty" validate:"max=100"`
+}
+
+// ImportHistoryRequest 他のアプリの瞑想履歴の取り込みリクエスト
+type ImportHistoryRequest struct {
+	UserID string `json:"-"`
+	// Format csv または json
+	Format string `json:"format" validate:"required,oneof=csv json"`
+	// Data 書き出されたファイルの内容
+	Data string `json:"data" validate:"required,max=10485760"`
+	// Preset よく使われるアプリの列の対応（Mapping とどちらか一方を指定）
+	Preset  string            `json:"preset,omitempty" validate:"max=50"`
+	Mapping *ImportMappingDTO `json:"mapping,omitempty"`
+	// TimeZone オフセットのない時刻のタイムゾーン（既定は UTC）
+	TimeZone string `json:"time_zone,omitempty" validate:"max=64"`
+	// DryRun 保存せずに結果だけを返す
+	DryRun bool `json:"dry_run,omitempty"`
+	// SkipInvalid 取り込めない行があっても残りを保存する
+	SkipInvalid bool `json:"skip_invalid,omitempty"`
+}
+
+// ImportRowError 取り込めなかった行
+type ImportRowError struct {
+	// Row ヘッダーを除いた 1 始まりの行番号
+	Row     int    `json:"row"`
+	Column  string `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+// ImportHistoryResponse 取り込みの結果
+type ImportHistoryResponse struct {
+	Rows int `json:"rows"`
+	// Imported 保存した（ドライランでは保存できる）件数
+	Imported int `json:"imported"`
+	// Drafts Imported のうち感情がなく、下書きとして保存される件数
+	Drafts int `json:"drafts"`
+	// Duplicates すでに記録がある、またはファイル内で重複していた件数
+	Duplicates    int   `json:"duplicates"`
+	DuplicateRows []int `json:"duplicate_rows"`
+	Invalid       int   `json:"invalid"`
+	// Errors 取り込めなかった行と理由
+	Errors []ImportRowError `json:"errors"`
+	DryRun bool             `json:"dry_run"`
+	// Committed 保存したか（ドライランか、取り込めない行があって skip_invalid でない場合は false）
+	Committed bool `json:"committed"`
+}
@@ -0,0 +1,66 @@
+package dto
+
+import "time"
+
+// UpdateVisibilityRequest 体験記録の公開設定リクエスト
+type UpdateVisibilityRequest struct {
+	ExperienceID string `json:"-"`
+	UserID       string `json:"-"`
+	IsPublic     *bool  `json:"is_public" validate:"required"`
+}
+
+// ReactionCountDTO リアクションの種類ごとの件数
+type ReactionCountDTO struct {
+	Kind  string `json:"kind"`
+	Emoji string `json:"emoji"`
+	Count int    `json:"count"`
+	// Reacted 閲覧者自身がこのリアクションをしているか
+	Reacted bool `json:"reacted"`
+}
+
+// ReactionsResponse 体験記録へのリアクション（すべての種類を表示順に返す）
+type ReactionsResponse struct {
+	Reactions []ReactionCountDTO `json:"reactions"`
+}
+
+// CreateCommentRequest コメント投稿リクエスト
+type CreateCommentRequest struct {
+	ExperienceID string `json:"-"`
+	UserID       string `json:"-"`
+	UserName     string `json:"-"`
+	Body         string `json:"body" validate:"required,max=1000"`
+	// ParentID 返信先のコメント（トップレベルのコメントでは省略）
+	ParentID string `json:"parent_id,omitempty" validate:"omitempty,uuid"`
+}
+
+// UpdateCommentRequest コメント編集リクエスト
+type UpdateCommentRequest struct {
+	ExperienceID string `json:"-"`
+	CommentID    string `json:"-"`
+	UserID       string `json:"-"`
+	Body         string `json:"body" validate:"required,max=1000"`
+}
+
+// CommentDTO コメントのDTO（返信を入れ子で持つ）
+type CommentDTO struct {
+	CommentID string `json:"comment_id"`
+	ParentID  string `json:"parent_id,omitempty"`
+	// AuthorID・AuthorName・Body は削除済み・非表示のコメントでは空
+	AuthorID   string `json:"author_id,omitempty"`
+	AuthorName string `json:"author_name,omitempty"`
+	Body       string `json:"body"`
+	IsMine     bool   `json:"is_mine"`
+	IsDeleted  bool   `json:"is_deleted"`
+	// IsHidden モデレーターが非表示にしたか
+	IsHidden  bool         `json:"is_hidden"`
+	EditedAt  *time.Time   `json:"edited_at,omitempty"`
+	CreatedAt time.Time    `json:"created_at"`
+	Replies   []CommentDTO `json:"replies"`
+}
+
+// CommentThreadResponse 体験記録のコメント一覧（古い順）
+type CommentThreadResponse struct {
+	Comments []CommentDTO `json:"comments"`
+	// Count 削除済み・非表示を除いたコメント数
+	Count int `json:"count"`
+}
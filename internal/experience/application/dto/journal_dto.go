@@ -0,0 +1,43 @@
+package dto
+
+import "time"
+
+// UpdateJournalRequest 体験記録の振り返りとタグの更新リクエスト
+type UpdateJournalRequest struct {
+	ExperienceID string   `json:"-"`
+	UserID       string   `json:"-"`
+	Journal      string   `json:"journal" validate:"max=20000"`
+	Tags         []string `json:"tags" validate:"max=10,dive,experience_tag"`
+}
+
+// SearchExperiencesRequest 自分の体験記録の検索条件（クエリパラメータ）
+type SearchExperiencesRequest struct {
+	UserID string `query:"-"`
+	// Query メモ・振り返り・自由記述の瞑想タイプに含まれる語（空白区切りですべてを含むもの）
+	Query string    `query:"q" validate:"max=200"`
+	Tags  []string  `query:"tag" validate:"max=10,dive,experience_tag"`
+	From  time.Time `query:"from"`
+	To    time.Time `query:"to"`
+	// MeditationType 廃止済みの種類も指定できる
+	MeditationType string `query:"meditation_type" validate:"max=100"`
+	Limit          int    `query:"limit" validate:"omitempty,gte=1,lte=100"`
+	Offset         int    `query:"offset" validate:"gte=0"`
+}
+
+// SearchExperiencesResponse 体験記録の検索結果
+type SearchExperiencesResponse struct {
+	Experiences []ExperienceDTO `json:"experiences"`
+	// NextOffset 続きがある場合に次のページを取得する offset
+	NextOffset *int `json:"next_offset,omitempty"`
+}
+
+// TagCountDTO タグと使用回数
+type TagCountDTO struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+// ListTagsResponse 自分が使ったタグの一覧
+type ListTagsResponse struct {
+	Tags []TagCountDTO `json:"tags"`
+}
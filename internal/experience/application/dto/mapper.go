@@ -0,0 +1,140 @@
+package dto
+
+import (
+	"time"
+
+	"zen-connect/internal/experience/domain"
+)
+
+// FromExperience ドメインの体験記録をDTOに変換
+func FromExperience(experience *domain.Experience) *ExperienceDTO {
+	session := experience.Content().Session()
+
+	response := &ExperienceDTO{
+		ExperienceID:    experience.ID(),
+		UserID:          experience.UserID(),
+		StartTime:       session.StartTime(),
+		EndTime:         session.EndTime(),
+		DurationSeconds: int64(session.Duration().Seconds()),
+		MeditationType:  session.MeditationType(),
+		CustomType:      session.CustomType(),
+		Note:            session.Note(),
+		Journal:         experience.Journal().Entry(),
+		Tags:            experience.Journal().Tags(),
+		IsDraft:         experience.IsDraft(),
+		IsPublic:        experience.IsPublic(),
+		IsHidden:        experience.IsHidden(),
+		CreatedAt:       experience.CreatedAt(),
+		UpdatedAt:       experience.UpdatedAt(),
+	}
+	if emotionalState := experience.Content().EmotionalState(); emotionalState != nil {
+		response.EmotionBefore = emotionalState.Before()
+		response.EmotionAfter = emotionalState.After()
+	}
+	return response
+}
+
+// FromTimerSession ドメインのライブタイマーをDTOに変換
+// abandonTimeout は無操作で自動終了されるまでの時間
+func FromTimerSession(timer *domain.TimerSession, now time.Time, abandonTimeout time.Duration) TimerSessionDTO {
+	response := TimerSessionDTO{
+		TimerSessionID: timer.ID(),
+		MeditationType: timer.MeditationType(),
+		CustomType:     timer.CustomType(),
+		Status:         string(timer.Status()),
+		StartedAt:      timer.StartedAt(),
+		ElapsedSeconds: int64(timer.Elapsed(now).Seconds()),
+		Interruptions:  []InterruptionDTO{},
+		LastActivityAt: timer.LastActivityAt(),
+		FinishReason:   string(timer.FinishReason()),
+		ExperienceID:   timer.ExperienceID(),
+		ServerTime:     now,
+	}
+	for _, interruption := range timer.Interruptions() {
+		item := InterruptionDTO{
+			PausedAt:        interruption.PausedAt,
+			DurationSeconds: int64(interruption.Duration(now).Seconds()),
+		}
+		if !interruption.ResumedAt.IsZero() {
+			resumedAt := interruption.ResumedAt
+			item.ResumedAt = &resumedAt
+		}
+		response.Interruptions = append(response.Interruptions, item)
+	}
+	if timer.IsActive() {
+		abandonsAt := timer.LastActivityAt().Add(abandonTimeout)
+		response.AbandonsAt = &abandonsAt
+	} else {
+		finishedAt := timer.FinishedAt()
+		response.FinishedAt = &finishedAt
+	}
+	return response
+}
+
+// FromMeditationType ドメインの瞑想タイプをDTOに変換
+func FromMeditationType(mt *domain.MeditationType) MeditationTypeDTO {
+	return MeditationTypeDTO{
+		ID:                         mt.ID(),
+		Names:                      mt.Names(),
+		Descriptions:               mt.Descriptions(),
+		RecommendedDurationSeconds: int64(mt.RecommendedDuration().Seconds()),
+		Categories:                 mt.Categories(),
+		SortOrder:                  mt.SortOrder(),
+		IsActive:                   mt.IsActive(),
+		RequiresCustomType:         mt.ID() == domain.OtherMeditationTypeID,
+	}
+}
+
+// FromComment ドメインのコメントをDTOに変換（返信は含まない）
+// 削除済み・モデレーターが非表示にしたコメントは投稿者と本文を伏せる
+func FromComment(comment *domain.Comment, viewerID string) CommentDTO {
+	response := CommentDTO{
+		CommentID: comment.ID(),
+		ParentID:  comment.ParentID(),
+		IsDeleted: comment.IsDeleted(),
+		IsHidden:  comment.IsHidden(),
+		CreatedAt: comment.CreatedAt(),
+		Replies:   []CommentDTO{},
+	}
+	if !comment.IsDeleted() && !comment.IsHidden() {
+		response.AuthorID = comment.AuthorID()
+		response.AuthorName = comment.AuthorName()
+		response.Body = comment.Body()
+		response.IsMine = comment.IsWrittenBy(viewerID)
+		if comment.IsEdited() {
+			editedAt := comment.EditedAt()
+			response.EditedAt = &editedAt
+		}
+	}
+	return response
+}
+
+// FromCommentThread 古い順のコメントを返信の入れ子に組み立てる
+// 削除済み・非表示のコメントは、表示する返信がある場合だけスレッドに残す
+func FromCommentThread(comments []*domain.Comment, viewerID string) *CommentThreadResponse {
+	replies := make(map[string][]*domain.Comment)
+	for _, comment := range comments {
+		replies[comment.ParentID()] = append(replies[comment.ParentID()], comment)
+	}
+
+	response := &CommentThreadResponse{}
+	var build func(parentID string) []CommentDTO
+	build = func(parentID string) []CommentDTO {
+		thread := []CommentDTO{}
+		for _, comment := range replies[parentID] {
+			node := FromComment(comment, viewerID)
+			node.Replies = build(comment.ID())
+			masked := comment.IsDeleted() || comment.IsHidden()
+			if masked && len(node.Replies) == 0 {
+				continue
+			}
+			if !masked {
+				response.Count++
+			}
+			thread = append(thread, node)
+		}
+		return thread
+	}
+	response.Comments = build("")
+	return response
+}
@@ -0,0 +1,40 @@
+package dto
+
+// MeditationTypeDTO 瞑想タイプカタログの項目
+type MeditationTypeDTO struct {
+	ID                         string            `json:"id"`
+	Names                      map[string]string `json:"names"`
+	Descriptions               map[string]string `json:"descriptions"`
+	RecommendedDurationSeconds int64             `json:"recommended_duration_seconds"`
+	Categories                 []string          `json:"categories"`
+	SortOrder                  int               `json:"sort_order"`
+	IsActive                   bool              `json:"is_active"`
+	// RequiresCustomType 自由記述の種類（custom_meditation_type）が必要か
+	RequiresCustomType bool `json:"requires_custom_type"`
+}
+
+// ListMeditationTypesResponse 瞑想タイプカタログ一覧レスポンス
+type ListMeditationTypesResponse struct {
+	MeditationTypes []MeditationTypeDTO `json:"meditation_types"`
+}
+
+// CreateMeditationTypeRequest 瞑想タイプ作成リクエスト（管理者用）
+type CreateMeditationTypeRequest struct {
+	ID                         string            `json:"id" validate:"required,max=50"`
+	Names                      map[string]string `json:"names" validate:"required"`
+	Descriptions               map[string]string `json:"descriptions,omitempty"`
+	RecommendedDurationSeconds int64             `json:"recommended_duration_seconds" validate:"gt=0"`
+	Categories                 []string          `json:"categories,omitempty" validate:"max=10,dive,max=30"`
+	SortOrder                  int               `json:"sort_order,omitempty"`
+}
+
+// UpdateMeditationTypeRequest 瞑想タイプ更新リクエスト（管理者用）
+type UpdateMeditationTypeRequest struct {
+	ID                         string            `json:"-"`
+	Names                      map[string]string `json:"names" validate:"required"`
+	Descriptions               map[string]string `json:"descriptions,omitempty"`
+	RecommendedDurationSeconds int64             `json:"recommended_duration_seconds" validate:"gt=0"`
+	Categories                 []string          `json:"categories,omitempty" validate:"max=10,dive,max=30"`
+	SortOrder                  int               `json:"sort_order,omitempty"`
+	IsActive                   bool              `json:"is_active"`
+}
@@ -0,0 +1,55 @@
+package dto
+
+import "time"
+
+// StartTimerSessionRequest ライブタイマー開始リクエスト
+type StartTimerSessionRequest struct {
+	UserID         string `json:"-"`
+	MeditationType string `json:"meditation_type" validate:"required,meditation_type"`
+	CustomType     string `json:"custom_meditation_type,omitempty" validate:"max=100"`
+}
+
+// InterruptionDTO タイマーの中断（一時停止）
+type InterruptionDTO struct {
+	PausedAt time.Time `json:"paused_at"`
+	// ResumedAt 一時停止中の場合は省略
+	ResumedAt       *time.Time `json:"resumed_at,omitempty"`
+	DurationSeconds int64      `json:"duration_seconds"`
+}
+
+// TimerSessionDTO ライブタイマーのDTO
+// 再接続したクライアントは server_time と elapsed_seconds から表示を復元する
+type TimerSessionDTO struct {
+	TimerSessionID string            `json:"timer_session_id"`
+	MeditationType string            `json:"meditation_type"`
+	CustomType     string            `json:"custom_meditation_type,omitempty"`
+	Status         string            `json:"status"`
+	StartedAt      time.Time         `json:"started_at"`
+	ElapsedSeconds int64             `json:"elapsed_seconds"`
+	Interruptions  []InterruptionDTO `json:"interruptions"`
+	LastActivityAt time.Time         `json:"last_activity_at"`
+	// AbandonsAt この時刻までに操作やハートビートがなければサーバーが自動終了する
+	AbandonsAt   *time.Time `json:"abandons_at,omitempty"`
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+	FinishReason string     `json:"finish_reason,omitempty"`
+	// ExperienceID 終了時に作成された下書きの体験記録
+	ExperienceID string    `json:"experience_id,omitempty"`
+	ServerTime   time.Time `json:"server_time"`
+}
+
+// FinishTimerSessionResponse ライブタイマー終了レスポンス
+type FinishTimerSessionResponse struct {
+	TimerSession TimerSessionDTO `json:"timer_session"`
+	// Experience 感情の入力を待つ下書きの体験記録（記録できる瞑想時間がない場合は省略）
+	Experience *ExperienceDTO `json:"experience,omitempty"`
+}
+
+// CompleteExperienceRequest 下書きの体験記録に感情を入力するリクエスト
+type CompleteExperienceRequest struct {
+	ExperienceID  string `json:"-"`
+	UserID        string `json:"-"`
+	EmotionBefore string `json:"emotion_before" validate:"required,emotion_level"`
+	EmotionAfter  string `json:"emotion_after" validate:"required,emotion_level"`
+	Note          string `json:"note,omitempty" validate:"max=2000"`
+	IsPublic      bool   `json:"is_public,omitempty"`
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"zen-connect/internal/experience/domain"
+)
+
+// MeditationTypeCatalogService 瞑想タイプカタログをキャッシュして提供するサービス
+// 管理者による変更は同じプロセス内では即時に、他のインスタンスでは ttl 経過後に反映される
+type MeditationTypeCatalogService struct {
+	repo domain.MeditationTypeRepository
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu       sync.Mutex
+	catalog  *domain.MeditationTypeCatalog
+	loadedAt time.Time
+}
+
+// NewMeditationTypeCatalogService コンストラクタ
+func NewMeditationTypeCatalogService(repo domain.MeditationTypeRepository, ttl time.Duration) *MeditationTypeCatalogService {
+	return &MeditationTypeCatalogService{
+		repo: repo,
+		ttl:  ttl,
+		now:  time.Now,
+	}
+}
+
+// Catalog 現在のカタログを取得（キャッシュが古い場合はリポジトリから再読み込み）
+func (s *MeditationTypeCatalogService) Catalog(ctx context.Context) (*domain.MeditationTypeCatalog, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.catalog != nil && s.now().Sub(s.loadedAt) < s.ttl {
+		return s.catalog, nil
+	}
+
+	types, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.catalog = domain.NewMeditationTypeCatalog(types)
+	s.loadedAt = s.now()
+	return s.catalog, nil
+}
+
+// Invalidate キャッシュを破棄し、次回の参照で再読み込みさせる
+func (s *MeditationTypeCatalogService) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.catalog = nil
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/domain"
+)
+
+// CompleteExperienceUseCase 下書きの体験記録に感情を入力するユースケース
+type CompleteExperienceUseCase struct {
+	experienceRepo domain.ExperienceRepository
+	screener       ContentScreener
+	publisher      EventPublisher
+}
+
+// NewCompleteExperienceUseCase コンストラクタ
+func NewCompleteExperienceUseCase(experienceRepo domain.ExperienceRepository, screener ContentScreener, publisher EventPublisher) *CompleteExperienceUseCase {
+	return &CompleteExperienceUseCase{
+		experienceRepo: experienceRepo,
+		screener:       screener,
+		publisher:      publisher,
+	}
+}
+
+// Execute 感情状態を入力して下書きを完成させる
+func (uc *CompleteExperienceUseCase) Execute(ctx context.Context, req *dto.CompleteExperienceRequest) (*dto.ExperienceDTO, error) {
+	experience, err := uc.experienceRepo.FindByID(ctx, req.ExperienceID)
+	if err != nil {
+		return nil, err
+	}
+	// 他のユーザーの体験記録は見つからない扱い
+	if !experience.BelongsToUser(req.UserID) {
+		return nil, domain.ErrExperienceNotFound
+	}
+
+	if err := uc.screener.Screen(ctx, req.Note); err != nil {
+		return nil, err
+	}
+	emotionalState, err := domain.NewEmotionalStateWithValidation(req.EmotionBefore, req.EmotionAfter)
+	if err != nil {
+		return nil, err
+	}
+	if err := experience.CompleteDraft(emotionalState, req.Note); err != nil {
+		return nil, err
+	}
+	if req.IsPublic {
+		experience.MakePublic()
+	}
+
+	if err := uc.experienceRepo.Save(ctx, experience); err != nil {
+		return nil, err
+	}
+	uc.publisher.Publish(ctx, experience.Events()...)
+	experience.ClearEvents()
+
+	return dto.FromExperience(experience), nil
+}
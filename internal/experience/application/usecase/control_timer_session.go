@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/domain"
+)
+
+// ControlTimerSessionUseCase ライブタイマーの参照・一時停止・再開・ハートビートのユースケース
+type ControlTimerSessionUseCase struct {
+	timerRepo      domain.TimerSessionRepository
+	abandonTimeout time.Duration
+}
+
+// NewControlTimerSessionUseCase コンストラクタ
+func NewControlTimerSessionUseCase(timerRepo domain.TimerSessionRepository, abandonTimeout time.Duration) *ControlTimerSessionUseCase {
+	return &ControlTimerSessionUseCase{
+		timerRepo:      timerRepo,
+		abandonTimeout: abandonTimeout,
+	}
+}
+
+// Current ユーザーの進行中のタイマーを取得（再接続用）
+func (uc *ControlTimerSessionUseCase) Current(ctx context.Context, userID string) (*dto.TimerSessionDTO, error) {
+	timer, err := uc.timerRepo.FindActiveByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	response := dto.FromTimerSession(timer, time.Now(), uc.abandonTimeout)
+	return &response, nil
+}
+
+// Get タイマーを取得（他のユーザーのタイマーは見つからない扱い）
+func (uc *ControlTimerSessionUseCase) Get(ctx context.Context, userID, timerSessionID string) (*dto.TimerSessionDTO, error) {
+	timer, err := findOwnTimerSession(ctx, uc.timerRepo, userID, timerSessionID)
+	if err != nil {
+		return nil, err
+	}
+	response := dto.FromTimerSession(timer, time.Now(), uc.abandonTimeout)
+	return &response, nil
+}
+
+// Pause タイマーを一時停止（中断として記録される）
+func (uc *ControlTimerSessionUseCase) Pause(ctx context.Context, userID, timerSessionID string) (*dto.TimerSessionDTO, error) {
+	return uc.apply(ctx, userID, timerSessionID, (*domain.TimerSession).Pause)
+}
+
+// Resume 一時停止したタイマーを再開
+func (uc *ControlTimerSessionUseCase) Resume(ctx context.Context, userID, timerSessionID string) (*dto.TimerSessionDTO, error) {
+	return uc.apply(ctx, userID, timerSessionID, (*domain.TimerSession).Resume)
+}
+
+// Heartbeat クライアントが接続中であることを記録（自動終了までの時間が延長される）
+func (uc *ControlTimerSessionUseCase) Heartbeat(ctx context.Context, userID, timerSessionID string) (*dto.TimerSessionDTO, error) {
+	return uc.apply(ctx, userID, timerSessionID, (*domain.TimerSession).Heartbeat)
+}
+
+// apply タイマーに操作を適用して保存
+// 別の端末から同時に操作された場合は ErrTimerSessionConflict
+func (uc *ControlTimerSessionUseCase) apply(ctx context.Context, userID, timerSessionID string, action func(*domain.TimerSession, time.Time) error) (*dto.TimerSessionDTO, error) {
+	timer, err := findOwnTimerSession(ctx, uc.timerRepo, userID, timerSessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := action(timer, now); err != nil {
+		return nil, err
+	}
+	if err := uc.timerRepo.Update(ctx, timer); err != nil {
+		return nil, err
+	}
+
+	response := dto.FromTimerSession(timer, now, uc.abandonTimeout)
+	return &response, nil
+}
+
+// findOwnTimerSession ユーザー自身のタイマーを取得
+func findOwnTimerSession(ctx context.Context, timerRepo domain.TimerSessionRepository, userID, timerSessionID string) (*domain.TimerSession, error) {
+	timer, err := timerRepo.FindByID(ctx, timerSessionID)
+	if err != nil {
+		return nil, err
+	}
+	if !timer.BelongsToUser(userID) {
+		return nil, domain.ErrTimerSessionNotFound
+	}
+	return timer, nil
+}
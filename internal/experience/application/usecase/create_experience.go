@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/application/service"
+	"zen-connect/internal/experience/domain"
+)
+
+// CreateExperienceUseCase 体験記録作成ユースケース
+type CreateExperienceUseCase struct {
+	experienceRepo domain.ExperienceRepository
+	catalogService *service.MeditationTypeCatalogService
+	screener       ContentScreener
+	publisher      EventPublisher
+}
+
+// NewCreateExperienceUseCase コンストラクタ
+func NewCreateExperienceUseCase(experienceRepo domain.ExperienceRepository, catalogService *service.MeditationTypeCatalogService, screener ContentScreener, publisher EventPublisher) *CreateExperienceUseCase {
+	return &CreateExperienceUseCase{
+		experienceRepo: experienceRepo,
+		catalogService: catalogService,
+		screener:       screener,
+		publisher:      publisher,
+	}
+}
+
+// Execute 体験記録を作成
+func (uc *CreateExperienceUseCase) Execute(ctx context.Context, req *dto.CreateExperienceRequest) (*dto.ExperienceDTO, error) {
+	// 禁止語を含むメモ・振り返りは保存しない
+	if err := uc.screener.Screen(ctx, req.Note, req.Journal); err != nil {
+		return nil, err
+	}
+
+	catalog, err := uc.catalogService.Catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 瞑想セッションの値オブジェクトを作成（瞑想タイプはカタログの正規IDに変換される）
+	session, err := domain.NewMeditationSessionWithValidation(req.StartTime, req.EndTime, req.MeditationType, req.CustomType, req.Note, catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	// 感情状態の値オブジェクトを作成
+	emotionalState, err := domain.NewEmotionalStateWithValidation(req.EmotionBefore, req.EmotionAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	// 振り返りとタグ（タグは正規化・重複除去される）
+	journal, err := domain.NewJournal(req.Journal, req.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	content, err := domain.NewExperienceContentWithValidation(session, emotionalState, now, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// 体験記録エンティティを作成
+	experience, err := domain.NewExperienceWithValidation(req.UserID, content)
+	if err != nil {
+		return nil, err
+	}
+	if err := experience.UpdateJournal(journal); err != nil {
+		return nil, err
+	}
+	if req.IsPublic {
+		experience.MakePublic()
+	}
+
+	// 体験記録を保存
+	if err := uc.experienceRepo.Save(ctx, experience); err != nil {
+		return nil, err
+	}
+	uc.publisher.Publish(ctx, experience.Events()...)
+	experience.ClearEvents()
+
+	return dto.FromExperience(experience), nil
+}
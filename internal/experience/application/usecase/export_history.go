@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/application/service"
+	"zen-connect/internal/experience/domain"
+)
+
+// ExportOptions 書き出し形式に共通の設定
+type ExportOptions struct {
+	// Location CSV と JSON の時刻のタイムゾーン
+	Location *time.Location
+	// Language 瞑想タイプ名の言語
+	Language string
+	Catalog  *domain.MeditationTypeCatalog
+}
+
+// HistoryEncoder 体験記録を書き出し形式で書き込むポート
+type HistoryEncoder interface {
+	NewWriter(w io.Writer, format string, options ExportOptions) (HistoryWriter, error)
+}
+
+// HistoryWriter 体験記録を一件ずつ書き込み、Close で書き終える
+type HistoryWriter interface {
+	Write(experience *domain.Experience) error
+	Close() error
+}
+
+// ExportHistoryUseCase 自分の体験記録を書き出すユースケース
+type ExportHistoryUseCase struct {
+	experienceRepo domain.ExperienceRepository
+	catalogService *service.MeditationTypeCatalogService
+	encoder        HistoryEncoder
+}
+
+// NewExportHistoryUseCase コンストラクタ
+func NewExportHistoryUseCase(experienceRepo domain.ExperienceRepository, catalogService *service.MeditationTypeCatalogService, encoder HistoryEncoder) *ExportHistoryUseCase {
+	return &ExportHistoryUseCase{
+		experienceRepo: experienceRepo,
+		catalogService: catalogService,
+		encoder:        encoder,
+	}
+}
+
+// Execute 期間内の体験記録を古い順に w へ書き出す
+// 履歴全体をメモリに載せないよう、読み出した行から順に書き込む
+func (uc *ExportHistoryUseCase) Execute(ctx context.Context, req *dto.ExportHistoryRequest, w io.Writer) error {
+	if !req.From.IsZero() && !req.To.IsZero() && !req.To.After(req.From) {
+		return domain.ErrInvalidExportRange
+	}
+	options := ExportOptions{Location: time.UTC, Language: req.Language}
+	if req.TimeZone != "" {
+		location, err := time.LoadLocation(req.TimeZone)
+		if err != nil {
+			return domain.ErrInvalidHistoryTimeZone
+		}
+		options.Location = location
+	}
+	if options.Language == "" {
+		options.Language = domain.LanguageJapanese
+	}
+	catalog, err := uc.catalogService.Catalog(ctx)
+	if err != nil {
+		return err
+	}
+	options.Catalog = catalog
+
+	writer, err := uc.encoder.NewWriter(w, req.Format, options)
+	if err != nil {
+		return err
+	}
+	if err := uc.experienceRepo.Export(ctx, req.UserID, req.From, req.To, writer.Write); err != nil {
+		return err
+	}
+	return writer.Close()
+}
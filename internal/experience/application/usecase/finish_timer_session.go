@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/domain"
+)
+
+// abandonedTimerBatchSize 1回の自動終了で処理するタイマーの上限
+const abandonedTimerBatchSize = 100
+
+// FinishTimerSessionUseCase ライブタイマー終了ユースケース
+type FinishTimerSessionUseCase struct {
+	timerRepo      domain.TimerSessionRepository
+	publisher      EventPublisher
+	abandonTimeout time.Duration
+}
+
+// NewFinishTimerSessionUseCase コンストラクタ
+func NewFinishTimerSessionUseCase(timerRepo domain.TimerSessionRepository, publisher EventPublisher, abandonTimeout time.Duration) *FinishTimerSessionUseCase {
+	return &FinishTimerSessionUseCase{
+		timerRepo:      timerRepo,
+		publisher:      publisher,
+		abandonTimeout: abandonTimeout,
+	}
+}
+
+// Execute タイマーを終了し、瞑想セッションを感情の入力待ちの下書き体験記録にする
+func (uc *FinishTimerSessionUseCase) Execute(ctx context.Context, userID, timerSessionID string) (*dto.FinishTimerSessionResponse, error) {
+	timer, err := findOwnTimerSession(ctx, uc.timerRepo, userID, timerSessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session, err := timer.Finish(now)
+	draft, err := finishTimerSession(ctx, uc.timerRepo, uc.publisher, timer, session, err, now)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.FinishTimerSessionResponse{
+		TimerSession: dto.FromTimerSession(timer, now, uc.abandonTimeout),
+	}
+	if draft != nil {
+		response.Experience = dto.FromExperience(draft)
+	}
+	return response, nil
+}
+
+// FinishAbandonedTimerSessionsUseCase 放置されたライブタイマーの自動終了ユースケース
+type FinishAbandonedTimerSessionsUseCase struct {
+	timerRepo      domain.TimerSessionRepository
+	publisher      EventPublisher
+	abandonTimeout time.Duration
+}
+
+// NewFinishAbandonedTimerSessionsUseCase コンストラクタ
+func NewFinishAbandonedTimerSessionsUseCase(timerRepo domain.TimerSessionRepository, publisher EventPublisher, abandonTimeout time.Duration) *FinishAbandonedTimerSessionsUseCase {
+	return &FinishAbandonedTimerSessionsUseCase{
+		timerRepo:      timerRepo,
+		publisher:      publisher,
+		abandonTimeout: abandonTimeout,
+	}
+}
+
+// Execute abandonTimeout の間操作のないタイマーを最後の操作時点で終了し、終了した件数を返す
+// 同時に操作されたタイマー（他のインスタンスやクライアントが先に更新したもの）は次回に回す
+func (uc *FinishAbandonedTimerSessionsUseCase) Execute(ctx context.Context) (int, error) {
+	now := time.Now()
+	timers, err := uc.timerRepo.FindAbandoned(ctx, now.Add(-uc.abandonTimeout), abandonedTimerBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	finished := 0
+	var errs []error
+	for _, timer := range timers {
+		session, err := timer.FinishAbandoned(now)
+		if _, err := finishTimerSession(ctx, uc.timerRepo, uc.publisher, timer, session, err, now); err != nil {
+			if !errors.Is(err, domain.ErrTimerSessionConflict) {
+				errs = append(errs, fmt.Errorf("timer session %s: %w", timer.ID(), err))
+			}
+			continue
+		}
+		finished++
+	}
+	return finished, errors.Join(errs...)
+}
+
+// finishTimerSession 終了したタイマーと下書き体験記録を保存
+// 記録できる瞑想時間がない場合はタイマーのみ終了し、下書きは作成しない
+func finishTimerSession(ctx context.Context, timerRepo domain.TimerSessionRepository, publisher EventPublisher, timer *domain.TimerSession, session *domain.MeditationSession, finishErr error, now time.Time) (*domain.Experience, error) {
+	var draft *domain.Experience
+	switch {
+	case errors.Is(finishErr, domain.ErrTimerTooShort):
+	case finishErr != nil:
+		return nil, finishErr
+	default:
+		var err error
+		draft, err = domain.NewDraftExperience(timer.UserID(), session, now)
+		if err != nil {
+			return nil, err
+		}
+		timer.AttachExperience(draft.ID())
+	}
+
+	if err := timerRepo.Finish(ctx, timer, draft); err != nil {
+		return nil, err
+	}
+	if draft != nil {
+		publisher.Publish(ctx, draft.Events()...)
+		draft.ClearEvents()
+	}
+	return draft, nil
+}
@@ -0,0 +1,243 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/application/service"
+	"zen-connect/internal/experience/domain"
+)
+
+// HistoryDecoder 他のアプリから書き出されたファイルを列名をキーにした行に分解するポート
+type HistoryDecoder interface {
+	Decode(format string, r io.Reader) ([]domain.ImportRecord, error)
+}
+
+// ImportHistoryUseCase 他のアプリの瞑想履歴を体験記録として取り込むユースケース
+type ImportHistoryUseCase struct {
+	experienceRepo domain.ExperienceRepository
+	catalogService *service.MeditationTypeCatalogService
+	screener       ContentScreener
+	decoder        HistoryDecoder
+}
+
+// NewImportHistoryUseCase コンストラクタ
+func NewImportHistoryUseCase(experienceRepo domain.ExperienceRepository, catalogService *service.MeditationTypeCatalogService, screener ContentScreener, decoder HistoryDecoder) *ImportHistoryUseCase {
+	return &ImportHistoryUseCase{
+		experienceRepo: experienceRepo,
+		catalogService: catalogService,
+		screener:       screener,
+		decoder:        decoder,
+	}
+}
+
+// importCandidate 取り込める行
+type importCandidate struct {
+	row        int
+	experience *domain.Experience
+}
+
+// Execute ファイルの各行を体験記録にし、すでにある記録と重複しないものを一つのトランザクションで保存する
+// 取り込めない行がある場合は、skip_invalid でなければ何も保存しない
+// 集計・目標・バッジ・Webhook は取り込みごとに ProcessImportBatchesUseCase がまとめて行う
+func (uc *ImportHistoryUseCase) Execute(ctx context.Context, req *dto.ImportHistoryRequest) (*dto.ImportHistoryResponse, error) {
+	mapping, err := importMapping(req)
+	if err != nil {
+		return nil, err
+	}
+	location := time.UTC
+	if req.TimeZone != "" {
+		if location, err = time.LoadLocation(req.TimeZone); err != nil {
+			return nil, domain.ErrInvalidHistoryTimeZone
+		}
+	}
+
+	records, err := uc.decoder.Decode(req.Format, strings.NewReader(req.Data))
+	if err != nil {
+		return nil, err
+	}
+	catalog, err := uc.catalogService.Catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ImportHistoryResponse{
+		Rows:          len(records),
+		DuplicateRows: []int{},
+		Errors:        []dto.ImportRowError{},
+		DryRun:        req.DryRun,
+	}
+
+	// 各行をドメインの検証に通す（ファイル内の重複も除く）
+	now := time.Now()
+	var candidates []importCandidate
+	seen := make(map[int64]bool, len(records))
+	for i, record := range records {
+		row := i + 1
+		experience, err := uc.experienceFromRecord(ctx, req.UserID, mapping, record, location, catalog, now)
+		if err != nil {
+			response.Errors = append(response.Errors, rowError(row, err))
+			continue
+		}
+		key := domain.ImportDuplicateKey(experience.Content().Session().StartTime())
+		if seen[key] {
+			response.DuplicateRows = append(response.DuplicateRows, row)
+			continue
+		}
+		seen[key] = true
+		candidates = append(candidates, importCandidate{row: row, experience: experience})
+	}
+
+	// すでに記録がある瞑想は取り込まない
+	candidates, err = uc.withoutRecorded(ctx, req.UserID, candidates, response)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Invalid = len(response.Errors)
+	if req.DryRun || (response.Invalid > 0 && !req.SkipInvalid) {
+		tally(response, candidates)
+		return response, nil
+	}
+
+	experiences := make([]*domain.Experience, 0, len(candidates))
+	for _, candidate := range candidates {
+		experiences = append(experiences, candidate.experience)
+	}
+	imported, err := uc.experienceRepo.Import(ctx, req.UserID, experiences)
+	if err != nil {
+		return nil, err
+	}
+
+	// 確認の後に別の取り込みで保存された行も重複として数える
+	saved := make(map[*domain.Experience]bool, len(imported))
+	for _, experience := range imported {
+		saved[experience] = true
+	}
+	committed := candidates[:0]
+	for _, candidate := range candidates {
+		if saved[candidate.experience] {
+			committed = append(committed, candidate)
+		} else {
+			response.DuplicateRows = append(response.DuplicateRows, candidate.row)
+		}
+	}
+	tally(response, committed)
+	response.Committed = true
+	return response, nil
+}
+
+// experienceFromRecord 一行を体験記録にする（感情のない行は感情の入力を待つ下書きになる）
+func (uc *ImportHistoryUseCase) experienceFromRecord(ctx context.Context, userID string, mapping domain.ImportMapping, record domain.ImportRecord, location *time.Location, catalog *domain.MeditationTypeCatalog, now time.Time) (*domain.Experience, error) {
+	session, err := mapping.Session(record, location, catalog)
+	if err != nil {
+		return nil, err
+	}
+	emotionalState, err := mapping.EmotionalState(record)
+	if err != nil {
+		return nil, err
+	}
+	// 禁止語を含むメモは取り込まない
+	if err := uc.screener.Screen(ctx, session.Note()); err != nil {
+		return nil, &domain.ImportFieldError{Column: mapping.NoteColumn, Err: err}
+	}
+
+	var content *domain.ExperienceContent
+	if emotionalState == nil {
+		content, err = domain.NewDraftExperienceContent(session, now)
+	} else {
+		content, err = domain.NewExperienceContentWithValidation(session, emotionalState, now, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewExperienceWithValidation(userID, content)
+}
+
+// withoutRecorded すでに記録がある瞑想を重複として除く
+func (uc *ImportHistoryUseCase) withoutRecorded(ctx context.Context, userID string, candidates []importCandidate, response *dto.ImportHistoryResponse) ([]importCandidate, error) {
+	if len(candidates) == 0 {
+		return candidates, nil
+	}
+	from, to := candidates[0].experience.Content().Session().StartTime(), candidates[0].experience.Content().Session().StartTime()
+	for _, candidate := range candidates {
+		start := candidate.experience.Content().Session().StartTime()
+		if start.Before(from) {
+			from = start
+		}
+		if start.After(to) {
+			to = start
+		}
+	}
+	starts, err := uc.experienceRepo.SessionStarts(ctx, userID, from.Truncate(time.Minute), to.Truncate(time.Minute).Add(time.Minute))
+	if err != nil {
+		return nil, err
+	}
+	recorded := make(map[int64]bool, len(starts))
+	for _, start := range starts {
+		recorded[domain.ImportDuplicateKey(start)] = true
+	}
+
+	remaining := candidates[:0]
+	for _, candidate := range candidates {
+		if recorded[domain.ImportDuplicateKey(candidate.experience.Content().Session().StartTime())] {
+			response.DuplicateRows = append(response.DuplicateRows, candidate.row)
+			continue
+		}
+		remaining = append(remaining, candidate)
+	}
+	return remaining, nil
+}
+
+// importMapping プリセットまたは指定された列の対応
+func importMapping(req *dto.ImportHistoryRequest) (domain.ImportMapping, error) {
+	if req.Preset != "" {
+		return domain.ImportPreset(req.Preset)
+	}
+	if req.Mapping == nil {
+		return domain.ImportMapping{}, domain.ErrInvalidImportMapping
+	}
+	mapping := domain.ImportMapping{
+		StartColumn:         req.Mapping.StartColumn,
+		EndColumn:           req.Mapping.EndColumn,
+		DurationColumn:      req.Mapping.DurationColumn,
+		DurationUnit:        domain.DurationUnit(req.Mapping.DurationUnit),
+		TimeFormat:          req.Mapping.TimeFormat,
+		TypeColumn:          req.Mapping.TypeColumn,
+		CustomTypeColumn:    req.Mapping.CustomTypeColumn,
+		DefaultType:         req.Mapping.DefaultType,
+		NoteColumn:          req.Mapping.NoteColumn,
+		EmotionBeforeColumn: req.Mapping.EmotionBeforeColumn,
+		EmotionAfterColumn:  req.Mapping.EmotionAfterColumn,
+	}
+	if err := mapping.Validate(); err != nil {
+		return domain.ImportMapping{}, err
+	}
+	return mapping, nil
+}
+
+// rowError 取り込めない行の理由（列がわかる場合は列名も）
+func rowError(row int, err error) dto.ImportRowError {
+	var fieldErr *domain.ImportFieldError
+	if errors.As(err, &fieldErr) {
+		return dto.ImportRowError{Row: row, Column: fieldErr.Column, Message: fieldErr.Err.Error()}
+	}
+	return dto.ImportRowError{Row: row, Message: err.Error()}
+}
+
+// tally 取り込む（取り込んだ）件数を数える
+func tally(response *dto.ImportHistoryResponse, candidates []importCandidate) {
+	response.Imported = len(candidates)
+	for _, candidate := range candidates {
+		if candidate.experience.Content().IsDraft() {
+			response.Drafts++
+		}
+	}
+	sort.Ints(response.DuplicateRows)
+	response.Duplicates = len(response.DuplicateRows)
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/application/service"
+	"zen-connect/internal/experience/domain"
+)
+
+// defaultSearchLimit 件数を指定しない検索で返す件数
+const defaultSearchLimit = 20
+
+// UpdateJournalUseCase 体験記録の振り返りとタグの更新ユースケース
+type UpdateJournalUseCase struct {
+	experienceRepo domain.ExperienceRepository
+	screener       ContentScreener
+}
+
+// NewUpdateJournalUseCase コンストラクタ
+func NewUpdateJournalUseCase(experienceRepo domain.ExperienceRepository, screener ContentScreener) *UpdateJournalUseCase {
+	return &UpdateJournalUseCase{
+		experienceRepo: experienceRepo,
+		screener:       screener,
+	}
+}
+
+// Execute 振り返りとタグを置き換える（下書きにも書ける）
+func (uc *UpdateJournalUseCase) Execute(ctx context.Context, req *dto.UpdateJournalRequest) (*dto.ExperienceDTO, error) {
+	experience, err := uc.experienceRepo.FindByID(ctx, req.ExperienceID)
+	if err != nil {
+		return nil, err
+	}
+	// 他のユーザーの体験記録は見つからない扱い
+	if !experience.BelongsToUser(req.UserID) {
+		return nil, domain.ErrExperienceNotFound
+	}
+
+	if err := uc.screener.Screen(ctx, req.Journal); err != nil {
+		return nil, err
+	}
+	journal, err := domain.NewJournal(req.Journal, req.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := experience.UpdateJournal(journal); err != nil {
+		return nil, err
+	}
+
+	if err := uc.experienceRepo.Save(ctx, experience); err != nil {
+		return nil, err
+	}
+
+	return dto.FromExperience(experience), nil
+}
+
+// SearchExperiencesUseCase 自分の体験記録の検索ユースケース
+type SearchExperiencesUseCase struct {
+	experienceRepo domain.ExperienceRepository
+	catalogService *service.MeditationTypeCatalogService
+}
+
+// NewSearchExperiencesUseCase コンストラクタ
+func NewSearchExperiencesUseCase(experienceRepo domain.ExperienceRepository, catalogService *service.MeditationTypeCatalogService) *SearchExperiencesUseCase {
+	return &SearchExperiencesUseCase{
+		experienceRepo: experienceRepo,
+		catalogService: catalogService,
+	}
+}
+
+// Execute 語句・タグ・期間・瞑想タイプで絞り込み、瞑想の開始が新しい順に返す
+func (uc *SearchExperiencesUseCase) Execute(ctx context.Context, req *dto.SearchExperiencesRequest) (*dto.SearchExperiencesResponse, error) {
+	meditationType := req.MeditationType
+	if meditationType != "" {
+		catalog, err := uc.catalogService.Catalog(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// 名前での指定もカタログの正規IDで検索する（廃止済みの種類でも過去の記録を探せる）
+		mt, ok := catalog.Find(meditationType)
+		if !ok {
+			return nil, domain.ErrUnknownMeditationType
+		}
+		meditationType = mt.ID()
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+	// 続きがあるかを判定するため1件多く取得する
+	criteria, err := domain.NewExperienceSearchCriteria(req.UserID, req.Query, req.Tags, req.From, req.To, meditationType, limit+1, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	experiences, err := uc.experienceRepo.Search(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.SearchExperiencesResponse{
+		Experiences: []dto.ExperienceDTO{},
+	}
+	if len(experiences) > limit {
+		experiences = experiences[:limit]
+		nextOffset := req.Offset + limit
+		response.NextOffset = &nextOffset
+	}
+	for _, experience := range experiences {
+		response.Experiences = append(response.Experiences, *dto.FromExperience(experience))
+	}
+	return response, nil
+}
+
+// ListTagsUseCase 自分が使ったタグ一覧ユースケース
+type ListTagsUseCase struct {
+	experienceRepo domain.ExperienceRepository
+}
+
+// NewListTagsUseCase コンストラクタ
+func NewListTagsUseCase(experienceRepo domain.ExperienceRepository) *ListTagsUseCase {
+	return &ListTagsUseCase{
+		experienceRepo: experienceRepo,
+	}
+}
+
+// Execute タグを使用回数の多い順に取得
+func (uc *ListTagsUseCase) Execute(ctx context.Context, userID string) (*dto.ListTagsResponse, error) {
+	counts, err := uc.experienceRepo.TagCounts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListTagsResponse{
+		Tags: []dto.TagCountDTO{},
+	}
+	for _, count := range counts {
+		response.Tags = append(response.Tags, dto.TagCountDTO{Tag: count.Tag, Count: count.Count})
+	}
+	return response, nil
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/application/service"
+)
+
+// ListMeditationTypesUseCase 瞑想タイプカタログ一覧ユースケース
+type ListMeditationTypesUseCase struct {
+	catalogService *service.MeditationTypeCatalogService
+}
+
+// NewListMeditationTypesUseCase コンストラクタ
+func NewListMeditationTypesUseCase(catalogService *service.MeditationTypeCatalogService) *ListMeditationTypesUseCase {
+	return &ListMeditationTypesUseCase{
+		catalogService: catalogService,
+	}
+}
+
+// Execute カタログを表示順に取得（includeInactive が false の場合は廃止済みを除く）
+func (uc *ListMeditationTypesUseCase) Execute(ctx context.Context, includeInactive bool) (*dto.ListMeditationTypesResponse, error) {
+	catalog, err := uc.catalogService.Catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListMeditationTypesResponse{
+		MeditationTypes: []dto.MeditationTypeDTO{},
+	}
+	for _, mt := range catalog.Types() {
+		if !includeInactive && !mt.IsActive() {
+			continue
+		}
+		response.MeditationTypes = append(response.MeditationTypes, dto.FromMeditationType(mt))
+	}
+	return response, nil
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/application/service"
+	"zen-connect/internal/experience/domain"
+)
+
+// CreateMeditationTypeUseCase 瞑想タイプ作成ユースケース（管理者用）
+type CreateMeditationTypeUseCase struct {
+	repo           domain.MeditationTypeRepository
+	catalogService *service.MeditationTypeCatalogService
+}
+
+// NewCreateMeditationTypeUseCase コンストラクタ
+func NewCreateMeditationTypeUseCase(repo domain.MeditationTypeRepository, catalogService *service.MeditationTypeCatalogService) *CreateMeditationTypeUseCase {
+	return &CreateMeditationTypeUseCase{
+		repo:           repo,
+		catalogService: catalogService,
+	}
+}
+
+// Execute カタログに瞑想タイプを追加
+func (uc *CreateMeditationTypeUseCase) Execute(ctx context.Context, req *dto.CreateMeditationTypeRequest) (*dto.MeditationTypeDTO, error) {
+	mt, err := domain.NewMeditationType(
+		req.ID,
+		req.Names,
+		req.Descriptions,
+		time.Duration(req.RecommendedDurationSeconds)*time.Second,
+		req.Categories,
+		req.SortOrder,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Create(ctx, mt); err != nil {
+		return nil, err
+	}
+	uc.catalogService.Invalidate()
+
+	response := dto.FromMeditationType(mt)
+	return &response, nil
+}
+
+// UpdateMeditationTypeUseCase 瞑想タイプ更新ユースケース（管理者用）
+type UpdateMeditationTypeUseCase struct {
+	repo           domain.MeditationTypeRepository
+	catalogService *service.MeditationTypeCatalogService
+}
+
+// NewUpdateMeditationTypeUseCase コンストラクタ
+func NewUpdateMeditationTypeUseCase(repo domain.MeditationTypeRepository, catalogService *service.MeditationTypeCatalogService) *UpdateMeditationTypeUseCase {
+	return &UpdateMeditationTypeUseCase{
+		repo:           repo,
+		catalogService: catalogService,
+	}
+}
+
+// Execute 瞑想タイプの内容と有効状態を更新
+// IDは体験記録から参照されるため変更できない。削除の代わりに is_active を false にする
+func (uc *UpdateMeditationTypeUseCase) Execute(ctx context.Context, req *dto.UpdateMeditationTypeRequest) (*dto.MeditationTypeDTO, error) {
+	mt, err := uc.repo.FindByID(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := mt.Update(
+		req.Names,
+		req.Descriptions,
+		time.Duration(req.RecommendedDurationSeconds)*time.Second,
+		req.Categories,
+		req.SortOrder,
+		now,
+	); err != nil {
+		return nil, err
+	}
+	if err := mt.SetActive(req.IsActive, now); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Update(ctx, mt); err != nil {
+		return nil, err
+	}
+	uc.catalogService.Invalidate()
+
+	response := dto.FromMeditationType(mt)
+	return &response, nil
+}
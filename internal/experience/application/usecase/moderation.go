@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"zen-connect/internal/experience/domain"
+)
+
+// maxExcerptLength 通報時にモデレーター向けに残す本文の長さ（文字数）
+const maxExcerptLength = 500
+
+// ContentScreener 保存前に文章を検査する（禁止語フィルター）
+// 保存させない場合はエラーを返す
+type ContentScreener interface {
+	Screen(ctx context.Context, texts ...string) error
+}
+
+// ReportedContent 通報された体験記録・コメントの投稿者と本文の抜粋
+type ReportedContent struct {
+	AuthorID string
+	Excerpt  string
+}
+
+// ModerateContentUseCase 通報された体験記録・コメントの確認と非表示のユースケース
+type ModerateContentUseCase struct {
+	experienceRepo domain.ExperienceRepository
+	commentRepo    domain.CommentRepository
+}
+
+// NewModerateContentUseCase コンストラクタ
+func NewModerateContentUseCase(experienceRepo domain.ExperienceRepository, commentRepo domain.CommentRepository) *ModerateContentUseCase {
+	return &ModerateContentUseCase{
+		experienceRepo: experienceRepo,
+		commentRepo:    commentRepo,
+	}
+}
+
+// FindExperience 通報者が見られる体験記録の投稿者と抜粋を取得
+func (uc *ModerateContentUseCase) FindExperience(ctx context.Context, experienceID, reporterID string) (*ReportedContent, error) {
+	experience, err := findVisibleExperience(ctx, uc.experienceRepo, experienceID, reporterID)
+	if err != nil {
+		return nil, err
+	}
+	session := experience.Content().Session()
+	return &ReportedContent{
+		AuthorID: experience.UserID(),
+		Excerpt:  excerpt(session.Note(), experience.Journal().Entry()),
+	}, nil
+}
+
+// FindComment 通報者が見られるコメントの投稿者と抜粋を取得（削除済みは見つからない扱い）
+func (uc *ModerateContentUseCase) FindComment(ctx context.Context, commentID, reporterID string) (*ReportedContent, error) {
+	comment, err := uc.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.IsDeleted() {
+		return nil, domain.ErrCommentNotFound
+	}
+	if _, err := findVisibleExperience(ctx, uc.experienceRepo, comment.ExperienceID(), reporterID); err != nil {
+		return nil, domain.ErrCommentNotFound
+	}
+	return &ReportedContent{
+		AuthorID: comment.AuthorID(),
+		Excerpt:  excerpt(comment.Body()),
+	}, nil
+}
+
+// HideExperience 体験記録を本人以外から見えなくする
+func (uc *ModerateContentUseCase) HideExperience(ctx context.Context, experienceID string) error {
+	experience, err := uc.experienceRepo.FindByID(ctx, experienceID)
+	if err != nil {
+		return err
+	}
+	experience.Hide(time.Now())
+	return uc.experienceRepo.Save(ctx, experience)
+}
+
+// HideComment コメントの投稿者と本文を伏せる
+func (uc *ModerateContentUseCase) HideComment(ctx context.Context, commentID string) error {
+	comment, err := uc.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	comment.Hide(time.Now())
+	return uc.commentRepo.Save(ctx, comment)
+}
+
+// excerpt 空でない文章をつなげて先頭を切り出す
+func excerpt(texts ...string) string {
+	var parts []string
+	for _, text := range texts {
+		if text = strings.TrimSpace(text); text != "" {
+			parts = append(parts, text)
+		}
+	}
+	joined := strings.Join(parts, "\n\n")
+	if utf8.RuneCountInString(joined) <= maxExcerptLength {
+		return joined
+	}
+	return string([]rune(joined)[:maxExcerptLength]) + "…"
+}
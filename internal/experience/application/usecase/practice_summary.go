@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/experience/domain"
+)
+
+// PracticeSummary 期間中のユーザーごとの瞑想の集計（週間サマリーのメールなどに使う）
+type PracticeSummary struct {
+	UserID         string
+	Sessions       int
+	TotalMinutes   int
+	LongestMinutes int
+	Days           int
+}
+
+// PracticeSummaryUseCase 瞑想の実践状況の集計ユースケース
+type PracticeSummaryUseCase struct {
+	experienceRepo domain.ExperienceRepository
+}
+
+// NewPracticeSummaryUseCase コンストラクタ
+func NewPracticeSummaryUseCase(experienceRepo domain.ExperienceRepository) *PracticeSummaryUseCase {
+	return &PracticeSummaryUseCase{
+		experienceRepo: experienceRepo,
+	}
+}
+
+// Execute 期間中に瞑想したユーザーごとの回数と時間（下書きも含む、日数は location の暦日で数える）
+func (uc *PracticeSummaryUseCase) Execute(ctx context.Context, from, to time.Time, location *time.Location) ([]PracticeSummary, error) {
+	summaries, err := uc.experienceRepo.SummarizePractice(ctx, from, to, location)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]PracticeSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		result = append(result, PracticeSummary{
+			UserID:         summary.UserID,
+			Sessions:       summary.Sessions,
+			TotalMinutes:   int(summary.TotalDuration / time.Minute),
+			LongestMinutes: int(summary.LongestDuration / time.Minute),
+			Days:           summary.Days,
+		})
+	}
+	return result, nil
+}
+
+// HasPracticed 期間中にユーザーが瞑想したか（下書きも含む）
+func (uc *PracticeSummaryUseCase) HasPracticed(ctx context.Context, userID string, from, to time.Time) (bool, error) {
+	return uc.experienceRepo.HasPracticed(ctx, userID, from, to)
+}
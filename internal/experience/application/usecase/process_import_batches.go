@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"zen-connect/internal/experience/domain"
+)
+
+// importBatchSize 1回の処理で扱う取り込みの上限
+const importBatchSize = 20
+
+// ProcessImportBatchesUseCase 取り込んだ履歴の後続処理を取り込みごとにまとめて行うユースケース
+type ProcessImportBatchesUseCase struct {
+	batchRepo domain.ImportBatchRepository
+	publisher EventPublisher
+}
+
+// NewProcessImportBatchesUseCase コンストラクタ
+func NewProcessImportBatchesUseCase(batchRepo domain.ImportBatchRepository, publisher EventPublisher) *ProcessImportBatchesUseCase {
+	return &ProcessImportBatchesUseCase{
+		batchRepo: batchRepo,
+		publisher: publisher,
+	}
+}
+
+// Execute 未処理の取り込みごとに HistoryImported を一つ発行し、処理した件数を返す
+// 取り込みは発行の前に処理済みにするため、同じ取り込みの後続処理が二度行われることはない
+func (uc *ProcessImportBatchesUseCase) Execute(ctx context.Context) (int, error) {
+	batches, err := uc.batchRepo.FindPending(ctx, importBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	var errs []error
+	for _, batch := range batches {
+		batch.Process(time.Now())
+		claimed, err := uc.batchRepo.MarkProcessed(ctx, batch)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("import batch %s: %w", batch.ID(), err))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		uc.publisher.Publish(ctx, batch.Events()...)
+		batch.ClearEvents()
+		processed++
+	}
+	return processed, errors.Join(errs...)
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/application/service"
+	"zen-connect/internal/experience/domain"
+)
+
+// RecordDraftExperienceUseCase 他のコンテキストで行われた瞑想を下書きの体験記録として保存するユースケース
+// グループ瞑想ルームの終了時に参加者ごとに呼ばれる
+type RecordDraftExperienceUseCase struct {
+	experienceRepo domain.ExperienceRepository
+	catalogService *service.MeditationTypeCatalogService
+	publisher      EventPublisher
+}
+
+// NewRecordDraftExperienceUseCase コンストラクタ
+func NewRecordDraftExperienceUseCase(experienceRepo domain.ExperienceRepository, catalogService *service.MeditationTypeCatalogService, publisher EventPublisher) *RecordDraftExperienceUseCase {
+	return &RecordDraftExperienceUseCase{
+		experienceRepo: experienceRepo,
+		catalogService: catalogService,
+		publisher:      publisher,
+	}
+}
+
+// Execute 瞑想セッションを感情の入力待ちの下書き体験記録として保存
+func (uc *RecordDraftExperienceUseCase) Execute(ctx context.Context, userID, meditationType, customType string, startTime, endTime time.Time) (*dto.ExperienceDTO, error) {
+	catalog, err := uc.catalogService.Catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := domain.NewMeditationSessionWithValidation(startTime, endTime, meditationType, customType, "", catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := domain.NewDraftExperience(userID, session, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.experienceRepo.Save(ctx, draft); err != nil {
+		return nil, err
+	}
+	uc.publisher.Publish(ctx, draft.Events()...)
+	draft.ClearEvents()
+
+	return dto.FromExperience(draft), nil
+}
@@ -0,0 +1,280 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/domain"
+)
+
+// EventPublisher ドメインイベントを他のコンテキストに伝える（リアクションやコメントの通知、Webhook）
+// 保存後に呼ばれるため、配信の失敗はリクエストの失敗にしない
+type EventPublisher interface {
+	Publish(ctx context.Context, events ...domain.DomainEvent)
+}
+
+// findVisibleExperience 閲覧者が見られる体験記録を取得
+// 非公開・モデレーターが非表示にした体験記録は本人以外には見つからない扱い（リアクションやコメントも同様に隠れる）
+func findVisibleExperience(ctx context.Context, experienceRepo domain.ExperienceRepository, experienceID, viewerID string) (*domain.Experience, error) {
+	experience, err := experienceRepo.FindByID(ctx, experienceID)
+	if err != nil {
+		return nil, err
+	}
+	if !experience.IsVisibleTo(viewerID) {
+		return nil, domain.ErrExperienceNotFound
+	}
+	return experience, nil
+}
+
+// ExperienceVisibilityUseCase 公開された体験記録の閲覧と公開設定のユースケース
+type ExperienceVisibilityUseCase struct {
+	experienceRepo domain.ExperienceRepository
+	publisher      EventPublisher
+}
+
+// NewExperienceVisibilityUseCase コンストラクタ
+func NewExperienceVisibilityUseCase(experienceRepo domain.ExperienceRepository, publisher EventPublisher) *ExperienceVisibilityUseCase {
+	return &ExperienceVisibilityUseCase{
+		experienceRepo: experienceRepo,
+		publisher:      publisher,
+	}
+}
+
+// Get 公開中または自分の体験記録を取得
+func (uc *ExperienceVisibilityUseCase) Get(ctx context.Context, experienceID, viewerID string) (*dto.ExperienceDTO, error) {
+	experience, err := findVisibleExperience(ctx, uc.experienceRepo, experienceID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.FromExperience(experience), nil
+}
+
+// Update 自分の体験記録を公開・非公開にする
+// 非公開にするとリアクションとコメントは本人以外から見えなくなり、再び公開すると元に戻る
+func (uc *ExperienceVisibilityUseCase) Update(ctx context.Context, req *dto.UpdateVisibilityRequest) (*dto.ExperienceDTO, error) {
+	experience, err := uc.experienceRepo.FindByID(ctx, req.ExperienceID)
+	if err != nil {
+		return nil, err
+	}
+	if !experience.BelongsToUser(req.UserID) {
+		return nil, domain.ErrExperienceNotFound
+	}
+
+	if *req.IsPublic {
+		experience.MakePublic()
+	} else {
+		experience.MakePrivate()
+	}
+	if err := uc.experienceRepo.Save(ctx, experience); err != nil {
+		return nil, err
+	}
+	uc.publisher.Publish(ctx, experience.Events()...)
+	experience.ClearEvents()
+	return dto.FromExperience(experience), nil
+}
+
+// ReactionUseCase 体験記録へのリアクションのユースケース
+type ReactionUseCase struct {
+	experienceRepo domain.ExperienceRepository
+	reactionRepo   domain.ReactionRepository
+	publisher      EventPublisher
+}
+
+// NewReactionUseCase コンストラクタ
+func NewReactionUseCase(experienceRepo domain.ExperienceRepository, reactionRepo domain.ReactionRepository, publisher EventPublisher) *ReactionUseCase {
+	return &ReactionUseCase{
+		experienceRepo: experienceRepo,
+		reactionRepo:   reactionRepo,
+		publisher:      publisher,
+	}
+}
+
+// List リアクションの種類ごとの件数を取得
+func (uc *ReactionUseCase) List(ctx context.Context, experienceID, viewerID string) (*dto.ReactionsResponse, error) {
+	if _, err := findVisibleExperience(ctx, uc.experienceRepo, experienceID, viewerID); err != nil {
+		return nil, err
+	}
+	return uc.summarize(ctx, experienceID, viewerID)
+}
+
+// Add リアクションする（同じ種類を重ねても1件のまま、通知も最初の1回のみ）
+func (uc *ReactionUseCase) Add(ctx context.Context, experienceID, userID, kind string) (*dto.ReactionsResponse, error) {
+	experience, err := findVisibleExperience(ctx, uc.experienceRepo, experienceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	reactionKind, err := domain.ParseReactionKind(kind)
+	if err != nil {
+		return nil, err
+	}
+	reaction, err := domain.NewReaction(experience, userID, reactionKind, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	added, err := uc.reactionRepo.Add(ctx, reaction)
+	if err != nil {
+		return nil, err
+	}
+	if added {
+		uc.publisher.Publish(ctx, reaction.Events()...)
+	}
+	return uc.summarize(ctx, experienceID, userID)
+}
+
+// Remove リアクションを取り消す（非公開になった体験記録でも本人は取り消せる）
+func (uc *ReactionUseCase) Remove(ctx context.Context, experienceID, userID, kind string) (*dto.ReactionsResponse, error) {
+	if _, err := findVisibleExperience(ctx, uc.experienceRepo, experienceID, userID); err != nil {
+		return nil, err
+	}
+	reactionKind, err := domain.ParseReactionKind(kind)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.reactionRepo.Remove(ctx, experienceID, userID, reactionKind); err != nil {
+		return nil, err
+	}
+	return uc.summarize(ctx, experienceID, userID)
+}
+
+// summarize すべての種類を表示順に並べたリアクションの集計
+func (uc *ReactionUseCase) summarize(ctx context.Context, experienceID, viewerID string) (*dto.ReactionsResponse, error) {
+	summary, err := uc.reactionRepo.Summarize(ctx, experienceID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	mine := make(map[domain.ReactionKind]bool, len(summary.Mine))
+	for _, kind := range summary.Mine {
+		mine[kind] = true
+	}
+	response := &dto.ReactionsResponse{
+		Reactions: []dto.ReactionCountDTO{},
+	}
+	for _, kind := range domain.ReactionKinds() {
+		response.Reactions = append(response.Reactions, dto.ReactionCountDTO{
+			Kind:    string(kind),
+			Emoji:   kind.Emoji(),
+			Count:   summary.Counts[kind],
+			Reacted: mine[kind],
+		})
+	}
+	return response, nil
+}
+
+// CommentUseCase 体験記録へのコメントのユースケース
+type CommentUseCase struct {
+	experienceRepo domain.ExperienceRepository
+	commentRepo    domain.CommentRepository
+	screener       ContentScreener
+	publisher      EventPublisher
+}
+
+// NewCommentUseCase コンストラクタ
+func NewCommentUseCase(
+	experienceRepo domain.ExperienceRepository,
+	commentRepo domain.CommentRepository,
+	screener ContentScreener,
+	publisher EventPublisher,
+) *CommentUseCase {
+	return &CommentUseCase{
+		experienceRepo: experienceRepo,
+		commentRepo:    commentRepo,
+		screener:       screener,
+		publisher:      publisher,
+	}
+}
+
+// List コメントをスレッドにまとめて古い順に取得
+func (uc *CommentUseCase) List(ctx context.Context, experienceID, viewerID string) (*dto.CommentThreadResponse, error) {
+	if _, err := findVisibleExperience(ctx, uc.experienceRepo, experienceID, viewerID); err != nil {
+		return nil, err
+	}
+	comments, err := uc.commentRepo.FindByExperienceID(ctx, experienceID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.FromCommentThread(comments, viewerID), nil
+}
+
+// Add コメントまたは返信を投稿
+func (uc *CommentUseCase) Add(ctx context.Context, req *dto.CreateCommentRequest) (*dto.CommentDTO, error) {
+	experience, err := findVisibleExperience(ctx, uc.experienceRepo, req.ExperienceID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.screener.Screen(ctx, req.Body); err != nil {
+		return nil, err
+	}
+
+	var parent *domain.Comment
+	if req.ParentID != "" {
+		parent, err = uc.commentRepo.FindByID(ctx, req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	comment, err := domain.NewComment(experience, req.UserID, req.UserName, req.Body, parent, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.commentRepo.Save(ctx, comment); err != nil {
+		return nil, err
+	}
+	uc.publisher.Publish(ctx, comment.Events()...)
+
+	response := dto.FromComment(comment, req.UserID)
+	return &response, nil
+}
+
+// Edit 自分のコメントを編集（公開中の体験記録のみ）
+func (uc *CommentUseCase) Edit(ctx context.Context, req *dto.UpdateCommentRequest) (*dto.CommentDTO, error) {
+	experience, comment, err := uc.findComment(ctx, req.ExperienceID, req.CommentID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := experience.AcceptsInteractions(); err != nil {
+		return nil, err
+	}
+	if err := uc.screener.Screen(ctx, req.Body); err != nil {
+		return nil, err
+	}
+	if err := comment.Edit(req.UserID, req.Body, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.commentRepo.Save(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	response := dto.FromComment(comment, req.UserID)
+	return &response, nil
+}
+
+// Delete 自分のコメントを削除（返信はスレッドに残る）
+func (uc *CommentUseCase) Delete(ctx context.Context, experienceID, commentID, userID string) error {
+	_, comment, err := uc.findComment(ctx, experienceID, commentID, userID)
+	if err != nil {
+		return err
+	}
+	if err := comment.Delete(userID, time.Now()); err != nil {
+		return err
+	}
+	return uc.commentRepo.Save(ctx, comment)
+}
+
+// findComment 閲覧者が見られる体験記録のコメントを取得
+func (uc *CommentUseCase) findComment(ctx context.Context, experienceID, commentID, viewerID string) (*domain.Experience, *domain.Comment, error) {
+	experience, err := findVisibleExperience(ctx, uc.experienceRepo, experienceID, viewerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	comment, err := uc.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if comment.ExperienceID() != experience.ID() {
+		return nil, nil, domain.ErrCommentNotFound
+	}
+	return experience, comment, nil
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/application/service"
+	"zen-connect/internal/experience/domain"
+)
+
+// StartTimerSessionUseCase ライブタイマー開始ユースケース
+type StartTimerSessionUseCase struct {
+	timerRepo      domain.TimerSessionRepository
+	catalogService *service.MeditationTypeCatalogService
+	abandonTimeout time.Duration
+}
+
+// NewStartTimerSessionUseCase コンストラクタ
+func NewStartTimerSessionUseCase(timerRepo domain.TimerSessionRepository, catalogService *service.MeditationTypeCatalogService, abandonTimeout time.Duration) *StartTimerSessionUseCase {
+	return &StartTimerSessionUseCase{
+		timerRepo:      timerRepo,
+		catalogService: catalogService,
+		abandonTimeout: abandonTimeout,
+	}
+}
+
+// Execute タイマーを開始
+// 進行中のタイマーがある場合は ErrTimerSessionAlreadyActive（クライアントはそのタイマーに再接続する）
+func (uc *StartTimerSessionUseCase) Execute(ctx context.Context, req *dto.StartTimerSessionRequest) (*dto.TimerSessionDTO, error) {
+	catalog, err := uc.catalogService.Catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	timer, err := domain.StartTimerSession(req.UserID, req.MeditationType, req.CustomType, catalog, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.timerRepo.Create(ctx, timer); err != nil {
+		return nil, err
+	}
+
+	response := dto.FromTimerSession(timer, now, uc.abandonTimeout)
+	return &response, nil
+}
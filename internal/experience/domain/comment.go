@@ -0,0 +1,213 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxCommentLength is the maximum length of a comment in characters
+	MaxCommentLength = 1000
+	// MaxCommentDepth is how deep replies can be nested; top-level comments
+	// have depth 0
+	MaxCommentDepth = 3
+)
+
+// Domain errors for Comment
+var (
+	ErrCommentNotFound      = errors.New("comment not found")
+	ErrEmptyComment         = errors.New("comment cannot be empty")
+	ErrCommentTooLong       = errors.New("comment is too long")
+	ErrCommentThreadTooDeep = errors.New("comment thread is too deep")
+	ErrCommentDeleted       = errors.New("comment has been deleted")
+	ErrNotCommentAuthor     = errors.New("only the author can change the comment")
+	ErrCommentHidden        = errors.New("comment has been hidden by a moderator")
+)
+
+// Comment is a comment on a public experience, optionally replying to
+// another comment of the same experience (aggregate root). Deleted comments
+// are kept without their body so that the replies stay in their thread;
+// comments hidden by a moderator are kept with their body for the record.
+type Comment struct {
+	id           string
+	experienceID string
+	authorID     string
+	authorName   string
+	parentID     string
+	depth        int
+	body         string
+	createdAt    time.Time
+	updatedAt    time.Time
+	editedAt     time.Time
+	deletedAt    time.Time
+	hiddenAt     time.Time
+	events       []DomainEvent
+}
+
+// NewComment comments on a public experience; parent is nil for a top-level comment
+func NewComment(experience *Experience, authorID, authorName, body string, parent *Comment, now time.Time) (*Comment, error) {
+	if err := experience.AcceptsInteractions(); err != nil {
+		return nil, err
+	}
+	body, err := normalizeCommentBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &Comment{
+		id:           uuid.New().String(),
+		experienceID: experience.ID(),
+		authorID:     authorID,
+		authorName:   authorName,
+		body:         body,
+		createdAt:    now,
+		updatedAt:    now,
+	}
+	var parentAuthorID string
+	if parent != nil {
+		if parent.experienceID != experience.ID() {
+			return nil, ErrCommentNotFound
+		}
+		if parent.IsDeleted() {
+			return nil, ErrCommentDeleted
+		}
+		if parent.IsHidden() {
+			return nil, ErrCommentHidden
+		}
+		if parent.depth >= MaxCommentDepth {
+			return nil, ErrCommentThreadTooDeep
+		}
+		comment.parentID = parent.id
+		comment.depth = parent.depth + 1
+		parentAuthorID = parent.authorID
+	}
+
+	comment.events = append(comment.events, NewCommentAdded(comment.id, experience.ID(), experience.UserID(), authorID, parentAuthorID, now))
+	return comment, nil
+}
+
+// ReconstructComment recreates a comment from persisted data
+func ReconstructComment(
+	id, experienceID, authorID, authorName, parentID string,
+	depth int,
+	body string,
+	createdAt, updatedAt, editedAt, deletedAt, hiddenAt time.Time,
+) *Comment {
+	return &Comment{
+		id:           id,
+		experienceID: experienceID,
+		authorID:     authorID,
+		authorName:   authorName,
+		parentID:     parentID,
+		depth:        depth,
+		body:         body,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+		editedAt:     editedAt,
+		deletedAt:    deletedAt,
+		hiddenAt:     hiddenAt,
+	}
+}
+
+// normalizeCommentBody trims the body and checks its length
+func normalizeCommentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", ErrEmptyComment
+	}
+	if utf8.RuneCountInString(body) > MaxCommentLength {
+		return "", ErrCommentTooLong
+	}
+	return body, nil
+}
+
+func (c *Comment) ID() string            { return c.id }
+func (c *Comment) ExperienceID() string  { return c.experienceID }
+func (c *Comment) AuthorID() string      { return c.authorID }
+func (c *Comment) AuthorName() string    { return c.authorName }
+func (c *Comment) ParentID() string      { return c.parentID }
+func (c *Comment) Depth() int            { return c.depth }
+func (c *Comment) Body() string          { return c.body }
+func (c *Comment) CreatedAt() time.Time  { return c.createdAt }
+func (c *Comment) UpdatedAt() time.Time  { return c.updatedAt }
+func (c *Comment) EditedAt() time.Time   { return c.editedAt }
+func (c *Comment) DeletedAt() time.Time  { return c.deletedAt }
+func (c *Comment) HiddenAt() time.Time   { return c.hiddenAt }
+func (c *Comment) Events() []DomainEvent { return c.events }
+
+// IsEdited reports whether the body was changed after posting
+func (c *Comment) IsEdited() bool {
+	return !c.editedAt.IsZero()
+}
+
+// IsDeleted reports whether the comment was deleted
+func (c *Comment) IsDeleted() bool {
+	return !c.deletedAt.IsZero()
+}
+
+// IsHidden reports whether a moderator hid the comment
+func (c *Comment) IsHidden() bool {
+	return !c.hiddenAt.IsZero()
+}
+
+// IsWrittenBy reports whether the user wrote the comment
+func (c *Comment) IsWrittenBy(userID string) bool {
+	return c.authorID == userID
+}
+
+// Edit replaces the body; only the author can edit
+func (c *Comment) Edit(userID, body string, now time.Time) error {
+	if !c.IsWrittenBy(userID) {
+		return ErrNotCommentAuthor
+	}
+	if c.IsDeleted() {
+		return ErrCommentDeleted
+	}
+	if c.IsHidden() {
+		return ErrCommentHidden
+	}
+	body, err := normalizeCommentBody(body)
+	if err != nil {
+		return err
+	}
+	if body == c.body {
+		return nil
+	}
+
+	c.body = body
+	c.editedAt = now
+	c.updatedAt = now
+	c.events = append(c.events, NewCommentEdited(c.id, c.experienceID, now))
+	return nil
+}
+
+// Delete removes the body and keeps the comment as a placeholder for its
+// replies; only the author can delete, and deleting twice is a no-op
+func (c *Comment) Delete(userID string, now time.Time) error {
+	if !c.IsWrittenBy(userID) {
+		return ErrNotCommentAuthor
+	}
+	if c.IsDeleted() {
+		return nil
+	}
+
+	c.body = ""
+	c.deletedAt = now
+	c.updatedAt = now
+	c.events = append(c.events, NewCommentDeleted(c.id, c.experienceID, now))
+	return nil
+}
+
+// Hide hides the comment after a moderator reviewed a report; hiding twice
+// keeps the first time
+func (c *Comment) Hide(now time.Time) {
+	if c.IsHidden() {
+		return
+	}
+	c.hiddenAt = now
+	c.updatedAt = now
+}
@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newPublicTestExperience(t *testing.T) *Experience {
+	t.Helper()
+	startTime := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	session := NewMeditationSession(startTime, startTime.Add(20*time.Minute), "zazen", "静かだった")
+	content := NewExperienceContent(session, NewEmotionalState("不安", "穏やか"), startTime, startTime)
+	experience := NewExperience("owner", content)
+	experience.MakePublic()
+	experience.ClearEvents()
+	return experience
+}
+
+func TestExperience_MakePrivateShouldHideInteractionsFromOthers(t *testing.T) {
+	// given
+	experience := newPublicTestExperience(t)
+	now := time.Now()
+
+	// when
+	experience.MakePrivate()
+	_, reactionErr := NewReaction(experience, "guest", ReactionGassho, now)
+	_, commentErr := NewComment(experience, "guest", "Guest", "ありがとう", nil, now)
+
+	// then
+	if experience.IsVisibleTo("guest") || !experience.IsVisibleTo("owner") {
+		t.Error("Expected private experience to be visible to its owner only")
+	}
+	if !errors.Is(reactionErr, ErrExperienceNotPublic) || !errors.Is(commentErr, ErrExperienceNotPublic) {
+		t.Errorf("Expected ErrExperienceNotPublic, got %v / %v", reactionErr, commentErr)
+	}
+}
+
+func TestParseReactionKind_ShouldAcceptNameOrEmoji(t *testing.T) {
+	// when
+	byName, nameErr := ParseReactionKind("gassho")
+	byEmoji, emojiErr := ParseReactionKind("🙏")
+	_, unknownErr := ParseReactionKind("👎")
+
+	// then
+	if nameErr != nil || emojiErr != nil || byName != ReactionGassho || byEmoji != ReactionGassho {
+		t.Errorf("Expected gassho both ways, got %s %v / %s %v", byName, nameErr, byEmoji, emojiErr)
+	}
+	if !errors.Is(unknownErr, ErrUnknownReaction) {
+		t.Errorf("Expected ErrUnknownReaction, got %v", unknownErr)
+	}
+}
+
+func TestNewReaction_ShouldNotifyExperienceOwner(t *testing.T) {
+	// given
+	experience := newPublicTestExperience(t)
+
+	// when
+	reaction, err := NewReaction(experience, "guest", ReactionLotus, time.Now())
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	added, ok := reaction.Events()[0].(*ReactionAdded)
+	if !ok || added.ExperienceOwnerID() != "owner" || added.UserID() != "guest" || added.Kind() != ReactionLotus {
+		t.Errorf("Expected ReactionAdded for the owner, got %+v", reaction.Events())
+	}
+}
+
+func TestNewComment_ShouldThreadRepliesUpToMaxDepth(t *testing.T) {
+	// given
+	experience := newPublicTestExperience(t)
+	now := time.Now()
+	parent, err := NewComment(experience, "guest", "Guest", "  良い時間でしたね  ", nil, now)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// when
+	reply := parent
+	for depth := 1; depth <= MaxCommentDepth; depth++ {
+		reply, err = NewComment(experience, "owner", "Owner", "ありがとう", reply, now)
+		if err != nil {
+			t.Fatalf("Expected reply at depth %d, got %v", depth, err)
+		}
+	}
+	_, tooDeepErr := NewComment(experience, "guest", "Guest", "もう一言", reply, now)
+
+	// then
+	if parent.Body() != "良い時間でしたね" || parent.Depth() != 0 {
+		t.Errorf("Expected trimmed top-level comment, got %q at depth %d", parent.Body(), parent.Depth())
+	}
+	if reply.Depth() != MaxCommentDepth {
+		t.Errorf("Expected reply at depth %d, got %d", MaxCommentDepth, reply.Depth())
+	}
+	if !errors.Is(tooDeepErr, ErrCommentThreadTooDeep) {
+		t.Errorf("Expected ErrCommentThreadTooDeep, got %v", tooDeepErr)
+	}
+	added, ok := reply.Events()[0].(*CommentAdded)
+	if !ok || added.ExperienceOwnerID() != "owner" || added.ParentAuthorID() == "" {
+		t.Errorf("Expected CommentAdded with the parent author, got %+v", reply.Events())
+	}
+}
+
+func TestNewComment_ShouldRejectInvalidBody(t *testing.T) {
+	// given
+	experience := newPublicTestExperience(t)
+
+	// when
+	_, emptyErr := NewComment(experience, "guest", "Guest", "   ", nil, time.Now())
+	_, tooLongErr := NewComment(experience, "guest", "Guest", strings.Repeat("禅", MaxCommentLength+1), nil, time.Now())
+
+	// then
+	if !errors.Is(emptyErr, ErrEmptyComment) || !errors.Is(tooLongErr, ErrCommentTooLong) {
+		t.Errorf("Expected ErrEmptyComment and ErrCommentTooLong, got %v / %v", emptyErr, tooLongErr)
+	}
+}
+
+func TestComment_OnlyAuthorShouldEditOrDelete(t *testing.T) {
+	// given
+	experience := newPublicTestExperience(t)
+	now := time.Now()
+	comment, _ := NewComment(experience, "guest", "Guest", "良い時間でしたね", nil, now)
+
+	// when
+	ownerEditErr := comment.Edit("owner", "書き換え", now)
+	ownerDeleteErr := comment.Delete("owner", now)
+	editErr := comment.Edit("guest", "本当に良い時間でしたね", now.Add(time.Minute))
+
+	// then
+	if !errors.Is(ownerEditErr, ErrNotCommentAuthor) || !errors.Is(ownerDeleteErr, ErrNotCommentAuthor) {
+		t.Errorf("Expected ErrNotCommentAuthor, got %v / %v", ownerEditErr, ownerDeleteErr)
+	}
+	if editErr != nil || !comment.IsEdited() || comment.Body() != "本当に良い時間でしたね" {
+		t.Errorf("Expected edited comment, got %q (%v)", comment.Body(), editErr)
+	}
+}
+
+func TestComment_DeleteShouldKeepPlaceholderForReplies(t *testing.T) {
+	// given
+	experience := newPublicTestExperience(t)
+	now := time.Now()
+	comment, _ := NewComment(experience, "guest", "Guest", "良い時間でしたね", nil, now)
+
+	// when
+	err := comment.Delete("guest", now)
+	againErr := comment.Delete("guest", now)
+	editErr := comment.Edit("guest", "復活", now)
+	_, replyErr := NewComment(experience, "owner", "Owner", "ありがとう", comment, now)
+
+	// then
+	if err != nil || againErr != nil {
+		t.Fatalf("Expected no error, got %v / %v", err, againErr)
+	}
+	if !comment.IsDeleted() || comment.Body() != "" {
+		t.Errorf("Expected deleted comment without body, got %q", comment.Body())
+	}
+	if !errors.Is(editErr, ErrCommentDeleted) || !errors.Is(replyErr, ErrCommentDeleted) {
+		t.Errorf("Expected ErrCommentDeleted, got %v / %v", editErr, replyErr)
+	}
+	if events := comment.Events(); len(events) != 2 || events[1].EventName() != "CommentDeleted" {
+		t.Errorf("Expected CommentAdded and a single CommentDeleted, got %d events", len(events))
+	}
+}
+
+func TestExperience_HideShouldKeepItVisibleToOwnerOnly(t *testing.T) {
+	// given
+	experience := newPublicTestExperience(t)
+	now := time.Now()
+
+	// when
+	experience.Hide(now)
+	experience.Hide(now.Add(time.Hour))
+	_, commentErr := NewComment(experience, "guest", "Guest", "ありがとう", nil, now)
+
+	// then
+	if !experience.IsHidden() || !experience.HiddenAt().Equal(now) {
+		t.Errorf("Expected hidden at the first hide, got %v", experience.HiddenAt())
+	}
+	if experience.IsVisibleTo("guest") || !experience.IsVisibleTo("owner") {
+		t.Error("Expected hidden experience to be visible to its owner only")
+	}
+	if !errors.Is(commentErr, ErrExperienceHidden) {
+		t.Errorf("Expected ErrExperienceHidden, got %v", commentErr)
+	}
+}
+
+func TestComment_HideShouldStopEditsAndReplies(t *testing.T) {
+	// given
+	experience := newPublicTestExperience(t)
+	now := time.Now()
+	comment, _ := NewComment(experience, "guest", "Guest", "宣伝です", nil, now)
+
+	// when
+	comment.Hide(now)
+	editErr := comment.Edit("guest", "書き換え", now)
+	_, replyErr := NewComment(experience, "owner", "Owner", "返信", comment, now)
+
+	// then
+	if !comment.IsHidden() {
+		t.Error("Expected hidden comment")
+	}
+	if !errors.Is(editErr, ErrCommentHidden) || !errors.Is(replyErr, ErrCommentHidden) {
+		t.Errorf("Expected ErrCommentHidden, got %v / %v", editErr, replyErr)
+	}
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"sort"
 	"strings"
 )
 
@@ -28,6 +29,31 @@ var positiveStates = map[string]int{
 	"非常に不安":   -1,
 }
 
+// EmotionLevels returns the predefined emotional states from calmest to most anxious
+func EmotionLevels() []string {
+	levels := make([]string, 0, len(positiveStates))
+	for level := range positiveStates {
+		levels = append(levels, level)
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		return positiveStates[levels[i]] > positiveStates[levels[j]]
+	})
+	return levels
+}
+
+// IsEmotionLevel reports whether state is one of the predefined emotional states
+func IsEmotionLevel(state string) bool {
+	_, ok := positiveStates[state]
+	return ok
+}
+
+// EmotionScore returns the calmness score of a predefined emotional state,
+// from -1 for 非常に不安 to 5 for 非常に穏やか
+func EmotionScore(state string) (int, bool) {
+	score, ok := positiveStates[state]
+	return score, ok
+}
+
 // NewEmotionalState creates a new EmotionalState value object
 func NewEmotionalState(before, after string) *EmotionalState {
 	return &EmotionalState{
@@ -185,4 +185,17 @@ func TestEmotionalState_Equals_ShouldReturnFalseWhenComparingWithNil(t *testing.
 	if actual {
 		t.Error("Expected state to not be equal to nil")
 	}
-}
\ No newline at end of file
+}
+
+func TestEmotionLevels_ShouldBeOrderedFromCalmestToMostAnxious(t *testing.T) {
+	// when
+	levels := EmotionLevels()
+	
+	// then
+	if len(levels) != 7 || levels[0] != "非常に穏やか" || levels[6] != "非常に不安" {
+		t.Errorf("Unexpected emotion levels %v", levels)
+	}
+	if !IsEmotionLevel("普通") || IsEmotionLevel("まあまあ") {
+		t.Error("Expected only predefined states to be emotion levels")
+	}
+}
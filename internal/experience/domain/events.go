@@ -61,15 +61,17 @@ type ExperienceVisibilityChanged struct {
 	eventName   string
 	aggregateID string
 	occurredAt  time.Time
+	userID      string
 	isPublic    bool
 	changedAt   time.Time
 }
 
-func NewExperienceVisibilityChanged(aggregateID string, isPublic bool, changedAt time.Time) *ExperienceVisibilityChanged {
+func NewExperienceVisibilityChanged(aggregateID, userID string, isPublic bool, changedAt time.Time) *ExperienceVisibilityChanged {
 	return &ExperienceVisibilityChanged{
 		eventName:   "ExperienceVisibilityChanged",
 		aggregateID: aggregateID,
 		occurredAt:  changedAt,
+		userID:      userID,
 		isPublic:    isPublic,
 		changedAt:   changedAt,
 	}
@@ -78,5 +80,139 @@ func NewExperienceVisibilityChanged(aggregateID string, isPublic bool, changedAt
 func (e *ExperienceVisibilityChanged) EventName() string     { return e.eventName }
 func (e *ExperienceVisibilityChanged) AggregateID() string   { return e.aggregateID }
 func (e *ExperienceVisibilityChanged) OccurredAt() time.Time { return e.occurredAt }
+func (e *ExperienceVisibilityChanged) UserID() string        { return e.userID }
 func (e *ExperienceVisibilityChanged) IsPublic() bool        { return e.isPublic }
-func (e *ExperienceVisibilityChanged) ChangedAt() time.Time  { return e.changedAt }
\ No newline at end of file
+func (e *ExperienceVisibilityChanged) ChangedAt() time.Time  { return e.changedAt }
+
+// ReactionAdded event fired when a user reacts to a public experience
+type ReactionAdded struct {
+	eventName         string
+	aggregateID       string
+	occurredAt        time.Time
+	experienceOwnerID string
+	userID            string
+	kind              ReactionKind
+}
+
+func NewReactionAdded(experienceID, experienceOwnerID, userID string, kind ReactionKind, occurredAt time.Time) *ReactionAdded {
+	return &ReactionAdded{
+		eventName:         "ReactionAdded",
+		aggregateID:       experienceID,
+		occurredAt:        occurredAt,
+		experienceOwnerID: experienceOwnerID,
+		userID:            userID,
+		kind:              kind,
+	}
+}
+
+func (e *ReactionAdded) EventName() string         { return e.eventName }
+func (e *ReactionAdded) AggregateID() string       { return e.aggregateID }
+func (e *ReactionAdded) OccurredAt() time.Time     { return e.occurredAt }
+func (e *ReactionAdded) ExperienceOwnerID() string { return e.experienceOwnerID }
+func (e *ReactionAdded) UserID() string            { return e.userID }
+func (e *ReactionAdded) Kind() ReactionKind        { return e.kind }
+
+// CommentAdded event fired when a comment or a reply is posted.
+// ParentAuthorID is empty for top-level comments.
+type CommentAdded struct {
+	eventName         string
+	aggregateID       string
+	occurredAt        time.Time
+	experienceID      string
+	experienceOwnerID string
+	authorID          string
+	parentAuthorID    string
+}
+
+func NewCommentAdded(commentID, experienceID, experienceOwnerID, authorID, parentAuthorID string, occurredAt time.Time) *CommentAdded {
+	return &CommentAdded{
+		eventName:         "CommentAdded",
+		aggregateID:       commentID,
+		occurredAt:        occurredAt,
+		experienceID:      experienceID,
+		experienceOwnerID: experienceOwnerID,
+		authorID:          authorID,
+		parentAuthorID:    parentAuthorID,
+	}
+}
+
+func (e *CommentAdded) EventName() string         { return e.eventName }
+func (e *CommentAdded) AggregateID() string       { return e.aggregateID }
+func (e *CommentAdded) OccurredAt() time.Time     { return e.occurredAt }
+func (e *CommentAdded) ExperienceID() string      { return e.experienceID }
+func (e *CommentAdded) ExperienceOwnerID() string { return e.experienceOwnerID }
+func (e *CommentAdded) AuthorID() string          { return e.authorID }
+func (e *CommentAdded) ParentAuthorID() string    { return e.parentAuthorID }
+
+// CommentEdited event fired when the author edits a comment
+type CommentEdited struct {
+	eventName    string
+	aggregateID  string
+	occurredAt   time.Time
+	experienceID string
+}
+
+func NewCommentEdited(commentID, experienceID string, occurredAt time.Time) *CommentEdited {
+	return &CommentEdited{
+		eventName:    "CommentEdited",
+		aggregateID:  commentID,
+		occurredAt:   occurredAt,
+		experienceID: experienceID,
+	}
+}
+
+func (e *CommentEdited) EventName() string     { return e.eventName }
+func (e *CommentEdited) AggregateID() string   { return e.aggregateID }
+func (e *CommentEdited) OccurredAt() time.Time { return e.occurredAt }
+func (e *CommentEdited) ExperienceID() string  { return e.experienceID }
+
+// CommentDeleted event fired when the author deletes a comment
+type CommentDeleted struct {
+	eventName    string
+	aggregateID  string
+	occurredAt   time.Time
+	experienceID string
+}
+
+func NewCommentDeleted(commentID, experienceID string, occurredAt time.Time) *CommentDeleted {
+	return &CommentDeleted{
+		eventName:    "CommentDeleted",
+		aggregateID:  commentID,
+		occurredAt:   occurredAt,
+		experienceID: experienceID,
+	}
+}
+
+func (e *CommentDeleted) EventName() string     { return e.eventName }
+func (e *CommentDeleted) AggregateID() string   { return e.aggregateID }
+func (e *CommentDeleted) OccurredAt() time.Time { return e.occurredAt }
+func (e *CommentDeleted) ExperienceID() string  { return e.experienceID }
+
+// HistoryImported event fired once for a batch of imported experiences, in
+// place of an ExperienceCreated for each of them
+type HistoryImported struct {
+	eventName     string
+	aggregateID   string
+	occurredAt    time.Time
+	userID        string
+	experienceIDs []string
+	importedAt    time.Time
+}
+
+func NewHistoryImported(batchID, userID string, experienceIDs []string, importedAt, occurredAt time.Time) *HistoryImported {
+	return &HistoryImported{
+		eventName:     "HistoryImported",
+		aggregateID:   batchID,
+		occurredAt:    occurredAt,
+		userID:        userID,
+		experienceIDs: experienceIDs,
+		importedAt:    importedAt,
+	}
+}
+
+func (e *HistoryImported) EventName() string       { return e.eventName }
+func (e *HistoryImported) AggregateID() string     { return e.aggregateID }
+func (e *HistoryImported) OccurredAt() time.Time   { return e.occurredAt }
+func (e *HistoryImported) UserID() string          { return e.userID }
+func (e *HistoryImported) ExperienceIDs() []string { return e.experienceIDs }
+func (e *HistoryImported) ImportedAt() time.Time   { return e.importedAt }
@@ -13,7 +13,9 @@ type Experience struct {
 	id        string
 	userID    string
 	content   *ExperienceContent
+	journal   *Journal
 	isPublic  bool
+	hiddenAt  time.Time
 	createdAt time.Time
 	updatedAt time.Time
 	events    []DomainEvent
@@ -21,8 +23,12 @@ type Experience struct {
 
 // Domain errors for Experience
 var (
-	ErrEmptyUserID   = errors.New("user ID cannot be empty")
-	ErrNilContent    = errors.New("content cannot be nil")
+	ErrEmptyUserID         = errors.New("user ID cannot be empty")
+	ErrNilContent          = errors.New("content cannot be nil")
+	ErrNotDraft            = errors.New("experience is not a draft")
+	ErrNilJournal          = errors.New("journal cannot be nil")
+	ErrExperienceNotPublic = errors.New("experience is not public")
+	ErrExperienceHidden    = errors.New("experience has been hidden by a moderator")
 )
 
 // NewExperience creates a new Experience entity
@@ -32,6 +38,7 @@ func NewExperience(userID string, content *ExperienceContent) *Experience {
 		id:        uuid.New().String(),
 		userID:    userID,
 		content:   content,
+		journal:   EmptyJournal(),
 		isPublic:  false, // Default to private
 		createdAt: now,
 		updatedAt: now,
@@ -59,6 +66,7 @@ func NewExperienceWithValidation(userID string, content *ExperienceContent) (*Ex
 		id:        uuid.New().String(),
 		userID:    userID,
 		content:   content,
+		journal:   EmptyJournal(),
 		isPublic:  false, // Default to private
 		createdAt: now,
 		updatedAt: now,
@@ -71,6 +79,16 @@ func NewExperienceWithValidation(userID string, content *ExperienceContent) (*Ex
 	return experience, nil
 }
 
+// NewDraftExperience creates a private experience from a finished live timer.
+// The emotional state is entered later with CompleteDraft.
+func NewDraftExperience(userID string, session *MeditationSession, now time.Time) (*Experience, error) {
+	content, err := NewDraftExperienceContent(session, now)
+	if err != nil {
+		return nil, err
+	}
+	return NewExperienceWithValidation(userID, content)
+}
+
 // Getter methods
 func (e *Experience) ID() string {
 	return e.id
@@ -84,10 +102,24 @@ func (e *Experience) Content() *ExperienceContent {
 	return e.content
 }
 
+// Journal returns the journal entry and tags (empty when none was written)
+func (e *Experience) Journal() *Journal {
+	return e.journal
+}
+
 func (e *Experience) IsPublic() bool {
 	return e.isPublic
 }
 
+// IsHidden reports whether a moderator has hidden the experience
+func (e *Experience) IsHidden() bool {
+	return !e.hiddenAt.IsZero()
+}
+
+func (e *Experience) HiddenAt() time.Time {
+	return e.hiddenAt
+}
+
 func (e *Experience) CreatedAt() time.Time {
 	return e.createdAt
 }
@@ -110,7 +142,7 @@ func (e *Experience) MakePublic() {
 	if !e.isPublic {
 		e.isPublic = true
 		e.updatedAt = time.Now()
-		e.events = append(e.events, NewExperienceVisibilityChanged(e.id, true, e.updatedAt))
+		e.events = append(e.events, NewExperienceVisibilityChanged(e.id, e.userID, true, e.updatedAt))
 	}
 }
 
@@ -119,7 +151,7 @@ func (e *Experience) MakePrivate() {
 	if e.isPublic {
 		e.isPublic = false
 		e.updatedAt = time.Now()
-		e.events = append(e.events, NewExperienceVisibilityChanged(e.id, false, e.updatedAt))
+		e.events = append(e.events, NewExperienceVisibilityChanged(e.id, e.userID, false, e.updatedAt))
 	}
 }
 
@@ -132,6 +164,77 @@ func (e *Experience) UpdateContent(content *ExperienceContent) {
 	}
 }
 
+// UpdateJournal replaces the journal entry and tags
+func (e *Experience) UpdateJournal(journal *Journal) error {
+	if journal == nil {
+		return ErrNilJournal
+	}
+	if e.journal.Equals(journal) {
+		return nil
+	}
+	
+	e.journal = journal
+	e.updatedAt = time.Now()
+	e.events = append(e.events, NewExperienceUpdated(e.id, e.updatedAt))
+	return nil
+}
+
+// IsDraft reports whether the experience is still awaiting its emotional state
+func (e *Experience) IsDraft() bool {
+	return e.content.IsDraft()
+}
+
+// CompleteDraft enters the emotional state of a draft experience
+func (e *Experience) CompleteDraft(emotionalState *EmotionalState, note string) error {
+	if !e.IsDraft() {
+		return ErrNotDraft
+	}
+	if emotionalState == nil {
+		return ErrNilEmotionalState
+	}
+	
+	session := e.content.Session()
+	if note != "" {
+		session = ReconstructMeditationSession(session.StartTime(), session.EndTime(), session.MeditationType(), session.CustomType(), note)
+	}
+	now := time.Now()
+	e.UpdateContent(NewExperienceContent(session, emotionalState, e.content.CreatedAt(), now))
+	return nil
+}
+
+// IsVisibleTo reports whether the user may read the experience and its
+// reactions and comments: public experiences are visible to everyone, private
+// ones only to their owner. Making an experience private therefore hides its
+// interactions from everyone else without touching them. Experiences hidden
+// by a moderator are visible only to their owner even when public.
+func (e *Experience) IsVisibleTo(userID string) bool {
+	if e.userID == userID {
+		return true
+	}
+	return e.isPublic && !e.IsHidden()
+}
+
+// AcceptsInteractions checks that reactions and comments can be added
+func (e *Experience) AcceptsInteractions() error {
+	if e.IsHidden() {
+		return ErrExperienceHidden
+	}
+	if !e.isPublic {
+		return ErrExperienceNotPublic
+	}
+	return nil
+}
+
+// Hide hides the experience from everyone but its owner after a moderator
+// reviewed a report; hiding twice keeps the first time
+func (e *Experience) Hide(now time.Time) {
+	if e.IsHidden() {
+		return
+	}
+	e.hiddenAt = now
+	e.updatedAt = now
+}
+
 // BelongsToUser checks if the experience belongs to the specified user
 func (e *Experience) BelongsToUser(userID string) bool {
 	return e.userID == userID
@@ -142,7 +245,9 @@ func FromSnapshot(
 	id string,
 	userID string,
 	content *ExperienceContent,
+	journal *Journal,
 	isPublic bool,
+	hiddenAt time.Time,
 	createdAt time.Time,
 	updatedAt time.Time,
 ) *Experience {
@@ -150,7 +255,9 @@ func FromSnapshot(
 		id:        id,
 		userID:    userID,
 		content:   content,
+		journal:   journal,
 		isPublic:  isPublic,
+		hiddenAt:  hiddenAt,
 		createdAt: createdAt,
 		updatedAt: updatedAt,
 		events:    []DomainEvent{},
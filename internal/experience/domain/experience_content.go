@@ -52,15 +52,35 @@ func NewExperienceContentWithValidation(session *MeditationSession, emotionalSta
 	}, nil
 }
 
+// NewDraftExperienceContent creates the content of a draft experience whose
+// emotional state has not been entered yet
+func NewDraftExperienceContent(session *MeditationSession, createdAt time.Time) (*ExperienceContent, error) {
+	if session == nil {
+		return nil, ErrNilSession
+	}
+	
+	return &ExperienceContent{
+		session:   session,
+		createdAt: createdAt,
+		updatedAt: createdAt,
+	}, nil
+}
+
 // Getter methods
 func (ec *ExperienceContent) Session() *MeditationSession {
 	return ec.session
 }
 
+// EmotionalState returns the emotional state, or nil for a draft
 func (ec *ExperienceContent) EmotionalState() *EmotionalState {
 	return ec.emotionalState
 }
 
+// IsDraft reports whether the emotional state is still missing
+func (ec *ExperienceContent) IsDraft() bool {
+	return ec.emotionalState == nil
+}
+
 func (ec *ExperienceContent) CreatedAt() time.Time {
 	return ec.createdAt
 }
@@ -81,6 +101,9 @@ func (ec *ExperienceContent) UpdateTimestamp(newTimestamp time.Time) *Experience
 
 // HasEmotionalImprovement returns true if the emotional state has improved
 func (ec *ExperienceContent) HasEmotionalImprovement() bool {
+	if ec.IsDraft() {
+		return false
+	}
 	return ec.emotionalState.IsImproved()
 }
 
@@ -95,8 +118,11 @@ func (ec *ExperienceContent) Equals(other *ExperienceContent) bool {
 		return false
 	}
 	
+	sameEmotionalState := ec.emotionalState == nil && other.emotionalState == nil ||
+		ec.emotionalState != nil && ec.emotionalState.Equals(other.emotionalState)
+	
 	return ec.session.Equals(other.session) &&
-		ec.emotionalState.Equals(other.emotionalState) &&
+		sameEmotionalState &&
 		ec.createdAt.Equal(other.createdAt) &&
 		ec.updatedAt.Equal(other.updatedAt)
 }
\ No newline at end of file
@@ -273,10 +273,12 @@ func TestFromSnapshot_ShouldCreateExperienceFromSnapshot(t *testing.T) {
 	createdAt := time.Now()
 	updatedAt := createdAt
 	content := NewExperienceContent(session, emotionalState, createdAt, updatedAt)
+	journal := ReconstructJournal("## 振り返り\n呼吸に集中できた", []string{"朝"})
 	isPublic := true
+	hiddenAt := createdAt.Add(time.Hour)
 	
 	// when
-	experience := FromSnapshot(id, userID, content, isPublic, createdAt, updatedAt)
+	experience := FromSnapshot(id, userID, content, journal, isPublic, hiddenAt, createdAt, updatedAt)
 	
 	// then
 	if experience == nil {
@@ -291,9 +293,15 @@ func TestFromSnapshot_ShouldCreateExperienceFromSnapshot(t *testing.T) {
 	if !experience.Content().Equals(content) {
 		t.Error("Expected content to match snapshot")
 	}
+	if !experience.Journal().Equals(journal) {
+		t.Error("Expected journal to match snapshot")
+	}
 	if experience.IsPublic() != isPublic {
 		t.Error("Expected public status to match snapshot")
 	}
+	if !experience.IsHidden() || !experience.HiddenAt().Equal(hiddenAt) {
+		t.Error("Expected moderation status to match snapshot")
+	}
 	if experience.CreatedAt() != createdAt {
 		t.Error("Expected created at to match snapshot")
 	}
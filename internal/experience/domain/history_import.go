@@ -0,0 +1,294 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxImportNoteLength is the longest note accepted from an imported row,
+// the same limit as a note written in the app
+const MaxImportNoteLength = 2000
+
+// MaxImportRows is the most rows one import may hold
+const MaxImportRows = 10000
+
+// Errors raised while importing and exporting a history
+var (
+	ErrUnsupportedImportFormat = errors.New("import format must be csv or json")
+	ErrInvalidImportFile       = errors.New("import file cannot be read")
+	ErrTooManyImportRows       = errors.New("import file has too many rows")
+	ErrInvalidHistoryTimeZone  = errors.New("time zone is not valid")
+	ErrUnknownImportPreset     = errors.New("unknown import preset")
+	ErrInvalidImportMapping    = errors.New("import mapping needs a start column, an end or duration column and a type column or default type")
+	ErrInvalidDurationUnit     = errors.New("duration unit must be seconds, minutes or hms")
+	ErrMissingImportValue      = errors.New("value is missing")
+	ErrInvalidImportTime       = errors.New("time does not match the time format")
+	ErrInvalidImportLength     = errors.New("duration is not a valid length")
+	ErrUnknownEmotionLevel     = errors.New("emotion is not one of the predefined levels")
+	ErrIncompleteEmotions      = errors.New("both emotions are needed when one is given")
+	ErrImportNoteTooLong       = errors.New("note is too long")
+	ErrUnsupportedExportFormat = errors.New("export format must be csv, json or ics")
+	ErrInvalidExportRange      = errors.New("export range must end after it starts")
+)
+
+// Time formats of an import mapping besides Go reference layouts
+const (
+	// ImportTimeRFC3339 accepts RFC 3339 times, with or without an offset
+	ImportTimeRFC3339 = "rfc3339"
+	// ImportTimeUnix accepts seconds since the Unix epoch
+	ImportTimeUnix = "unix"
+)
+
+// DurationUnit is how the duration column of an import is written
+type DurationUnit string
+
+const (
+	DurationSeconds DurationUnit = "seconds"
+	DurationMinutes DurationUnit = "minutes"
+	// DurationHMS is H:MM:SS or MM:SS
+	DurationHMS DurationUnit = "hms"
+)
+
+// ImportRecord is one row of an exported history, keyed by column name
+type ImportRecord map[string]string
+
+// ImportFieldError is a problem with one column of an imported row
+type ImportFieldError struct {
+	Column string
+	Err    error
+}
+
+func (e *ImportFieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Column, e.Err)
+}
+
+func (e *ImportFieldError) Unwrap() error {
+	return e.Err
+}
+
+// ImportMapping describes which columns of an exported history hold the
+// session. Either EndColumn or DurationColumn is needed, and TypeColumn or
+// DefaultType; times without an offset are read in the location passed to
+// Session.
+type ImportMapping struct {
+	StartColumn    string
+	EndColumn      string
+	DurationColumn string
+	DurationUnit   DurationUnit
+	// TimeFormat is rfc3339, unix or a Go reference layout such as "2006-01-02 15:04"
+	TimeFormat string
+	TypeColumn string
+	// CustomTypeColumn holds the free text of "other" sessions
+	CustomTypeColumn string
+	// DefaultType is used when the row has no meditation type
+	DefaultType         string
+	NoteColumn          string
+	EmotionBeforeColumn string
+	EmotionAfterColumn  string
+}
+
+// importPresets are the mappings of the exports of common apps
+var importPresets = map[string]ImportMapping{
+	// Insight Timer "Export sessions" CSV; the activity is mostly just
+	// "Meditation", so sessions are recorded as mindfulness
+	"insight_timer": {
+		StartColumn:    "Started At",
+		DurationColumn: "Duration",
+		DurationUnit:   DurationHMS,
+		TimeFormat:     "01/02/2006 15:04:05",
+		DefaultType:    "mindfulness",
+		NoteColumn:     "Preset",
+	},
+	// The CSV and JSON exports of this service
+	"zen_connect": {
+		StartColumn:         "start_time",
+		EndColumn:           "end_time",
+		TimeFormat:          ImportTimeRFC3339,
+		TypeColumn:          "meditation_type",
+		CustomTypeColumn:    "custom_type",
+		NoteColumn:          "note",
+		EmotionBeforeColumn: "emotion_before",
+		EmotionAfterColumn:  "emotion_after",
+	},
+}
+
+// ImportPreset returns the mapping of a preset
+func ImportPreset(name string) (ImportMapping, error) {
+	mapping, ok := importPresets[name]
+	if !ok {
+		return ImportMapping{}, ErrUnknownImportPreset
+	}
+	return mapping, nil
+}
+
+// ImportPresetNames returns the names of the presets in alphabetical order
+func ImportPresetNames() []string {
+	names := make([]string, 0, len(importPresets))
+	for name := range importPresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate checks that the mapping can produce sessions
+func (m ImportMapping) Validate() error {
+	if m.StartColumn == "" || (m.EndColumn == "" && m.DurationColumn == "") || (m.TypeColumn == "" && m.DefaultType == "") {
+		return ErrInvalidImportMapping
+	}
+	if m.EndColumn == "" {
+		switch m.DurationUnit {
+		case DurationSeconds, DurationMinutes, DurationHMS:
+		default:
+			return ErrInvalidDurationUnit
+		}
+	}
+	return nil
+}
+
+// Session maps a row to a validated meditation session. A type that is not in
+// the catalog is kept as the free text of an "other" session, so the history
+// of another app keeps its own names.
+func (m ImportMapping) Session(record ImportRecord, loc *time.Location, catalog *MeditationTypeCatalog) (*MeditationSession, error) {
+	start, err := m.parseTime(record, m.StartColumn, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	var end time.Time
+	if m.EndColumn != "" {
+		if end, err = m.parseTime(record, m.EndColumn, loc); err != nil {
+			return nil, err
+		}
+	} else {
+		length, err := m.parseDuration(record)
+		if err != nil {
+			return nil, err
+		}
+		end = start.Add(length)
+	}
+
+	meditationType := strings.TrimSpace(record[m.TypeColumn])
+	if meditationType == "" {
+		meditationType = m.DefaultType
+	}
+	customType, customColumn := strings.TrimSpace(record[m.CustomTypeColumn]), m.CustomTypeColumn
+	if _, ok := catalog.Resolve(meditationType); !ok && meditationType != "" {
+		meditationType, customType, customColumn = OtherMeditationTypeID, meditationType, m.TypeColumn
+	}
+
+	note := strings.TrimSpace(record[m.NoteColumn])
+	if utf8.RuneCountInString(note) > MaxImportNoteLength {
+		return nil, &ImportFieldError{Column: m.NoteColumn, Err: ErrImportNoteTooLong}
+	}
+
+	session, err := NewMeditationSessionWithValidation(start, end, meditationType, customType, note, catalog)
+	if err != nil {
+		column := m.TypeColumn
+		if (errors.Is(err, ErrEmptyCustomMeditationType) || errors.Is(err, ErrCustomMeditationTypeTooLong)) && customColumn != "" {
+			column = customColumn
+		} else if errors.Is(err, ErrInvalidTimeRange) {
+			column = m.EndColumn
+			if column == "" {
+				column = m.DurationColumn
+			}
+		}
+		return nil, &ImportFieldError{Column: column, Err: err}
+	}
+	return session, nil
+}
+
+// EmotionalState maps the emotions of a row; nil when the row has none, and
+// the experience is imported as a draft waiting for them
+func (m ImportMapping) EmotionalState(record ImportRecord) (*EmotionalState, error) {
+	before := strings.TrimSpace(record[m.EmotionBeforeColumn])
+	after := strings.TrimSpace(record[m.EmotionAfterColumn])
+	if before == "" && after == "" {
+		return nil, nil
+	}
+	for _, value := range []struct{ column, level string }{
+		{m.EmotionBeforeColumn, before},
+		{m.EmotionAfterColumn, after},
+	} {
+		if value.level == "" {
+			return nil, &ImportFieldError{Column: value.column, Err: ErrIncompleteEmotions}
+		}
+		if !IsEmotionLevel(value.level) {
+			return nil, &ImportFieldError{Column: value.column, Err: ErrUnknownEmotionLevel}
+		}
+	}
+	return NewEmotionalStateWithValidation(before, after)
+}
+
+func (m ImportMapping) parseTime(record ImportRecord, column string, loc *time.Location) (time.Time, error) {
+	value := strings.TrimSpace(record[column])
+	if value == "" {
+		return time.Time{}, &ImportFieldError{Column: column, Err: ErrMissingImportValue}
+	}
+
+	var parsed time.Time
+	var err error
+	switch m.TimeFormat {
+	case ImportTimeUnix:
+		var seconds int64
+		if seconds, err = strconv.ParseInt(value, 10, 64); err == nil {
+			parsed = time.Unix(seconds, 0)
+		}
+	case ImportTimeRFC3339, "":
+		if parsed, err = time.Parse(time.RFC3339, value); err != nil {
+			parsed, err = time.ParseInLocation("2006-01-02T15:04:05", value, loc)
+		}
+	default:
+		parsed, err = time.ParseInLocation(m.TimeFormat, value, loc)
+	}
+	if err != nil {
+		return time.Time{}, &ImportFieldError{Column: column, Err: ErrInvalidImportTime}
+	}
+	return parsed, nil
+}
+
+func (m ImportMapping) parseDuration(record ImportRecord) (time.Duration, error) {
+	value := strings.TrimSpace(record[m.DurationColumn])
+	if value == "" {
+		return 0, &ImportFieldError{Column: m.DurationColumn, Err: ErrMissingImportValue}
+	}
+
+	invalid := &ImportFieldError{Column: m.DurationColumn, Err: ErrInvalidImportLength}
+	switch m.DurationUnit {
+	case DurationSeconds, DurationMinutes:
+		amount, err := strconv.ParseFloat(value, 64)
+		if err != nil || amount <= 0 || amount > 24*60*60 {
+			return 0, invalid
+		}
+		if m.DurationUnit == DurationMinutes {
+			return time.Duration(amount * float64(time.Minute)), nil
+		}
+		return time.Duration(amount * float64(time.Second)), nil
+	default:
+		parts := strings.Split(value, ":")
+		if len(parts) < 2 || len(parts) > 3 {
+			return 0, invalid
+		}
+		var length time.Duration
+		for i, part := range parts {
+			amount, err := strconv.Atoi(part)
+			if err != nil || amount < 0 || (i > 0 && amount >= 60) {
+				return 0, invalid
+			}
+			length = length*60 + time.Duration(amount)
+		}
+		return length * time.Second, nil
+	}
+}
+
+// ImportDuplicateKey identifies a session for duplicate detection: sessions
+// of a user starting in the same minute are the same session, as exports
+// often drop the seconds
+func ImportDuplicateKey(startTime time.Time) int64 {
+	return startTime.Truncate(time.Minute).Unix()
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestImportMapping_ShouldMapInsightTimerRows(t *testing.T) {
+	// given
+	mapping, _ := ImportPreset("insight_timer")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	record := ImportRecord{"Started At": "03/14/2024 07:30:15", "Duration": "0:20:00", "Preset": "朝の瞑想"}
+
+	// when
+	session, err := mapping.Session(record, tokyo, newTestCatalog(t))
+	emotionalState, emotionErr := mapping.EmotionalState(record)
+
+	// then
+	if err != nil || emotionErr != nil {
+		t.Fatalf("Expected the row to be mapped, got %v and %v", err, emotionErr)
+	}
+	if !session.StartTime().Equal(time.Date(2024, 3, 13, 22, 30, 15, 0, time.UTC)) {
+		t.Errorf("Expected the start to be read in Asia/Tokyo, got %v", session.StartTime())
+	}
+	if session.Duration() != 20*time.Minute || session.MeditationType() != "mindfulness" || session.Note() != "朝の瞑想" {
+		t.Errorf("Expected a 20 minute mindfulness session with the preset as note, got %v %s %q",
+			session.Duration(), session.MeditationType(), session.Note())
+	}
+	if emotionalState != nil {
+		t.Errorf("Expected no emotional state, got %+v", emotionalState)
+	}
+}
+
+func TestImportMapping_ShouldKeepUnknownTypesAsOther(t *testing.T) {
+	// given
+	mapping := ImportMapping{
+		StartColumn: "date", DurationColumn: "minutes", DurationUnit: DurationMinutes,
+		TimeFormat: "2006-01-02 15:04", TypeColumn: "kind",
+		EmotionBeforeColumn: "before", EmotionAfterColumn: "after",
+	}
+	record := ImportRecord{"date": "2024-03-14 07:30", "minutes": "12.5", "kind": "Yoga nidra", "before": "不安", "after": "穏やか"}
+
+	// when
+	session, err := mapping.Session(record, time.UTC, newTestCatalog(t))
+	emotionalState, _ := mapping.EmotionalState(record)
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected the row to be mapped, got %v", err)
+	}
+	if session.MeditationType() != OtherMeditationTypeID || session.CustomType() != "Yoga nidra" {
+		t.Errorf("Expected other with the app's type name, got %s %q", session.MeditationType(), session.CustomType())
+	}
+	if session.Duration() != 12*time.Minute+30*time.Second {
+		t.Errorf("Expected 12.5 minutes, got %v", session.Duration())
+	}
+	if emotionalState == nil || !emotionalState.IsImproved() {
+		t.Errorf("Expected the emotions to be mapped, got %+v", emotionalState)
+	}
+}
+
+func TestImportMapping_ShouldReportTheColumnOfInvalidValues(t *testing.T) {
+	// given
+	mapping, _ := ImportPreset("zen_connect")
+	catalog := newTestCatalog(t)
+	cases := []struct {
+		record ImportRecord
+		column string
+		err    error
+	}{
+		{ImportRecord{"end_time": "2024-03-14T08:00:00Z"}, "start_time", ErrMissingImportValue},
+		{ImportRecord{"start_time": "14.03.2024", "end_time": "2024-03-14T08:00:00Z"}, "start_time", ErrInvalidImportTime},
+		{ImportRecord{"start_time": "2024-03-14T08:00:00Z", "end_time": "2024-03-14T07:00:00Z"}, "end_time", ErrInvalidTimeRange},
+	}
+
+	for _, tc := range cases {
+		// when
+		_, err := mapping.Session(tc.record, time.UTC, catalog)
+
+		// then
+		var fieldErr *ImportFieldError
+		if !errors.As(err, &fieldErr) || fieldErr.Column != tc.column || !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v in %s for %v, got %v", tc.err, tc.column, tc.record, err)
+		}
+	}
+
+	_, err := mapping.EmotionalState(ImportRecord{"emotion_before": "穏やか"})
+	if !errors.Is(err, ErrIncompleteEmotions) {
+		t.Errorf("Expected a single emotion to be rejected, got %v", err)
+	}
+	_, err = mapping.EmotionalState(ImportRecord{"emotion_before": "happy", "emotion_after": "穏やか"})
+	if !errors.Is(err, ErrUnknownEmotionLevel) {
+		t.Errorf("Expected an unknown level to be rejected, got %v", err)
+	}
+}
+
+func TestImportMapping_ShouldRequireStartLengthAndType(t *testing.T) {
+	// given
+	noLength := ImportMapping{StartColumn: "start", DefaultType: "zazen"}
+	noType := ImportMapping{StartColumn: "start", EndColumn: "end"}
+	noUnit := ImportMapping{StartColumn: "start", DurationColumn: "length", DefaultType: "zazen"}
+
+	// then
+	if !errors.Is(noLength.Validate(), ErrInvalidImportMapping) {
+		t.Error("Expected a mapping without end or duration to be rejected")
+	}
+	if !errors.Is(noType.Validate(), ErrInvalidImportMapping) {
+		t.Error("Expected a mapping without meditation type to be rejected")
+	}
+	if !errors.Is(noUnit.Validate(), ErrInvalidDurationUnit) {
+		t.Error("Expected a duration without unit to be rejected")
+	}
+	if _, err := ImportPreset("headspace"); !errors.Is(err, ErrUnknownImportPreset) {
+		t.Errorf("Expected an unknown preset to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ImportBatch is the set of experiences one history import inserted
+// (aggregate root). What follows a recorded experience (rollups, goals,
+// badges, webhooks) runs once for the whole batch in the background instead
+// of once per experience within the import.
+type ImportBatch struct {
+	id            string
+	userID        string
+	experienceIDs []string
+	importedAt    time.Time
+	processedAt   time.Time
+	events        []DomainEvent
+}
+
+// NewImportBatch creates a pending batch of imported experiences
+func NewImportBatch(userID string, experienceIDs []string, importedAt time.Time) *ImportBatch {
+	return &ImportBatch{
+		id:            uuid.New().String(),
+		userID:        userID,
+		experienceIDs: experienceIDs,
+		importedAt:    importedAt,
+		events:        []DomainEvent{},
+	}
+}
+
+// ReconstructImportBatch rebuilds a batch from storage; processedAt is zero
+// while the batch is pending
+func ReconstructImportBatch(id, userID string, experienceIDs []string, importedAt, processedAt time.Time) *ImportBatch {
+	return &ImportBatch{
+		id:            id,
+		userID:        userID,
+		experienceIDs: experienceIDs,
+		importedAt:    importedAt,
+		processedAt:   processedAt,
+		events:        []DomainEvent{},
+	}
+}
+
+func (b *ImportBatch) ID() string              { return b.id }
+func (b *ImportBatch) UserID() string          { return b.userID }
+func (b *ImportBatch) ExperienceIDs() []string { return b.experienceIDs }
+func (b *ImportBatch) ImportedAt() time.Time   { return b.importedAt }
+func (b *ImportBatch) ProcessedAt() time.Time  { return b.processedAt }
+
+// IsProcessed reports whether the batch's follow-up work has been started
+func (b *ImportBatch) IsProcessed() bool {
+	return !b.processedAt.IsZero()
+}
+
+// Process marks the batch processed and raises HistoryImported; a batch
+// already processed raises nothing
+func (b *ImportBatch) Process(now time.Time) {
+	if b.IsProcessed() {
+		return
+	}
+	b.processedAt = now
+	b.events = append(b.events, NewHistoryImported(b.id, b.userID, b.experienceIDs, b.importedAt, now))
+}
+
+// Events returns domain events
+func (b *ImportBatch) Events() []DomainEvent {
+	return b.events
+}
+
+// ClearEvents clears domain events
+func (b *ImportBatch) ClearEvents() {
+	b.events = []DomainEvent{}
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestImportBatch_ProcessShouldRaiseOneHistoryImportedForTheBatch(t *testing.T) {
+	// given
+	importedAt := time.Date(2024, 3, 14, 7, 0, 0, 0, time.UTC)
+	batch := NewImportBatch("user-1", []string{"experience-1", "experience-2", "experience-3"}, importedAt)
+	now := importedAt.Add(time.Minute)
+
+	// when
+	batch.Process(now)
+	batch.Process(now.Add(time.Minute))
+
+	// then
+	if !batch.IsProcessed() || !batch.ProcessedAt().Equal(now) {
+		t.Errorf("Expected the batch to be processed at %v, got %v", now, batch.ProcessedAt())
+	}
+	if len(batch.Events()) != 1 {
+		t.Fatalf("Expected one event, got %d", len(batch.Events()))
+	}
+	imported, ok := batch.Events()[0].(*HistoryImported)
+	if !ok {
+		t.Fatalf("Expected HistoryImported, got %T", batch.Events()[0])
+	}
+	if imported.AggregateID() != batch.ID() || imported.UserID() != "user-1" || len(imported.ExperienceIDs()) != 3 {
+		t.Errorf("Expected the event to carry the batch, got %s %s %v", imported.AggregateID(), imported.UserID(), imported.ExperienceIDs())
+	}
+	if !imported.ImportedAt().Equal(importedAt) {
+		t.Errorf("Expected imported at %v, got %v", importedAt, imported.ImportedAt())
+	}
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Limits of a journal
+const (
+	MaxJournalEntryLength = 20000
+	MaxTags               = 10
+	MaxTagLength          = 30
+)
+
+// Domain errors for Journal
+var (
+	ErrJournalEntryTooLong = errors.New("journal entry is too long")
+	ErrTooManyTags         = errors.New("too many tags")
+	ErrInvalidTag          = errors.New("tag must be 1 to 30 characters without spaces or commas")
+)
+
+// Journal is the free-form part of an experience: a Markdown entry written
+// after the session and user-defined tags. The Markdown is stored as written
+// and rendered by clients.
+type Journal struct {
+	entry string
+	tags  []string
+}
+
+// NewJournal creates a journal with validation. Tags are normalized with
+// NormalizeTag and de-duplicated, keeping the order they were given in.
+func NewJournal(entry string, tags []string) (*Journal, error) {
+	entry = strings.TrimSpace(entry)
+	if utf8.RuneCountInString(entry) > MaxJournalEntryLength {
+		return nil, ErrJournalEntryTooLong
+	}
+
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag, err := NormalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) > MaxTags {
+		return nil, ErrTooManyTags
+	}
+
+	return &Journal{entry: entry, tags: normalized}, nil
+}
+
+// ReconstructJournal restores a journal from persistence
+func ReconstructJournal(entry string, tags []string) *Journal {
+	if tags == nil {
+		tags = []string{}
+	}
+	return &Journal{entry: entry, tags: tags}
+}
+
+// EmptyJournal returns a journal without entry and tags
+func EmptyJournal() *Journal {
+	return ReconstructJournal("", nil)
+}
+
+// NormalizeTag trims a leading "#" and surrounding spaces and lowercases
+// the tag so "#Morning" and "morning" are the same tag
+func NormalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(tag), "#")))
+	if tag == "" || utf8.RuneCountInString(tag) > MaxTagLength {
+		return "", ErrInvalidTag
+	}
+	if strings.ContainsFunc(tag, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsControl(r) || r == ',' || r == '、' || r == '#'
+	}) {
+		return "", ErrInvalidTag
+	}
+	return tag, nil
+}
+
+// Getter methods
+func (j *Journal) Entry() string { return j.entry }
+
+// Tags returns a copy of the tags
+func (j *Journal) Tags() []string {
+	return append([]string{}, j.tags...)
+}
+
+// HasTag reports whether the journal is tagged with tag
+func (j *Journal) HasTag(tag string) bool {
+	normalized, err := NormalizeTag(tag)
+	if err != nil {
+		return false
+	}
+	for _, t := range j.tags {
+		if t == normalized {
+			return true
+		}
+	}
+	return false
+}
+
+// Equals checks if two journals have the same entry and tags (in any order)
+func (j *Journal) Equals(other *Journal) bool {
+	if other == nil || j.entry != other.entry || len(j.tags) != len(other.tags) {
+		return false
+	}
+	a, b := j.Tags(), other.Tags()
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewJournal_ShouldNormalizeAndDeduplicateTags(t *testing.T) {
+	// given
+	tags := []string{"#Morning", "morning", " 呼吸 ", "#呼吸"}
+
+	// when
+	journal, err := NewJournal("  ## 今日の座禅\n\n- 呼吸に集中できた  ", tags)
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(journal.Tags(), []string{"morning", "呼吸"}) {
+		t.Errorf("Expected normalized tags in order, got %v", journal.Tags())
+	}
+	if journal.Entry() != "## 今日の座禅\n\n- 呼吸に集中できた" {
+		t.Errorf("Expected Markdown kept as written, got %q", journal.Entry())
+	}
+	if !journal.HasTag("#MORNING") {
+		t.Error("Expected HasTag to normalize the tag")
+	}
+}
+
+func TestNewJournal_ShouldRejectInvalidInput(t *testing.T) {
+	tooManyTags := make([]string, MaxTags+1)
+	for i := range tooManyTags {
+		tooManyTags[i] = strings.Repeat("a", i+1)
+	}
+
+	tests := []struct {
+		name  string
+		entry string
+		tags  []string
+		want  error
+	}{
+		{name: "entry too long", entry: strings.Repeat("静", MaxJournalEntryLength+1), want: ErrJournalEntryTooLong},
+		{name: "too many tags", tags: tooManyTags, want: ErrTooManyTags},
+		{name: "empty tag", tags: []string{"#"}, want: ErrInvalidTag},
+		{name: "tag with space", tags: []string{"朝 の座禅"}, want: ErrInvalidTag},
+		{name: "tag with comma", tags: []string{"朝,夜"}, want: ErrInvalidTag},
+		{name: "tag too long", tags: []string{strings.Repeat("禅", MaxTagLength+1)}, want: ErrInvalidTag},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			_, err := NewJournal(tt.entry, tt.tags)
+
+			// then
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestExperience_UpdateJournalShouldEmitEventOnlyWhenChanged(t *testing.T) {
+	// given
+	startTime := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	session := NewMeditationSession(startTime, startTime.Add(20*time.Minute), "zazen", "")
+	experience := NewExperience("user-123", NewExperienceContent(session, NewEmotionalState("不安", "穏やか"), startTime, startTime))
+	experience.ClearEvents()
+	journal, _ := NewJournal("静かな朝", []string{"朝", "呼吸"})
+	sameJournal, _ := NewJournal("静かな朝", []string{"呼吸", "朝"})
+
+	// when
+	err := experience.UpdateJournal(journal)
+	againErr := experience.UpdateJournal(sameJournal)
+	nilErr := experience.UpdateJournal(nil)
+
+	// then
+	if err != nil || againErr != nil {
+		t.Fatalf("Expected no error, got %v / %v", err, againErr)
+	}
+	if len(experience.Events()) != 1 || experience.Events()[0].EventName() != "ExperienceUpdated" {
+		t.Errorf("Expected a single ExperienceUpdated event, got %v", experience.Events())
+	}
+	if !errors.Is(nilErr, ErrNilJournal) {
+		t.Errorf("Expected ErrNilJournal, got %v", nilErr)
+	}
+}
+
+func TestNewExperienceSearchCriteria_ShouldSplitQueryAndNormalizeTags(t *testing.T) {
+	// given
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	// when
+	criteria, err := NewExperienceSearchCriteria("user-123", " 呼吸　Breath  集中 ", []string{"#朝"}, from, to, "zazen", 20, 0)
+	_, rangeErr := NewExperienceSearchCriteria("user-123", "", nil, to, from, "", 20, 0)
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(criteria.Terms, []string{"呼吸", "breath", "集中"}) {
+		t.Errorf("Expected terms split on any space and lowercased, got %v", criteria.Terms)
+	}
+	if !reflect.DeepEqual(criteria.Tags, []string{"朝"}) {
+		t.Errorf("Expected normalized tags, got %v", criteria.Tags)
+	}
+	if !errors.Is(rangeErr, ErrInvalidSearchRange) {
+		t.Errorf("Expected ErrInvalidSearchRange, got %v", rangeErr)
+	}
+}
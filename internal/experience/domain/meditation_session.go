@@ -2,7 +2,9 @@ package domain
 
 import (
 	"errors"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // MeditationSession represents a meditation session value object
@@ -10,6 +12,7 @@ type MeditationSession struct {
 	startTime      time.Time
 	endTime        time.Time
 	meditationType string
+	customType     string
 	note           string
 }
 
@@ -19,6 +22,14 @@ var (
 	ErrEmptyMeditationType = errors.New("meditation type cannot be empty")
 )
 
+// ValidateTimeRange checks that a session ends after it starts
+func ValidateTimeRange(startTime, endTime time.Time) error {
+	if !endTime.After(startTime) {
+		return ErrInvalidTimeRange
+	}
+	return nil
+}
+
 // NewMeditationSession creates a new MeditationSession value object
 func NewMeditationSession(startTime, endTime time.Time, meditationType, note string) *MeditationSession {
 	return &MeditationSession{
@@ -29,24 +40,65 @@ func NewMeditationSession(startTime, endTime time.Time, meditationType, note str
 	}
 }
 
-// NewMeditationSessionWithValidation creates a new MeditationSession with validation
-func NewMeditationSessionWithValidation(startTime, endTime time.Time, meditationType, note string) (*MeditationSession, error) {
-	if endTime.Before(startTime) || endTime.Equal(startTime) {
-		return nil, ErrInvalidTimeRange
+// ReconstructMeditationSession restores a MeditationSession, including the
+// free-text type of an "other" session, from persistence
+func ReconstructMeditationSession(startTime, endTime time.Time, meditationType, customType, note string) *MeditationSession {
+	return &MeditationSession{
+		startTime:      startTime,
+		endTime:        endTime,
+		meditationType: meditationType,
+		customType:     customType,
+		note:           note,
+	}
+}
+
+// NewMeditationSessionWithValidation creates a new MeditationSession with validation.
+// The meditation type is resolved against the catalog and stored as its
+// canonical ID; customType is the free-text type required when it is "other"
+// and ignored otherwise.
+func NewMeditationSessionWithValidation(startTime, endTime time.Time, meditationType, customType, note string, catalog *MeditationTypeCatalog) (*MeditationSession, error) {
+	if err := ValidateTimeRange(startTime, endTime); err != nil {
+		return nil, err
 	}
 	
-	if meditationType == "" {
-		return nil, ErrEmptyMeditationType
+	meditationType, customType, err := ResolveMeditationType(meditationType, customType, catalog)
+	if err != nil {
+		return nil, err
 	}
 	
 	return &MeditationSession{
 		startTime:      startTime,
 		endTime:        endTime,
 		meditationType: meditationType,
+		customType:     customType,
 		note:           note,
 	}, nil
 }
 
+// ResolveMeditationType resolves a meditation type against the catalog and
+// returns its canonical ID together with the normalized free-text type, which
+// is required for "other" and dropped for every other type
+func ResolveMeditationType(meditationType, customType string, catalog *MeditationTypeCatalog) (string, string, error) {
+	if strings.TrimSpace(meditationType) == "" {
+		return "", "", ErrEmptyMeditationType
+	}
+	
+	resolved, ok := catalog.Resolve(meditationType)
+	if !ok {
+		return "", "", ErrUnknownMeditationType
+	}
+	
+	customType = strings.TrimSpace(customType)
+	if resolved.ID() != OtherMeditationTypeID {
+		customType = ""
+	} else if customType == "" {
+		return "", "", ErrEmptyCustomMeditationType
+	} else if utf8.RuneCountInString(customType) > maxCustomMeditationTypeLength {
+		return "", "", ErrCustomMeditationTypeTooLong
+	}
+	return resolved.ID(), customType, nil
+}
+
 // Getter methods
 func (ms *MeditationSession) StartTime() time.Time {
 	return ms.startTime
@@ -60,6 +112,11 @@ func (ms *MeditationSession) MeditationType() string {
 	return ms.meditationType
 }
 
+// CustomType returns the free-text type of an "other" session
+func (ms *MeditationSession) CustomType() string {
+	return ms.customType
+}
+
 func (ms *MeditationSession) Note() string {
 	return ms.note
 }
@@ -83,5 +140,6 @@ func (ms *MeditationSession) Equals(other *MeditationSession) bool {
 	return ms.startTime.Equal(other.startTime) &&
 		ms.endTime.Equal(other.endTime) &&
 		ms.meditationType == other.meditationType &&
+		ms.customType == other.customType &&
 		ms.note == other.note
 }
\ No newline at end of file
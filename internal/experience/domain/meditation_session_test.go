@@ -41,7 +41,7 @@ func TestNewMeditationSession_ShouldReturnErrorWhenEndTimeBeforeStartTime(t *tes
 	note := "test note"
 	
 	// when
-	session, err := NewMeditationSessionWithValidation(startTime, endTime, meditationType, note)
+	session, err := NewMeditationSessionWithValidation(startTime, endTime, meditationType, "", note, newTestCatalog(t))
 	
 	// then
 	if err == nil {
@@ -60,7 +60,7 @@ func TestNewMeditationSession_ShouldReturnErrorWhenMeditationTypeIsEmpty(t *test
 	note := "test note"
 	
 	// when
-	session, err := NewMeditationSessionWithValidation(startTime, endTime, meditationType, note)
+	session, err := NewMeditationSessionWithValidation(startTime, endTime, meditationType, "", note, newTestCatalog(t))
 	
 	// then
 	if err == nil {
@@ -147,4 +147,75 @@ func TestMeditationSession_Equals_ShouldReturnFalseWhenDifferentValues(t *testin
 	if actual {
 		t.Error("Expected sessions with different values to be not equal")
 	}
-}
\ No newline at end of file
+}
+
+func TestValidateTimeRange_ShouldRejectNonPositiveDuration(t *testing.T) {
+	// given
+	startTime := time.Now()
+	
+	// when / then
+	if err := ValidateTimeRange(startTime, startTime.Add(time.Minute)); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if err := ValidateTimeRange(startTime, startTime); err != ErrInvalidTimeRange {
+		t.Errorf("Expected ErrInvalidTimeRange for equal times, got %v", err)
+	}
+	if err := ValidateTimeRange(startTime, startTime.Add(-time.Minute)); err != ErrInvalidTimeRange {
+		t.Errorf("Expected ErrInvalidTimeRange for reversed times, got %v", err)
+	}
+}
+
+func TestNewMeditationSessionWithValidation_ShouldCanonicalizeMeditationType(t *testing.T) {
+	// given
+	startTime := time.Now()
+	endTime := startTime.Add(20 * time.Minute)
+	catalog := newTestCatalog(t)
+	
+	for _, input := range []string{"zazen", "Zazen", " 座禅 "} {
+		// when
+		session, err := NewMeditationSessionWithValidation(startTime, endTime, input, "ignored", "", catalog)
+		
+		// then
+		if err != nil {
+			t.Fatalf("Expected %q to resolve, got %v", input, err)
+		}
+		if session.MeditationType() != "zazen" || session.CustomType() != "" {
+			t.Errorf("Expected %q to be stored as zazen, got %q (%q)", input, session.MeditationType(), session.CustomType())
+		}
+	}
+}
+
+func TestNewMeditationSessionWithValidation_ShouldRejectUnknownMeditationType(t *testing.T) {
+	// given
+	startTime := time.Now()
+	
+	// when
+	_, err := NewMeditationSessionWithValidation(startTime, startTime.Add(time.Minute), "qigong", "", "", newTestCatalog(t))
+	
+	// then
+	if err != ErrUnknownMeditationType {
+		t.Errorf("Expected ErrUnknownMeditationType, got %v", err)
+	}
+}
+
+func TestNewMeditationSessionWithValidation_ShouldRequireCustomTypeForOther(t *testing.T) {
+	// given
+	startTime := time.Now()
+	endTime := startTime.Add(time.Minute)
+	catalog := newTestCatalog(t)
+	
+	// when
+	_, missingErr := NewMeditationSessionWithValidation(startTime, endTime, "other", "  ", "", catalog)
+	session, err := NewMeditationSessionWithValidation(startTime, endTime, "other", " 気功 ", "", catalog)
+	
+	// then
+	if missingErr != ErrEmptyCustomMeditationType {
+		t.Errorf("Expected ErrEmptyCustomMeditationType, got %v", missingErr)
+	}
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if session.MeditationType() != OtherMeditationTypeID || session.CustomType() != "気功" {
+		t.Errorf("Expected other/気功, got %s/%s", session.MeditationType(), session.CustomType())
+	}
+}
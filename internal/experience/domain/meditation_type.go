@@ -0,0 +1,214 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// OtherMeditationTypeID is the catalog entry for meditation types that are
+// not in the catalog; sessions using it carry a free-text custom type
+const OtherMeditationTypeID = "other"
+
+// Languages every localized text must provide
+const (
+	LanguageJapanese = "ja"
+	LanguageEnglish  = "en"
+)
+
+// maxCustomMeditationTypeLength is the longest free-text type of an "other" session
+const maxCustomMeditationTypeLength = 100
+
+// Domain errors for MeditationType
+var (
+	ErrInvalidMeditationTypeID     = errors.New("meditation type ID must be lowercase letters, digits or underscores")
+	ErrMissingMeditationTypeName   = errors.New("meditation type name is required in Japanese and English")
+	ErrInvalidRecommendedDuration  = errors.New("recommended duration must be positive")
+	ErrMeditationTypeNotFound      = errors.New("meditation type not found")
+	ErrMeditationTypeAlreadyExists = errors.New("meditation type already exists")
+	ErrUnknownMeditationType       = errors.New("meditation type is not in the catalog")
+	ErrEmptyCustomMeditationType   = errors.New("custom meditation type is required for other")
+	ErrCustomMeditationTypeTooLong = errors.New("custom meditation type is too long")
+	ErrCannotDeactivateOtherType   = errors.New("the other meditation type cannot be deactivated")
+)
+
+var meditationTypeIDPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,49}$`)
+
+// LocalizedText holds a text per language code
+type LocalizedText map[string]string
+
+// In returns the text for language, falling back to Japanese
+func (t LocalizedText) In(language string) string {
+	if text, ok := t[language]; ok && text != "" {
+		return text
+	}
+	return t[LanguageJapanese]
+}
+
+// MeditationType is an entry of the meditation type catalog
+type MeditationType struct {
+	id                  string
+	names               LocalizedText
+	descriptions        LocalizedText
+	recommendedDuration time.Duration
+	categories          []string
+	sortOrder           int
+	active              bool
+	createdAt           time.Time
+	updatedAt           time.Time
+}
+
+// NewMeditationType creates a new active catalog entry with validation
+func NewMeditationType(id string, names, descriptions LocalizedText, recommendedDuration time.Duration, categories []string, sortOrder int) (*MeditationType, error) {
+	if !meditationTypeIDPattern.MatchString(id) {
+		return nil, ErrInvalidMeditationTypeID
+	}
+
+	now := time.Now()
+	mt := &MeditationType{
+		id:        id,
+		active:    true,
+		createdAt: now,
+		updatedAt: now,
+	}
+	if err := mt.Update(names, descriptions, recommendedDuration, categories, sortOrder, now); err != nil {
+		return nil, err
+	}
+	return mt, nil
+}
+
+// ReconstructMeditationType restores a catalog entry from persistence
+func ReconstructMeditationType(id string, names, descriptions LocalizedText, recommendedDuration time.Duration, categories []string, sortOrder int, active bool, createdAt, updatedAt time.Time) *MeditationType {
+	return &MeditationType{
+		id:                  id,
+		names:               names,
+		descriptions:        descriptions,
+		recommendedDuration: recommendedDuration,
+		categories:          categories,
+		sortOrder:           sortOrder,
+		active:              active,
+		createdAt:           createdAt,
+		updatedAt:           updatedAt,
+	}
+}
+
+// Update replaces the editable attributes of the entry
+func (mt *MeditationType) Update(names, descriptions LocalizedText, recommendedDuration time.Duration, categories []string, sortOrder int, now time.Time) error {
+	if strings.TrimSpace(names[LanguageJapanese]) == "" || strings.TrimSpace(names[LanguageEnglish]) == "" {
+		return ErrMissingMeditationTypeName
+	}
+	if recommendedDuration <= 0 {
+		return ErrInvalidRecommendedDuration
+	}
+	if descriptions == nil {
+		descriptions = LocalizedText{}
+	}
+
+	mt.names = names
+	mt.descriptions = descriptions
+	mt.recommendedDuration = recommendedDuration
+	mt.categories = normalizeCategories(categories)
+	mt.sortOrder = sortOrder
+	mt.updatedAt = now
+	return nil
+}
+
+// SetActive activates or retires the entry. Retired entries remain valid on
+// existing sessions but cannot be chosen for new ones.
+func (mt *MeditationType) SetActive(active bool, now time.Time) error {
+	if !active && mt.id == OtherMeditationTypeID {
+		return ErrCannotDeactivateOtherType
+	}
+	mt.active = active
+	mt.updatedAt = now
+	return nil
+}
+
+// Getter methods
+func (mt *MeditationType) ID() string                         { return mt.id }
+func (mt *MeditationType) Names() LocalizedText               { return mt.names }
+func (mt *MeditationType) Descriptions() LocalizedText        { return mt.descriptions }
+func (mt *MeditationType) RecommendedDuration() time.Duration { return mt.recommendedDuration }
+func (mt *MeditationType) Categories() []string               { return mt.categories }
+func (mt *MeditationType) SortOrder() int                     { return mt.sortOrder }
+func (mt *MeditationType) IsActive() bool                     { return mt.active }
+func (mt *MeditationType) CreatedAt() time.Time               { return mt.createdAt }
+func (mt *MeditationType) UpdatedAt() time.Time               { return mt.updatedAt }
+
+// normalizeCategories lowercases, trims and deduplicates category tags
+func normalizeCategories(categories []string) []string {
+	seen := make(map[string]bool, len(categories))
+	normalized := make([]string, 0, len(categories))
+	for _, category := range categories {
+		category = strings.ToLower(strings.TrimSpace(category))
+		if category == "" || seen[category] {
+			continue
+		}
+		seen[category] = true
+		normalized = append(normalized, category)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+// MeditationTypeCatalog is a snapshot of the catalog used to canonicalize
+// the meditation type of new sessions
+type MeditationTypeCatalog struct {
+	types []*MeditationType
+	index map[string]*MeditationType
+}
+
+// NewMeditationTypeCatalog builds a catalog from its entries
+func NewMeditationTypeCatalog(types []*MeditationType) *MeditationTypeCatalog {
+	sorted := append([]*MeditationType(nil), types...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].sortOrder != sorted[j].sortOrder {
+			return sorted[i].sortOrder < sorted[j].sortOrder
+		}
+		return sorted[i].id < sorted[j].id
+	})
+
+	index := make(map[string]*MeditationType, len(sorted)*3)
+	for _, mt := range sorted {
+		index[catalogKey(mt.id)] = mt
+	}
+	// Localized names are aliases of the ID unless they collide with an ID
+	for _, mt := range sorted {
+		for _, name := range mt.names {
+			if key := catalogKey(name); key != "" {
+				if _, exists := index[key]; !exists {
+					index[key] = mt
+				}
+			}
+		}
+	}
+	return &MeditationTypeCatalog{types: sorted, index: index}
+}
+
+// Types returns the entries in display order
+func (c *MeditationTypeCatalog) Types() []*MeditationType {
+	return c.types
+}
+
+// Resolve finds the active entry for an ID or localized name, ignoring case
+// and surrounding whitespace, so that "Zazen" and "座禅" both resolve to zazen
+func (c *MeditationTypeCatalog) Resolve(meditationType string) (*MeditationType, bool) {
+	mt, ok := c.index[catalogKey(meditationType)]
+	if !ok || !mt.active {
+		return nil, false
+	}
+	return mt, true
+}
+
+// Find finds an entry like Resolve but includes retired entries, which past
+// experiences may still refer to
+func (c *MeditationTypeCatalog) Find(meditationType string) (*MeditationType, bool) {
+	mt, ok := c.index[catalogKey(meditationType)]
+	return mt, ok
+}
+
+func catalogKey(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestCatalog(t *testing.T) *MeditationTypeCatalog {
+	t.Helper()
+	var types []*MeditationType
+	for i, names := range []LocalizedText{
+		{LanguageJapanese: "マインドフルネス", LanguageEnglish: "Mindfulness"},
+		{LanguageJapanese: "座禅", LanguageEnglish: "Zazen"},
+		{LanguageJapanese: "その他", LanguageEnglish: "Other"},
+	} {
+		id := []string{"mindfulness", "zazen", OtherMeditationTypeID}[i]
+		mt, err := NewMeditationType(id, names, nil, 10*time.Minute, nil, i)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		types = append(types, mt)
+	}
+	return NewMeditationTypeCatalog(types)
+}
+
+func TestNewMeditationType_ShouldValidateAttributes(t *testing.T) {
+	// given
+	names := LocalizedText{LanguageJapanese: "歩行瞑想", LanguageEnglish: "Walking"}
+	
+	// when
+	mt, err := NewMeditationType("walking", names, nil, 15*time.Minute, []string{" Movement", "movement", "beginner"}, 1)
+	
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !mt.IsActive() || len(mt.Categories()) != 2 || mt.Categories()[0] != "beginner" || mt.Categories()[1] != "movement" {
+		t.Errorf("Expected active entry with normalized categories, got %v", mt.Categories())
+	}
+	
+	cases := map[error]func() error{
+		ErrInvalidMeditationTypeID: func() error {
+			_, err := NewMeditationType("Walking Meditation", names, nil, time.Minute, nil, 0)
+			return err
+		},
+		ErrMissingMeditationTypeName: func() error {
+			_, err := NewMeditationType("walking", LocalizedText{LanguageJapanese: "歩行瞑想"}, nil, time.Minute, nil, 0)
+			return err
+		},
+		ErrInvalidRecommendedDuration: func() error {
+			_, err := NewMeditationType("walking", names, nil, 0, nil, 0)
+			return err
+		},
+	}
+	for expected, create := range cases {
+		if err := create(); err != expected {
+			t.Errorf("Expected %v, got %v", expected, err)
+		}
+	}
+}
+
+func TestMeditationTypeCatalog_ShouldNotResolveRetiredTypes(t *testing.T) {
+	// given
+	catalog := newTestCatalog(t)
+	zazen, _ := catalog.Resolve("zazen")
+	
+	// when
+	zazen.SetActive(false, time.Now())
+	
+	// then
+	if _, ok := catalog.Resolve("座禅"); ok {
+		t.Error("Expected retired type not to resolve")
+	}
+	if len(catalog.Types()) != 3 {
+		t.Error("Expected retired type to remain in the catalog")
+	}
+}
+
+func TestMeditationType_ShouldNotDeactivateOther(t *testing.T) {
+	// given
+	other, _ := newTestCatalog(t).Resolve(OtherMeditationTypeID)
+	
+	// when
+	err := other.SetActive(false, time.Now())
+	
+	// then
+	if err != ErrCannotDeactivateOtherType {
+		t.Errorf("Expected ErrCannotDeactivateOtherType, got %v", err)
+	}
+}
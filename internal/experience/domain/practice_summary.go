@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// PracticeSummary is how much a user meditated in a period
+type PracticeSummary struct {
+	UserID          string
+	Sessions        int
+	TotalDuration   time.Duration
+	LongestDuration time.Duration
+	// Days is the number of distinct days, in the time zone the summary was
+	// made for, with at least one session
+	Days int
+}
+
+// PracticeTotals is everything a user has meditated, drafts included
+type PracticeTotals struct {
+	Sessions        int
+	TotalDuration   time.Duration
+	MeditationTypes int
+	// LongestStreakDays is the longest run of consecutive days (UTC) with a session
+	LongestStreakDays int
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// ReactionKind is a lightweight reaction to a public experience
+type ReactionKind string
+
+const (
+	// ReactionGassho 合掌 🙏
+	ReactionGassho ReactionKind = "gassho"
+	// ReactionLotus 蓮 🪷
+	ReactionLotus ReactionKind = "lotus"
+	// ReactionCalm 安らぎ 😌
+	ReactionCalm ReactionKind = "calm"
+)
+
+// reactionEmoji is the emoji shown for each kind, also accepted as its name
+var reactionEmoji = map[ReactionKind]string{
+	ReactionGassho: "🙏",
+	ReactionLotus:  "🪷",
+	ReactionCalm:   "😌",
+}
+
+// Domain errors for Reaction
+var (
+	ErrUnknownReaction = errors.New("unknown reaction")
+)
+
+// ReactionKinds returns every reaction kind in display order
+func ReactionKinds() []ReactionKind {
+	return []ReactionKind{ReactionGassho, ReactionLotus, ReactionCalm}
+}
+
+// ParseReactionKind accepts the name of a reaction or its emoji
+func ParseReactionKind(s string) (ReactionKind, error) {
+	s = strings.TrimSpace(s)
+	for kind, emoji := range reactionEmoji {
+		if s == string(kind) || s == emoji {
+			return kind, nil
+		}
+	}
+	return "", ErrUnknownReaction
+}
+
+// Emoji returns the emoji of the reaction
+func (k ReactionKind) Emoji() string {
+	return reactionEmoji[k]
+}
+
+// Reaction is a user's reaction to an experience. A user reacts at most once
+// with each kind.
+type Reaction struct {
+	experienceID string
+	userID       string
+	kind         ReactionKind
+	createdAt    time.Time
+	events       []DomainEvent
+}
+
+// NewReaction reacts to a public experience
+func NewReaction(experience *Experience, userID string, kind ReactionKind, now time.Time) (*Reaction, error) {
+	if err := experience.AcceptsInteractions(); err != nil {
+		return nil, err
+	}
+	if _, ok := reactionEmoji[kind]; !ok {
+		return nil, ErrUnknownReaction
+	}
+
+	reaction := &Reaction{
+		experienceID: experience.ID(),
+		userID:       userID,
+		kind:         kind,
+		createdAt:    now,
+	}
+	reaction.events = append(reaction.events, NewReactionAdded(experience.ID(), experience.UserID(), userID, kind, now))
+	return reaction, nil
+}
+
+func (r *Reaction) ExperienceID() string  { return r.experienceID }
+func (r *Reaction) UserID() string        { return r.userID }
+func (r *Reaction) Kind() ReactionKind    { return r.kind }
+func (r *Reaction) CreatedAt() time.Time  { return r.createdAt }
+func (r *Reaction) Events() []DomainEvent { return r.events }
+
+// ReactionSummary is the number of reactions of each kind on an experience
+// and the kinds the viewer has reacted with
+type ReactionSummary struct {
+	Counts map[ReactionKind]int
+	Mine   []ReactionKind
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Repository errors
+var (
+	ErrExperienceNotFound = errors.New("experience not found")
+)
+
+// ExperienceRepository defines the interface for experience persistence
+type ExperienceRepository interface {
+	Save(ctx context.Context, experience *Experience) error
+	FindByID(ctx context.Context, id string) (*Experience, error)
+	// Search returns the user's experiences matching the criteria, newest session first
+	Search(ctx context.Context, criteria *ExperienceSearchCriteria) ([]*Experience, error)
+	// TagCounts returns the tags the user has used, most used first
+	TagCounts(ctx context.Context, userID string) ([]TagCount, error)
+	// SummarizePractice totals the sessions started in [from, to) for every
+	// user who meditated in the period, counting days in location
+	SummarizePractice(ctx context.Context, from, to time.Time, location *time.Location) ([]PracticeSummary, error)
+	// HasPracticed reports whether the user has a session started in [from, to)
+	HasPracticed(ctx context.Context, userID string, from, to time.Time) (bool, error)
+	// SessionStarts returns the start times of the user's sessions started in [from, to)
+	SessionStarts(ctx context.Context, userID string, from, to time.Time) ([]time.Time, error)
+	// PracticeTotals totals all of the user's sessions, counting streak days
+	// in location
+	PracticeTotals(ctx context.Context, userID string, location *time.Location) (PracticeTotals, error)
+	// Practitioners returns every user with an experience
+	Practitioners(ctx context.Context) ([]string, error)
+	// Import inserts the user's imported experiences in one transaction,
+	// skipping those with the ImportDuplicateKey of a session the user
+	// already has, and returns the ones inserted. The inserted experiences
+	// are stored as a pending ImportBatch in the same transaction.
+	Import(ctx context.Context, userID string, experiences []*Experience) ([]*Experience, error)
+	// Export calls each for the user's experiences with a session started in
+	// [from, to), oldest first, as they are read; zero times leave the range
+	// open and an empty userID covers every user. An error from each stops
+	// the export and is returned.
+	Export(ctx context.Context, userID string, from, to time.Time, each func(*Experience) error) error
+}
+
+// MeditationTypeRepository persists the meditation type catalog
+type MeditationTypeRepository interface {
+	FindAll(ctx context.Context) ([]*MeditationType, error)
+	FindByID(ctx context.Context, id string) (*MeditationType, error)
+	Create(ctx context.Context, meditationType *MeditationType) error
+	Update(ctx context.Context, meditationType *MeditationType) error
+}
+
+// TimerSessionRepository persists live meditation timers
+type TimerSessionRepository interface {
+	// Create stores a new timer; ErrTimerSessionAlreadyActive if the user already has one
+	Create(ctx context.Context, timer *TimerSession) error
+	// Update stores a state change; ErrTimerSessionConflict if the timer was changed since it was loaded
+	Update(ctx context.Context, timer *TimerSession) error
+	// Finish stores a finished timer together with the draft experience
+	// created from it (nil when there was nothing to record) atomically
+	Finish(ctx context.Context, timer *TimerSession, draft *Experience) error
+	FindByID(ctx context.Context, id string) (*TimerSession, error)
+	FindActiveByUserID(ctx context.Context, userID string) (*TimerSession, error)
+	// FindAbandoned returns active timers without activity since lastActivityBefore
+	FindAbandoned(ctx context.Context, lastActivityBefore time.Time, limit int) ([]*TimerSession, error)
+}
+
+// ImportBatchRepository persists the batches of imported experiences
+type ImportBatchRepository interface {
+	// FindPending returns batches not processed yet, oldest first
+	FindPending(ctx context.Context, limit int) ([]*ImportBatch, error)
+	// MarkProcessed stores that the batch was processed; false when another
+	// instance processed it first
+	MarkProcessed(ctx context.Context, batch *ImportBatch) (bool, error)
+}
+
+// ReactionRepository persists reactions to experiences
+type ReactionRepository interface {
+	// Add stores a reaction; false when the user has already reacted with the same kind
+	Add(ctx context.Context, reaction *Reaction) (bool, error)
+	Remove(ctx context.Context, experienceID, userID string, kind ReactionKind) error
+	// Summarize counts the reactions of an experience and finds the viewer's own
+	Summarize(ctx context.Context, experienceID, viewerID string) (*ReactionSummary, error)
+}
+
+// CommentRepository persists comments on experiences
+type CommentRepository interface {
+	// Save inserts or updates a comment
+	Save(ctx context.Context, comment *Comment) error
+	FindByID(ctx context.Context, id string) (*Comment, error)
+	// FindByExperienceID returns all comments of an experience, including
+	// deleted ones, oldest first
+	FindByExperienceID(ctx context.Context, experienceID string) ([]*Comment, error)
+}
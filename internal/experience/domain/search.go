@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Domain errors for experience search
+var (
+	ErrInvalidSearchRange = errors.New("search range must end after it starts")
+)
+
+// ExperienceSearchCriteria filters a user's experiences. Zero values mean
+// "no filter"; every given filter must match.
+type ExperienceSearchCriteria struct {
+	UserID string
+	// Terms are matched as substrings of the note, the journal entry and the
+	// free-text meditation type, so Japanese text without word boundaries is
+	// found as well. Every term must appear.
+	Terms []string
+	// Tags must all be on the experience
+	Tags []string
+	// From and To bound the start time of the session (To is exclusive)
+	From           time.Time
+	To             time.Time
+	MeditationType string
+	Limit          int
+	Offset         int
+}
+
+// NewExperienceSearchCriteria builds search criteria from a free-text query.
+// The query is split on whitespace (including full-width spaces) and tags
+// are normalized like the tags of a journal.
+func NewExperienceSearchCriteria(userID, query string, tags []string, from, to time.Time, meditationType string, limit, offset int) (*ExperienceSearchCriteria, error) {
+	if !from.IsZero() && !to.IsZero() && !to.After(from) {
+		return nil, ErrInvalidSearchRange
+	}
+
+	criteria := &ExperienceSearchCriteria{
+		UserID:         userID,
+		Terms:          []string{},
+		Tags:           []string{},
+		From:           from,
+		To:             to,
+		MeditationType: meditationType,
+		Limit:          limit,
+		Offset:         offset,
+	}
+	for _, term := range strings.Fields(strings.ToLower(query)) {
+		criteria.Terms = append(criteria.Terms, term)
+	}
+	for _, tag := range tags {
+		normalized, err := NormalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		criteria.Tags = append(criteria.Tags, normalized)
+	}
+	return criteria, nil
+}
+
+// TagCount is a tag and the number of a user's experiences tagged with it
+type TagCount struct {
+	Tag   string
+	Count int
+}
@@ -0,0 +1,265 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TimerStatus is the state of a live meditation timer
+type TimerStatus string
+
+const (
+	TimerStatusRunning  TimerStatus = "running"
+	TimerStatusPaused   TimerStatus = "paused"
+	TimerStatusFinished TimerStatus = "finished"
+)
+
+// TimerFinishReason tells how a timer came to an end
+type TimerFinishReason string
+
+const (
+	// TimerFinishCompleted means the user finished the timer
+	TimerFinishCompleted TimerFinishReason = "completed"
+	// TimerFinishAbandoned means the server finished a timer whose client
+	// stopped reporting activity
+	TimerFinishAbandoned TimerFinishReason = "abandoned"
+)
+
+// Domain errors for TimerSession
+var (
+	ErrTimerSessionNotFound      = errors.New("timer session not found")
+	ErrTimerSessionAlreadyActive = errors.New("user already has an active timer session")
+	ErrTimerSessionConflict      = errors.New("timer session was changed concurrently")
+	ErrTimerNotRunning           = errors.New("timer session is not running")
+	ErrTimerNotPaused            = errors.New("timer session is not paused")
+	ErrTimerAlreadyFinished      = errors.New("timer session is already finished")
+	ErrTimerTooShort             = errors.New("timer session has no meditation time to record")
+)
+
+// Interruption is a pause of a live timer; ResumedAt is zero while the timer
+// is still paused
+type Interruption struct {
+	PausedAt  time.Time
+	ResumedAt time.Time
+}
+
+// Duration returns how long the timer was paused, counting an ongoing pause up to now
+func (i Interruption) Duration(now time.Time) time.Duration {
+	if i.ResumedAt.IsZero() {
+		return now.Sub(i.PausedAt)
+	}
+	return i.ResumedAt.Sub(i.PausedAt)
+}
+
+// TimerSession is a live meditation timer whose state is kept on the server
+// so that clients can reconnect to it (aggregate root). Finishing it produces
+// the MeditationSession of a draft Experience.
+type TimerSession struct {
+	id             string
+	userID         string
+	meditationType string
+	customType     string
+	status         TimerStatus
+	startedAt      time.Time
+	interruptions  []Interruption
+	lastActivityAt time.Time
+	finishedAt     time.Time
+	finishReason   TimerFinishReason
+	experienceID   string
+	version        int
+}
+
+// StartTimerSession starts a running timer. The meditation type is resolved
+// against the catalog in the same way as for recorded sessions.
+func StartTimerSession(userID, meditationType, customType string, catalog *MeditationTypeCatalog, now time.Time) (*TimerSession, error) {
+	if strings.TrimSpace(userID) == "" {
+		return nil, ErrEmptyUserID
+	}
+	meditationType, customType, err := ResolveMeditationType(meditationType, customType, catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TimerSession{
+		id:             uuid.New().String(),
+		userID:         userID,
+		meditationType: meditationType,
+		customType:     customType,
+		status:         TimerStatusRunning,
+		startedAt:      now,
+		lastActivityAt: now,
+	}, nil
+}
+
+// ReconstructTimerSession restores a timer from persistence
+func ReconstructTimerSession(
+	id string,
+	userID string,
+	meditationType string,
+	customType string,
+	status TimerStatus,
+	startedAt time.Time,
+	interruptions []Interruption,
+	lastActivityAt time.Time,
+	finishedAt time.Time,
+	finishReason TimerFinishReason,
+	experienceID string,
+	version int,
+) *TimerSession {
+	return &TimerSession{
+		id:             id,
+		userID:         userID,
+		meditationType: meditationType,
+		customType:     customType,
+		status:         status,
+		startedAt:      startedAt,
+		interruptions:  interruptions,
+		lastActivityAt: lastActivityAt,
+		finishedAt:     finishedAt,
+		finishReason:   finishReason,
+		experienceID:   experienceID,
+		version:        version,
+	}
+}
+
+// Getter methods
+func (ts *TimerSession) ID() string                      { return ts.id }
+func (ts *TimerSession) UserID() string                  { return ts.userID }
+func (ts *TimerSession) MeditationType() string          { return ts.meditationType }
+func (ts *TimerSession) CustomType() string              { return ts.customType }
+func (ts *TimerSession) Status() TimerStatus             { return ts.status }
+func (ts *TimerSession) StartedAt() time.Time            { return ts.startedAt }
+func (ts *TimerSession) Interruptions() []Interruption   { return ts.interruptions }
+func (ts *TimerSession) LastActivityAt() time.Time       { return ts.lastActivityAt }
+func (ts *TimerSession) FinishedAt() time.Time           { return ts.finishedAt }
+func (ts *TimerSession) FinishReason() TimerFinishReason { return ts.finishReason }
+func (ts *TimerSession) ExperienceID() string            { return ts.experienceID }
+
+// Version is the optimistic lock version loaded from persistence
+func (ts *TimerSession) Version() int { return ts.version }
+
+// BelongsToUser checks if the timer belongs to the specified user
+func (ts *TimerSession) BelongsToUser(userID string) bool {
+	return ts.userID == userID
+}
+
+// IsActive reports whether the timer is running or paused
+func (ts *TimerSession) IsActive() bool {
+	return ts.status != TimerStatusFinished
+}
+
+// Pause stops the clock until Resume is called
+func (ts *TimerSession) Pause(now time.Time) error {
+	if ts.status != TimerStatusRunning {
+		if ts.status == TimerStatusFinished {
+			return ErrTimerAlreadyFinished
+		}
+		return ErrTimerNotRunning
+	}
+	ts.status = TimerStatusPaused
+	ts.interruptions = append(ts.interruptions, Interruption{PausedAt: now})
+	ts.lastActivityAt = now
+	return nil
+}
+
+// Resume restarts the clock after a pause
+func (ts *TimerSession) Resume(now time.Time) error {
+	if ts.status != TimerStatusPaused {
+		if ts.status == TimerStatusFinished {
+			return ErrTimerAlreadyFinished
+		}
+		return ErrTimerNotPaused
+	}
+	ts.status = TimerStatusRunning
+	ts.interruptions[len(ts.interruptions)-1].ResumedAt = now
+	ts.lastActivityAt = now
+	return nil
+}
+
+// Heartbeat records that the client is still connected to the timer
+func (ts *TimerSession) Heartbeat(now time.Time) error {
+	if ts.status == TimerStatusFinished {
+		return ErrTimerAlreadyFinished
+	}
+	if now.After(ts.lastActivityAt) {
+		ts.lastActivityAt = now
+	}
+	return nil
+}
+
+// Elapsed returns the meditation time so far, excluding interruptions
+func (ts *TimerSession) Elapsed(now time.Time) time.Duration {
+	end := now
+	if ts.status == TimerStatusFinished {
+		end = ts.finishedAt
+	}
+	elapsed := end.Sub(ts.startedAt)
+	for _, interruption := range ts.interruptions {
+		elapsed -= interruption.Duration(end)
+	}
+	if elapsed < 0 {
+		return 0
+	}
+	return elapsed
+}
+
+// IsAbandoned reports whether the client has not shown any activity for timeout
+func (ts *TimerSession) IsAbandoned(now time.Time, timeout time.Duration) bool {
+	return ts.IsActive() && now.Sub(ts.lastActivityAt) >= timeout
+}
+
+// Finish ends the timer at now and returns the session to record. A paused
+// timer ends when it was paused.
+func (ts *TimerSession) Finish(now time.Time) (*MeditationSession, error) {
+	if ts.status == TimerStatusFinished {
+		return nil, ErrTimerAlreadyFinished
+	}
+	end := now
+	if ts.status == TimerStatusPaused {
+		end = ts.interruptions[len(ts.interruptions)-1].PausedAt
+	}
+	return ts.finish(end, now, TimerFinishCompleted)
+}
+
+// FinishAbandoned ends a timer whose client went away. Only the time up to the
+// last activity (or the pause) is known to have been spent meditating, so the
+// session ends there rather than when the abandonment was detected.
+func (ts *TimerSession) FinishAbandoned(now time.Time) (*MeditationSession, error) {
+	if ts.status == TimerStatusFinished {
+		return nil, ErrTimerAlreadyFinished
+	}
+	end := ts.lastActivityAt
+	if ts.status == TimerStatusPaused {
+		end = ts.interruptions[len(ts.interruptions)-1].PausedAt
+	}
+	return ts.finish(end, now, TimerFinishAbandoned)
+}
+
+// finish closes any ongoing pause and marks the timer finished. The session
+// starts with the timer and lasts as long as the meditation time, so pauses
+// are not counted in its duration. The timer is finished even when there is
+// no time to record, in which case ErrTimerTooShort is returned instead of a
+// session.
+func (ts *TimerSession) finish(end, now time.Time, reason TimerFinishReason) (*MeditationSession, error) {
+	if n := len(ts.interruptions); n > 0 && ts.interruptions[n-1].ResumedAt.IsZero() {
+		ts.interruptions[n-1].ResumedAt = end
+	}
+	ts.status = TimerStatusFinished
+	ts.finishedAt = end
+	ts.finishReason = reason
+	ts.lastActivityAt = now
+
+	sessionEnd := ts.startedAt.Add(ts.Elapsed(now))
+	if err := ValidateTimeRange(ts.startedAt, sessionEnd); err != nil {
+		return nil, ErrTimerTooShort
+	}
+	return ReconstructMeditationSession(ts.startedAt, sessionEnd, ts.meditationType, ts.customType, ""), nil
+}
+
+// AttachExperience links the finished timer to the draft experience created from it
+func (ts *TimerSession) AttachExperience(experienceID string) {
+	ts.experienceID = experienceID
+}
@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func startTestTimer(t *testing.T, startedAt time.Time) *TimerSession {
+	t.Helper()
+	timer, err := StartTimerSession("user-123", "座禅", "", newTestCatalog(t), startedAt)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return timer
+}
+
+func TestStartTimerSession_ShouldResolveMeditationType(t *testing.T) {
+	// given
+	startedAt := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+
+	// when
+	timer := startTestTimer(t, startedAt)
+	_, unknownErr := StartTimerSession("user-123", "unknown", "", newTestCatalog(t), startedAt)
+
+	// then
+	if timer.MeditationType() != "zazen" || timer.Status() != TimerStatusRunning {
+		t.Errorf("Expected running zazen timer, got %s %s", timer.MeditationType(), timer.Status())
+	}
+	if !errors.Is(unknownErr, ErrUnknownMeditationType) {
+		t.Errorf("Expected ErrUnknownMeditationType, got %v", unknownErr)
+	}
+}
+
+func TestTimerSession_ShouldExcludeInterruptionsFromElapsedTime(t *testing.T) {
+	// given
+	startedAt := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	timer := startTestTimer(t, startedAt)
+
+	// when
+	pauseErr := timer.Pause(startedAt.Add(10 * time.Minute))
+	resumeErr := timer.Resume(startedAt.Add(15 * time.Minute))
+	session, finishErr := timer.Finish(startedAt.Add(25 * time.Minute))
+
+	// then
+	for _, err := range []error{pauseErr, resumeErr, finishErr} {
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if elapsed := timer.Elapsed(startedAt.Add(time.Hour)); elapsed != 20*time.Minute {
+		t.Errorf("Expected 20m of meditation, got %s", elapsed)
+	}
+	if len(timer.Interruptions()) != 1 || timer.FinishReason() != TimerFinishCompleted {
+		t.Errorf("Expected one interruption and completed timer, got %v %s", timer.Interruptions(), timer.FinishReason())
+	}
+	if !session.StartTime().Equal(startedAt) || session.Duration() != 20*time.Minute {
+		t.Errorf("Expected a 20m session from the start of the timer, got %s - %s", session.StartTime(), session.EndTime())
+	}
+}
+
+func TestTimerSession_ShouldRejectInvalidTransitions(t *testing.T) {
+	// given
+	startedAt := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	timer := startTestTimer(t, startedAt)
+
+	// when
+	resumeErr := timer.Resume(startedAt.Add(time.Minute))
+	_ = timer.Pause(startedAt.Add(2 * time.Minute))
+	pauseErr := timer.Pause(startedAt.Add(3 * time.Minute))
+	_, _ = timer.Finish(startedAt.Add(4 * time.Minute))
+	_, finishErr := timer.Finish(startedAt.Add(5 * time.Minute))
+	heartbeatErr := timer.Heartbeat(startedAt.Add(6 * time.Minute))
+
+	// then
+	expected := map[error]error{
+		ErrTimerNotPaused:       resumeErr,
+		ErrTimerNotRunning:      pauseErr,
+		ErrTimerAlreadyFinished: finishErr,
+	}
+	for want, got := range expected {
+		if !errors.Is(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	}
+	if !errors.Is(heartbeatErr, ErrTimerAlreadyFinished) {
+		t.Errorf("Expected heartbeat on finished timer to fail, got %v", heartbeatErr)
+	}
+}
+
+func TestTimerSession_FinishWhilePausedShouldEndAtPause(t *testing.T) {
+	// given
+	startedAt := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	timer := startTestTimer(t, startedAt)
+	_ = timer.Pause(startedAt.Add(12 * time.Minute))
+
+	// when
+	session, err := timer.Finish(startedAt.Add(30 * time.Minute))
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if session.Duration() != 12*time.Minute || timer.Elapsed(startedAt.Add(time.Hour)) != 12*time.Minute {
+		t.Errorf("Expected 12m session, got %s", session.Duration())
+	}
+}
+
+func TestTimerSession_ShouldFinishAbandonedTimerAtLastActivity(t *testing.T) {
+	// given
+	startedAt := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	timeout := 30 * time.Minute
+	timer := startTestTimer(t, startedAt)
+	_ = timer.Heartbeat(startedAt.Add(8 * time.Minute))
+	detectedAt := startedAt.Add(8*time.Minute + timeout)
+
+	// when
+	abandonedBefore := timer.IsAbandoned(detectedAt.Add(-time.Second), timeout)
+	abandoned := timer.IsAbandoned(detectedAt, timeout)
+	session, err := timer.FinishAbandoned(detectedAt)
+
+	// then
+	if abandonedBefore || !abandoned {
+		t.Errorf("Expected timer to be abandoned exactly after the timeout, got %v/%v", abandonedBefore, abandoned)
+	}
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if session.Duration() != 8*time.Minute || timer.FinishReason() != TimerFinishAbandoned {
+		t.Errorf("Expected 8m abandoned session, got %s %s", session.Duration(), timer.FinishReason())
+	}
+}
+
+func TestTimerSession_AbandonedWithoutActivityShouldHaveNothingToRecord(t *testing.T) {
+	// given
+	startedAt := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	timer := startTestTimer(t, startedAt)
+
+	// when
+	session, err := timer.FinishAbandoned(startedAt.Add(time.Hour))
+
+	// then
+	if !errors.Is(err, ErrTimerTooShort) || session != nil {
+		t.Errorf("Expected ErrTimerTooShort, got %v", err)
+	}
+	if timer.IsActive() {
+		t.Error("Expected timer to be finished anyway")
+	}
+}
+
+func TestNewDraftExperience_ShouldAwaitEmotionalState(t *testing.T) {
+	// given
+	startedAt := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	session := NewMeditationSession(startedAt, startedAt.Add(20*time.Minute), "zazen", "")
+
+	// when
+	experience, err := NewDraftExperience("user-123", session, startedAt.Add(20*time.Minute))
+	completeErr := experience.CompleteDraft(NewEmotionalState("不安", "穏やか"), "静かだった")
+	againErr := experience.CompleteDraft(NewEmotionalState("不安", "穏やか"), "")
+
+	// then
+	if err != nil || completeErr != nil {
+		t.Fatalf("Expected no error, got %v / %v", err, completeErr)
+	}
+	if experience.IsDraft() || experience.Content().Session().Note() != "静かだった" {
+		t.Errorf("Expected completed experience with note, got draft=%v", experience.IsDraft())
+	}
+	if !errors.Is(againErr, ErrNotDraft) {
+		t.Errorf("Expected ErrNotDraft, got %v", againErr)
+	}
+}
@@ -0,0 +1,146 @@
+package infrastructure
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"zen-connect/internal/experience/domain"
+)
+
+// HistoryDecoder reads the CSV and JSON exports of meditation apps into rows
+type HistoryDecoder struct{}
+
+// NewHistoryDecoder creates a history decoder
+func NewHistoryDecoder() *HistoryDecoder {
+	return &HistoryDecoder{}
+}
+
+// Decode splits an export into rows keyed by column name. CSV needs a header
+// row and may be separated by commas, semicolons or tabs. JSON is an array of
+// objects, or an object holding one such array such as {"experiences": [...]}.
+func (d *HistoryDecoder) Decode(format string, r io.Reader) ([]domain.ImportRecord, error) {
+	switch format {
+	case "csv":
+		return decodeCSV(r)
+	case "json":
+		return decodeJSON(r)
+	}
+	return nil, domain.ErrUnsupportedImportFormat
+}
+
+func decodeCSV(r io.Reader) ([]domain.ImportRecord, error) {
+	buffered := bufio.NewReader(r)
+	// Spreadsheet apps write a byte order mark that would end up in the first column name
+	if bom, err := buffered.Peek(3); err == nil && bytes.Equal(bom, []byte("\xef\xbb\xbf")) {
+		buffered.Discard(3)
+	}
+	// Peek returns what there is of a shorter file along with its error
+	sample, _ := buffered.Peek(4096)
+
+	reader := csv.NewReader(buffered)
+	reader.Comma = detectDelimiter(sample)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidImportFile, err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	var records []domain.ImportRecord
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrInvalidImportFile, err)
+		}
+		if len(records) == domain.MaxImportRows {
+			return nil, domain.ErrTooManyImportRows
+		}
+		record := make(domain.ImportRecord, len(header))
+		for i, field := range fields {
+			if i < len(header) {
+				record[header[i]] = field
+			}
+		}
+		records = append(records, record)
+	}
+}
+
+// detectDelimiter picks the separator that appears in the header line
+func detectDelimiter(sample []byte) rune {
+	if end := bytes.IndexByte(sample, '\n'); end >= 0 {
+		sample = sample[:end]
+	}
+	delimiter, most := ',', bytes.Count(sample, []byte{','})
+	for _, candidate := range []rune{';', '\t'} {
+		if count := bytes.Count(sample, []byte(string(candidate))); count > most {
+			delimiter, most = candidate, count
+		}
+	}
+	return delimiter
+}
+
+func decodeJSON(r io.Reader) ([]domain.ImportRecord, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidImportFile, err)
+	}
+
+	items, ok := document.([]any)
+	if object, isObject := document.(map[string]any); isObject {
+		for _, value := range object {
+			if array, isArray := value.([]any); isArray {
+				if ok {
+					return nil, fmt.Errorf("%w: more than one array of rows", domain.ErrInvalidImportFile)
+				}
+				items, ok = array, true
+			}
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: no array of rows", domain.ErrInvalidImportFile)
+	}
+	if len(items) > domain.MaxImportRows {
+		return nil, domain.ErrTooManyImportRows
+	}
+
+	records := make([]domain.ImportRecord, 0, len(items))
+	for i, item := range items {
+		object, isObject := item.(map[string]any)
+		if !isObject {
+			return nil, fmt.Errorf("%w: row %d is not an object", domain.ErrInvalidImportFile, i+1)
+		}
+		record := make(domain.ImportRecord, len(object))
+		for column, value := range object {
+			switch v := value.(type) {
+			case string:
+				record[column] = v
+			case json.Number:
+				record[column] = v.String()
+			case bool:
+				record[column] = fmt.Sprint(v)
+			}
+			// Nested values and nulls are left out
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
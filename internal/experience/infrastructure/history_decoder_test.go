@@ -0,0 +1,68 @@
+package infrastructure
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"zen-connect/internal/experience/domain"
+)
+
+func TestHistoryDecoder_ShouldReadCSVWithHeader(t *testing.T) {
+	// given
+	data := "\xef\xbb\xbfStarted At;Duration;Preset\n03/14/2024 07:30:15;0:20:00;\"Morning; quiet\"\n\n03/15/2024 07:30:00;0:10:00;\n"
+
+	// when
+	records, err := NewHistoryDecoder().Decode("csv", strings.NewReader(data))
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 rows, got %d", len(records))
+	}
+	if records[0]["Started At"] != "03/14/2024 07:30:15" || records[0]["Preset"] != "Morning; quiet" {
+		t.Errorf("Expected the columns by header name, got %v", records[0])
+	}
+}
+
+func TestHistoryDecoder_ShouldReadJSONRowsInsideAnObject(t *testing.T) {
+	// given
+	data := `{"version": 1, "experiences": [{"start_time": "2024-03-14T07:30:00Z", "minutes": 20, "public": true, "tags": ["a"]}]}`
+
+	// when
+	records, err := NewHistoryDecoder().Decode("json", strings.NewReader(data))
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(records) != 1 || records[0]["minutes"] != "20" || records[0]["public"] != "true" {
+		t.Errorf("Expected numbers and booleans as text, got %v", records)
+	}
+	if _, ok := records[0]["tags"]; ok {
+		t.Errorf("Expected nested values to be left out, got %v", records[0])
+	}
+}
+
+func TestHistoryDecoder_ShouldRejectUnreadableFiles(t *testing.T) {
+	// given
+	decoder := NewHistoryDecoder()
+
+	// when
+	_, formatErr := decoder.Decode("xml", strings.NewReader("<sessions/>"))
+	_, jsonErr := decoder.Decode("json", strings.NewReader(`{"count": 2}`))
+	_, rowsErr := decoder.Decode("csv", strings.NewReader("start\n"+strings.Repeat("2024-03-14T07:30:00Z\n", domain.MaxImportRows+1)))
+
+	// then
+	if !errors.Is(formatErr, domain.ErrUnsupportedImportFormat) {
+		t.Errorf("Expected an unsupported format, got %v", formatErr)
+	}
+	if !errors.Is(jsonErr, domain.ErrInvalidImportFile) {
+		t.Errorf("Expected JSON without rows to be rejected, got %v", jsonErr)
+	}
+	if !errors.Is(rowsErr, domain.ErrTooManyImportRows) {
+		t.Errorf("Expected too many rows to be rejected, got %v", rowsErr)
+	}
+}
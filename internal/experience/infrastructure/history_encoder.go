@@ -0,0 +1,257 @@
+package infrastructure
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"zen-connect/internal/experience/application/usecase"
+	"zen-connect/internal/experience/domain"
+)
+
+// exportColumns are the columns of CSV and JSON exports. They include the
+// columns the zen_connect import preset reads, so an export can be imported
+// again.
+var exportColumns = []string{
+	"id", "start_time", "end_time", "duration_minutes", "meditation_type", "custom_type",
+	"note", "emotion_before", "emotion_after", "tags", "journal",
+}
+
+// HistoryEncoder writes experiences as CSV, JSON or iCalendar
+type HistoryEncoder struct{}
+
+// NewHistoryEncoder creates a history encoder
+func NewHistoryEncoder() *HistoryEncoder {
+	return &HistoryEncoder{}
+}
+
+// NewWriter starts an export in format on w. Output is buffered, so nothing
+// reaches w before the first few kilobytes or Close.
+func (e *HistoryEncoder) NewWriter(w io.Writer, format string, options usecase.ExportOptions) (usecase.HistoryWriter, error) {
+	buffered := bufio.NewWriter(w)
+	switch format {
+	case "csv":
+		return newCSVHistoryWriter(buffered, options)
+	case "json":
+		return newJSONHistoryWriter(buffered, options)
+	case "ics":
+		return newICSHistoryWriter(buffered, options)
+	}
+	return nil, domain.ErrUnsupportedExportFormat
+}
+
+// exportRow holds the values of exportColumns for one experience
+type exportRow struct {
+	ID              string   `json:"id"`
+	StartTime       string   `json:"start_time"`
+	EndTime         string   `json:"end_time"`
+	DurationMinutes float64  `json:"duration_minutes"`
+	MeditationType  string   `json:"meditation_type"`
+	CustomType      string   `json:"custom_type"`
+	Note            string   `json:"note"`
+	EmotionBefore   string   `json:"emotion_before"`
+	EmotionAfter    string   `json:"emotion_after"`
+	Tags            []string `json:"tags"`
+	Journal         string   `json:"journal"`
+}
+
+func newExportRow(experience *domain.Experience, location *time.Location) exportRow {
+	session := experience.Content().Session()
+	row := exportRow{
+		ID:              experience.ID(),
+		StartTime:       session.StartTime().In(location).Format(time.RFC3339),
+		EndTime:         session.EndTime().In(location).Format(time.RFC3339),
+		DurationMinutes: session.Duration().Round(time.Second).Minutes(),
+		MeditationType:  session.MeditationType(),
+		CustomType:      session.CustomType(),
+		Note:            session.Note(),
+		Tags:            experience.Journal().Tags(),
+		Journal:         experience.Journal().Entry(),
+	}
+	if emotionalState := experience.Content().EmotionalState(); emotionalState != nil {
+		row.EmotionBefore, row.EmotionAfter = emotionalState.Before(), emotionalState.After()
+	}
+	if row.Tags == nil {
+		row.Tags = []string{}
+	}
+	return row
+}
+
+type csvHistoryWriter struct {
+	buffered *bufio.Writer
+	writer   *csv.Writer
+	location *time.Location
+}
+
+func newCSVHistoryWriter(buffered *bufio.Writer, options usecase.ExportOptions) (*csvHistoryWriter, error) {
+	// The byte order mark lets spreadsheet apps read the file as UTF-8
+	if _, err := buffered.WriteString("\xef\xbb\xbf"); err != nil {
+		return nil, err
+	}
+	w := &csvHistoryWriter{buffered: buffered, writer: csv.NewWriter(buffered), location: options.Location}
+	if err := w.writer.Write(exportColumns); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *csvHistoryWriter) Write(experience *domain.Experience) error {
+	row := newExportRow(experience, w.location)
+	return w.writer.Write([]string{
+		row.ID, row.StartTime, row.EndTime, strconv.FormatFloat(row.DurationMinutes, 'f', -1, 64),
+		row.MeditationType, csvText(row.CustomType), csvText(row.Note), row.EmotionBefore, row.EmotionAfter,
+		// Tags cannot contain spaces
+		csvText(strings.Join(row.Tags, " ")), csvText(row.Journal),
+	})
+}
+
+// csvFormulaPrefixes start cells that spreadsheet apps evaluate as formulas
+const csvFormulaPrefixes = "=+-@\t\r"
+
+// csvText keeps user text that a spreadsheet would run as a formula as text,
+// by prefixing it with an apostrophe
+func csvText(s string) string {
+	if s != "" && strings.IndexByte(csvFormulaPrefixes, s[0]) >= 0 {
+		return "'" + s
+	}
+	return s
+}
+
+func (w *csvHistoryWriter) Close() error {
+	w.writer.Flush()
+	if err := w.writer.Error(); err != nil {
+		return err
+	}
+	return w.buffered.Flush()
+}
+
+type jsonHistoryWriter struct {
+	buffered *bufio.Writer
+	location *time.Location
+	rows     int
+}
+
+func newJSONHistoryWriter(buffered *bufio.Writer, options usecase.ExportOptions) (*jsonHistoryWriter, error) {
+	if _, err := buffered.WriteString(`{"experiences":[`); err != nil {
+		return nil, err
+	}
+	return &jsonHistoryWriter{buffered: buffered, location: options.Location}, nil
+}
+
+func (w *jsonHistoryWriter) Write(experience *domain.Experience) error {
+	data, err := json.Marshal(newExportRow(experience, w.location))
+	if err != nil {
+		return err
+	}
+	if w.rows > 0 {
+		w.buffered.WriteByte(',')
+	}
+	w.rows++
+	w.buffered.WriteByte('\n')
+	_, err = w.buffered.Write(data)
+	return err
+}
+
+func (w *jsonHistoryWriter) Close() error {
+	if _, err := w.buffered.WriteString("\n]}\n"); err != nil {
+		return err
+	}
+	return w.buffered.Flush()
+}
+
+// icsTimeLayout is the UTC date-time form of RFC 5545
+const icsTimeLayout = "20060102T150405Z"
+
+// icsLineLength is the most octets of a content line before it is folded
+const icsLineLength = 75
+
+type icsHistoryWriter struct {
+	buffered *bufio.Writer
+	language string
+	catalog  *domain.MeditationTypeCatalog
+	err      error
+}
+
+func newICSHistoryWriter(buffered *bufio.Writer, options usecase.ExportOptions) (*icsHistoryWriter, error) {
+	w := &icsHistoryWriter{buffered: buffered, language: options.Language, catalog: options.Catalog}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:-//Zen Connect//Meditation history//EN")
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	w.line("X-WR-CALNAME:Zen Connect")
+	return w, w.err
+}
+
+// Write adds the session as an event in UTC, named after its meditation type
+// and described by its note
+func (w *icsHistoryWriter) Write(experience *domain.Experience) error {
+	session := experience.Content().Session()
+	w.line("BEGIN:VEVENT")
+	w.line("UID:" + experience.ID() + "@zen-connect")
+	w.line("DTSTAMP:" + experience.UpdatedAt().UTC().Format(icsTimeLayout))
+	w.line("DTSTART:" + session.StartTime().UTC().Format(icsTimeLayout))
+	w.line("DTEND:" + session.EndTime().UTC().Format(icsTimeLayout))
+	w.line("SUMMARY:" + escapeICSText(w.typeName(session)))
+	if session.Note() != "" {
+		w.line("DESCRIPTION:" + escapeICSText(session.Note()))
+	}
+	w.line("TRANSP:TRANSPARENT")
+	w.line("END:VEVENT")
+	return w.err
+}
+
+func (w *icsHistoryWriter) Close() error {
+	w.line("END:VCALENDAR")
+	if w.err != nil {
+		return w.err
+	}
+	return w.buffered.Flush()
+}
+
+// typeName is the free text of "other" sessions, or the catalog name
+func (w *icsHistoryWriter) typeName(session *domain.MeditationSession) string {
+	if session.CustomType() != "" {
+		return session.CustomType()
+	}
+	if meditationType, ok := w.catalog.Find(session.MeditationType()); ok {
+		return meditationType.Names().In(w.language)
+	}
+	return session.MeditationType()
+}
+
+// line writes a content line, folded after 75 octets without splitting a
+// UTF-8 character and ended with CRLF
+func (w *icsHistoryWriter) line(content string) {
+	if w.err != nil {
+		return
+	}
+	var folded strings.Builder
+	limit := icsLineLength
+	for len(content) > limit {
+		cut := limit
+		for !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		folded.WriteString(content[:cut])
+		folded.WriteString("\r\n ")
+		content = content[cut:]
+		// Continuation lines begin with a space, which counts towards their length
+		limit = icsLineLength - 1
+	}
+	folded.WriteString(content)
+	folded.WriteString("\r\n")
+	_, w.err = w.buffered.WriteString(folded.String())
+}
+
+var icsTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// escapeICSText escapes a TEXT value of RFC 5545
+func escapeICSText(text string) string {
+	return icsTextEscaper.Replace(text)
+}
@@ -0,0 +1,191 @@
+package infrastructure
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"zen-connect/internal/experience/application/usecase"
+	"zen-connect/internal/experience/domain"
+)
+
+func newExportCatalog(t *testing.T) *domain.MeditationTypeCatalog {
+	t.Helper()
+	zazen, err := domain.NewMeditationType("zazen", domain.LocalizedText{domain.LanguageJapanese: "座禅", domain.LanguageEnglish: "Zazen"}, nil, 20*time.Minute, nil, 0)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	other, err := domain.NewMeditationType(domain.OtherMeditationTypeID, domain.LocalizedText{domain.LanguageJapanese: "その他", domain.LanguageEnglish: "Other"}, nil, 10*time.Minute, nil, 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return domain.NewMeditationTypeCatalog([]*domain.MeditationType{zazen, other})
+}
+
+func newExportedExperiences() []*domain.Experience {
+	start := time.Date(2024, 3, 13, 22, 30, 0, 0, time.UTC)
+	recorded := domain.NewExperienceContent(
+		domain.ReconstructMeditationSession(start, start.Add(20*time.Minute), "zazen", "", "静かな朝, 鳥の声;\n窓を開けて"),
+		domain.NewEmotionalState("不安", "穏やか"), start, start)
+	draft := domain.NewExperienceContent(
+		domain.ReconstructMeditationSession(start.Add(24*time.Hour), start.Add(24*time.Hour+90*time.Second), domain.OtherMeditationTypeID, "Yoga nidra", ""),
+		nil, start, start)
+	return []*domain.Experience{
+		domain.FromSnapshot("exp-1", "user-1", recorded, domain.ReconstructJournal("# 振り返り", []string{"morning", "calm"}), false, time.Time{}, start, start),
+		domain.FromSnapshot("exp-2", "user-1", draft, domain.ReconstructJournal("", nil), false, time.Time{}, start, start),
+	}
+}
+
+func export(t *testing.T, format string, options usecase.ExportOptions) string {
+	t.Helper()
+	var out bytes.Buffer
+	writer, err := NewHistoryEncoder().NewWriter(&out, format, options)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for _, experience := range newExportedExperiences() {
+		if err := writer.Write(experience); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return out.String()
+}
+
+func TestHistoryEncoder_ShouldWriteExportsTheImportPresetReads(t *testing.T) {
+	// given
+	catalog := newExportCatalog(t)
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	mapping, _ := domain.ImportPreset("zen_connect")
+
+	for _, format := range []string{"csv", "json"} {
+		// when
+		data := export(t, format, usecase.ExportOptions{Location: tokyo, Language: domain.LanguageJapanese, Catalog: catalog})
+		records, err := NewHistoryDecoder().Decode(format, strings.NewReader(data))
+
+		// then
+		if err != nil || len(records) != 2 {
+			t.Fatalf("Expected the %s export to be read back, got %d rows and %v", format, len(records), err)
+		}
+		if records[0]["start_time"] != "2024-03-14T07:30:00+09:00" {
+			t.Errorf("Expected %s times in Asia/Tokyo, got %s", format, records[0]["start_time"])
+		}
+		session, err := mapping.Session(records[0], time.UTC, catalog)
+		if err != nil || session.Note() != "静かな朝, 鳥の声;\n窓を開けて" || session.Duration() != 20*time.Minute {
+			t.Errorf("Expected the %s session to be imported unchanged, got %v", format, err)
+		}
+		if emotionalState, _ := mapping.EmotionalState(records[0]); emotionalState == nil || emotionalState.After() != "穏やか" {
+			t.Errorf("Expected the %s emotions to be imported, got %+v", format, emotionalState)
+		}
+		draft, err := mapping.Session(records[1], time.UTC, catalog)
+		if err != nil || draft.MeditationType() != domain.OtherMeditationTypeID || draft.CustomType() != "Yoga nidra" {
+			t.Errorf("Expected the %s free-text type to be kept, got %v", format, err)
+		}
+	}
+
+	csvData := export(t, "csv", usecase.ExportOptions{Location: time.UTC, Catalog: catalog})
+	if !strings.Contains(csvData, ",morning calm,") || !strings.Contains(csvData, ",1.5,") {
+		t.Errorf("Expected tags separated by spaces and the length in minutes, got %s", csvData)
+	}
+}
+
+func TestHistoryEncoder_ShouldKeepFormulasInCSVCellsAsText(t *testing.T) {
+	// given
+	start := time.Date(2024, 3, 13, 22, 30, 0, 0, time.UTC)
+	content := domain.NewExperienceContent(
+		domain.ReconstructMeditationSession(start, start.Add(20*time.Minute), domain.OtherMeditationTypeID, "@SUM(A1)", "=HYPERLINK(\"https://example.com\")"),
+		nil, start, start)
+	experience := domain.FromSnapshot("exp-1", "user-1", content, domain.ReconstructJournal("\t-1+1", []string{"+calm"}), false, time.Time{}, start, start)
+	options := usecase.ExportOptions{Location: time.UTC, Catalog: newExportCatalog(t)}
+
+	for _, format := range []string{"csv", "json"} {
+		// when
+		var out bytes.Buffer
+		writer, _ := NewHistoryEncoder().NewWriter(&out, format, options)
+		writer.Write(experience)
+		writer.Close()
+		records, err := NewHistoryDecoder().Decode(format, strings.NewReader(out.String()))
+
+		// then
+		if err != nil || len(records) != 1 {
+			t.Fatalf("Expected the %s export to be read back, got %d rows and %v", format, len(records), err)
+		}
+		prefix := ""
+		if format == "csv" {
+			prefix = "'"
+		}
+		for column, value := range map[string]string{
+			"custom_type": "@SUM(A1)",
+			"note":        "=HYPERLINK(\"https://example.com\")",
+			"journal":     "\t-1+1",
+		} {
+			if records[0][column] != prefix+value {
+				t.Errorf("Expected the %s %s to be %q, got %q", format, column, prefix+value, records[0][column])
+			}
+		}
+	}
+}
+
+func TestHistoryEncoder_ShouldWriteSessionsAsCalendarEvents(t *testing.T) {
+	// given
+	options := usecase.ExportOptions{Location: time.UTC, Language: domain.LanguageEnglish, Catalog: newExportCatalog(t)}
+
+	// when
+	data := export(t, "ics", options)
+
+	// then
+	lines := strings.Split(strings.TrimSuffix(data, "\r\n"), "\r\n")
+	if lines[0] != "BEGIN:VCALENDAR" || lines[len(lines)-1] != "END:VCALENDAR" || strings.Count(data, "BEGIN:VEVENT") != 2 {
+		t.Fatalf("Expected a calendar with 2 events, got %q", data)
+	}
+	for _, want := range []string{"UID:exp-1@zen-connect", "DTSTART:20240313T223000Z", "DTEND:20240313T225000Z", "SUMMARY:Zazen", "SUMMARY:Yoga nidra"} {
+		if !strings.Contains(data, want+"\r\n") {
+			t.Errorf("Expected %s, got %q", want, data)
+		}
+	}
+	if !strings.Contains(data, `DESCRIPTION:静かな朝\, 鳥の声\;\n窓を開けて`) {
+		t.Errorf("Expected the note escaped as description, got %q", data)
+	}
+	if strings.Count(data, "DESCRIPTION:") != 1 {
+		t.Errorf("Expected no description for a session without note, got %q", data)
+	}
+	for _, line := range lines {
+		if len(line) > 75 {
+			t.Errorf("Expected lines folded at 75 octets, got %d: %q", len(line), line)
+		}
+	}
+}
+
+func TestHistoryEncoder_ShouldFoldLongLinesBetweenCharacters(t *testing.T) {
+	// given
+	var out bytes.Buffer
+	writer := &icsHistoryWriter{buffered: bufio.NewWriter(&out)}
+	content := "DESCRIPTION:" + strings.Repeat("静", 60)
+
+	// when
+	writer.line(content)
+	writer.buffered.Flush()
+
+	// then
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\r\n"), "\r\n")
+	var unfolded strings.Builder
+	for i, line := range lines {
+		if len(line) > 75 {
+			t.Errorf("Expected at most 75 octets, got %d", len(line))
+		}
+		if i > 0 {
+			if !strings.HasPrefix(line, " ") {
+				t.Fatalf("Expected continuation lines to start with a space, got %q", line)
+			}
+			line = line[1:]
+		}
+		unfolded.WriteString(line)
+	}
+	if unfolded.String() != content {
+		t.Errorf("Expected unfolding to give the line back, got %q", unfolded.String())
+	}
+}
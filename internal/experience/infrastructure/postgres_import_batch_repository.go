@@ -0,0 +1,72 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"zen-connect/internal/experience/domain"
+)
+
+// PostgresImportBatchRepository implements ImportBatchRepository interface
+type PostgresImportBatchRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresImportBatchRepository creates a new PostgreSQL import batch repository
+func NewPostgresImportBatchRepository(pool *pgxpool.Pool) *PostgresImportBatchRepository {
+	return &PostgresImportBatchRepository{
+		pool: pool,
+	}
+}
+
+// insertImportBatch stores a pending batch, within the import's transaction
+func insertImportBatch(ctx context.Context, db execer, batch *domain.ImportBatch) error {
+	query := `
+		INSERT INTO experience_import_batches (id, user_id, experience_ids, imported_at)
+		VALUES ($1, $2, $3, $4)
+	`
+	_, err := db.Exec(ctx, query, batch.ID(), batch.UserID(), batch.ExperienceIDs(), batch.ImportedAt())
+	return err
+}
+
+// FindPending returns batches not processed yet, oldest first
+func (r *PostgresImportBatchRepository) FindPending(ctx context.Context, limit int) ([]*domain.ImportBatch, error) {
+	query := `
+		SELECT id, user_id, experience_ids, imported_at
+		FROM experience_import_batches
+		WHERE processed_at IS NULL
+		ORDER BY imported_at
+		LIMIT $1
+	`
+	rows, err := r.pool.Query(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var batches []*domain.ImportBatch
+	for rows.Next() {
+		var id, userID string
+		var experienceIDs []string
+		var importedAt time.Time
+		if err := rows.Scan(&id, &userID, &experienceIDs, &importedAt); err != nil {
+			return nil, err
+		}
+		batches = append(batches, domain.ReconstructImportBatch(id, userID, experienceIDs, importedAt, time.Time{}))
+	}
+	return batches, rows.Err()
+}
+
+// MarkProcessed sets processed_at unless another instance already did
+func (r *PostgresImportBatchRepository) MarkProcessed(ctx context.Context, batch *domain.ImportBatch) (bool, error) {
+	query := `
+		UPDATE experience_import_batches SET processed_at = $2
+		WHERE id = $1 AND processed_at IS NULL
+	`
+	tag, err := r.pool.Exec(ctx, query, batch.ID(), batch.ProcessedAt())
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
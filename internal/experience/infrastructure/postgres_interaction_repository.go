@@ -0,0 +1,202 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"zen-connect/internal/experience/domain"
+)
+
+// PostgresReactionRepository implements ReactionRepository interface
+type PostgresReactionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresReactionRepository creates a new PostgreSQL reaction repository
+func NewPostgresReactionRepository(pool *pgxpool.Pool) *PostgresReactionRepository {
+	return &PostgresReactionRepository{
+		pool: pool,
+	}
+}
+
+// Add stores a reaction unless the user has already reacted with the same kind
+func (r *PostgresReactionRepository) Add(ctx context.Context, reaction *domain.Reaction) (bool, error) {
+	query := `
+		INSERT INTO experience_reactions (experience_id, user_id, kind, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (experience_id, user_id, kind) DO NOTHING
+	`
+	tag, err := r.pool.Exec(ctx, query,
+		reaction.ExperienceID(),
+		reaction.UserID(),
+		string(reaction.Kind()),
+		reaction.CreatedAt(),
+	)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// Remove deletes a reaction; removing one that does not exist is not an error
+func (r *PostgresReactionRepository) Remove(ctx context.Context, experienceID, userID string, kind domain.ReactionKind) error {
+	query := `DELETE FROM experience_reactions WHERE experience_id = $1 AND user_id = $2 AND kind = $3`
+	_, err := r.pool.Exec(ctx, query, experienceID, userID, string(kind))
+	return err
+}
+
+// Summarize counts the reactions of each kind and whether the viewer used it
+func (r *PostgresReactionRepository) Summarize(ctx context.Context, experienceID, viewerID string) (*domain.ReactionSummary, error) {
+	query := `
+		SELECT kind, COUNT(*), BOOL_OR(user_id = $2)
+		FROM experience_reactions
+		WHERE experience_id = $1
+		GROUP BY kind
+	`
+	rows, err := r.pool.Query(ctx, query, experienceID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	summary := &domain.ReactionSummary{Counts: map[domain.ReactionKind]int{}}
+	for rows.Next() {
+		var kind string
+		var count int
+		var mine bool
+		if err := rows.Scan(&kind, &count, &mine); err != nil {
+			return nil, err
+		}
+		summary.Counts[domain.ReactionKind(kind)] = count
+		if mine {
+			summary.Mine = append(summary.Mine, domain.ReactionKind(kind))
+		}
+	}
+	return summary, rows.Err()
+}
+
+// PostgresCommentRepository implements CommentRepository interface
+type PostgresCommentRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresCommentRepository creates a new PostgreSQL comment repository
+func NewPostgresCommentRepository(pool *pgxpool.Pool) *PostgresCommentRepository {
+	return &PostgresCommentRepository{
+		pool: pool,
+	}
+}
+
+const commentColumns = `
+	id, experience_id, author_id, author_name, parent_id, depth, body,
+	created_at, updated_at, edited_at, deleted_at, hidden_at
+`
+
+// Save upserts a comment; only the body and its timestamps can change
+func (r *PostgresCommentRepository) Save(ctx context.Context, comment *domain.Comment) error {
+	query := `
+		INSERT INTO experience_comments (` + commentColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (id) DO UPDATE SET
+			body = EXCLUDED.body,
+			edited_at = EXCLUDED.edited_at,
+			deleted_at = EXCLUDED.deleted_at,
+			hidden_at = EXCLUDED.hidden_at
+	`
+	_, err := r.pool.Exec(ctx, query,
+		comment.ID(),
+		comment.ExperienceID(),
+		comment.AuthorID(),
+		comment.AuthorName(),
+		nullString(comment.ParentID()),
+		comment.Depth(),
+		comment.Body(),
+		comment.CreatedAt(),
+		comment.UpdatedAt(),
+		nullTime(comment.EditedAt()),
+		nullTime(comment.DeletedAt()),
+		nullTime(comment.HiddenAt()),
+	)
+	return err
+}
+
+// FindByID finds a comment by ID
+func (r *PostgresCommentRepository) FindByID(ctx context.Context, id string) (*domain.Comment, error) {
+	comment, err := scanComment(r.pool.QueryRow(ctx, `SELECT `+commentColumns+` FROM experience_comments WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrCommentNotFound
+		}
+		return nil, err
+	}
+	return comment, nil
+}
+
+// FindByExperienceID returns the comments of an experience, oldest first
+func (r *PostgresCommentRepository) FindByExperienceID(ctx context.Context, experienceID string) ([]*domain.Comment, error) {
+	query := `
+		SELECT ` + commentColumns + ` FROM experience_comments
+		WHERE experience_id = $1
+		ORDER BY created_at, id
+	`
+	rows, err := r.pool.Query(ctx, query, experienceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var comments []*domain.Comment
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, comment)
+	}
+	return comments, rows.Err()
+}
+
+// scanComment reconstructs a comment from a result row
+func scanComment(row pgx.Row) (*domain.Comment, error) {
+	var id, experienceID, authorID, authorName, body string
+	var parentID *string
+	var depth int
+	var createdAt, updatedAt time.Time
+	var editedAt, deletedAt, hiddenAt *time.Time
+
+	err := row.Scan(
+		&id,
+		&experienceID,
+		&authorID,
+		&authorName,
+		&parentID,
+		&depth,
+		&body,
+		&createdAt,
+		&updatedAt,
+		&editedAt,
+		&deletedAt,
+		&hiddenAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstructComment(
+		id,
+		experienceID,
+		authorID,
+		authorName,
+		stringValue(parentID),
+		depth,
+		body,
+		createdAt,
+		updatedAt,
+		timeValue(editedAt),
+		timeValue(deletedAt),
+		timeValue(hiddenAt),
+	), nil
+}
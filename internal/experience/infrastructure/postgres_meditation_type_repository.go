@@ -0,0 +1,157 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"zen-connect/internal/experience/domain"
+)
+
+// uniqueViolation is the PostgreSQL error code for duplicate keys
+const uniqueViolation = "23505"
+
+// PostgresMeditationTypeRepository implements MeditationTypeRepository interface
+type PostgresMeditationTypeRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresMeditationTypeRepository creates a new PostgreSQL meditation type repository
+func NewPostgresMeditationTypeRepository(pool *pgxpool.Pool) *PostgresMeditationTypeRepository {
+	return &PostgresMeditationTypeRepository{
+		pool: pool,
+	}
+}
+
+const meditationTypeColumns = `
+	id, names, descriptions, recommended_duration_seconds, categories,
+	sort_order, is_active, created_at, updated_at
+`
+
+// FindAll returns every catalog entry, including retired ones
+func (r *PostgresMeditationTypeRepository) FindAll(ctx context.Context) ([]*domain.MeditationType, error) {
+	rows, err := r.pool.Query(ctx, `SELECT `+meditationTypeColumns+` FROM meditation_types ORDER BY sort_order, id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var types []*domain.MeditationType
+	for rows.Next() {
+		mt, err := scanMeditationType(rows)
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, mt)
+	}
+	return types, rows.Err()
+}
+
+// FindByID finds a catalog entry by ID
+func (r *PostgresMeditationTypeRepository) FindByID(ctx context.Context, id string) (*domain.MeditationType, error) {
+	mt, err := scanMeditationType(r.pool.QueryRow(ctx, `SELECT `+meditationTypeColumns+` FROM meditation_types WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrMeditationTypeNotFound
+		}
+		return nil, err
+	}
+	return mt, nil
+}
+
+// Create inserts a new catalog entry
+func (r *PostgresMeditationTypeRepository) Create(ctx context.Context, mt *domain.MeditationType) error {
+	query := `
+		INSERT INTO meditation_types (` + meditationTypeColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	_, err := r.pool.Exec(ctx, query,
+		mt.ID(),
+		mt.Names(),
+		mt.Descriptions(),
+		int64(mt.RecommendedDuration().Seconds()),
+		mt.Categories(),
+		mt.SortOrder(),
+		mt.IsActive(),
+		mt.CreatedAt(),
+		mt.UpdatedAt(),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return domain.ErrMeditationTypeAlreadyExists
+	}
+	return err
+}
+
+// Update saves the editable attributes of an existing entry
+func (r *PostgresMeditationTypeRepository) Update(ctx context.Context, mt *domain.MeditationType) error {
+	query := `
+		UPDATE meditation_types SET
+			names = $2,
+			descriptions = $3,
+			recommended_duration_seconds = $4,
+			categories = $5,
+			sort_order = $6,
+			is_active = $7,
+			updated_at = $8
+		WHERE id = $1
+	`
+	tag, err := r.pool.Exec(ctx, query,
+		mt.ID(),
+		mt.Names(),
+		mt.Descriptions(),
+		int64(mt.RecommendedDuration().Seconds()),
+		mt.Categories(),
+		mt.SortOrder(),
+		mt.IsActive(),
+		mt.UpdatedAt(),
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrMeditationTypeNotFound
+	}
+	return nil
+}
+
+// scanMeditationType reconstructs a catalog entry from a result row
+func scanMeditationType(row pgx.Row) (*domain.MeditationType, error) {
+	var id string
+	var names, descriptions map[string]string
+	var recommendedSeconds int64
+	var categories []string
+	var sortOrder int
+	var active bool
+	var createdAt, updatedAt time.Time
+
+	err := row.Scan(
+		&id,
+		&names,
+		&descriptions,
+		&recommendedSeconds,
+		&categories,
+		&sortOrder,
+		&active,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstructMeditationType(
+		id,
+		domain.LocalizedText(names),
+		domain.LocalizedText(descriptions),
+		time.Duration(recommendedSeconds)*time.Second,
+		categories,
+		sortOrder,
+		active,
+		createdAt,
+		updatedAt,
+	), nil
+}
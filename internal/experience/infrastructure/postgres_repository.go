@@ -0,0 +1,470 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"zen-connect/internal/experience/domain"
+)
+
+// PostgresExperienceRepository implements ExperienceRepository interface
+type PostgresExperienceRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresExperienceRepository creates a new PostgreSQL experience repository
+func NewPostgresExperienceRepository(pool *pgxpool.Pool) *PostgresExperienceRepository {
+	return &PostgresExperienceRepository{
+		pool: pool,
+	}
+}
+
+// execer is satisfied by both the pool and a transaction
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
+// querier is satisfied by both the pool and a transaction
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// Save saves an experience to the database
+func (r *PostgresExperienceRepository) Save(ctx context.Context, experience *domain.Experience) error {
+	return saveExperience(ctx, r.pool, experience)
+}
+
+// saveExperience upserts an experience; a draft is stored without emotional state
+func saveExperience(ctx context.Context, db execer, experience *domain.Experience) error {
+	query := `
+		INSERT INTO experiences (
+			id, user_id, start_time, end_time, meditation_type, custom_meditation_type, note,
+			emotion_before, emotion_after, journal, tags, is_public, hidden_at, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		ON CONFLICT (id) DO UPDATE SET
+			start_time = EXCLUDED.start_time,
+			end_time = EXCLUDED.end_time,
+			meditation_type = EXCLUDED.meditation_type,
+			custom_meditation_type = EXCLUDED.custom_meditation_type,
+			note = EXCLUDED.note,
+			emotion_before = EXCLUDED.emotion_before,
+			emotion_after = EXCLUDED.emotion_after,
+			journal = EXCLUDED.journal,
+			tags = EXCLUDED.tags,
+			is_public = EXCLUDED.is_public,
+			hidden_at = EXCLUDED.hidden_at,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	session := experience.Content().Session()
+	var emotionBefore, emotionAfter *string
+	if emotionalState := experience.Content().EmotionalState(); emotionalState != nil {
+		before, after := emotionalState.Before(), emotionalState.After()
+		emotionBefore, emotionAfter = &before, &after
+	}
+
+	_, err := db.Exec(ctx, query,
+		experience.ID(),
+		experience.UserID(),
+		session.StartTime(),
+		session.EndTime(),
+		session.MeditationType(),
+		session.CustomType(),
+		session.Note(),
+		emotionBefore,
+		emotionAfter,
+		experience.Journal().Entry(),
+		experience.Journal().Tags(),
+		experience.IsPublic(),
+		nullTime(experience.HiddenAt()),
+		experience.CreatedAt(),
+		experience.UpdatedAt(),
+	)
+
+	return err
+}
+
+const experienceColumns = `
+	id, user_id, start_time, end_time, meditation_type, custom_meditation_type, note,
+	emotion_before, emotion_after, journal, tags, is_public, hidden_at, created_at, updated_at
+`
+
+// FindByID finds an experience by ID
+func (r *PostgresExperienceRepository) FindByID(ctx context.Context, id string) (*domain.Experience, error) {
+	query := `SELECT ` + experienceColumns + ` FROM experiences WHERE id = $1`
+
+	experience, err := scanExperience(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrExperienceNotFound
+		}
+		return nil, err
+	}
+
+	return experience, nil
+}
+
+// Search finds a user's experiences by free text, tags, date range and meditation type
+func (r *PostgresExperienceRepository) Search(ctx context.Context, criteria *domain.ExperienceSearchCriteria) ([]*domain.Experience, error) {
+	conditions := []string{"user_id = $1"}
+	args := []any{criteria.UserID}
+	where := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	for _, term := range criteria.Terms {
+		// Substring match, served by the pg_bigm / pg_trgm index on search_text
+		where("search_text LIKE '%%' || $%d || '%%'", escapeLike(term))
+	}
+	if len(criteria.Tags) > 0 {
+		where("tags @> $%d", criteria.Tags)
+	}
+	if !criteria.From.IsZero() {
+		where("start_time >= $%d", criteria.From)
+	}
+	if !criteria.To.IsZero() {
+		where("start_time < $%d", criteria.To)
+	}
+	if criteria.MeditationType != "" {
+		where("meditation_type = $%d", criteria.MeditationType)
+	}
+
+	args = append(args, criteria.Limit, criteria.Offset)
+	query := fmt.Sprintf(`
+		SELECT %s FROM experiences
+		WHERE %s
+		ORDER BY start_time DESC, id
+		LIMIT $%d OFFSET $%d
+	`, experienceColumns, strings.Join(conditions, " AND "), len(args)-1, len(args))
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var experiences []*domain.Experience
+	for rows.Next() {
+		experience, err := scanExperience(rows)
+		if err != nil {
+			return nil, err
+		}
+		experiences = append(experiences, experience)
+	}
+	return experiences, rows.Err()
+}
+
+// TagCounts returns the tags a user has used with their number of experiences
+func (r *PostgresExperienceRepository) TagCounts(ctx context.Context, userID string) ([]domain.TagCount, error) {
+	query := `
+		SELECT tag, COUNT(*) FROM experiences, unnest(tags) AS tag
+		WHERE user_id = $1
+		GROUP BY tag
+		ORDER BY COUNT(*) DESC, tag
+	`
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var counts []domain.TagCount
+	for rows.Next() {
+		var count domain.TagCount
+		if err := rows.Scan(&count.Tag, &count.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, count)
+	}
+	return counts, rows.Err()
+}
+
+// SummarizePractice totals each user's sessions started in [from, to),
+// counting the calendar days in location
+func (r *PostgresExperienceRepository) SummarizePractice(ctx context.Context, from, to time.Time, location *time.Location) ([]domain.PracticeSummary, error) {
+	query := `
+		SELECT
+			user_id,
+			COUNT(*),
+			SUM(EXTRACT(EPOCH FROM end_time - start_time))::bigint,
+			MAX(EXTRACT(EPOCH FROM end_time - start_time))::bigint,
+			COUNT(DISTINCT (start_time AT TIME ZONE $3)::date)
+		FROM experiences
+		WHERE start_time >= $1 AND start_time < $2
+		GROUP BY user_id
+		ORDER BY user_id
+	`
+	rows, err := r.pool.Query(ctx, query, from, to, location.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var summaries []domain.PracticeSummary
+	for rows.Next() {
+		var summary domain.PracticeSummary
+		var totalSeconds, longestSeconds int64
+		if err := rows.Scan(&summary.UserID, &summary.Sessions, &totalSeconds, &longestSeconds, &summary.Days); err != nil {
+			return nil, err
+		}
+		summary.TotalDuration = time.Duration(totalSeconds) * time.Second
+		summary.LongestDuration = time.Duration(longestSeconds) * time.Second
+		summaries = append(summaries, summary)
+	}
+	return summaries, rows.Err()
+}
+
+// HasPracticed reports whether the user has a session started in [from, to)
+func (r *PostgresExperienceRepository) HasPracticed(ctx context.Context, userID string, from, to time.Time) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM experiences
+			WHERE user_id = $1 AND start_time >= $2 AND start_time < $3
+		)
+	`
+	var practiced bool
+	err := r.pool.QueryRow(ctx, query, userID, from, to).Scan(&practiced)
+	return practiced, err
+}
+
+// SessionStarts returns the start times of the user's sessions started in [from, to)
+func (r *PostgresExperienceRepository) SessionStarts(ctx context.Context, userID string, from, to time.Time) ([]time.Time, error) {
+	return sessionStarts(ctx, r.pool, userID, from, to)
+}
+
+func sessionStarts(ctx context.Context, db querier, userID string, from, to time.Time) ([]time.Time, error) {
+	query := `
+		SELECT start_time FROM experiences
+		WHERE user_id = $1 AND start_time >= $2 AND start_time < $3
+	`
+	rows, err := db.Query(ctx, query, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var starts []time.Time
+	for rows.Next() {
+		var start time.Time
+		if err := rows.Scan(&start); err != nil {
+			return nil, err
+		}
+		starts = append(starts, start)
+	}
+	return starts, rows.Err()
+}
+
+// PracticeTotals totals all of the user's sessions. Streaks are the runs of
+// consecutive days in location, found by subtracting each day's rank from the day.
+func (r *PostgresExperienceRepository) PracticeTotals(ctx context.Context, userID string, location *time.Location) (domain.PracticeTotals, error) {
+	query := `
+		WITH days AS (
+			SELECT DISTINCT (start_time AT TIME ZONE $2)::date AS day
+			FROM experiences
+			WHERE user_id = $1
+		), streaks AS (
+			SELECT COUNT(*) AS days
+			FROM (SELECT day - (ROW_NUMBER() OVER (ORDER BY day))::int AS run FROM days) runs
+			GROUP BY run
+		)
+		SELECT
+			COUNT(*),
+			COALESCE(SUM(EXTRACT(EPOCH FROM end_time - start_time)), 0)::bigint,
+			COUNT(DISTINCT NULLIF(meditation_type, '')),
+			(SELECT COALESCE(MAX(days), 0) FROM streaks)
+		FROM experiences
+		WHERE user_id = $1
+	`
+	var totals domain.PracticeTotals
+	var totalSeconds int64
+	err := r.pool.QueryRow(ctx, query, userID, location.String()).Scan(
+		&totals.Sessions, &totalSeconds, &totals.MeditationTypes, &totals.LongestStreakDays)
+	if err != nil {
+		return domain.PracticeTotals{}, err
+	}
+	totals.TotalDuration = time.Duration(totalSeconds) * time.Second
+	return totals, nil
+}
+
+// Practitioners returns every user with an experience, in the order they first meditated
+func (r *PostgresExperienceRepository) Practitioners(ctx context.Context) ([]string, error) {
+	query := `
+		SELECT user_id FROM experiences
+		GROUP BY user_id
+		ORDER BY MIN(start_time), user_id
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, rows.Err()
+}
+
+// Import inserts imported experiences and their pending import batch in one
+// transaction. Imports of the same user are serialized with an advisory lock
+// so that two concurrent imports of the same file cannot both insert a session.
+func (r *PostgresExperienceRepository) Import(ctx context.Context, userID string, experiences []*domain.Experience) ([]*domain.Experience, error) {
+	if len(experiences) == 0 {
+		return nil, nil
+	}
+
+	var imported []*domain.Experience
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('experience_import:' || $1))`, userID); err != nil {
+			return err
+		}
+
+		from, to := experiences[0].Content().Session().StartTime(), experiences[0].Content().Session().StartTime()
+		for _, experience := range experiences {
+			start := experience.Content().Session().StartTime()
+			if start.Before(from) {
+				from = start
+			}
+			if start.After(to) {
+				to = start
+			}
+		}
+		existing, err := sessionStarts(ctx, tx, userID, from.Truncate(time.Minute), to.Truncate(time.Minute).Add(time.Minute))
+		if err != nil {
+			return err
+		}
+		seen := make(map[int64]bool, len(existing)+len(experiences))
+		for _, start := range existing {
+			seen[domain.ImportDuplicateKey(start)] = true
+		}
+
+		for _, experience := range experiences {
+			key := domain.ImportDuplicateKey(experience.Content().Session().StartTime())
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			if err := saveExperience(ctx, tx, experience); err != nil {
+				return fmt.Errorf("failed to import experience: %w", err)
+			}
+			imported = append(imported, experience)
+		}
+
+		if len(imported) == 0 {
+			return nil
+		}
+		experienceIDs := make([]string, 0, len(imported))
+		for _, experience := range imported {
+			experienceIDs = append(experienceIDs, experience.ID())
+		}
+		if err := insertImportBatch(ctx, tx, domain.NewImportBatch(userID, experienceIDs, time.Now())); err != nil {
+			return fmt.Errorf("failed to save import batch: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return imported, nil
+}
+
+// escapeLike escapes the LIKE wildcards in a search term
+// Export streams a user's experiences in a start time range, oldest first,
+// without holding the whole history in memory
+func (r *PostgresExperienceRepository) Export(ctx context.Context, userID string, from, to time.Time, each func(*domain.Experience) error) error {
+	var conditions []string
+	var args []any
+	if userID != "" {
+		args = append(args, userID)
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", len(args)))
+	}
+	if !from.IsZero() {
+		args = append(args, from)
+		conditions = append(conditions, fmt.Sprintf("start_time >= $%d", len(args)))
+	}
+	if !to.IsZero() {
+		args = append(args, to)
+		conditions = append(conditions, fmt.Sprintf("start_time < $%d", len(args)))
+	}
+	query := `SELECT ` + experienceColumns + ` FROM experiences`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY start_time, id`
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		experience, err := scanExperience(rows)
+		if err != nil {
+			return err
+		}
+		if err := each(experience); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func escapeLike(term string) string {
+	return likeEscaper.Replace(term)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// scanExperience reconstructs an experience from a result row
+func scanExperience(row pgx.Row) (*domain.Experience, error) {
+	var id, userID, meditationType, customMeditationType, note, journal string
+	var tags []string
+	var emotionBefore, emotionAfter *string
+	var startTime, endTime, createdAt, updatedAt time.Time
+	var isPublic bool
+	var hiddenAt *time.Time
+
+	err := row.Scan(
+		&id,
+		&userID,
+		&startTime,
+		&endTime,
+		&meditationType,
+		&customMeditationType,
+		&note,
+		&emotionBefore,
+		&emotionAfter,
+		&journal,
+		&tags,
+		&isPublic,
+		&hiddenAt,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	session := domain.ReconstructMeditationSession(startTime, endTime, meditationType, customMeditationType, note)
+	var emotionalState *domain.EmotionalState
+	if emotionBefore != nil && emotionAfter != nil {
+		emotionalState = domain.NewEmotionalState(*emotionBefore, *emotionAfter)
+	}
+	content := domain.NewExperienceContent(session, emotionalState, createdAt, updatedAt)
+
+	return domain.FromSnapshot(id, userID, content, domain.ReconstructJournal(journal, tags), isPublic, timeValue(hiddenAt), createdAt, updatedAt), nil
+}
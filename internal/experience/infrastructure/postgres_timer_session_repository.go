@@ -0,0 +1,286 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"zen-connect/internal/experience/domain"
+)
+
+// PostgresTimerSessionRepository implements TimerSessionRepository interface
+type PostgresTimerSessionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresTimerSessionRepository creates a new PostgreSQL timer session repository
+func NewPostgresTimerSessionRepository(pool *pgxpool.Pool) *PostgresTimerSessionRepository {
+	return &PostgresTimerSessionRepository{
+		pool: pool,
+	}
+}
+
+const timerSessionColumns = `
+	id, user_id, meditation_type, custom_meditation_type, status, started_at,
+	interruptions, last_activity_at, finished_at, finish_reason, experience_id, version
+`
+
+// interruptionRecord is the JSON form of an interruption
+type interruptionRecord struct {
+	PausedAt  time.Time  `json:"paused_at"`
+	ResumedAt *time.Time `json:"resumed_at,omitempty"`
+}
+
+// Create inserts a new timer
+func (r *PostgresTimerSessionRepository) Create(ctx context.Context, timer *domain.TimerSession) error {
+	interruptions, err := marshalInterruptions(timer.Interruptions())
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO timer_sessions (` + timerSessionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+	_, err = r.pool.Exec(ctx, query,
+		timer.ID(),
+		timer.UserID(),
+		timer.MeditationType(),
+		timer.CustomType(),
+		string(timer.Status()),
+		timer.StartedAt(),
+		interruptions,
+		timer.LastActivityAt(),
+		nullTime(timer.FinishedAt()),
+		nullString(string(timer.FinishReason())),
+		nullString(timer.ExperienceID()),
+		timer.Version(),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return domain.ErrTimerSessionAlreadyActive
+	}
+	return err
+}
+
+// Update stores the timer's state if nobody changed it since it was loaded
+func (r *PostgresTimerSessionRepository) Update(ctx context.Context, timer *domain.TimerSession) error {
+	return updateTimerSession(ctx, r.pool, timer)
+}
+
+// Finish stores a finished timer and its draft experience in one transaction
+func (r *PostgresTimerSessionRepository) Finish(ctx context.Context, timer *domain.TimerSession, draft *domain.Experience) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if draft != nil {
+		if err := saveExperience(ctx, tx, draft); err != nil {
+			return fmt.Errorf("failed to save draft experience: %w", err)
+		}
+	}
+	if err := updateTimerSession(ctx, tx, timer); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// updateTimerSession updates a timer using its version as an optimistic lock
+func updateTimerSession(ctx context.Context, db execer, timer *domain.TimerSession) error {
+	interruptions, err := marshalInterruptions(timer.Interruptions())
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE timer_sessions SET
+			status = $3,
+			interruptions = $4,
+			last_activity_at = $5,
+			finished_at = $6,
+			finish_reason = $7,
+			experience_id = $8,
+			version = version + 1
+		WHERE id = $1 AND version = $2
+	`
+	tag, err := db.Exec(ctx, query,
+		timer.ID(),
+		timer.Version(),
+		string(timer.Status()),
+		interruptions,
+		timer.LastActivityAt(),
+		nullTime(timer.FinishedAt()),
+		nullString(string(timer.FinishReason())),
+		nullString(timer.ExperienceID()),
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTimerSessionConflict
+	}
+	return nil
+}
+
+// FindByID finds a timer by ID
+func (r *PostgresTimerSessionRepository) FindByID(ctx context.Context, id string) (*domain.TimerSession, error) {
+	timer, err := scanTimerSession(r.pool.QueryRow(ctx, `SELECT `+timerSessionColumns+` FROM timer_sessions WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTimerSessionNotFound
+		}
+		return nil, err
+	}
+	return timer, nil
+}
+
+// FindActiveByUserID finds the running or paused timer of a user
+func (r *PostgresTimerSessionRepository) FindActiveByUserID(ctx context.Context, userID string) (*domain.TimerSession, error) {
+	query := `SELECT ` + timerSessionColumns + ` FROM timer_sessions WHERE user_id = $1 AND status <> 'finished'`
+	timer, err := scanTimerSession(r.pool.QueryRow(ctx, query, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTimerSessionNotFound
+		}
+		return nil, err
+	}
+	return timer, nil
+}
+
+// FindAbandoned returns active timers without activity since lastActivityBefore, oldest first
+func (r *PostgresTimerSessionRepository) FindAbandoned(ctx context.Context, lastActivityBefore time.Time, limit int) ([]*domain.TimerSession, error) {
+	query := `
+		SELECT ` + timerSessionColumns + ` FROM timer_sessions
+		WHERE status <> 'finished' AND last_activity_at <= $1
+		ORDER BY last_activity_at
+		LIMIT $2
+	`
+	rows, err := r.pool.Query(ctx, query, lastActivityBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var timers []*domain.TimerSession
+	for rows.Next() {
+		timer, err := scanTimerSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		timers = append(timers, timer)
+	}
+	return timers, rows.Err()
+}
+
+// scanTimerSession reconstructs a timer from a result row
+func scanTimerSession(row pgx.Row) (*domain.TimerSession, error) {
+	var id, userID, meditationType, customMeditationType, status string
+	var startedAt, lastActivityAt time.Time
+	var finishedAt *time.Time
+	var finishReason, experienceID *string
+	var interruptionsJSON []byte
+	var version int
+
+	err := row.Scan(
+		&id,
+		&userID,
+		&meditationType,
+		&customMeditationType,
+		&status,
+		&startedAt,
+		&interruptionsJSON,
+		&lastActivityAt,
+		&finishedAt,
+		&finishReason,
+		&experienceID,
+		&version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []interruptionRecord
+	if err := json.Unmarshal(interruptionsJSON, &records); err != nil {
+		return nil, fmt.Errorf("failed to decode interruptions of timer %s: %w", id, err)
+	}
+	interruptions := make([]domain.Interruption, 0, len(records))
+	for _, record := range records {
+		interruption := domain.Interruption{PausedAt: record.PausedAt}
+		if record.ResumedAt != nil {
+			interruption.ResumedAt = *record.ResumedAt
+		}
+		interruptions = append(interruptions, interruption)
+	}
+
+	var finished time.Time
+	if finishedAt != nil {
+		finished = *finishedAt
+	}
+	return domain.ReconstructTimerSession(
+		id,
+		userID,
+		meditationType,
+		customMeditationType,
+		domain.TimerStatus(status),
+		startedAt,
+		interruptions,
+		lastActivityAt,
+		finished,
+		domain.TimerFinishReason(stringValue(finishReason)),
+		stringValue(experienceID),
+		version,
+	), nil
+}
+
+// marshalInterruptions encodes interruptions for the JSONB column
+func marshalInterruptions(interruptions []domain.Interruption) ([]byte, error) {
+	records := make([]interruptionRecord, 0, len(interruptions))
+	for _, interruption := range interruptions {
+		record := interruptionRecord{PausedAt: interruption.PausedAt}
+		if !interruption.ResumedAt.IsZero() {
+			resumedAt := interruption.ResumedAt
+			record.ResumedAt = &resumedAt
+		}
+		records = append(records, record)
+	}
+	return json.Marshal(records)
+}
+
+// nullTime maps the zero time to NULL
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// timeValue maps NULL to the zero time
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+// nullString maps the empty string to NULL
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// stringValue maps NULL to the empty string
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,322 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"zen-connect/internal/experience/domain"
+	"zen-connect/internal/infrastructure/problem"
+)
+
+// ErrorMappings 体験記録ドメインのエラーとHTTPレスポンスの対応
+func ErrorMappings() []problem.Mapping {
+	return []problem.Mapping{
+		{
+			Err: domain.ErrExperienceNotFound, Status: http.StatusNotFound, Code: "experience_not_found",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "体験記録が見つかりません。",
+				problem.LanguageEnglish:  "The experience was not found.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidTimeRange, Status: http.StatusBadRequest, Code: "invalid_time_range",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "終了時刻は開始時刻より後である必要があります。",
+				problem.LanguageEnglish:  "The end time must be after the start time.",
+			},
+		},
+		{
+			Err: domain.ErrEmptyMeditationType, Status: http.StatusBadRequest, Code: "meditation_type_required",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "瞑想の種類を指定してください。",
+				problem.LanguageEnglish:  "The meditation type is required.",
+			},
+		},
+		{
+			Err: domain.ErrEmptyBeforeState, Status: http.StatusBadRequest, Code: "emotion_before_required",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "瞑想前の感情を入力してください。",
+				problem.LanguageEnglish:  "The emotional state before meditation is required.",
+			},
+		},
+		{
+			Err: domain.ErrEmptyAfterState, Status: http.StatusBadRequest, Code: "emotion_after_required",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "瞑想後の感情を入力してください。",
+				problem.LanguageEnglish:  "The emotional state after meditation is required.",
+			},
+		},
+		{
+			Err: domain.ErrUnknownMeditationType, Status: http.StatusBadRequest, Code: "unknown_meditation_type",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "瞑想の種類がカタログに登録されていません。",
+				problem.LanguageEnglish:  "The meditation type is not in the catalog.",
+			},
+		},
+		{
+			Err: domain.ErrEmptyCustomMeditationType, Status: http.StatusBadRequest, Code: "custom_meditation_type_required",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "「その他」を選んだ場合は瞑想の種類を入力してください。",
+				problem.LanguageEnglish:  "A custom meditation type is required when the type is other.",
+			},
+		},
+		{
+			Err: domain.ErrCustomMeditationTypeTooLong, Status: http.StatusBadRequest, Code: "custom_meditation_type_too_long",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "瞑想の種類は100文字以内で入力してください。",
+				problem.LanguageEnglish:  "The custom meditation type must be at most 100 characters.",
+			},
+		},
+		{
+			Err: domain.ErrMeditationTypeNotFound, Status: http.StatusNotFound, Code: "meditation_type_not_found",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "瞑想タイプが見つかりません。",
+				problem.LanguageEnglish:  "The meditation type was not found.",
+			},
+		},
+		{
+			Err: domain.ErrMeditationTypeAlreadyExists, Status: http.StatusConflict, Code: "meditation_type_already_exists",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "同じIDの瞑想タイプが既に存在します。",
+				problem.LanguageEnglish:  "A meditation type with this ID already exists.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidMeditationTypeID, Status: http.StatusBadRequest, Code: "invalid_meditation_type_id",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "瞑想タイプのIDは英小文字で始まる英小文字・数字・アンダースコアで指定してください。",
+				problem.LanguageEnglish:  "The meditation type ID must start with a lowercase letter and contain only lowercase letters, digits or underscores.",
+			},
+		},
+		{
+			Err: domain.ErrMissingMeditationTypeName, Status: http.StatusBadRequest, Code: "meditation_type_name_required",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "瞑想タイプの名前を日本語と英語で入力してください。",
+				problem.LanguageEnglish:  "The meditation type name is required in Japanese and English.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidRecommendedDuration, Status: http.StatusBadRequest, Code: "invalid_recommended_duration",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "推奨時間は正の値を指定してください。",
+				problem.LanguageEnglish:  "The recommended duration must be positive.",
+			},
+		},
+		{
+			Err: domain.ErrCannotDeactivateOtherType, Status: http.StatusConflict, Code: "other_meditation_type_required",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "「その他」の瞑想タイプは無効にできません。",
+				problem.LanguageEnglish:  "The other meditation type cannot be deactivated.",
+			},
+		},
+		{
+			Err: domain.ErrNotDraft, Status: http.StatusConflict, Code: "experience_not_draft",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "この体験記録は既に感情が入力されています。",
+				problem.LanguageEnglish:  "The emotional state of this experience has already been entered.",
+			},
+		},
+		{
+			Err: domain.ErrTimerSessionNotFound, Status: http.StatusNotFound, Code: "timer_session_not_found",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "タイマーが見つかりません。",
+				problem.LanguageEnglish:  "The timer session was not found.",
+			},
+		},
+		{
+			Err: domain.ErrTimerSessionAlreadyActive, Status: http.StatusConflict, Code: "timer_session_already_active",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "進行中のタイマーがあります。GET /timer-sessions/current で再開してください。",
+				problem.LanguageEnglish:  "A timer session is already active; reconnect to it with GET /timer-sessions/current.",
+			},
+		},
+		{
+			Err: domain.ErrTimerSessionConflict, Status: http.StatusConflict, Code: "timer_session_conflict",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "タイマーが別の端末から操作されました。最新の状態を取得してやり直してください。",
+				problem.LanguageEnglish:  "The timer session was changed from another device; fetch it and try again.",
+			},
+		},
+		{
+			Err: domain.ErrTimerNotRunning, Status: http.StatusConflict, Code: "timer_not_running",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "タイマーは実行中ではありません。",
+				problem.LanguageEnglish:  "The timer session is not running.",
+			},
+		},
+		{
+			Err: domain.ErrTimerNotPaused, Status: http.StatusConflict, Code: "timer_not_paused",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "タイマーは一時停止していません。",
+				problem.LanguageEnglish:  "The timer session is not paused.",
+			},
+		},
+		{
+			Err: domain.ErrTimerAlreadyFinished, Status: http.StatusConflict, Code: "timer_already_finished",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "タイマーは既に終了しています。",
+				problem.LanguageEnglish:  "The timer session has already finished.",
+			},
+		},
+		{
+			Err: domain.ErrJournalEntryTooLong, Status: http.StatusBadRequest, Code: "journal_too_long",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "振り返りは20000文字以内で入力してください。",
+				problem.LanguageEnglish:  "The journal entry must be at most 20000 characters.",
+			},
+		},
+		{
+			Err: domain.ErrTooManyTags, Status: http.StatusBadRequest, Code: "too_many_tags",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "タグは10個までです。",
+				problem.LanguageEnglish:  "An experience can have at most 10 tags.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidTag, Status: http.StatusBadRequest, Code: "invalid_tag",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "タグは空白やカンマを含まない30文字以内で指定してください。",
+				problem.LanguageEnglish:  "A tag must be 1 to 30 characters without spaces or commas.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidSearchRange, Status: http.StatusBadRequest, Code: "invalid_search_range",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "検索期間の終了は開始より後を指定してください。",
+				problem.LanguageEnglish:  "The end of the search range must be after its start.",
+			},
+		},
+		{
+			Err: domain.ErrExperienceNotPublic, Status: http.StatusConflict, Code: "experience_not_public",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "非公開の体験記録にはリアクションやコメントができません。",
+				problem.LanguageEnglish:  "Reactions and comments are only possible on public experiences.",
+			},
+		},
+		{
+			Err: domain.ErrUnknownReaction, Status: http.StatusBadRequest, Code: "unknown_reaction",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "このリアクションは使えません。",
+				problem.LanguageEnglish:  "The reaction is not supported.",
+			},
+		},
+		{
+			Err: domain.ErrCommentNotFound, Status: http.StatusNotFound, Code: "comment_not_found",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "コメントが見つかりません。",
+				problem.LanguageEnglish:  "The comment was not found.",
+			},
+		},
+		{
+			Err: domain.ErrEmptyComment, Status: http.StatusBadRequest, Code: "comment_required",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "コメントを入力してください。",
+				problem.LanguageEnglish:  "The comment cannot be empty.",
+			},
+		},
+		{
+			Err: domain.ErrCommentTooLong, Status: http.StatusBadRequest, Code: "comment_too_long",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "コメントは1000文字以内で入力してください。",
+				problem.LanguageEnglish:  "The comment must be at most 1000 characters.",
+			},
+		},
+		{
+			Err: domain.ErrCommentThreadTooDeep, Status: http.StatusBadRequest, Code: "comment_thread_too_deep",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "これ以上深い返信はできません。",
+				problem.LanguageEnglish:  "Replies cannot be nested any deeper.",
+			},
+		},
+		{
+			Err: domain.ErrCommentDeleted, Status: http.StatusConflict, Code: "comment_deleted",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "このコメントは削除されています。",
+				problem.LanguageEnglish:  "The comment has been deleted.",
+			},
+		},
+		{
+			Err: domain.ErrNotCommentAuthor, Status: http.StatusForbidden, Code: "not_comment_author",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "コメントを編集・削除できるのは投稿者だけです。",
+				problem.LanguageEnglish:  "Only the author can edit or delete the comment.",
+			},
+		},
+		{
+			Err: domain.ErrExperienceHidden, Status: http.StatusConflict, Code: "experience_hidden",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "この体験記録はモデレーターにより非表示になっています。",
+				problem.LanguageEnglish:  "The experience has been hidden by a moderator.",
+			},
+		},
+		{
+			Err: domain.ErrCommentHidden, Status: http.StatusConflict, Code: "comment_hidden",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "このコメントはモデレーターにより非表示になっています。",
+				problem.LanguageEnglish:  "The comment has been hidden by a moderator.",
+			},
+		},
+		{
+			Err: domain.ErrUnsupportedImportFormat, Status: http.StatusBadRequest, Code: "unsupported_import_format",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "取り込むファイルの形式は csv または json を指定してください。",
+				problem.LanguageEnglish:  "The import format must be csv or json.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidImportFile, Status: http.StatusBadRequest, Code: "invalid_import_file",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "取り込むファイルを読み込めません。形式を確認してください。",
+				problem.LanguageEnglish:  "The import file cannot be read. Check its format.",
+			},
+		},
+		{
+			Err: domain.ErrTooManyImportRows, Status: http.StatusBadRequest, Code: "too_many_import_rows",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "一度に取り込めるのは10000行までです。ファイルを分けてください。",
+				problem.LanguageEnglish:  "At most 10000 rows can be imported at once. Split the file.",
+			},
+		},
+		{
+			Err: domain.ErrUnknownImportPreset, Status: http.StatusBadRequest, Code: "unknown_import_preset",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "取り込みのプリセットが正しくありません。",
+				problem.LanguageEnglish:  "The import preset is not known.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidImportMapping, Status: http.StatusBadRequest, Code: "invalid_import_mapping",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "列の対応には開始時刻の列、終了時刻か長さの列、瞑想の種類の列か既定の種類が必要です。",
+				problem.LanguageEnglish:  "The mapping needs a start column, an end or duration column, and a type column or default type.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidDurationUnit, Status: http.StatusBadRequest, Code: "invalid_duration_unit",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "長さの単位は seconds、minutes、hms のいずれかを指定してください。",
+				problem.LanguageEnglish:  "The duration unit must be seconds, minutes or hms.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidHistoryTimeZone, Status: http.StatusBadRequest, Code: "invalid_time_zone",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "タイムゾーンが正しくありません。",
+				problem.LanguageEnglish:  "The time zone is not valid.",
+			},
+		},
+		{
+			Err: domain.ErrUnsupportedExportFormat, Status: http.StatusBadRequest, Code: "unsupported_export_format",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "書き出し形式は csv、json、ics のいずれかを指定してください。",
+				problem.LanguageEnglish:  "The export format must be csv, json or ics.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidExportRange, Status: http.StatusBadRequest, Code: "invalid_export_range",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "書き出す期間の終わりは始まりより後にしてください。",
+				problem.LanguageEnglish:  "The end of the export range must be after its start.",
+			},
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/application/usecase"
+	"zen-connect/internal/experience/domain"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/shared/openapi"
+)
+
+// exportContentTypes 書き出し形式ごとのContent-Type
+var exportContentTypes = map[string]string{
+	"csv":  "text/csv; charset=utf-8",
+	"json": "application/json; charset=utf-8",
+	"ics":  "text/calendar; charset=utf-8",
+}
+
+// HistoryHandler 瞑想履歴の取り込みと書き出しのHTTPハンドラー
+type HistoryHandler struct {
+	importHistoryUseCase *usecase.ImportHistoryUseCase
+	exportHistoryUseCase *usecase.ExportHistoryUseCase
+}
+
+// NewHistoryHandler コンストラクタ
+func NewHistoryHandler(importHistoryUseCase *usecase.ImportHistoryUseCase, exportHistoryUseCase *usecase.ExportHistoryUseCase) *HistoryHandler {
+	return &HistoryHandler{
+		importHistoryUseCase: importHistoryUseCase,
+		exportHistoryUseCase: exportHistoryUseCase,
+	}
+}
+
+// SetupRoutes 瞑想履歴関連のルーティング設定
+func (h *HistoryHandler) SetupRoutes(e *echo.Echo, sessionMiddleware *session.Middleware) {
+	experienceGroup := e.Group("/experiences", sessionMiddleware.RequireAuth())
+
+	// 他のアプリから書き出した履歴の取り込み（重複した瞑想は取り込まないため、再送しても安全）
+	experienceGroup.POST("/import", h.ImportHistory)
+	// 表計算ソフトやカレンダー向けの書き出し（履歴が多くても一度に読み込まず、順に送る）
+	experienceGroup.GET("/export", h.ExportHistory)
+}
+
+// Endpoints OpenAPIドキュメント用のエンドポイント定義
+func (h *HistoryHandler) Endpoints() []openapi.Endpoint {
+	tags := []string{"experiences"}
+	return []openapi.Endpoint{
+		{
+			Method: http.MethodPost, Path: "/experiences/import", Tags: tags,
+			Summary: "Import your meditation history from another app",
+			Description: "data is the exported CSV (with a header row) or JSON (an array of objects, or an object holding one) as text, " +
+				"at most " + strconv.Itoa(domain.MaxImportRows) + " rows. Give a preset (" + strings.Join(domain.ImportPresetNames(), ", ") + ") or a mapping of columns. " +
+				"Rows with both emotions become experiences and rows without become drafts. Types missing from the catalog are kept as other with the app's name. " +
+				"A row starting in the same minute as a session you already have, or as an earlier row, is a duplicate and skipped. " +
+				"dry_run reports what would happen without saving. Everything is saved in one transaction; if any row is invalid nothing is saved " +
+				"(422 with the report) unless skip_invalid is set.",
+			Security: []string{openapi.SecuritySession},
+			Request:  dto.ImportHistoryRequest{},
+			Responses: map[int]interface{}{
+				http.StatusOK:                  dto.ImportHistoryResponse{},
+				http.StatusBadRequest:          nil,
+				http.StatusUnauthorized:        nil,
+				http.StatusUnprocessableEntity: dto.ImportHistoryResponse{},
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/experiences/export", Tags: tags,
+			Summary: "Export your experiences as CSV, JSON or iCalendar",
+			Description: "Sessions are written oldest first and streamed as they are read. csv (text/csv, with a byte order mark for spreadsheet apps) " +
+				"and json (application/json, {\"experiences\": [...]}) have the columns id, start_time, end_time, duration_minutes, meditation_type, " +
+				"custom_type, note, emotion_before, emotion_after, tags (space separated in csv) and journal, and can be imported again with the zen_connect preset. " +
+				"ics (text/calendar) has an event per session from start to end in UTC, named after the meditation type and described by the note.",
+			Security: []string{openapi.SecuritySession},
+			Query: []openapi.Parameter{
+				{Name: "format", Required: true, Description: "csv, json or ics", Schema: &openapi.Schema{Type: "string", Enum: []interface{}{"csv", "json", "ics"}}},
+				{Name: "from", Description: "Sessions starting at or after (RFC 3339)", Schema: &openapi.Schema{Type: "string", Format: "date-time"}},
+				{Name: "to", Description: "Sessions starting before (RFC 3339)", Schema: &openapi.Schema{Type: "string", Format: "date-time"}},
+				{Name: "time_zone", Description: "IANA time zone of csv and json times, default UTC", Schema: &openapi.Schema{Type: "string"}},
+				{Name: "lang", Description: "ja (default) or en, the language of meditation type names in ics", Schema: &openapi.Schema{Type: "string"}},
+			},
+			ContentType: "text/csv",
+			Responses: map[int]interface{}{
+				http.StatusOK:           &openapi.Schema{Type: "string"},
+				http.StatusBadRequest:   nil,
+				http.StatusUnauthorized: nil,
+			},
+		},
+	}
+}
+
+// ImportHistory 他のアプリの瞑想履歴を取り込む
+// 取り込めない行があって保存しなかった場合は、行ごとのエラーを 422 で返す
+func (h *HistoryHandler) ImportHistory(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.ImportHistoryRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.UserID = userID
+
+	response, err := h.importHistoryUseCase.Execute(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	if !response.DryRun && !response.Committed {
+		return c.JSON(http.StatusUnprocessableEntity, response)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// ExportHistory 自分の体験記録をファイルとして書き出す
+func (h *HistoryHandler) ExportHistory(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.ExportHistoryRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeBadRequest)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.UserID = userID
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentType, exportContentTypes[req.Format])
+	header.Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="zen-connect-%s.%s"`, time.Now().Format("20060102"), req.Format))
+	if err := h.exportHistoryUseCase.Execute(c.Request().Context(), &req, c.Response()); err != nil {
+		// 書き出し前のエラーは通常のエラーレスポンスにする（書き出し後はエラーハンドラーが記録のみ行う）
+		if !c.Response().Committed {
+			header.Del(echo.HeaderContentDisposition)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/application/usecase"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/shared/openapi"
+)
+
+// MeditationTypeHandler 瞑想タイプカタログ関連のHTTPハンドラー
+type MeditationTypeHandler struct {
+	listMeditationTypesUseCase  *usecase.ListMeditationTypesUseCase
+	createMeditationTypeUseCase *usecase.CreateMeditationTypeUseCase
+	updateMeditationTypeUseCase *usecase.UpdateMeditationTypeUseCase
+}
+
+// NewMeditationTypeHandler コンストラクタ
+func NewMeditationTypeHandler(
+	listMeditationTypesUseCase *usecase.ListMeditationTypesUseCase,
+	createMeditationTypeUseCase *usecase.CreateMeditationTypeUseCase,
+	updateMeditationTypeUseCase *usecase.UpdateMeditationTypeUseCase,
+) *MeditationTypeHandler {
+	return &MeditationTypeHandler{
+		listMeditationTypesUseCase:  listMeditationTypesUseCase,
+		createMeditationTypeUseCase: createMeditationTypeUseCase,
+		updateMeditationTypeUseCase: updateMeditationTypeUseCase,
+	}
+}
+
+// SetupRoutes 瞑想タイプ関連のルーティング設定
+// requireAdmin は管理者APIに適用する管理者チェックミドルウェア
+func (h *MeditationTypeHandler) SetupRoutes(e *echo.Echo, sessionMiddleware *session.Middleware, requireAdmin echo.MiddlewareFunc) {
+	// カタログの参照（クライアントの選択肢表示用、認証不要）
+	e.GET("/meditation-types", h.ListMeditationTypes)
+
+	// カタログの管理（管理者のみ）
+	adminGroup := e.Group("/admin/meditation-types", sessionMiddleware.RequireAuth(), requireAdmin)
+	adminGroup.GET("", h.ListAllMeditationTypes)
+	adminGroup.POST("", h.CreateMeditationType)
+	adminGroup.PUT("/:id", h.UpdateMeditationType)
+}
+
+// Endpoints OpenAPIドキュメント用のエンドポイント定義
+func (h *MeditationTypeHandler) Endpoints() []openapi.Endpoint {
+	tags := []string{"meditation-types"}
+	adminTags := []string{"admin"}
+	return []openapi.Endpoint{
+		{
+			Method: http.MethodGet, Path: "/meditation-types", Tags: tags,
+			Summary: "List active meditation types",
+			Responses: map[int]interface{}{
+				http.StatusOK: dto.ListMeditationTypesResponse{},
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/admin/meditation-types", Tags: adminTags,
+			Summary:  "List all meditation types including retired ones",
+			Security: []string{openapi.SecuritySession},
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.ListMeditationTypesResponse{},
+				http.StatusUnauthorized: nil,
+				http.StatusForbidden:    nil,
+			},
+		},
+		{
+			Method: http.MethodPost, Path: "/admin/meditation-types", Tags: adminTags,
+			Summary:  "Add a meditation type to the catalog",
+			Security: []string{openapi.SecuritySession},
+			Request:  dto.CreateMeditationTypeRequest{},
+			Responses: map[int]interface{}{
+				http.StatusCreated:      dto.MeditationTypeDTO{},
+				http.StatusBadRequest:   nil,
+				http.StatusUnauthorized: nil,
+				http.StatusForbidden:    nil,
+				http.StatusConflict:     nil,
+			},
+		},
+		{
+			Method: http.MethodPut, Path: "/admin/meditation-types/:id", Tags: adminTags,
+			Summary:     "Update or retire a meditation type",
+			Description: "IDs cannot change because experiences reference them; set is_active to false instead of deleting.",
+			Security:    []string{openapi.SecuritySession},
+			Request:     dto.UpdateMeditationTypeRequest{},
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.MeditationTypeDTO{},
+				http.StatusBadRequest:   nil,
+				http.StatusUnauthorized: nil,
+				http.StatusForbidden:    nil,
+				http.StatusNotFound:     nil,
+				http.StatusConflict:     nil,
+			},
+		},
+	}
+}
+
+// ListMeditationTypes 有効な瞑想タイプを表示順に取得
+func (h *MeditationTypeHandler) ListMeditationTypes(c echo.Context) error {
+	response, err := h.listMeditationTypesUseCase.Execute(c.Request().Context(), false)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// ListAllMeditationTypes 廃止済みを含むすべての瞑想タイプを取得
+func (h *MeditationTypeHandler) ListAllMeditationTypes(c echo.Context) error {
+	response, err := h.listMeditationTypesUseCase.Execute(c.Request().Context(), true)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// CreateMeditationType 瞑想タイプを追加
+func (h *MeditationTypeHandler) CreateMeditationType(c echo.Context) error {
+	var req dto.CreateMeditationTypeRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+
+	response, err := h.createMeditationTypeUseCase.Execute(c.Request().Context(), &req)
+	if err != nil {
+		// ドメインエラーはエラーハンドラーでHTTPレスポンスに変換される
+		return err
+	}
+	return c.JSON(http.StatusCreated, response)
+}
+
+// UpdateMeditationType 瞑想タイプを更新
+func (h *MeditationTypeHandler) UpdateMeditationType(c echo.Context) error {
+	var req dto.UpdateMeditationTypeRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.ID = c.Param("id")
+
+	response, err := h.updateMeditationTypeUseCase.Execute(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, response)
+}
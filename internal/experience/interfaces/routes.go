@@ -0,0 +1,247 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/application/usecase"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/shared/openapi"
+)
+
+// ExperienceHandler 体験記録関連のHTTPハンドラー
+type ExperienceHandler struct {
+	createExperienceUseCase   *usecase.CreateExperienceUseCase
+	completeExperienceUseCase *usecase.CompleteExperienceUseCase
+	updateJournalUseCase      *usecase.UpdateJournalUseCase
+	searchExperiencesUseCase  *usecase.SearchExperiencesUseCase
+	listTagsUseCase           *usecase.ListTagsUseCase
+}
+
+// NewExperienceHandler コンストラクタ
+func NewExperienceHandler(
+	createExperienceUseCase *usecase.CreateExperienceUseCase,
+	completeExperienceUseCase *usecase.CompleteExperienceUseCase,
+	updateJournalUseCase *usecase.UpdateJournalUseCase,
+	searchExperiencesUseCase *usecase.SearchExperiencesUseCase,
+	listTagsUseCase *usecase.ListTagsUseCase,
+) *ExperienceHandler {
+	return &ExperienceHandler{
+		createExperienceUseCase:   createExperienceUseCase,
+		completeExperienceUseCase: completeExperienceUseCase,
+		updateJournalUseCase:      updateJournalUseCase,
+		searchExperiencesUseCase:  searchExperiencesUseCase,
+		listTagsUseCase:           listTagsUseCase,
+	}
+}
+
+// SetupRoutes 体験記録関連のルーティング設定
+// idempotency は作成系エンドポイントに適用するIdempotency-Keyミドルウェア
+func (h *ExperienceHandler) SetupRoutes(e *echo.Echo, sessionMiddleware *session.Middleware, idempotency echo.MiddlewareFunc) {
+	experienceGroup := e.Group("/experiences", sessionMiddleware.RequireAuth())
+
+	// 体験記録の作成（リトライ時の重複作成を防止）
+	experienceGroup.POST("", h.CreateExperience, idempotency)
+	// ライブタイマーから作成された下書きに感情を入力
+	experienceGroup.PUT("/:id/emotional-state", h.CompleteExperience)
+	// 振り返り（Markdown）とタグ
+	experienceGroup.PUT("/:id/journal", h.UpdateJournal)
+	experienceGroup.GET("/search", h.SearchExperiences)
+	experienceGroup.GET("/tags", h.ListTags)
+}
+
+// Endpoints OpenAPIドキュメント用のエンドポイント定義
+func (h *ExperienceHandler) Endpoints() []openapi.Endpoint {
+	tags := []string{"experiences"}
+	return []openapi.Endpoint{
+		{
+			Method: http.MethodPost, Path: "/experiences", Tags: tags,
+			Summary:  "Record a meditation experience",
+			Security: []string{openapi.SecuritySession},
+			Headers:  []openapi.Parameter{openapi.IdempotencyKeyHeader},
+			Request:  dto.CreateExperienceRequest{},
+			Responses: map[int]interface{}{
+				http.StatusCreated:             dto.ExperienceDTO{},
+				http.StatusBadRequest:          nil,
+				http.StatusUnauthorized:        nil,
+				http.StatusConflict:            nil,
+				http.StatusUnprocessableEntity: nil,
+			},
+		},
+		{
+			Method: http.MethodPut, Path: "/experiences/:id/emotional-state", Tags: tags,
+			Summary:     "Complete a draft experience with its emotional state",
+			Description: "Drafts are created when a live timer session finishes.",
+			Security:    []string{openapi.SecuritySession},
+			Request:     dto.CompleteExperienceRequest{},
+			Responses: map[int]interface{}{
+				http.StatusOK:                  dto.ExperienceDTO{},
+				http.StatusBadRequest:          nil,
+				http.StatusUnauthorized:        nil,
+				http.StatusNotFound:            nil,
+				http.StatusConflict:            nil,
+				http.StatusUnprocessableEntity: nil,
+			},
+		},
+		{
+			Method: http.MethodPut, Path: "/experiences/:id/journal", Tags: tags,
+			Summary:     "Replace the journal entry and tags of an experience",
+			Description: "The journal is Markdown and returned as written. Tags are lowercased, a leading # is dropped and duplicates are removed.",
+			Security:    []string{openapi.SecuritySession},
+			Request:     dto.UpdateJournalRequest{},
+			Responses: map[int]interface{}{
+				http.StatusOK:                  dto.ExperienceDTO{},
+				http.StatusBadRequest:          nil,
+				http.StatusUnauthorized:        nil,
+				http.StatusNotFound:            nil,
+				http.StatusUnprocessableEntity: nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/experiences/search", Tags: tags,
+			Summary: "Search your experiences",
+			Description: "Every word of q must appear in the note, journal or free-text meditation type (substring match, so Japanese works without spaces). " +
+				"Combines with tags (all must match), a start time range and a meditation type. Results are ordered by session start, newest first.",
+			Security: []string{openapi.SecuritySession},
+			Query: []openapi.Parameter{
+				{Name: "q", Description: "Words separated by spaces", Schema: &openapi.Schema{Type: "string"}},
+				{Name: "tag", Description: "Repeat for several tags", Schema: &openapi.Schema{Type: "array", Items: &openapi.Schema{Type: "string"}}},
+				{Name: "from", Description: "Sessions starting at or after (RFC 3339)", Schema: &openapi.Schema{Type: "string", Format: "date-time"}},
+				{Name: "to", Description: "Sessions starting before (RFC 3339)", Schema: &openapi.Schema{Type: "string", Format: "date-time"}},
+				{Name: "meditation_type", Description: "Catalog ID or name, including retired types", Schema: &openapi.Schema{Type: "string"}},
+				{Name: "limit", Description: "1-100, default 20", Schema: &openapi.Schema{Type: "integer"}},
+				{Name: "offset", Description: "next_offset of the previous page", Schema: &openapi.Schema{Type: "integer"}},
+			},
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.SearchExperiencesResponse{},
+				http.StatusBadRequest:   nil,
+				http.StatusUnauthorized: nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/experiences/tags", Tags: tags,
+			Summary:  "List the tags you have used, most used first",
+			Security: []string{openapi.SecuritySession},
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.ListTagsResponse{},
+				http.StatusUnauthorized: nil,
+			},
+		},
+	}
+}
+
+// CreateExperience 体験記録を作成
+func (h *ExperienceHandler) CreateExperience(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.CreateExperienceRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.UserID = userID
+
+	response, err := h.createExperienceUseCase.Execute(c.Request().Context(), &req)
+	if err != nil {
+		// ドメインエラーはエラーハンドラーでHTTPレスポンスに変換される
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// CompleteExperience 下書きの体験記録に感情を入力
+func (h *ExperienceHandler) CompleteExperience(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.CompleteExperienceRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.ExperienceID = c.Param("id")
+	req.UserID = userID
+
+	response, err := h.completeExperienceUseCase.Execute(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// UpdateJournal 体験記録の振り返りとタグを更新
+func (h *ExperienceHandler) UpdateJournal(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.UpdateJournalRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.ExperienceID = c.Param("id")
+	req.UserID = userID
+
+	response, err := h.updateJournalUseCase.Execute(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// SearchExperiences 自分の体験記録を検索
+func (h *ExperienceHandler) SearchExperiences(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.SearchExperiencesRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeBadRequest)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.UserID = userID
+
+	response, err := h.searchExperiencesUseCase.Execute(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ListTags 自分が使ったタグの一覧を取得
+func (h *ExperienceHandler) ListTags(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.listTagsUseCase.Execute(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
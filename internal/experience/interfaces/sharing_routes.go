@@ -0,0 +1,332 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/application/usecase"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/shared/openapi"
+)
+
+// SharingHandler 公開された体験記録の閲覧・公開設定・リアクション・コメントのHTTPハンドラー
+type SharingHandler struct {
+	visibilityUseCase *usecase.ExperienceVisibilityUseCase
+	reactionUseCase   *usecase.ReactionUseCase
+	commentUseCase    *usecase.CommentUseCase
+}
+
+// NewSharingHandler コンストラクタ
+func NewSharingHandler(
+	visibilityUseCase *usecase.ExperienceVisibilityUseCase,
+	reactionUseCase *usecase.ReactionUseCase,
+	commentUseCase *usecase.CommentUseCase,
+) *SharingHandler {
+	return &SharingHandler{
+		visibilityUseCase: visibilityUseCase,
+		reactionUseCase:   reactionUseCase,
+		commentUseCase:    commentUseCase,
+	}
+}
+
+// SetupRoutes 公開された体験記録関連のルーティング設定
+// idempotency はコメント投稿に適用するIdempotency-Keyミドルウェア
+func (h *SharingHandler) SetupRoutes(e *echo.Echo, sessionMiddleware *session.Middleware, idempotency echo.MiddlewareFunc) {
+	experienceGroup := e.Group("/experiences", sessionMiddleware.RequireAuth())
+
+	experienceGroup.GET("/:id", h.GetExperience)
+	experienceGroup.PUT("/:id/visibility", h.UpdateVisibility)
+	// リアクション（種類は名前または絵文字で指定）
+	experienceGroup.GET("/:id/reactions", h.ListReactions)
+	experienceGroup.PUT("/:id/reactions/:kind", h.AddReaction)
+	experienceGroup.DELETE("/:id/reactions/:kind", h.RemoveReaction)
+	// コメント（parent_id を指定すると返信）
+	experienceGroup.GET("/:id/comments", h.ListComments)
+	experienceGroup.POST("/:id/comments", h.CreateComment, idempotency)
+	experienceGroup.PUT("/:id/comments/:comment_id", h.UpdateComment)
+	experienceGroup.DELETE("/:id/comments/:comment_id", h.DeleteComment)
+}
+
+// Endpoints OpenAPIドキュメント用のエンドポイント定義
+func (h *SharingHandler) Endpoints() []openapi.Endpoint {
+	tags := []string{"experiences"}
+	security := []string{openapi.SecuritySession}
+	hidden := "Private experiences and their reactions and comments are visible only to their owner; everyone else gets 404."
+	reaction := func(method, summary string) openapi.Endpoint {
+		return openapi.Endpoint{
+			Method: method, Path: "/experiences/:id/reactions/:kind", Tags: tags,
+			Summary:     summary,
+			Description: "kind is gassho, lotus or calm, or its emoji (🙏, 🪷, 😌). Reacting twice with the same kind counts once.",
+			Security:    security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.ReactionsResponse{},
+				http.StatusBadRequest:   nil,
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+				http.StatusConflict:     nil,
+			},
+		}
+	}
+	return []openapi.Endpoint{
+		{
+			Method: http.MethodGet, Path: "/experiences/:id", Tags: tags,
+			Summary:     "Get a public experience or one of your own",
+			Description: hidden,
+			Security:    security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.ExperienceDTO{},
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+			},
+		},
+		{
+			Method: http.MethodPut, Path: "/experiences/:id/visibility", Tags: tags,
+			Summary:     "Make your experience public or private",
+			Description: "Making an experience private hides its reactions and comments from everyone else; they come back when it is made public again.",
+			Security:    security,
+			Request:     dto.UpdateVisibilityRequest{},
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.ExperienceDTO{},
+				http.StatusBadRequest:   nil,
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/experiences/:id/reactions", Tags: tags,
+			Summary:     "Count the reactions to an experience",
+			Description: hidden,
+			Security:    security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.ReactionsResponse{},
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+			},
+		},
+		reaction(http.MethodPut, "React to a public experience"),
+		reaction(http.MethodDelete, "Take back a reaction"),
+		{
+			Method: http.MethodGet, Path: "/experiences/:id/comments", Tags: tags,
+			Summary:     "List the comments on an experience as threads, oldest first",
+			Description: hidden + " Deleted comments are kept without author and body while they have replies.",
+			Security:    security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.CommentThreadResponse{},
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+			},
+		},
+		{
+			Method: http.MethodPost, Path: "/experiences/:id/comments", Tags: tags,
+			Summary:     "Comment on a public experience or reply to a comment",
+			Description: "Replies can be nested 3 levels deep.",
+			Security:    security,
+			Headers:     []openapi.Parameter{openapi.IdempotencyKeyHeader},
+			Request:     dto.CreateCommentRequest{},
+			Responses: map[int]interface{}{
+				http.StatusCreated:             dto.CommentDTO{},
+				http.StatusBadRequest:          nil,
+				http.StatusUnauthorized:        nil,
+				http.StatusNotFound:            nil,
+				http.StatusConflict:            nil,
+				http.StatusUnprocessableEntity: nil,
+			},
+		},
+		{
+			Method: http.MethodPut, Path: "/experiences/:id/comments/:comment_id", Tags: tags,
+			Summary:  "Edit your comment",
+			Security: security,
+			Request:  dto.UpdateCommentRequest{},
+			Responses: map[int]interface{}{
+				http.StatusOK:                  dto.CommentDTO{},
+				http.StatusBadRequest:          nil,
+				http.StatusUnauthorized:        nil,
+				http.StatusForbidden:           nil,
+				http.StatusNotFound:            nil,
+				http.StatusConflict:            nil,
+				http.StatusUnprocessableEntity: nil,
+			},
+		},
+		{
+			Method: http.MethodDelete, Path: "/experiences/:id/comments/:comment_id", Tags: tags,
+			Summary:  "Delete your comment",
+			Security: security,
+			Responses: map[int]interface{}{
+				http.StatusNoContent:    nil,
+				http.StatusUnauthorized: nil,
+				http.StatusForbidden:    nil,
+				http.StatusNotFound:     nil,
+			},
+		},
+	}
+}
+
+// GetExperience 公開中または自分の体験記録を取得
+func (h *SharingHandler) GetExperience(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.visibilityUseCase.Get(c.Request().Context(), c.Param("id"), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// UpdateVisibility 自分の体験記録を公開・非公開にする
+func (h *SharingHandler) UpdateVisibility(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.UpdateVisibilityRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.ExperienceID = c.Param("id")
+	req.UserID = userID
+
+	response, err := h.visibilityUseCase.Update(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ListReactions リアクションの件数を取得
+func (h *SharingHandler) ListReactions(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.reactionUseCase.List(c.Request().Context(), c.Param("id"), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// AddReaction リアクションする
+func (h *SharingHandler) AddReaction(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.reactionUseCase.Add(c.Request().Context(), c.Param("id"), userID, c.Param("kind"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// RemoveReaction リアクションを取り消す
+func (h *SharingHandler) RemoveReaction(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.reactionUseCase.Remove(c.Request().Context(), c.Param("id"), userID, c.Param("kind"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ListComments コメントをスレッドで取得
+func (h *SharingHandler) ListComments(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.commentUseCase.List(c.Request().Context(), c.Param("id"), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// CreateComment コメントまたは返信を投稿
+func (h *SharingHandler) CreateComment(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := session.GetUserIDFromContext(ctx)
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.CreateCommentRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.ExperienceID = c.Param("id")
+	req.UserID = userID
+	// 投稿時の表示名をコメントと一緒に残す
+	req.UserName, _ = session.GetUserNameFromContext(ctx)
+
+	response, err := h.commentUseCase.Add(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// UpdateComment 自分のコメントを編集
+func (h *SharingHandler) UpdateComment(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.UpdateCommentRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.ExperienceID = c.Param("id")
+	req.CommentID = c.Param("comment_id")
+	req.UserID = userID
+
+	response, err := h.commentUseCase.Edit(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeleteComment 自分のコメントを削除
+func (h *SharingHandler) DeleteComment(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	if err := h.commentUseCase.Delete(c.Request().Context(), c.Param("id"), c.Param("comment_id"), userID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
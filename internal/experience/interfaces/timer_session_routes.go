@@ -0,0 +1,214 @@
+package interfaces
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/application/usecase"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/shared/openapi"
+)
+
+// TimerSessionHandler ライブタイマー関連のHTTPハンドラー
+type TimerSessionHandler struct {
+	startTimerSessionUseCase   *usecase.StartTimerSessionUseCase
+	controlTimerSessionUseCase *usecase.ControlTimerSessionUseCase
+	finishTimerSessionUseCase  *usecase.FinishTimerSessionUseCase
+}
+
+// NewTimerSessionHandler コンストラクタ
+func NewTimerSessionHandler(
+	startTimerSessionUseCase *usecase.StartTimerSessionUseCase,
+	controlTimerSessionUseCase *usecase.ControlTimerSessionUseCase,
+	finishTimerSessionUseCase *usecase.FinishTimerSessionUseCase,
+) *TimerSessionHandler {
+	return &TimerSessionHandler{
+		startTimerSessionUseCase:   startTimerSessionUseCase,
+		controlTimerSessionUseCase: controlTimerSessionUseCase,
+		finishTimerSessionUseCase:  finishTimerSessionUseCase,
+	}
+}
+
+// SetupRoutes ライブタイマー関連のルーティング設定
+// idempotency は開始エンドポイントに適用するIdempotency-Keyミドルウェア
+func (h *TimerSessionHandler) SetupRoutes(e *echo.Echo, sessionMiddleware *session.Middleware, idempotency echo.MiddlewareFunc) {
+	timerGroup := e.Group("/timer-sessions", sessionMiddleware.RequireAuth())
+
+	timerGroup.POST("", h.StartTimerSession, idempotency)
+	// 再接続したクライアントが進行中のタイマーを取得する
+	timerGroup.GET("/current", h.GetCurrentTimerSession)
+	timerGroup.GET("/:id", h.GetTimerSession)
+	timerGroup.POST("/:id/pause", h.PauseTimerSession)
+	timerGroup.POST("/:id/resume", h.ResumeTimerSession)
+	timerGroup.POST("/:id/heartbeat", h.HeartbeatTimerSession)
+	timerGroup.POST("/:id/finish", h.FinishTimerSession)
+}
+
+// Endpoints OpenAPIドキュメント用のエンドポイント定義
+func (h *TimerSessionHandler) Endpoints() []openapi.Endpoint {
+	tags := []string{"timer-sessions"}
+	security := []string{openapi.SecuritySession}
+	control := func(path, summary string) openapi.Endpoint {
+		return openapi.Endpoint{
+			Method: http.MethodPost, Path: path, Tags: tags,
+			Summary:  summary,
+			Security: security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.TimerSessionDTO{},
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+				http.StatusConflict:     nil,
+			},
+		}
+	}
+	return []openapi.Endpoint{
+		{
+			Method: http.MethodPost, Path: "/timer-sessions", Tags: tags,
+			Summary:     "Start a live meditation timer",
+			Description: "A user has at most one active timer; starting another returns 409 and the client should reconnect to GET /timer-sessions/current.",
+			Security:    security,
+			Headers:     []openapi.Parameter{openapi.IdempotencyKeyHeader},
+			Request:     dto.StartTimerSessionRequest{},
+			Responses: map[int]interface{}{
+				http.StatusCreated:             dto.TimerSessionDTO{},
+				http.StatusBadRequest:          nil,
+				http.StatusUnauthorized:        nil,
+				http.StatusConflict:            nil,
+				http.StatusUnprocessableEntity: nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/timer-sessions/current", Tags: tags,
+			Summary:  "Get the running or paused timer to reconnect to",
+			Security: security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.TimerSessionDTO{},
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/timer-sessions/:id", Tags: tags,
+			Summary:  "Get a timer",
+			Security: security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.TimerSessionDTO{},
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+			},
+		},
+		control("/timer-sessions/:id/pause", "Pause a running timer"),
+		control("/timer-sessions/:id/resume", "Resume a paused timer"),
+		control("/timer-sessions/:id/heartbeat", "Report that the client is still connected"),
+		{
+			Method: http.MethodPost, Path: "/timer-sessions/:id/finish", Tags: tags,
+			Summary:     "Finish a timer and create a draft experience",
+			Description: "The draft awaits its emotional state via PUT /experiences/{id}/emotional-state.",
+			Security:    security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.FinishTimerSessionResponse{},
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+				http.StatusConflict:     nil,
+			},
+		},
+	}
+}
+
+// StartTimerSession ライブタイマーを開始
+func (h *TimerSessionHandler) StartTimerSession(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.StartTimerSessionRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.UserID = userID
+
+	response, err := h.startTimerSessionUseCase.Execute(c.Request().Context(), &req)
+	if err != nil {
+		// ドメインエラーはエラーハンドラーでHTTPレスポンスに変換される
+		return err
+	}
+	return c.JSON(http.StatusCreated, response)
+}
+
+// GetCurrentTimerSession 進行中のライブタイマーを取得
+func (h *TimerSessionHandler) GetCurrentTimerSession(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.controlTimerSessionUseCase.Current(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetTimerSession ライブタイマーを取得
+func (h *TimerSessionHandler) GetTimerSession(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.controlTimerSessionUseCase.Get(c.Request().Context(), userID, c.Param("id"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// PauseTimerSession ライブタイマーを一時停止
+func (h *TimerSessionHandler) PauseTimerSession(c echo.Context) error {
+	return h.control(c, h.controlTimerSessionUseCase.Pause)
+}
+
+// ResumeTimerSession ライブタイマーを再開
+func (h *TimerSessionHandler) ResumeTimerSession(c echo.Context) error {
+	return h.control(c, h.controlTimerSessionUseCase.Resume)
+}
+
+// HeartbeatTimerSession クライアントの接続を通知
+func (h *TimerSessionHandler) HeartbeatTimerSession(c echo.Context) error {
+	return h.control(c, h.controlTimerSessionUseCase.Heartbeat)
+}
+
+// FinishTimerSession ライブタイマーを終了し下書きの体験記録を作成
+func (h *TimerSessionHandler) FinishTimerSession(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.finishTimerSessionUseCase.Execute(c.Request().Context(), userID, c.Param("id"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// control ログインユーザーのタイマーに操作を適用
+func (h *TimerSessionHandler) control(c echo.Context, action func(ctx context.Context, userID, timerSessionID string) (*dto.TimerSessionDTO, error)) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := action(c.Request().Context(), userID, c.Param("id"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, response)
+}
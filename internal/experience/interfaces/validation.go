@@ -0,0 +1,89 @@
+package interfaces
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"zen-connect/internal/experience/application/service"
+	"zen-connect/internal/experience/domain"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/validation"
+)
+
+// ValidationRules 体験記録リクエストのカスタムバリデーションルール
+// 瞑想の種類は管理者が変更できるため、カタログサービス経由で検証する
+func ValidationRules(catalogService *service.MeditationTypeCatalogService) []validation.Rule {
+	emotionLevels := strings.Join(domain.EmotionLevels(), "、")
+
+	return []validation.Rule{
+		{
+			// 瞑想の種類がカタログに登録された有効な種類か
+			Tag: "meditation_type",
+			Func: func(fl validator.FieldLevel) bool {
+				catalog, err := catalogService.Catalog(context.Background())
+				if err != nil {
+					// カタログを読み込めない場合はユースケース側のエラーに任せる
+					return true
+				}
+				_, ok := catalog.Resolve(fl.Field().String())
+				return ok
+			},
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "瞑想の種類は GET /meditation-types で取得できる種類を指定してください。",
+				problem.LanguageEnglish:  "Must be one of the types listed by GET /meditation-types.",
+			},
+		},
+		{
+			// 定義済みの感情レベルか
+			Tag: "emotion_level",
+			Func: func(fl validator.FieldLevel) bool {
+				return domain.IsEmotionLevel(fl.Field().String())
+			},
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "感情は次のいずれかを指定してください: " + emotionLevels,
+				problem.LanguageEnglish:  "Must be one of: " + emotionLevels,
+			},
+		},
+		{
+			// タグとして使える文字列か（先頭の # と大文字小文字は正規化される）
+			Tag: "experience_tag",
+			Func: func(fl validator.FieldLevel) bool {
+				_, err := domain.NormalizeTag(fl.Field().String())
+				return err == nil
+			},
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "タグは空白やカンマを含まない30文字以内で指定してください。",
+				problem.LanguageEnglish:  "Must be 1 to 30 characters without spaces or commas.",
+			},
+		},
+		{
+			// 終了時刻がパラメータで指定した開始時刻より後か（MeditationSessionと同じ規則）
+			Tag:  "meditation_time_range",
+			Func: validateTimeRange,
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "終了時刻は開始時刻より後である必要があります。",
+				problem.LanguageEnglish:  "Must be after the start time.",
+			},
+		},
+	}
+}
+
+// validateTimeRange 終了時刻フィールドと開始時刻フィールドの範囲を検証
+func validateTimeRange(fl validator.FieldLevel) bool {
+	endTime, ok := fl.Field().Interface().(time.Time)
+	if !ok {
+		return false
+	}
+	startField := reflect.Indirect(fl.Parent()).FieldByName(fl.Param())
+	if !startField.IsValid() {
+		return false
+	}
+	startTime, ok := startField.Interface().(time.Time)
+	if !ok {
+		return false
+	}
+	return domain.ValidateTimeRange(startTime, endTime) == nil
+}
@@ -0,0 +1,146 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"zen-connect/internal/experience/application/dto"
+	"zen-connect/internal/experience/application/service"
+	"zen-connect/internal/experience/domain"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/validation"
+)
+
+func validCreateExperienceRequest() dto.CreateExperienceRequest {
+	startTime := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	return dto.CreateExperienceRequest{
+		StartTime:      startTime,
+		EndTime:        startTime.Add(20 * time.Minute),
+		MeditationType: "zazen",
+		EmotionBefore:  "不安",
+		EmotionAfter:   "穏やか",
+	}
+}
+
+// fakeMeditationTypeRepository 固定のカタログを返すテスト用リポジトリ
+type fakeMeditationTypeRepository struct {
+	types []*domain.MeditationType
+}
+
+func (r *fakeMeditationTypeRepository) FindAll(ctx context.Context) ([]*domain.MeditationType, error) {
+	return r.types, nil
+}
+
+func (r *fakeMeditationTypeRepository) FindByID(ctx context.Context, id string) (*domain.MeditationType, error) {
+	for _, mt := range r.types {
+		if mt.ID() == id {
+			return mt, nil
+		}
+	}
+	return nil, domain.ErrMeditationTypeNotFound
+}
+
+func (r *fakeMeditationTypeRepository) Create(ctx context.Context, mt *domain.MeditationType) error {
+	r.types = append(r.types, mt)
+	return nil
+}
+
+func (r *fakeMeditationTypeRepository) Update(ctx context.Context, mt *domain.MeditationType) error {
+	return nil
+}
+
+func newTestCatalogService(t *testing.T) *service.MeditationTypeCatalogService {
+	t.Helper()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	zazen := domain.ReconstructMeditationType("zazen",
+		domain.LocalizedText{"ja": "座禅", "en": "Zazen"}, nil, 20*time.Minute, nil, 1, true, now, now)
+	retired := domain.ReconstructMeditationType("retired",
+		domain.LocalizedText{"ja": "廃止", "en": "Retired"}, nil, 10*time.Minute, nil, 2, false, now, now)
+	repo := &fakeMeditationTypeRepository{types: []*domain.MeditationType{zazen, retired}}
+	return service.NewMeditationTypeCatalogService(repo, time.Minute)
+}
+
+func invalidFields(t *testing.T, req dto.CreateExperienceRequest) map[string]string {
+	t.Helper()
+	v, err := validation.New(ValidationRules(newTestCatalogService(t))...)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	fields := map[string]string{}
+	var problemErr *problem.Error
+	if err := v.Validate(&req); errors.As(err, &problemErr) {
+		for _, field := range problemErr.Fields {
+			fields[field.Field] = field.Rule
+		}
+	} else if err != nil {
+		t.Fatalf("Expected validation error, got %v", err)
+	}
+	return fields
+}
+
+func TestValidationRules_ShouldAcceptValidRequest(t *testing.T) {
+	// when
+	fields := invalidFields(t, validCreateExperienceRequest())
+
+	// then
+	if len(fields) != 0 {
+		t.Errorf("Expected no invalid fields, got %v", fields)
+	}
+}
+
+func TestValidationRules_ShouldRejectDomainViolations(t *testing.T) {
+	// given
+	req := validCreateExperienceRequest()
+	req.EndTime = req.StartTime
+	req.MeditationType = "retired"
+	req.EmotionAfter = "まあまあ"
+
+	// when
+	fields := invalidFields(t, req)
+
+	// then
+	expected := map[string]string{
+		"end_time":        "meditation_time_range",
+		"meditation_type": "meditation_type",
+		"emotion_after":   "emotion_level",
+	}
+	for field, rule := range expected {
+		if fields[field] != rule {
+			t.Errorf("Expected %s to fail %s, got %v", field, rule, fields)
+		}
+	}
+	if len(fields) != len(expected) {
+		t.Errorf("Expected %d invalid fields, got %v", len(expected), fields)
+	}
+}
+
+func TestValidationRules_ShouldRequireFields(t *testing.T) {
+	// when
+	fields := invalidFields(t, dto.CreateExperienceRequest{})
+
+	// then
+	for _, field := range []string{"start_time", "end_time", "meditation_type", "emotion_before", "emotion_after"} {
+		if fields[field] != "required" {
+			t.Errorf("Expected %s to be required, got %v", field, fields)
+		}
+	}
+}
+
+func TestValidationRules_ShouldResolveMeditationTypeThroughCatalog(t *testing.T) {
+	for _, meditationType := range []string{"zazen", "Zazen", "座禅"} {
+		// given
+		req := validCreateExperienceRequest()
+		req.MeditationType = meditationType
+
+		// when
+		fields := invalidFields(t, req)
+
+		// then
+		if len(fields) != 0 {
+			t.Errorf("Expected %q to be accepted, got %v", meditationType, fields)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package dto
+
+import "time"
+
+// GoalProgressDTO 目標の現在の期間（連続記録の目標は現在の連続記録）での進み具合
+type GoalProgressDTO struct {
+	Current int `json:"current"`
+	Target  int `json:"target"`
+	// Percent 達成率（100が上限）
+	Percent  int  `json:"percent"`
+	Achieved bool `json:"achieved"`
+	// PeriodStart 連続記録の目標では連続記録の始まり
+	PeriodStart time.Time `json:"period_start"`
+	// PeriodEnd 連続記録の目標では連続記録を続けるための期限
+	PeriodEnd time.Time `json:"period_end"`
+}
+
+// GoalDTO 目標
+type GoalDTO struct {
+	GoalID string `json:"goal_id"`
+	// Metric minutes（瞑想した分数）、sessions（回数）、streak（瞑想した期間の連続数）
+	Metric   string `json:"metric"`
+	Period   string `json:"period"`
+	Target   int    `json:"target"`
+	TimeZone string `json:"time_zone"`
+	// LastAchievedPeriod 最後に達成した期間の始まり
+	LastAchievedPeriod *time.Time `json:"last_achieved_period,omitempty"`
+	// Progress アーカイブした目標には含まれない
+	Progress   *GoalProgressDTO `json:"progress,omitempty"`
+	Archived   bool             `json:"archived"`
+	ArchivedAt *time.Time       `json:"archived_at,omitempty"`
+	CreatedAt  time.Time        `json:"created_at"`
+}
+
+// CreateGoalRequest 目標の作成リクエスト
+type CreateGoalRequest struct {
+	UserID string `json:"-"`
+	Metric string `json:"metric" validate:"required,oneof=minutes sessions streak"`
+	Period string `json:"period" validate:"required,oneof=day week month year"`
+	Target int    `json:"target" validate:"required,min=1"`
+	// TimeZone 期間を区切るタイムゾーン（省略時は UTC）
+	TimeZone string `json:"time_zone,omitempty" validate:"max=64"`
+}
+
+// ListGoalsRequest 目標の一覧のリクエスト
+type ListGoalsRequest struct {
+	UserID          string `query:"-"`
+	IncludeArchived bool   `query:"include_archived"`
+}
+
+// ListGoalsResponse 目標の一覧（古い順）
+type ListGoalsResponse struct {
+	Goals []GoalDTO `json:"goals"`
+}
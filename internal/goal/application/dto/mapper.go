@@ -0,0 +1,31 @@
+package dto
+
+import "zen-connect/internal/goal/domain"
+
+// FromGoal converts a goal to DTO; progress is nil for archived goals
+func FromGoal(goal *domain.Goal, progress *domain.Progress) GoalDTO {
+	response := GoalDTO{
+		GoalID:     goal.ID(),
+		Metric:     string(goal.Metric()),
+		Period:     string(goal.Period()),
+		Target:     goal.Target(),
+		TimeZone:   goal.TimeZone(),
+		Archived:   goal.IsArchived(),
+		ArchivedAt: goal.ArchivedAt(),
+		CreatedAt:  goal.CreatedAt(),
+	}
+	if lastAchieved := goal.LastAchieved(); !lastAchieved.IsZero() {
+		response.LastAchievedPeriod = &lastAchieved
+	}
+	if progress != nil {
+		response.Progress = &GoalProgressDTO{
+			Current:     progress.Current,
+			Target:      progress.Target,
+			Percent:     min(100, progress.Current*100/progress.Target),
+			Achieved:    progress.Achieved(),
+			PeriodStart: progress.PeriodStart,
+			PeriodEnd:   progress.PeriodEnd,
+		}
+	}
+	return response
+}
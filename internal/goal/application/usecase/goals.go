@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/goal/application/dto"
+	"zen-connect/internal/goal/domain"
+)
+
+// GoalUseCase 練習の目標の設定・進み具合・達成のユースケース
+type GoalUseCase struct {
+	goalRepo  domain.GoalRepository
+	sessions  SessionSource
+	publisher EventPublisher
+}
+
+// NewGoalUseCase コンストラクタ
+func NewGoalUseCase(goalRepo domain.GoalRepository, sessions SessionSource, publisher EventPublisher) *GoalUseCase {
+	return &GoalUseCase{
+		goalRepo:  goalRepo,
+		sessions:  sessions,
+		publisher: publisher,
+	}
+}
+
+// Create 目標を作成（作成時点で達成していれば GoalAchieved を発行する）
+// 進行中の目標の上限はリポジトリが保存と同じトランザクションで確認する
+func (uc *GoalUseCase) Create(ctx context.Context, req *dto.CreateGoalRequest) (*dto.GoalDTO, error) {
+	now := time.Now()
+	goal, err := domain.NewGoal(req.UserID, domain.Metric(req.Metric), domain.Period(req.Period), req.Target, req.TimeZone, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.goalRepo.Create(ctx, goal); err != nil {
+		return nil, err
+	}
+
+	progress, err := uc.measure(ctx, req.UserID, []*domain.Goal{goal}, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.recordAchievement(ctx, goal, progress[0], now); err != nil {
+		return nil, err
+	}
+
+	response := dto.FromGoal(goal, &progress[0])
+	return &response, nil
+}
+
+// List 目標の一覧（進行中の目標は進み具合付き）
+func (uc *GoalUseCase) List(ctx context.Context, req *dto.ListGoalsRequest) (*dto.ListGoalsResponse, error) {
+	goals, err := uc.goalRepo.FindByUser(ctx, req.UserID, req.IncludeArchived)
+	if err != nil {
+		return nil, err
+	}
+	active := make([]*domain.Goal, 0, len(goals))
+	for _, goal := range goals {
+		if !goal.IsArchived() {
+			active = append(active, goal)
+		}
+	}
+	progress, err := uc.measure(ctx, req.UserID, active, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListGoalsResponse{
+		Goals: make([]dto.GoalDTO, 0, len(goals)),
+	}
+	measured := 0
+	for _, goal := range goals {
+		if goal.IsArchived() {
+			response.Goals = append(response.Goals, dto.FromGoal(goal, nil))
+			continue
+		}
+		response.Goals = append(response.Goals, dto.FromGoal(goal, &progress[measured]))
+		measured++
+	}
+	return response, nil
+}
+
+// Archive 目標をアーカイブ（他人の目標は見つからない扱い）
+func (uc *GoalUseCase) Archive(ctx context.Context, userID, goalID string) (*dto.GoalDTO, error) {
+	goal, err := uc.goalRepo.FindByID(ctx, goalID)
+	if err != nil {
+		return nil, err
+	}
+	if goal.UserID() != userID {
+		return nil, domain.ErrGoalNotFound
+	}
+	if err := goal.Archive(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.goalRepo.Save(ctx, goal); err != nil {
+		return nil, err
+	}
+
+	response := dto.FromGoal(goal, nil)
+	return &response, nil
+}
+
+// Evaluate 体験記録の作成・更新の後に、記録したユーザーの目標の達成を確認する
+// 同じイベントを何度処理しても、同じ期間の達成は一度しか発行しない
+func (uc *GoalUseCase) Evaluate(ctx context.Context, experienceID string) error {
+	userID, err := uc.sessions.Owner(ctx, experienceID)
+	if err != nil {
+		return err
+	}
+	return uc.EvaluateUser(ctx, userID)
+}
+
+// EvaluateUser ユーザーの目標の達成を確認する（履歴の取り込みの後は取り込みごとに一度）
+func (uc *GoalUseCase) EvaluateUser(ctx context.Context, userID string) error {
+	goals, err := uc.goalRepo.FindByUser(ctx, userID, false)
+	if err != nil || len(goals) == 0 {
+		return err
+	}
+
+	now := time.Now()
+	progress, err := uc.measure(ctx, userID, goals, now)
+	if err != nil {
+		return err
+	}
+	for i, goal := range goals {
+		if err := uc.recordAchievement(ctx, goal, progress[i], now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// measure 目標の進み具合（セッションはすべての目標に必要な範囲をまとめて読む）
+func (uc *GoalUseCase) measure(ctx context.Context, userID string, goals []*domain.Goal, now time.Time) ([]domain.Progress, error) {
+	if len(goals) == 0 {
+		return nil, nil
+	}
+	var from, to time.Time
+	for i, goal := range goals {
+		goalFrom, goalTo := goal.Window(now)
+		if i == 0 || goalFrom.Before(from) {
+			from = goalFrom
+		}
+		if i == 0 || goalTo.After(to) {
+			to = goalTo
+		}
+	}
+	sessions, err := uc.sessions.Sessions(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := make([]domain.Progress, 0, len(goals))
+	for _, goal := range goals {
+		progress = append(progress, goal.Measure(sessions, now))
+	}
+	return progress, nil
+}
+
+// recordAchievement 達成を保存し、他の処理が先に保存していなければ GoalAchieved を発行する
+func (uc *GoalUseCase) recordAchievement(ctx context.Context, goal *domain.Goal, progress domain.Progress, now time.Time) error {
+	if !goal.RecordAchievement(progress, now) {
+		return nil
+	}
+	saved, err := uc.goalRepo.SaveAchievement(ctx, goal)
+	if err != nil {
+		return err
+	}
+	if saved {
+		uc.publisher.Publish(ctx, goal.Events()...)
+	}
+	goal.ClearEvents()
+	return nil
+}
@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/goal/domain"
+)
+
+// SessionSource 体験記録コンテキストから瞑想のセッションを読むポート
+type SessionSource interface {
+	// Sessions ユーザーの [from, to) に始まったセッション（下書きを含む）
+	Sessions(ctx context.Context, userID string, from, to time.Time) ([]domain.Session, error)
+	// Owner 体験記録を記録したユーザー
+	Owner(ctx context.Context, experienceID string) (string, error)
+}
+
+// EventPublisher ドメインイベントを他のコンテキストに伝える
+// 保存後に呼ばれるため、配信の失敗はリクエストの失敗にしない
+type EventPublisher interface {
+	Publish(ctx context.Context, events ...domain.DomainEvent)
+}
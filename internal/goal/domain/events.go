@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+// DomainEvent represents a domain event interface
+type DomainEvent interface {
+	EventName() string
+	AggregateID() string
+	OccurredAt() time.Time
+}
+
+// GoalAchieved event fired when a goal's target is reached in a period, or
+// when a streak first reaches it
+type GoalAchieved struct {
+	eventName   string
+	aggregateID string
+	occurredAt  time.Time
+	userID      string
+	metric      Metric
+	period      Period
+	target      int
+	periodStart time.Time
+	value       int
+}
+
+func NewGoalAchieved(goal *Goal, periodStart time.Time, value int, achievedAt time.Time) *GoalAchieved {
+	return &GoalAchieved{
+		eventName:   "GoalAchieved",
+		aggregateID: goal.ID(),
+		occurredAt:  achievedAt,
+		userID:      goal.UserID(),
+		metric:      goal.Metric(),
+		period:      goal.Period(),
+		target:      goal.Target(),
+		periodStart: periodStart,
+		value:       value,
+	}
+}
+
+func (e *GoalAchieved) EventName() string      { return e.eventName }
+func (e *GoalAchieved) AggregateID() string    { return e.aggregateID }
+func (e *GoalAchieved) OccurredAt() time.Time  { return e.occurredAt }
+func (e *GoalAchieved) UserID() string         { return e.userID }
+func (e *GoalAchieved) Metric() Metric         { return e.metric }
+func (e *GoalAchieved) Period() Period         { return e.period }
+func (e *GoalAchieved) Target() int            { return e.target }
+func (e *GoalAchieved) PeriodStart() time.Time { return e.periodStart }
+func (e *GoalAchieved) Value() int             { return e.value }
@@ -0,0 +1,332 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Domain errors for Goal
+var (
+	ErrGoalNotFound        = errors.New("goal not found")
+	ErrInvalidGoalMetric   = errors.New("goal metric must be minutes, sessions or streak")
+	ErrInvalidGoalPeriod   = errors.New("goal period must be day, week, month or year")
+	ErrInvalidGoalTarget   = errors.New("goal target is out of range")
+	ErrInvalidGoalTimeZone = errors.New("goal time zone is not valid")
+	ErrGoalArchived        = errors.New("goal is archived")
+	ErrTooManyGoals        = errors.New("too many active goals")
+)
+
+const (
+	// MaxActiveGoals is how many goals a user can pursue at once
+	MaxActiveGoals = 20
+	// MaxSessionsTarget is the highest target of a sessions goal
+	MaxSessionsTarget = 10000
+	// MaxStreakTarget is the longest streak a goal can ask for
+	MaxStreakTarget = 1000
+)
+
+// Metric is what a goal counts
+type Metric string
+
+const (
+	// MetricMinutes counts the minutes meditated in each period
+	MetricMinutes Metric = "minutes"
+	// MetricSessions counts the sessions started in each period
+	MetricSessions Metric = "sessions"
+	// MetricStreak counts the consecutive periods with at least one session
+	MetricStreak Metric = "streak"
+)
+
+// Period is the calendar period a goal is measured over, in the goal's time zone
+type Period string
+
+const (
+	PeriodDay   Period = "day"
+	PeriodWeek  Period = "week"
+	PeriodMonth Period = "month"
+	PeriodYear  Period = "year"
+)
+
+// Start returns the start of the period holding t, in t's location; weeks
+// start on Monday
+func (p Period) Start(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	switch p {
+	case PeriodWeek:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case PeriodMonth:
+		return day.AddDate(0, 0, 1-day.Day())
+	case PeriodYear:
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+	}
+	return day
+}
+
+// Add returns the start of the period n periods after the one starting at start
+func (p Period) Add(start time.Time, n int) time.Time {
+	switch p {
+	case PeriodWeek:
+		return start.AddDate(0, 0, 7*n)
+	case PeriodMonth:
+		return start.AddDate(0, n, 0)
+	case PeriodYear:
+		return start.AddDate(n, 0, 0)
+	}
+	return start.AddDate(0, 0, n)
+}
+
+// maxMinutes is the most minutes a period can hold, so no goal asks for more
+func (p Period) maxMinutes() int {
+	switch p {
+	case PeriodWeek:
+		return 7 * 24 * 60
+	case PeriodMonth:
+		return 31 * 24 * 60
+	case PeriodYear:
+		return 366 * 24 * 60
+	}
+	return 24 * 60
+}
+
+// Session is a meditation session counted towards goals
+type Session struct {
+	StartTime time.Time
+	Duration  time.Duration
+}
+
+// Progress is how far a goal is in its current period, or along the current
+// streak for streak goals
+type Progress struct {
+	Current int
+	Target  int
+	// PeriodStart and PeriodEnd bound the period being measured; for streaks
+	// PeriodStart is the first period of the streak and PeriodEnd the end of
+	// the period it must be extended in
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	// reachedIn is the start of the period the target was reached in; zero
+	// when the target is not reached or it cannot be told when it was
+	reachedIn time.Time
+}
+
+// Achieved reports whether the target is reached
+func (p Progress) Achieved() bool {
+	return p.Current >= p.Target
+}
+
+// Goal is a target a user sets for their practice, such as 20 minutes a day
+// or 100 hours this year (aggregate root)
+type Goal struct {
+	id       string
+	userID   string
+	metric   Metric
+	period   Period
+	target   int
+	location *time.Location
+	// lastAchieved is the start of the period the goal was last achieved in
+	lastAchieved time.Time
+	archivedAt   *time.Time
+	createdAt    time.Time
+	updatedAt    time.Time
+	events       []DomainEvent
+}
+
+// NewGoal creates an active goal. An empty time zone is UTC.
+func NewGoal(userID string, metric Metric, period Period, target int, timeZone string, now time.Time) (*Goal, error) {
+	switch metric {
+	case MetricMinutes, MetricSessions, MetricStreak:
+	default:
+		return nil, ErrInvalidGoalMetric
+	}
+	switch period {
+	case PeriodDay, PeriodWeek, PeriodMonth, PeriodYear:
+	default:
+		return nil, ErrInvalidGoalPeriod
+	}
+	if target < 1 || target > maxTarget(metric, period) {
+		return nil, ErrInvalidGoalTarget
+	}
+	location, err := loadLocation(timeZone)
+	if err != nil {
+		return nil, err
+	}
+	return &Goal{
+		id:        uuid.New().String(),
+		userID:    userID,
+		metric:    metric,
+		period:    period,
+		target:    target,
+		location:  location,
+		createdAt: now,
+		updatedAt: now,
+		events:    []DomainEvent{},
+	}, nil
+}
+
+// ReconstructGoal recreates a goal from persisted data
+func ReconstructGoal(id, userID string, metric Metric, period Period, target int, timeZone string, lastAchieved time.Time, archivedAt *time.Time, createdAt, updatedAt time.Time) (*Goal, error) {
+	location, err := loadLocation(timeZone)
+	if err != nil {
+		return nil, err
+	}
+	return &Goal{
+		id:           id,
+		userID:       userID,
+		metric:       metric,
+		period:       period,
+		target:       target,
+		location:     location,
+		lastAchieved: lastAchieved,
+		archivedAt:   archivedAt,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+		events:       []DomainEvent{},
+	}, nil
+}
+
+func maxTarget(metric Metric, period Period) int {
+	switch metric {
+	case MetricMinutes:
+		return period.maxMinutes()
+	case MetricStreak:
+		return MaxStreakTarget
+	}
+	return MaxSessionsTarget
+}
+
+func loadLocation(timeZone string) (*time.Location, error) {
+	if timeZone == "" {
+		return time.UTC, nil
+	}
+	// The server's own zone means nothing to the user
+	if timeZone == "Local" {
+		return nil, ErrInvalidGoalTimeZone
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, ErrInvalidGoalTimeZone
+	}
+	return location, nil
+}
+
+func (g *Goal) ID() string              { return g.id }
+func (g *Goal) UserID() string          { return g.userID }
+func (g *Goal) Metric() Metric          { return g.metric }
+func (g *Goal) Period() Period          { return g.period }
+func (g *Goal) Target() int             { return g.target }
+func (g *Goal) TimeZone() string        { return g.location.String() }
+func (g *Goal) LastAchieved() time.Time { return g.lastAchieved }
+func (g *Goal) ArchivedAt() *time.Time  { return g.archivedAt }
+func (g *Goal) IsArchived() bool        { return g.archivedAt != nil }
+func (g *Goal) CreatedAt() time.Time    { return g.createdAt }
+func (g *Goal) UpdatedAt() time.Time    { return g.updatedAt }
+func (g *Goal) Events() []DomainEvent   { return g.events }
+
+// ClearEvents clears domain events after they have been processed
+func (g *Goal) ClearEvents() {
+	g.events = []DomainEvent{}
+}
+
+// Archive stops pursuing the goal; it keeps its history but is no longer measured
+func (g *Goal) Archive(now time.Time) error {
+	if g.IsArchived() {
+		return ErrGoalArchived
+	}
+	g.archivedAt = &now
+	g.updatedAt = now
+	return nil
+}
+
+// Window returns the range of session start times Measure needs at now
+func (g *Goal) Window(now time.Time) (from, to time.Time) {
+	current := g.period.Start(now.In(g.location))
+	to = g.period.Add(current, 1)
+	if g.metric != MetricStreak {
+		return current, to
+	}
+	// One period more than a streak that just reached the target, so it can
+	// be told whether the target was reached in the previous period
+	return g.period.Add(current, -(g.target + 1)), to
+}
+
+// Measure computes the progress at now from the sessions in Window(now)
+func (g *Goal) Measure(sessions []Session, now time.Time) Progress {
+	current := g.period.Start(now.In(g.location))
+	progress := Progress{Target: g.target, PeriodStart: current, PeriodEnd: g.period.Add(current, 1)}
+	if g.metric == MetricStreak {
+		g.measureStreak(&progress, sessions, current)
+		return progress
+	}
+
+	var total time.Duration
+	for _, session := range sessions {
+		start := session.StartTime.In(g.location)
+		if start.Before(progress.PeriodStart) || !start.Before(progress.PeriodEnd) {
+			continue
+		}
+		total += session.Duration
+		if g.metric == MetricSessions {
+			progress.Current++
+		}
+	}
+	if g.metric == MetricMinutes {
+		progress.Current = int(total / time.Minute)
+	}
+	if progress.Achieved() {
+		progress.reachedIn = current
+	}
+	return progress
+}
+
+// measureStreak counts the consecutive periods with sessions up to the
+// current period, or up to the previous one while the current has none yet
+func (g *Goal) measureStreak(progress *Progress, sessions []Session, current time.Time) {
+	from, _ := g.Window(current)
+	active := map[time.Time]bool{}
+	for _, session := range sessions {
+		start := session.StartTime.In(g.location)
+		if start.Before(from) || !start.Before(progress.PeriodEnd) {
+			continue
+		}
+		active[g.period.Start(start)] = true
+	}
+
+	last := current
+	if !active[last] {
+		last = g.period.Add(current, -1)
+	}
+	streak := 0
+	start := last
+	for period := last; active[period]; period = g.period.Add(period, -1) {
+		streak++
+		start = period
+	}
+	if streak == 0 {
+		return
+	}
+	progress.PeriodStart = start
+	progress.Current = min(streak, g.target)
+	// A streak running back to the start of the window may be longer, so
+	// when it reached the target is only known for shorter ones
+	if streak >= g.target && start.After(from) {
+		progress.reachedIn = g.period.Add(start, g.target-1)
+	}
+}
+
+// RecordAchievement marks the goal achieved in the period of the progress
+// and raises GoalAchieved, unless it was already achieved then or later.
+// Archived goals are not achieved anymore.
+func (g *Goal) RecordAchievement(progress Progress, now time.Time) bool {
+	if g.IsArchived() || !progress.Achieved() || progress.reachedIn.IsZero() {
+		return false
+	}
+	if !g.lastAchieved.IsZero() && !progress.reachedIn.After(g.lastAchieved) {
+		return false
+	}
+	g.lastAchieved = progress.reachedIn
+	g.updatedAt = now
+	g.events = append(g.events, NewGoalAchieved(g, progress.reachedIn, progress.Current, now))
+	return true
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewGoal_ShouldRejectInvalidGoals(t *testing.T) {
+	// given
+	now := time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	// when
+	_, metricErr := NewGoal("user-1", "hours", PeriodDay, 20, "", now)
+	_, periodErr := NewGoal("user-1", MetricMinutes, "decade", 20, "", now)
+	_, targetErr := NewGoal("user-1", MetricMinutes, PeriodDay, 24*60+1, "", now)
+	_, zoneErr := NewGoal("user-1", MetricSessions, PeriodWeek, 5, "Mars/Olympus", now)
+	goal, err := NewGoal("user-1", MetricMinutes, PeriodYear, 6000, "", now)
+
+	// then
+	if !errors.Is(metricErr, ErrInvalidGoalMetric) || !errors.Is(periodErr, ErrInvalidGoalPeriod) ||
+		!errors.Is(targetErr, ErrInvalidGoalTarget) || !errors.Is(zoneErr, ErrInvalidGoalTimeZone) {
+		t.Errorf("Expected the goal errors, got %v, %v, %v and %v", metricErr, periodErr, targetErr, zoneErr)
+	}
+	if err != nil || goal.TimeZone() != "UTC" {
+		t.Errorf("Expected a yearly goal in UTC, got %v", err)
+	}
+}
+
+func TestGoal_Measure_ShouldCountTheCurrentPeriodInTheGoalTimeZone(t *testing.T) {
+	// given
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	now := time.Date(2024, 3, 14, 21, 0, 0, 0, tokyo) // Thursday
+	minutes, _ := NewGoal("user-1", MetricMinutes, PeriodDay, 20, "Asia/Tokyo", now)
+	sessions, _ := NewGoal("user-1", MetricSessions, PeriodWeek, 5, "Asia/Tokyo", now)
+	history := []Session{
+		{StartTime: time.Date(2024, 3, 10, 23, 0, 0, 0, tokyo), Duration: 30 * time.Minute}, // last Sunday
+		{StartTime: time.Date(2024, 3, 11, 6, 0, 0, 0, tokyo), Duration: 15 * time.Minute},
+		{StartTime: time.Date(2024, 3, 14, 7, 0, 0, 0, tokyo), Duration: 12 * time.Minute},
+		{StartTime: time.Date(2024, 3, 14, 20, 0, 0, 0, tokyo), Duration: 8*time.Minute + 30*time.Second},
+	}
+
+	// when
+	daily := minutes.Measure(history, now)
+	weekly := sessions.Measure(history, now)
+
+	// then
+	if daily.Current != 20 || !daily.Achieved() || !daily.PeriodStart.Equal(time.Date(2024, 3, 14, 0, 0, 0, 0, tokyo)) {
+		t.Errorf("Expected 20 minutes today in Tokyo, got %+v", daily)
+	}
+	if weekly.Current != 3 || weekly.Achieved() || !weekly.PeriodEnd.Equal(time.Date(2024, 3, 18, 0, 0, 0, 0, tokyo)) {
+		t.Errorf("Expected 3 sessions in the week from Monday, got %+v", weekly)
+	}
+}
+
+func TestGoal_Measure_ShouldCountTheStreakUpToYesterday(t *testing.T) {
+	// given
+	now := time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)
+	goal, _ := NewGoal("user-1", MetricStreak, PeriodDay, 3, "", now)
+	var history []Session
+	for _, day := range []int{9, 11, 12, 13} {
+		history = append(history, Session{StartTime: time.Date(2024, 3, day, 7, 0, 0, 0, time.UTC), Duration: 10 * time.Minute})
+	}
+
+	// when
+	ongoing := goal.Measure(history, now)
+	extended := goal.Measure(append(history, Session{StartTime: now, Duration: time.Minute}), now)
+
+	// then
+	if ongoing.Current != 3 || !ongoing.PeriodStart.Equal(time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)) ||
+		!ongoing.reachedIn.Equal(time.Date(2024, 3, 13, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected a 3 day streak reached yesterday, got %+v", ongoing)
+	}
+	if extended.Current != 3 || !extended.reachedIn.Equal(ongoing.reachedIn) {
+		t.Errorf("Expected the streak to be capped and reached on the same day, got %+v", extended)
+	}
+}
+
+func TestGoal_RecordAchievement_ShouldAnnounceEachPeriodOnce(t *testing.T) {
+	// given
+	now := time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)
+	goal, _ := NewGoal("user-1", MetricSessions, PeriodDay, 1, "", now)
+	today := []Session{{StartTime: now, Duration: 10 * time.Minute}}
+	tomorrow := now.AddDate(0, 0, 1)
+
+	// when
+	first := goal.RecordAchievement(goal.Measure(today, now), now)
+	replayed := goal.RecordAchievement(goal.Measure(today, now), now)
+	next := goal.RecordAchievement(goal.Measure([]Session{{StartTime: tomorrow, Duration: time.Minute}}, tomorrow), tomorrow)
+	goal.Archive(tomorrow)
+	archived := goal.RecordAchievement(goal.Measure(today, now.AddDate(0, 0, 2)), now.AddDate(0, 0, 2))
+
+	// then
+	if !first || replayed || !next || archived {
+		t.Errorf("Expected achievements on the 14th and 15th only, got %v %v %v %v", first, replayed, next, archived)
+	}
+	if len(goal.Events()) != 2 {
+		t.Fatalf("Expected 2 GoalAchieved events, got %d", len(goal.Events()))
+	}
+	if achieved := goal.Events()[1].(*GoalAchieved); !achieved.PeriodStart().Equal(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)) || achieved.UserID() != "user-1" {
+		t.Errorf("Expected the 15th for user-1, got %v %s", achieved.PeriodStart(), achieved.UserID())
+	}
+}
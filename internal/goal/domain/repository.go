@@ -0,0 +1,19 @@
+package domain
+
+import "context"
+
+// GoalRepository defines the interface for goal persistence
+type GoalRepository interface {
+	Save(ctx context.Context, goal *Goal) error
+	FindByID(ctx context.Context, id string) (*Goal, error)
+	// FindByUser returns the user's goals, oldest first; archived goals only
+	// when includeArchived
+	FindByUser(ctx context.Context, userID string, includeArchived bool) ([]*Goal, error)
+	// Create inserts a new goal; ErrTooManyGoals if the user already pursues
+	// MaxActiveGoals goals, also when goals are created concurrently
+	Create(ctx context.Context, goal *Goal) error
+	// SaveAchievement stores the period the goal was last achieved in unless
+	// it is archived or a later achievement is stored; false when nothing
+	// changed, so a concurrent or replayed evaluation does not announce it twice
+	SaveAchievement(ctx context.Context, goal *Goal) (bool, error)
+}
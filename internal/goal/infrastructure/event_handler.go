@@ -0,0 +1,44 @@
+package infrastructure
+
+import (
+	"context"
+
+	experiencedomain "zen-connect/internal/experience/domain"
+	"zen-connect/internal/goal/application/usecase"
+	"zen-connect/internal/shared/event"
+)
+
+// EventHandler subscribes to the event bus and checks the goals of users as
+// they record and change experiences
+type EventHandler struct {
+	goals *usecase.GoalUseCase
+}
+
+// NewEventHandler creates a new goal event handler
+func NewEventHandler(goals *usecase.GoalUseCase) *EventHandler {
+	return &EventHandler{
+		goals: goals,
+	}
+}
+
+// Subscribe registers the handler for the events that change progress
+func (h *EventHandler) Subscribe(bus event.EventBus) {
+	for _, name := range []string{
+		"ExperienceCreated",
+		"ExperienceUpdated",
+		"HistoryImported",
+	} {
+		bus.Register(name, h)
+	}
+}
+
+// Handle implements event.EventHandler
+func (h *EventHandler) Handle(ctx context.Context, e event.DomainEvent) error {
+	switch e := e.(type) {
+	case *experiencedomain.ExperienceCreated, *experiencedomain.ExperienceUpdated:
+		return h.goals.Evaluate(ctx, e.AggregateID())
+	case *experiencedomain.HistoryImported:
+		return h.goals.EvaluateUser(ctx, e.UserID())
+	}
+	return nil
+}
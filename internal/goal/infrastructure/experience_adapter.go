@@ -0,0 +1,45 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	experiencedomain "zen-connect/internal/experience/domain"
+	"zen-connect/internal/goal/domain"
+)
+
+// ExperienceAdapter reads the meditation sessions of users from the
+// experience context
+type ExperienceAdapter struct {
+	experienceRepo experiencedomain.ExperienceRepository
+}
+
+// NewExperienceAdapter creates a new experience adapter
+func NewExperienceAdapter(experienceRepo experiencedomain.ExperienceRepository) *ExperienceAdapter {
+	return &ExperienceAdapter{
+		experienceRepo: experienceRepo,
+	}
+}
+
+// Sessions returns the sessions the user started in [from, to), drafts included
+func (a *ExperienceAdapter) Sessions(ctx context.Context, userID string, from, to time.Time) ([]domain.Session, error) {
+	var sessions []domain.Session
+	err := a.experienceRepo.Export(ctx, userID, from, to, func(experience *experiencedomain.Experience) error {
+		session := experience.Content().Session()
+		sessions = append(sessions, domain.Session{
+			StartTime: session.StartTime(),
+			Duration:  session.Duration(),
+		})
+		return nil
+	})
+	return sessions, err
+}
+
+// Owner returns the user who recorded the experience
+func (a *ExperienceAdapter) Owner(ctx context.Context, experienceID string) (string, error) {
+	experience, err := a.experienceRepo.FindByID(ctx, experienceID)
+	if err != nil {
+		return "", err
+	}
+	return experience.UserID(), nil
+}
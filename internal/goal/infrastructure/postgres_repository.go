@@ -0,0 +1,155 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"zen-connect/internal/goal/domain"
+)
+
+// PostgresGoalRepository implements GoalRepository interface
+type PostgresGoalRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresGoalRepository creates a new PostgreSQL goal repository
+func NewPostgresGoalRepository(pool *pgxpool.Pool) *PostgresGoalRepository {
+	return &PostgresGoalRepository{
+		pool: pool,
+	}
+}
+
+const goalColumns = `id, user_id, metric, period, target, time_zone, last_achieved_period, archived_at, created_at, updated_at`
+
+// execer is satisfied by both the pool and a transaction
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
+// Save upserts a goal. The last achievement is only written by
+// SaveAchievement, so a save cannot undo a concurrent achievement.
+func (r *PostgresGoalRepository) Save(ctx context.Context, goal *domain.Goal) error {
+	return saveGoal(ctx, r.pool, goal)
+}
+
+// Create inserts a goal after counting the user's active goals in the same
+// transaction. Creates of the same user are serialized with an advisory lock
+// so that two concurrent creates cannot both pass the count.
+func (r *PostgresGoalRepository) Create(ctx context.Context, goal *domain.Goal) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('goal_create:' || $1))`, goal.UserID()); err != nil {
+			return err
+		}
+		var active int
+		if err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM goals WHERE user_id = $1 AND archived_at IS NULL`, goal.UserID()).Scan(&active); err != nil {
+			return err
+		}
+		if active >= domain.MaxActiveGoals {
+			return domain.ErrTooManyGoals
+		}
+		return saveGoal(ctx, tx, goal)
+	})
+}
+
+// saveGoal upserts a goal through the pool or a transaction
+func saveGoal(ctx context.Context, db execer, goal *domain.Goal) error {
+	query := `
+		INSERT INTO goals (` + goalColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE SET
+			archived_at = EXCLUDED.archived_at,
+			updated_at = EXCLUDED.updated_at
+	`
+	_, err := db.Exec(ctx, query,
+		goal.ID(),
+		goal.UserID(),
+		string(goal.Metric()),
+		string(goal.Period()),
+		goal.Target(),
+		goal.TimeZone(),
+		nullTime(goal.LastAchieved()),
+		goal.ArchivedAt(),
+		goal.CreatedAt(),
+		goal.UpdatedAt(),
+	)
+	return err
+}
+
+// FindByID returns a goal by ID
+func (r *PostgresGoalRepository) FindByID(ctx context.Context, id string) (*domain.Goal, error) {
+	goal, err := scanGoal(r.pool.QueryRow(ctx, `SELECT `+goalColumns+` FROM goals WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrGoalNotFound
+		}
+		return nil, err
+	}
+	return goal, nil
+}
+
+// FindByUser returns the user's goals, oldest first
+func (r *PostgresGoalRepository) FindByUser(ctx context.Context, userID string, includeArchived bool) ([]*domain.Goal, error) {
+	query := `SELECT ` + goalColumns + ` FROM goals WHERE user_id = $1`
+	if !includeArchived {
+		query += ` AND archived_at IS NULL`
+	}
+	query += ` ORDER BY created_at, id`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var goals []*domain.Goal
+	for rows.Next() {
+		goal, err := scanGoal(rows)
+		if err != nil {
+			return nil, err
+		}
+		goals = append(goals, goal)
+	}
+	return goals, rows.Err()
+}
+
+// SaveAchievement moves the last achievement forward only, so of concurrent
+// or replayed evaluations of the same period just one succeeds
+func (r *PostgresGoalRepository) SaveAchievement(ctx context.Context, goal *domain.Goal) (bool, error) {
+	query := `
+		UPDATE goals SET last_achieved_period = $2, updated_at = $3
+		WHERE id = $1 AND archived_at IS NULL
+			AND (last_achieved_period IS NULL OR last_achieved_period < $2)
+	`
+	tag, err := r.pool.Exec(ctx, query, goal.ID(), goal.LastAchieved(), goal.UpdatedAt())
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// scanGoal reconstructs a goal from a result row
+func scanGoal(row pgx.Row) (*domain.Goal, error) {
+	var id, userID, metric, period, timeZone string
+	var target int
+	var lastAchieved, archivedAt *time.Time
+	var createdAt, updatedAt time.Time
+	if err := row.Scan(&id, &userID, &metric, &period, &target, &timeZone, &lastAchieved, &archivedAt, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	var lastAchievedPeriod time.Time
+	if lastAchieved != nil {
+		lastAchievedPeriod = *lastAchieved
+	}
+	return domain.ReconstructGoal(id, userID, domain.Metric(metric), domain.Period(period), target, timeZone, lastAchievedPeriod, archivedAt, createdAt, updatedAt)
+}
+
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,64 @@
+package interfaces
+
+import (
+	"net/http"
+	"strconv"
+
+	"zen-connect/internal/goal/domain"
+	"zen-connect/internal/infrastructure/problem"
+)
+
+// ErrorMappings 目標ドメインのエラーとHTTPレスポンスの対応
+func ErrorMappings() []problem.Mapping {
+	return []problem.Mapping{
+		{
+			Err: domain.ErrGoalNotFound, Status: http.StatusNotFound, Code: "goal_not_found",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "目標が見つかりません。",
+				problem.LanguageEnglish:  "The goal was not found.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidGoalMetric, Status: http.StatusBadRequest, Code: "invalid_goal_metric",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "目標の種類は minutes、sessions、streak のいずれかを指定してください。",
+				problem.LanguageEnglish:  "The metric must be minutes, sessions or streak.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidGoalPeriod, Status: http.StatusBadRequest, Code: "invalid_goal_period",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "目標の期間は day、week、month、year のいずれかを指定してください。",
+				problem.LanguageEnglish:  "The period must be day, week, month or year.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidGoalTarget, Status: http.StatusBadRequest, Code: "invalid_goal_target",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "目標の値が大きすぎます。期間の長さを超える分数や、" + strconv.Itoa(domain.MaxSessionsTarget) + "回を超える回数、" + strconv.Itoa(domain.MaxStreakTarget) + "を超える連続記録は設定できません。",
+				problem.LanguageEnglish:  "The target is too large. It cannot exceed the minutes in the period, " + strconv.Itoa(domain.MaxSessionsTarget) + " sessions or a streak of " + strconv.Itoa(domain.MaxStreakTarget) + ".",
+			},
+		},
+		{
+			Err: domain.ErrInvalidGoalTimeZone, Status: http.StatusBadRequest, Code: "invalid_time_zone",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "タイムゾーンが正しくありません。",
+				problem.LanguageEnglish:  "The time zone is not valid.",
+			},
+		},
+		{
+			Err: domain.ErrGoalArchived, Status: http.StatusConflict, Code: "goal_archived",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "この目標はすでにアーカイブされています。",
+				problem.LanguageEnglish:  "The goal is already archived.",
+			},
+		},
+		{
+			Err: domain.ErrTooManyGoals, Status: http.StatusConflict, Code: "too_many_goals",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "目標が多すぎます。使っていない目標をアーカイブしてください。",
+				problem.LanguageEnglish:  "You have too many goals. Archive the ones you no longer pursue.",
+			},
+		},
+	}
+}
@@ -0,0 +1,147 @@
+package interfaces
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/goal/application/dto"
+	"zen-connect/internal/goal/application/usecase"
+	"zen-connect/internal/goal/domain"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/shared/openapi"
+)
+
+// GoalHandler 練習の目標のHTTPハンドラー
+type GoalHandler struct {
+	goalUseCase *usecase.GoalUseCase
+}
+
+// NewGoalHandler コンストラクタ
+func NewGoalHandler(goalUseCase *usecase.GoalUseCase) *GoalHandler {
+	return &GoalHandler{
+		goalUseCase: goalUseCase,
+	}
+}
+
+// SetupRoutes 目標関連のルーティング設定
+func (h *GoalHandler) SetupRoutes(e *echo.Echo, sessionMiddleware *session.Middleware) {
+	goalGroup := e.Group("/goals", sessionMiddleware.RequireAuth())
+
+	goalGroup.GET("", h.ListGoals)
+	goalGroup.POST("", h.CreateGoal)
+	// アーカイブした目標は進み具合を計算せず、達成もしない
+	goalGroup.POST("/:id/archive", h.ArchiveGoal)
+}
+
+// Endpoints OpenAPIドキュメント用のエンドポイント定義
+func (h *GoalHandler) Endpoints() []openapi.Endpoint {
+	security := []string{openapi.SecuritySession}
+	tags := []string{"goals"}
+	return []openapi.Endpoint{
+		{
+			Method: http.MethodGet, Path: "/goals", Tags: tags,
+			Summary: "List your goals with their progress, oldest first",
+			Description: "progress is measured in the current period of each goal in its time zone. " +
+				"For streak goals current is the number of consecutive periods with a session up to this one (or the previous one while this one has none yet), " +
+				"counted up to the target; period_end is when the streak breaks without another session.",
+			Security: security,
+			Query: []openapi.Parameter{
+				{Name: "include_archived", Description: "Also list archived goals, without progress", Schema: &openapi.Schema{Type: "boolean"}},
+			},
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.ListGoalsResponse{},
+				http.StatusBadRequest:   nil,
+				http.StatusUnauthorized: nil,
+			},
+		},
+		{
+			Method: http.MethodPost, Path: "/goals", Tags: tags,
+			Summary: "Set a goal",
+			Description: "metric is minutes or sessions per period, or streak for a number of consecutive periods with at least one session. " +
+				"Periods are calendar days, weeks (from Monday), months and years in time_zone (default UTC). " +
+				"A GoalAchieved event is raised once for every period the target is reached in, or once for every streak that reaches it. " +
+				"You can have up to " + strconv.Itoa(domain.MaxActiveGoals) + " goals that are not archived.",
+			Security: security,
+			Request:  dto.CreateGoalRequest{},
+			Responses: map[int]interface{}{
+				http.StatusCreated:      dto.GoalDTO{},
+				http.StatusBadRequest:   nil,
+				http.StatusUnauthorized: nil,
+				http.StatusConflict:     nil,
+			},
+		},
+		{
+			Method: http.MethodPost, Path: "/goals/:id/archive", Tags: tags,
+			Summary:  "Archive a goal",
+			Security: security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.GoalDTO{},
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+				http.StatusConflict:     nil,
+			},
+		},
+	}
+}
+
+// ListGoals 目標の一覧
+func (h *GoalHandler) ListGoals(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.ListGoalsRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeBadRequest)
+	}
+	req.UserID = userID
+
+	response, err := h.goalUseCase.List(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// CreateGoal 目標を作成
+func (h *GoalHandler) CreateGoal(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.CreateGoalRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.UserID = userID
+
+	response, err := h.goalUseCase.Create(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// ArchiveGoal 目標をアーカイブ
+func (h *GoalHandler) ArchiveGoal(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.goalUseCase.Archive(c.Request().Context(), userID, c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/auth0/go-jwt-middleware/v2/jwks"
 	"github.com/auth0/go-jwt-middleware/v2/validator"
 	"github.com/labstack/echo/v4"
+	"zen-connect/internal/infrastructure/problem"
 )
 
 // AuthMiddleware provides JWT authentication middleware for Auth0
@@ -67,41 +68,31 @@ func (m *AuthMiddleware) RequireAuth() echo.MiddlewareFunc {
 			// Extract token from Authorization header
 			authHeader := c.Request().Header.Get("Authorization")
 			if authHeader == "" {
-				return c.JSON(http.StatusUnauthorized, map[string]string{
-					"error": "Authorization header is required",
-				})
+				return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
 			}
 
 			// Check Bearer prefix
 			if !strings.HasPrefix(authHeader, "Bearer ") {
-				return c.JSON(http.StatusUnauthorized, map[string]string{
-					"error": "Authorization header must start with 'Bearer '",
-				})
+				return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
 			}
 
 			// Extract token
 			token := strings.TrimPrefix(authHeader, "Bearer ")
 			if token == "" {
-				return c.JSON(http.StatusUnauthorized, map[string]string{
-					"error": "Token is required",
-				})
+				return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
 			}
 
 			// Validate token
 			validatedClaims, err := m.validator.ValidateToken(c.Request().Context(), token)
 			if err != nil {
 				log.Printf("Token validation failed: %v", err)
-				return c.JSON(http.StatusUnauthorized, map[string]string{
-					"error": "Invalid token",
-				})
+				return problem.Wrap(err, http.StatusUnauthorized, problem.CodeUnauthenticated)
 			}
 
 			// Extract custom claims
 			customClaims, ok := validatedClaims.(*validator.ValidatedClaims)
 			if !ok {
-				return c.JSON(http.StatusUnauthorized, map[string]string{
-					"error": "Invalid token claims",
-				})
+				return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
 			}
 
 			// Add user info to context
@@ -0,0 +1,178 @@
+package config
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/infrastructure/auth0"
+	"zen-connect/internal/infrastructure/logger"
+	"zen-connect/internal/infrastructure/mail"
+	"zen-connect/internal/infrastructure/postgres"
+	"zen-connect/internal/infrastructure/ratelimit"
+	"zen-connect/internal/infrastructure/security"
+	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/infrastructure/webpush"
+)
+
+// LoggerConfig converts the log settings into a logger configuration
+func (c *Config) LoggerConfig() *logger.Config {
+	return &logger.Config{
+		Level:          logger.ParseLogLevel(c.Log.Level),
+		Format:         logger.ParseLogFormat(c.Log.Format),
+		Output:         logger.ParseLogOutput(c.Log.Output),
+		FilePath:       c.Log.FilePath,
+		MaxSize:        c.Log.MaxSize,
+		MaxAge:         c.Log.MaxAge,
+		MaxBackups:     c.Log.MaxBackups,
+		SamplingRate:   c.Log.SamplingRate,
+		MaskPasswords:  c.Log.MaskPasswords,
+		MaskTokens:     c.Log.MaskTokens,
+		MaskEmails:     logger.ParseMaskingLevel(c.Log.MaskEmails),
+		ServiceName:    c.Service.Name,
+		ServiceVersion: c.Service.Version,
+		Environment:    c.Service.Environment,
+	}
+}
+
+// PostgresConfig converts the database settings into a PostgreSQL client configuration
+func (c *Config) PostgresConfig() postgres.Config {
+	return postgres.Config{
+		URL:             c.Database.URL.Value(),
+		MaxConns:        int32(c.Database.MaxConns),
+		MinConns:        int32(c.Database.MinConns),
+		MaxConnLifetime: c.Database.MaxConnLifetime,
+		MaxConnIdleTime: c.Database.MaxConnIdleTime,
+	}
+}
+
+// SessionConfig converts the session settings into a cookie store configuration
+func (c *Config) SessionConfig() session.Config {
+	var sameSite http.SameSite
+	switch c.Session.CookieSameSite {
+	case "strict":
+		sameSite = http.SameSiteStrictMode
+	case "none":
+		sameSite = http.SameSiteNoneMode
+	default:
+		sameSite = http.SameSiteLaxMode
+	}
+
+	return session.Config{
+		Secret:         c.Session.Secret.Value(),
+		CookieName:     c.Session.CookieName,
+		CookieDomain:   c.Session.CookieDomain,
+		CookiePath:     c.Session.CookiePath,
+		CookieSecure:   c.Session.CookieSecure,
+		CookieHTTPOnly: c.Session.CookieHTTPOnly,
+		CookieSameSite: sameSite,
+		MaxAge:         c.Session.MaxAge,
+	}
+}
+
+// Auth0Config converts the Auth0 settings into an Auth0 client configuration
+func (c *Config) Auth0Config() *auth0.Config {
+	return &auth0.Config{
+		Domain:       c.Auth0.Domain,
+		ClientID:     c.Auth0.ClientID,
+		ClientSecret: c.Auth0.ClientSecret.Value(),
+		Audience:     c.Auth0.Audience,
+		CallbackURL:  c.Server.CallbackURL(),
+	}
+}
+
+// CORSConfig converts the CORS settings into a CORS middleware configuration
+func (c *Config) CORSConfig() security.CORSConfig {
+	return security.CORSConfig{
+		AllowOrigins:     c.Server.CORS.AllowOrigins,
+		AllowMethods:     c.Server.CORS.AllowMethods,
+		AllowHeaders:     c.Server.CORS.AllowHeaders,
+		ExposeHeaders:    c.Server.CORS.ExposeHeaders,
+		AllowCredentials: c.Server.CORS.AllowCredentials,
+		MaxAge:           c.Server.CORS.MaxAge,
+	}
+}
+
+// SecurityHeadersConfig converts the security settings into a headers middleware configuration
+func (c *Config) SecurityHeadersConfig() security.HeadersConfig {
+	return security.HeadersConfig{
+		HSTSMaxAge:            c.Server.Security.HSTSMaxAge,
+		HSTSIncludeSubdomains: c.Server.Security.HSTSIncludeSubdomains,
+		HSTSPreload:           c.Server.Security.HSTSPreload,
+		ContentSecurityPolicy: c.Server.Security.ContentSecurityPolicy,
+		FrameOptions:          c.Server.Security.FrameOptions,
+		ReferrerPolicy:        c.Server.Security.ReferrerPolicy,
+		ContentTypeNosniff:    c.Server.Security.ContentTypeNosniff,
+	}
+}
+
+// IPExtractor returns how the client IP is determined. X-Forwarded-For is
+// only honoured when the request comes from a trusted proxy, so clients
+// cannot choose the IP that rate limits and logs see.
+func (c *Config) IPExtractor() echo.IPExtractor {
+	if len(c.Server.TrustedProxies) == 0 {
+		return echo.ExtractIPDirect()
+	}
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, proxy := range c.Server.TrustedProxies {
+		if _, ipNet, err := net.ParseCIDR(proxy); err == nil {
+			options = append(options, echo.TrustIPRange(ipNet))
+		}
+	}
+	return echo.ExtractIPFromXFFHeader(options...)
+}
+
+// APIRateLimit returns the general per-route rate limit
+func (c *Config) APIRateLimit() ratelimit.Limit {
+	return ratelimit.Limit{
+		Requests: c.RateLimit.Requests,
+		Period:   c.RateLimit.Period,
+		Burst:    c.RateLimit.Burst,
+	}
+}
+
+// LoginRateLimit returns the stricter rate limit for login endpoints
+func (c *Config) LoginRateLimit() ratelimit.Limit {
+	return ratelimit.Limit{
+		Requests: c.RateLimit.LoginRequests,
+		Period:   c.RateLimit.LoginPeriod,
+		Burst:    c.RateLimit.LoginBurst,
+	}
+}
+
+// MailSMTPConfig converts the mail settings into an SMTP sender configuration
+func (c *Config) MailSMTPConfig() mail.SMTPConfig {
+	return mail.SMTPConfig{
+		Host:     c.Mail.SMTPHost,
+		Port:     c.Mail.SMTPPort,
+		Username: c.Mail.SMTPUsername,
+		Password: c.Mail.SMTPPassword.Value(),
+	}
+}
+
+// WeeklySummaryLocation returns the time zone of the weekly summary
+func (c *Config) WeeklySummaryLocation() *time.Location {
+	location, err := time.LoadLocation(c.Mail.WeeklySummaryTimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// MailWorkerConfig converts the mail settings into a queue worker configuration
+func (c *Config) MailWorkerConfig() mail.WorkerConfig {
+	return mail.WorkerConfig{
+		MaxAttempts: c.Mail.MaxAttempts,
+		Backoff:     c.Mail.RetryBackoff,
+	}
+}
+
+// PushVAPID creates the VAPID identity of the server from the push settings
+func (c *Config) PushVAPID() (*webpush.VAPID, error) {
+	return webpush.NewVAPID(c.Push.VAPIDPrivateKey.Value(), c.Push.VAPIDSubject)
+}
@@ -0,0 +1,435 @@
+// Package config loads and validates the application configuration.
+//
+// Values are resolved in three layers: built-in defaults, an optional YAML
+// file, and finally environment variables. Every field that can be set from
+// the environment declares its variable name with an `env` struct tag; a
+// variable that is set to an empty value clears the field.
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment names
+const (
+	EnvDevelopment = "development"
+	EnvStaging     = "staging"
+	EnvProduction  = "production"
+)
+
+// Config is the root application configuration
+type Config struct {
+	Service     ServiceConfig     `yaml:"service"`
+	Server      ServerConfig      `yaml:"server"`
+	Database    DatabaseConfig    `yaml:"database"`
+	Auth0       Auth0Config       `yaml:"auth0"`
+	Session     SessionConfig     `yaml:"session"`
+	RateLimit   RateLimitConfig   `yaml:"rate_limit"`
+	Idempotency IdempotencyConfig `yaml:"idempotency"`
+	Meditation  MeditationConfig  `yaml:"meditation"`
+	Rooms       RoomConfig        `yaml:"rooms"`
+	Admin       AdminConfig       `yaml:"admin"`
+	Moderation  ModerationConfig  `yaml:"moderation"`
+	Mail        MailConfig        `yaml:"mail"`
+	Push        PushConfig        `yaml:"push"`
+	Webhook     WebhookConfig     `yaml:"webhook"`
+	Scheduler   SchedulerConfig   `yaml:"scheduler"`
+	Log         LogConfig         `yaml:"log"`
+
+	// loadProblems holds values that could not be parsed while loading
+	loadProblems []string
+}
+
+// ServiceConfig identifies the running service
+type ServiceConfig struct {
+	Name        string `yaml:"name" env:"SERVICE_NAME"`
+	Version     string `yaml:"version" env:"SERVICE_VERSION"`
+	Environment string `yaml:"environment" env:"ENVIRONMENT"`
+}
+
+// ServerConfig holds HTTP server settings
+type ServerConfig struct {
+	Port            int           `yaml:"port" env:"PORT"`
+	APIURL          string        `yaml:"api_url" env:"API_URL"`
+	FrontendURL     string        `yaml:"frontend_url" env:"FRONTEND_URL"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT"`
+	// TrustedProxies are the CIDR ranges of the reverse proxies whose
+	// X-Forwarded-For header is trusted. When empty, the client IP is the
+	// address of the connection and forwarding headers are ignored.
+	TrustedProxies []string       `yaml:"trusted_proxies" env:"TRUSTED_PROXIES"`
+	CORS           CORSConfig     `yaml:"cors"`
+	Security       SecurityConfig `yaml:"security"`
+}
+
+// CORSConfig holds cross-origin settings for browser clients.
+// Origins may use a wildcard subdomain such as https://*.example.com.
+type CORSConfig struct {
+	AllowOrigins     []string      `yaml:"allow_origins" env:"CORS_ALLOW_ORIGINS"`
+	AllowMethods     []string      `yaml:"allow_methods" env:"CORS_ALLOW_METHODS"`
+	AllowHeaders     []string      `yaml:"allow_headers" env:"CORS_ALLOW_HEADERS"`
+	ExposeHeaders    []string      `yaml:"expose_headers" env:"CORS_EXPOSE_HEADERS"`
+	AllowCredentials bool          `yaml:"allow_credentials" env:"CORS_ALLOW_CREDENTIALS"`
+	MaxAge           time.Duration `yaml:"max_age" env:"CORS_MAX_AGE"`
+}
+
+// SecurityConfig holds response security header settings.
+// Empty values disable the corresponding header.
+type SecurityConfig struct {
+	HSTSMaxAge            time.Duration `yaml:"hsts_max_age" env:"SECURITY_HSTS_MAX_AGE"`
+	HSTSIncludeSubdomains bool          `yaml:"hsts_include_subdomains" env:"SECURITY_HSTS_INCLUDE_SUBDOMAINS"`
+	HSTSPreload           bool          `yaml:"hsts_preload" env:"SECURITY_HSTS_PRELOAD"`
+	ContentSecurityPolicy string        `yaml:"content_security_policy" env:"SECURITY_CONTENT_SECURITY_POLICY"`
+	FrameOptions          string        `yaml:"frame_options" env:"SECURITY_FRAME_OPTIONS"`
+	ReferrerPolicy        string        `yaml:"referrer_policy" env:"SECURITY_REFERRER_POLICY"`
+	ContentTypeNosniff    bool          `yaml:"content_type_nosniff" env:"SECURITY_CONTENT_TYPE_NOSNIFF"`
+}
+
+// DatabaseConfig holds PostgreSQL settings
+type DatabaseConfig struct {
+	URL             Secret        `yaml:"url" env:"DATABASE_URL"`
+	MaxConns        int           `yaml:"max_conns" env:"DATABASE_MAX_CONNS"`
+	MinConns        int           `yaml:"min_conns" env:"DATABASE_MIN_CONNS"`
+	MaxConnLifetime time.Duration `yaml:"max_conn_lifetime" env:"DATABASE_MAX_CONN_LIFETIME"`
+	MaxConnIdleTime time.Duration `yaml:"max_conn_idle_time" env:"DATABASE_MAX_CONN_IDLE_TIME"`
+	AutoMigrate     bool          `yaml:"auto_migrate" env:"DATABASE_AUTO_MIGRATE"`
+}
+
+// Auth0Config holds Auth0 tenant settings
+type Auth0Config struct {
+	Domain       string `yaml:"domain" env:"AUTH0_DOMAIN"`
+	ClientID     string `yaml:"client_id" env:"AUTH0_CLIENT_ID"`
+	ClientSecret Secret `yaml:"client_secret" env:"AUTH0_CLIENT_SECRET"`
+	Audience     string `yaml:"audience" env:"AUTH0_AUDIENCE"`
+}
+
+// SessionConfig holds session cookie settings
+type SessionConfig struct {
+	Secret         Secret `yaml:"secret" env:"SESSION_SECRET"`
+	CookieName     string `yaml:"cookie_name" env:"SESSION_COOKIE_NAME"`
+	CookieDomain   string `yaml:"cookie_domain" env:"SESSION_COOKIE_DOMAIN"`
+	CookiePath     string `yaml:"cookie_path" env:"SESSION_COOKIE_PATH"`
+	CookieSecure   bool   `yaml:"cookie_secure" env:"SESSION_COOKIE_SECURE"`
+	CookieHTTPOnly bool   `yaml:"cookie_http_only" env:"SESSION_COOKIE_HTTP_ONLY"`
+	CookieSameSite string `yaml:"cookie_same_site" env:"SESSION_COOKIE_SAME_SITE"`
+	MaxAge         int    `yaml:"max_age" env:"SESSION_MAX_AGE"` // seconds
+}
+
+// RateLimitConfig holds request throttling settings. General limits apply
+// per route and user (or client IP); login limits apply per client across
+// all login endpoints and lock the client out when exceeded.
+type RateLimitConfig struct {
+	Enabled       bool          `yaml:"enabled" env:"RATE_LIMIT_ENABLED"`
+	Requests      int           `yaml:"requests" env:"RATE_LIMIT_REQUESTS"`
+	Period        time.Duration `yaml:"period" env:"RATE_LIMIT_PERIOD"`
+	Burst         int           `yaml:"burst" env:"RATE_LIMIT_BURST"`
+	LoginRequests int           `yaml:"login_requests" env:"RATE_LIMIT_LOGIN_REQUESTS"`
+	LoginPeriod   time.Duration `yaml:"login_period" env:"RATE_LIMIT_LOGIN_PERIOD"`
+	LoginBurst    int           `yaml:"login_burst" env:"RATE_LIMIT_LOGIN_BURST"`
+	LoginLockout  time.Duration `yaml:"login_lockout" env:"RATE_LIMIT_LOGIN_LOCKOUT"`
+}
+
+// IdempotencyConfig holds Idempotency-Key settings
+type IdempotencyConfig struct {
+	TTL time.Duration `yaml:"ttl" env:"IDEMPOTENCY_TTL"`
+}
+
+// MeditationConfig holds meditation catalog settings
+type MeditationConfig struct {
+	// CatalogCacheTTL is how long other instances may serve a stale catalog
+	CatalogCacheTTL time.Duration `yaml:"catalog_cache_ttl" env:"MEDITATION_CATALOG_CACHE_TTL"`
+	// TimerAbandonTimeout is how long a live timer may go without activity
+	// before the server finishes it
+	TimerAbandonTimeout time.Duration `yaml:"timer_abandon_timeout" env:"MEDITATION_TIMER_ABANDON_TIMEOUT"`
+	// TimerSweepInterval is how often abandoned timers are looked for
+	TimerSweepInterval time.Duration `yaml:"timer_sweep_interval" env:"MEDITATION_TIMER_SWEEP_INTERVAL"`
+}
+
+// RoomConfig holds group meditation room settings
+type RoomConfig struct {
+	// StartCountdown is how long after the start event a room actually
+	// starts, so every participant receives it in time
+	StartCountdown time.Duration `yaml:"start_countdown" env:"ROOM_START_COUNTDOWN"`
+	// MaxParticipants is the number of users that may join a room at once
+	MaxParticipants int `yaml:"max_participants" env:"ROOM_MAX_PARTICIPANTS"`
+}
+
+// AdminConfig holds administrator settings
+type AdminConfig struct {
+	// UserIDs are the internal user IDs allowed to use the admin API
+	UserIDs []string `yaml:"user_ids" env:"ADMIN_USER_IDS"`
+}
+
+// ModerationConfig holds content moderation settings
+type ModerationConfig struct {
+	// BlockedKeywords reject notes, journals and comments that contain them
+	// (case-insensitive, full-width and half-width forms match alike)
+	BlockedKeywords []string `yaml:"blocked_keywords" env:"MODERATION_BLOCKED_KEYWORDS"`
+	// SuspensionCacheTTL is how long other instances may let a newly
+	// suspended account through
+	SuspensionCacheTTL time.Duration `yaml:"suspension_cache_ttl" env:"MODERATION_SUSPENSION_CACHE_TTL"`
+}
+
+// MailConfig holds email delivery settings
+type MailConfig struct {
+	// Enabled turns on the email channel, account emails and the weekly summary
+	Enabled bool `yaml:"enabled" env:"MAIL_ENABLED"`
+	// Sender is smtp, or file to write .eml files to FileDir during development
+	Sender          string `yaml:"sender" env:"MAIL_SENDER"`
+	From            string `yaml:"from" env:"MAIL_FROM"`
+	DefaultLanguage string `yaml:"default_language" env:"MAIL_DEFAULT_LANGUAGE"`
+	FileDir         string `yaml:"file_dir" env:"MAIL_FILE_DIR"`
+	SMTPHost        string `yaml:"smtp_host" env:"MAIL_SMTP_HOST"`
+	SMTPPort        int    `yaml:"smtp_port" env:"MAIL_SMTP_PORT"`
+	SMTPUsername    string `yaml:"smtp_username" env:"MAIL_SMTP_USERNAME"`
+	SMTPPassword    Secret `yaml:"smtp_password" env:"MAIL_SMTP_PASSWORD"`
+	// MaxAttempts is how often a message is tried before it is given up
+	MaxAttempts int `yaml:"max_attempts" env:"MAIL_MAX_ATTEMPTS"`
+	// RetryBackoff is the wait after the first failure; it doubles with every attempt
+	RetryBackoff time.Duration `yaml:"retry_backoff" env:"MAIL_RETRY_BACKOFF"`
+	// PollInterval is how often the queue is checked for messages to send
+	PollInterval time.Duration `yaml:"poll_interval" env:"MAIL_POLL_INTERVAL"`
+	// DigestInterval is how long notifications are collected into one digest email
+	DigestInterval time.Duration `yaml:"digest_interval" env:"MAIL_DIGEST_INTERVAL"`
+	// WeeklySummaryTimeZone is the IANA time zone whose Monday-to-Sunday weeks
+	// and calendar days the weekly summary counts
+	WeeklySummaryTimeZone string `yaml:"weekly_summary_time_zone" env:"MAIL_WEEKLY_SUMMARY_TIME_ZONE"`
+	// UnsubscribeSecret signs the unsubscribe links; changing it invalidates sent links
+	UnsubscribeSecret Secret `yaml:"unsubscribe_secret" env:"MAIL_UNSUBSCRIBE_SECRET"`
+}
+
+// PushConfig holds Web Push notification settings
+type PushConfig struct {
+	// Enabled turns on the push channel and the subscription endpoints
+	Enabled bool `yaml:"enabled" env:"PUSH_ENABLED"`
+	// VAPIDPrivateKey identifies the server to push services; changing it
+	// invalidates every browser subscription (generate one with "push keys")
+	VAPIDPrivateKey Secret `yaml:"vapid_private_key" env:"PUSH_VAPID_PRIVATE_KEY"`
+	// VAPIDSubject is a mailto: or https: contact for push service operators
+	VAPIDSubject string `yaml:"vapid_subject" env:"PUSH_VAPID_SUBJECT"`
+	// TTL is how long push services keep a message for an offline browser
+	TTL time.Duration `yaml:"ttl" env:"PUSH_TTL"`
+}
+
+// WebhookConfig holds outgoing webhook delivery settings
+type WebhookConfig struct {
+	// MaxAttempts is how often an event is sent before the delivery is given up
+	MaxAttempts int `yaml:"max_attempts" env:"WEBHOOK_MAX_ATTEMPTS"`
+	// RetryBackoff is the wait after the first failure; it doubles with every attempt, up to 6 hours
+	RetryBackoff time.Duration `yaml:"retry_backoff" env:"WEBHOOK_RETRY_BACKOFF"`
+	// Timeout bounds a single request to a receiver
+	Timeout time.Duration `yaml:"timeout" env:"WEBHOOK_TIMEOUT"`
+	// PollInterval is how often due deliveries are looked for
+	PollInterval time.Duration `yaml:"poll_interval" env:"WEBHOOK_POLL_INTERVAL"`
+	// AllowPrivateNetworks lets webhooks reach loopback and private addresses;
+	// only for development, as it lets users probe the internal network
+	AllowPrivateNetworks bool `yaml:"allow_private_networks" env:"WEBHOOK_ALLOW_PRIVATE_NETWORKS"`
+	// DeliveryRetention is how long finished deliveries stay in the log
+	DeliveryRetention time.Duration `yaml:"delivery_retention" env:"WEBHOOK_DELIVERY_RETENTION"`
+}
+
+// SchedulerConfig holds background job settings
+type SchedulerConfig struct {
+	// PollInterval is how often due jobs are looked for
+	PollInterval time.Duration `yaml:"poll_interval" env:"SCHEDULER_POLL_INTERVAL"`
+	// RetryBackoff is the wait before a failed job is retried; it doubles with every failure
+	RetryBackoff time.Duration `yaml:"retry_backoff" env:"SCHEDULER_RETRY_BACKOFF"`
+	// LockKey is the Postgres advisory lock the instances compete for;
+	// deployments sharing a database need different keys
+	LockKey int64 `yaml:"lock_key" env:"SCHEDULER_LOCK_KEY"`
+}
+
+// LogConfig holds logger settings
+type LogConfig struct {
+	Level         string  `yaml:"level" env:"LOG_LEVEL"`
+	Format        string  `yaml:"format" env:"LOG_FORMAT"`
+	Output        string  `yaml:"output" env:"LOG_OUTPUT"`
+	FilePath      string  `yaml:"file_path" env:"LOG_FILE_PATH"`
+	MaxSize       int     `yaml:"max_size" env:"LOG_MAX_SIZE"` // MB
+	MaxAge        int     `yaml:"max_age" env:"LOG_MAX_AGE"`   // days
+	MaxBackups    int     `yaml:"max_backups" env:"LOG_MAX_BACKUPS"`
+	SamplingRate  float64 `yaml:"sampling_rate" env:"LOG_SAMPLING_RATE"`
+	MaskPasswords bool    `yaml:"mask_passwords" env:"LOG_MASK_PASSWORDS"`
+	MaskTokens    bool    `yaml:"mask_tokens" env:"LOG_MASK_TOKENS"`
+	MaskEmails    string  `yaml:"mask_emails" env:"LOG_MASK_EMAILS"`
+}
+
+// Default returns the configuration used when nothing else is specified
+func Default() *Config {
+	return &Config{
+		Service: ServiceConfig{
+			Name:        "zen-connect",
+			Version:     "1.0.0",
+			Environment: EnvDevelopment,
+		},
+		Server: ServerConfig{
+			Port:            8080,
+			APIURL:          "http://localhost:8080",
+			FrontendURL:     "http://localhost:3000",
+			ShutdownTimeout: 10 * time.Second,
+			CORS: CORSConfig{
+				AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+				AllowHeaders:     []string{"Accept", "Authorization", "Content-Type", "X-Requested-With", "X-CSRF-Token"},
+				ExposeHeaders:    []string{"X-Request-ID"},
+				AllowCredentials: true,
+				MaxAge:           24 * time.Hour,
+			},
+			Security: SecurityConfig{
+				HSTSMaxAge:            365 * 24 * time.Hour,
+				HSTSIncludeSubdomains: true,
+				ContentSecurityPolicy: "default-src 'none'; frame-ancestors 'none'",
+				FrameOptions:          "DENY",
+				ReferrerPolicy:        "strict-origin-when-cross-origin",
+				ContentTypeNosniff:    true,
+			},
+		},
+		Database: DatabaseConfig{
+			MaxConns:        30,
+			MinConns:        5,
+			MaxConnLifetime: time.Hour,
+			MaxConnIdleTime: 30 * time.Minute,
+		},
+		Session: SessionConfig{
+			CookieName:     "zen_session",
+			CookieDomain:   "localhost",
+			CookiePath:     "/",
+			CookieSecure:   false,
+			CookieHTTPOnly: true,
+			CookieSameSite: "lax",
+			MaxAge:         86400, // 24 hours
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:       true,
+			Requests:      120,
+			Period:        time.Minute,
+			Burst:         30,
+			LoginRequests: 10,
+			LoginPeriod:   time.Minute,
+			LoginBurst:    5,
+			LoginLockout:  15 * time.Minute,
+		},
+		Idempotency: IdempotencyConfig{
+			TTL: 24 * time.Hour,
+		},
+		Meditation: MeditationConfig{
+			CatalogCacheTTL:     5 * time.Minute,
+			TimerAbandonTimeout: time.Hour,
+			TimerSweepInterval:  time.Minute,
+		},
+		Rooms: RoomConfig{
+			StartCountdown:  5 * time.Second,
+			MaxParticipants: 50,
+		},
+		Moderation: ModerationConfig{
+			SuspensionCacheTTL: 30 * time.Second,
+		},
+		Mail: MailConfig{
+			Sender:          "smtp",
+			From:            "ZenConnect <no-reply@localhost>",
+			DefaultLanguage: "ja",
+			FileDir:         "tmp/mail",
+			SMTPPort:        587,
+			MaxAttempts:     5,
+			RetryBackoff:    time.Minute,
+			PollInterval:    10 * time.Second,
+			DigestInterval:  time.Hour,
+
+			WeeklySummaryTimeZone: "UTC",
+		},
+		Push: PushConfig{
+			TTL: 24 * time.Hour,
+		},
+		Webhook: WebhookConfig{
+			MaxAttempts:       10,
+			RetryBackoff:      30 * time.Second,
+			Timeout:           10 * time.Second,
+			PollInterval:      5 * time.Second,
+			DeliveryRetention: 30 * 24 * time.Hour,
+		},
+		Scheduler: SchedulerConfig{
+			PollInterval: 5 * time.Second,
+			RetryBackoff: time.Minute,
+			LockKey:      7305063,
+		},
+		Log: LogConfig{
+			Level:         "info",
+			Format:        "console",
+			Output:        "stdout",
+			FilePath:      "/var/log/zenconnect.log",
+			MaxSize:       100,
+			MaxAge:        30,
+			MaxBackups:    10,
+			SamplingRate:  1.0,
+			MaskPasswords: true,
+			MaskTokens:    true,
+			MaskEmails:    "partial",
+		},
+	}
+}
+
+// Load builds the configuration from defaults, the optional YAML file at path
+// and the process environment. It does not validate the result; call Validate.
+func Load(path string) (*Config, error) {
+	return load(path, os.LookupEnv)
+}
+
+func load(path string, lookup func(string) (string, bool)) (*Config, error) {
+	cfg := Default()
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config file: %w", err)
+		}
+		if err := yaml.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+		}
+	}
+
+	cfg.loadProblems = applyEnv(cfg, lookup)
+
+	// CORS follows the frontend unless origins are configured explicitly
+	if len(cfg.Server.CORS.AllowOrigins) == 0 && cfg.Server.FrontendURL != "" {
+		cfg.Server.CORS.AllowOrigins = []string{cfg.Server.FrontendURL}
+	}
+
+	return cfg, nil
+}
+
+// IsProduction returns true when running in the production environment
+func (c *Config) IsProduction() bool {
+	return c.Service.Environment == EnvProduction
+}
+
+// Addr returns the listen address for the HTTP server
+func (c *ServerConfig) Addr() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+// CallbackURL returns the OAuth callback URL registered with Auth0
+func (c *ServerConfig) CallbackURL() string {
+	return strings.TrimRight(c.APIURL, "/") + "/auth/callback"
+}
+
+// Redacted renders the configuration as YAML with every secret masked
+func (c *Config) Redacted() string {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Sprintf("failed to render config: %v", err)
+	}
+	return string(data)
+}
+
+// isAbsoluteURL reports whether raw is an absolute http(s) URL
+func isAbsoluteURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
@@ -0,0 +1,40 @@
+package config
+
+// redactedValue replaces secret values whenever configuration is printed
+const redactedValue = "***MASKED***"
+
+// Secret is a configuration value that must never be printed or logged
+type Secret string
+
+// Value returns the underlying secret value
+func (s Secret) Value() string {
+	return string(s)
+}
+
+// IsSet returns true if the secret has a value
+func (s Secret) IsSet() bool {
+	return s != ""
+}
+
+// String masks the secret so that it is safe to print
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redactedValue
+}
+
+// GoString masks the secret when formatted with %#v
+func (s Secret) GoString() string {
+	return s.String()
+}
+
+// MarshalJSON masks the secret when encoded as JSON
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + s.String() + `"`), nil
+}
+
+// MarshalYAML masks the secret when encoded as YAML
+func (s Secret) MarshalYAML() (interface{}, error) {
+	return s.String(), nil
+}
@@ -0,0 +1,369 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"zen-connect/internal/infrastructure/mail"
+	"zen-connect/internal/infrastructure/security"
+	"zen-connect/internal/infrastructure/webpush"
+)
+
+// ValidationError reports every configuration problem found at once
+type ValidationError struct {
+	Problems []string
+}
+
+// Error joins all problems into a single message
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid configuration (%d problems):\n  - %s", len(e.Problems), strings.Join(e.Problems, "\n  - "))
+}
+
+// problems collects validation failures
+type problems []string
+
+func (p *problems) add(format string, args ...interface{}) {
+	*p = append(*p, fmt.Sprintf(format, args...))
+}
+
+func (p problems) err() error {
+	if len(p) == 0 {
+		return nil
+	}
+	return &ValidationError{Problems: p}
+}
+
+// Validate checks the whole configuration and reports all problems together
+func (c *Config) Validate() error {
+	p := problems(append([]string{}, c.loadProblems...))
+
+	c.Service.validate(&p)
+	c.Server.validate(&p)
+	c.Database.validate(&p)
+	c.Auth0.validate(&p)
+	c.Session.validate(&p, c.IsProduction())
+	c.RateLimit.validate(&p)
+	if c.Idempotency.TTL <= 0 {
+		p.add("IDEMPOTENCY_TTL must be positive (got %s)", c.Idempotency.TTL)
+	}
+	if c.Meditation.CatalogCacheTTL <= 0 {
+		p.add("MEDITATION_CATALOG_CACHE_TTL must be positive (got %s)", c.Meditation.CatalogCacheTTL)
+	}
+	if c.Meditation.TimerAbandonTimeout <= 0 {
+		p.add("MEDITATION_TIMER_ABANDON_TIMEOUT must be positive (got %s)", c.Meditation.TimerAbandonTimeout)
+	}
+	if c.Meditation.TimerSweepInterval <= 0 {
+		p.add("MEDITATION_TIMER_SWEEP_INTERVAL must be positive (got %s)", c.Meditation.TimerSweepInterval)
+	}
+	if c.Rooms.StartCountdown < 0 {
+		p.add("ROOM_START_COUNTDOWN must not be negative (got %s)", c.Rooms.StartCountdown)
+	}
+	if c.Rooms.MaxParticipants <= 0 {
+		p.add("ROOM_MAX_PARTICIPANTS must be positive (got %d)", c.Rooms.MaxParticipants)
+	}
+	for _, id := range c.Admin.UserIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			p.add("ADMIN_USER_IDS must contain user UUIDs (got %q)", id)
+		}
+	}
+	if c.Moderation.SuspensionCacheTTL <= 0 {
+		p.add("MODERATION_SUSPENSION_CACHE_TTL must be positive (got %s)", c.Moderation.SuspensionCacheTTL)
+	}
+	c.Mail.validate(&p)
+	c.Push.validate(&p)
+	c.Webhook.validate(&p)
+	if c.Scheduler.PollInterval <= 0 {
+		p.add("SCHEDULER_POLL_INTERVAL must be positive (got %s)", c.Scheduler.PollInterval)
+	}
+	if c.Scheduler.RetryBackoff <= 0 {
+		p.add("SCHEDULER_RETRY_BACKOFF must be positive (got %s)", c.Scheduler.RetryBackoff)
+	}
+	c.Log.validate(&p)
+
+	return p.err()
+}
+
+// ValidateDatabase checks only the settings needed to connect to the database
+func (c *Config) ValidateDatabase() error {
+	p := problems(append([]string{}, c.loadProblems...))
+	c.Database.validate(&p)
+	return p.err()
+}
+
+func (c *ServiceConfig) validate(p *problems) {
+	if c.Name == "" {
+		p.add("SERVICE_NAME is required")
+	}
+	switch c.Environment {
+	case EnvDevelopment, EnvStaging, EnvProduction:
+	default:
+		p.add("ENVIRONMENT must be one of development, staging, production (got %q)", c.Environment)
+	}
+}
+
+func (c *ServerConfig) validate(p *problems) {
+	if c.Port < 1 || c.Port > 65535 {
+		p.add("PORT must be between 1 and 65535 (got %d)", c.Port)
+	}
+	if !isAbsoluteURL(c.APIURL) {
+		p.add("API_URL must be an absolute http(s) URL (got %q)", c.APIURL)
+	}
+	if !isAbsoluteURL(c.FrontendURL) {
+		p.add("FRONTEND_URL must be an absolute http(s) URL (got %q)", c.FrontendURL)
+	}
+	if c.ShutdownTimeout <= 0 {
+		p.add("SHUTDOWN_TIMEOUT must be positive (got %s)", c.ShutdownTimeout)
+	}
+	for _, proxy := range c.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil {
+			p.add("TRUSTED_PROXIES must contain CIDR ranges (got %q)", proxy)
+		}
+	}
+	c.CORS.validate(p)
+	c.Security.validate(p)
+}
+
+func (c *CORSConfig) validate(p *problems) {
+	for _, origin := range c.AllowOrigins {
+		if origin == "*" {
+			if c.AllowCredentials {
+				p.add("CORS_ALLOW_ORIGINS cannot contain \"*\" when CORS_ALLOW_CREDENTIALS is true")
+			}
+			continue
+		}
+		if _, err := security.NewOriginMatcher([]string{origin}); err != nil {
+			p.add("CORS_ALLOW_ORIGINS contains an invalid origin %q", origin)
+		}
+	}
+	for _, method := range c.AllowMethods {
+		switch method {
+		case "GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS":
+		default:
+			p.add("CORS_ALLOW_METHODS contains an unsupported method %q", method)
+		}
+	}
+	if c.MaxAge < 0 {
+		p.add("CORS_MAX_AGE must not be negative (got %s)", c.MaxAge)
+	}
+}
+
+func (c *SecurityConfig) validate(p *problems) {
+	if c.HSTSMaxAge < 0 {
+		p.add("SECURITY_HSTS_MAX_AGE must not be negative (got %s)", c.HSTSMaxAge)
+	}
+	if c.HSTSPreload && (c.HSTSMaxAge < 365*24*time.Hour || !c.HSTSIncludeSubdomains) {
+		p.add("SECURITY_HSTS_PRELOAD requires SECURITY_HSTS_MAX_AGE of at least 1 year and SECURITY_HSTS_INCLUDE_SUBDOMAINS")
+	}
+	switch c.FrameOptions {
+	case "", "DENY", "SAMEORIGIN":
+	default:
+		p.add("SECURITY_FRAME_OPTIONS must be DENY, SAMEORIGIN or empty (got %q)", c.FrameOptions)
+	}
+	switch c.ReferrerPolicy {
+	case "", "no-referrer", "no-referrer-when-downgrade", "origin", "origin-when-cross-origin",
+		"same-origin", "strict-origin", "strict-origin-when-cross-origin", "unsafe-url":
+	default:
+		p.add("SECURITY_REFERRER_POLICY is not a valid Referrer-Policy (got %q)", c.ReferrerPolicy)
+	}
+}
+
+func (c *DatabaseConfig) validate(p *problems) {
+	if !c.URL.IsSet() {
+		p.add("DATABASE_URL is required")
+	} else if !strings.HasPrefix(c.URL.Value(), "postgres://") && !strings.HasPrefix(c.URL.Value(), "postgresql://") {
+		p.add("DATABASE_URL must be a postgres:// URL")
+	}
+	if c.MaxConns < 1 {
+		p.add("DATABASE_MAX_CONNS must be at least 1 (got %d)", c.MaxConns)
+	}
+	if c.MinConns < 0 || c.MinConns > c.MaxConns {
+		p.add("DATABASE_MIN_CONNS must be between 0 and DATABASE_MAX_CONNS (got %d)", c.MinConns)
+	}
+}
+
+func (c *Auth0Config) validate(p *problems) {
+	if c.Domain == "" {
+		p.add("AUTH0_DOMAIN is required")
+	} else if strings.Contains(c.Domain, "://") {
+		p.add("AUTH0_DOMAIN must be a host name without scheme (got %q)", c.Domain)
+	}
+	if c.ClientID == "" {
+		p.add("AUTH0_CLIENT_ID is required")
+	}
+	if !c.ClientSecret.IsSet() {
+		p.add("AUTH0_CLIENT_SECRET is required")
+	}
+	if c.Audience == "" {
+		p.add("AUTH0_AUDIENCE is required")
+	}
+}
+
+func (c *SessionConfig) validate(p *problems, production bool) {
+	if !c.Secret.IsSet() {
+		p.add("SESSION_SECRET is required")
+	} else if len(c.Secret.Value()) != 32 {
+		p.add("SESSION_SECRET must be exactly 32 bytes (got %d)", len(c.Secret.Value()))
+	}
+	if c.CookieName == "" {
+		p.add("SESSION_COOKIE_NAME is required")
+	}
+	switch c.CookieSameSite {
+	case "lax", "strict":
+	case "none":
+		if !c.CookieSecure {
+			p.add("SESSION_COOKIE_SECURE must be true when SESSION_COOKIE_SAME_SITE is none")
+		}
+	default:
+		p.add("SESSION_COOKIE_SAME_SITE must be one of lax, strict, none (got %q)", c.CookieSameSite)
+	}
+	if c.MaxAge < 1 {
+		p.add("SESSION_MAX_AGE must be positive (got %d)", c.MaxAge)
+	}
+	if production && !c.CookieSecure {
+		p.add("SESSION_COOKIE_SECURE must be true in production")
+	}
+}
+
+func (c *RateLimitConfig) validate(p *problems) {
+	if !c.Enabled {
+		return
+	}
+	if c.Requests < 1 {
+		p.add("RATE_LIMIT_REQUESTS must be at least 1 (got %d)", c.Requests)
+	}
+	if c.Period <= 0 {
+		p.add("RATE_LIMIT_PERIOD must be positive (got %s)", c.Period)
+	}
+	if c.Burst < 0 {
+		p.add("RATE_LIMIT_BURST must not be negative (got %d)", c.Burst)
+	}
+	if c.LoginRequests < 1 {
+		p.add("RATE_LIMIT_LOGIN_REQUESTS must be at least 1 (got %d)", c.LoginRequests)
+	}
+	if c.LoginPeriod <= 0 {
+		p.add("RATE_LIMIT_LOGIN_PERIOD must be positive (got %s)", c.LoginPeriod)
+	}
+	if c.LoginBurst < 0 {
+		p.add("RATE_LIMIT_LOGIN_BURST must not be negative (got %d)", c.LoginBurst)
+	}
+	if c.LoginLockout < 0 {
+		p.add("RATE_LIMIT_LOGIN_LOCKOUT must not be negative (got %s)", c.LoginLockout)
+	}
+}
+
+func (c *MailConfig) validate(p *problems) {
+	if !c.Enabled {
+		return
+	}
+	switch c.Sender {
+	case "smtp":
+		if c.SMTPHost == "" {
+			p.add("MAIL_SMTP_HOST is required when MAIL_SENDER is smtp")
+		}
+		if c.SMTPPort < 1 || c.SMTPPort > 65535 {
+			p.add("MAIL_SMTP_PORT must be between 1 and 65535 (got %d)", c.SMTPPort)
+		}
+	case "file":
+		if c.FileDir == "" {
+			p.add("MAIL_FILE_DIR is required when MAIL_SENDER is file")
+		}
+	default:
+		p.add("MAIL_SENDER must be one of smtp, file (got %q)", c.Sender)
+	}
+	if _, err := mail.ParseAddress(c.From); err != nil {
+		p.add("MAIL_FROM must be a mail address such as \"ZenConnect <no-reply@example.com>\" (got %q)", c.From)
+	}
+	switch c.DefaultLanguage {
+	case "ja", "en":
+	default:
+		p.add("MAIL_DEFAULT_LANGUAGE must be one of ja, en (got %q)", c.DefaultLanguage)
+	}
+	if c.MaxAttempts < 1 {
+		p.add("MAIL_MAX_ATTEMPTS must be at least 1 (got %d)", c.MaxAttempts)
+	}
+	if c.RetryBackoff <= 0 {
+		p.add("MAIL_RETRY_BACKOFF must be positive (got %s)", c.RetryBackoff)
+	}
+	if c.PollInterval <= 0 {
+		p.add("MAIL_POLL_INTERVAL must be positive (got %s)", c.PollInterval)
+	}
+	if c.DigestInterval <= 0 {
+		p.add("MAIL_DIGEST_INTERVAL must be positive (got %s)", c.DigestInterval)
+	}
+	if _, err := time.LoadLocation(c.WeeklySummaryTimeZone); err != nil || c.WeeklySummaryTimeZone == "" || c.WeeklySummaryTimeZone == "Local" {
+		p.add("MAIL_WEEKLY_SUMMARY_TIME_ZONE must be an IANA time zone such as Asia/Tokyo (got %q)", c.WeeklySummaryTimeZone)
+	}
+	if !c.UnsubscribeSecret.IsSet() {
+		p.add("MAIL_UNSUBSCRIBE_SECRET is required when MAIL_ENABLED is true")
+	} else if len(c.UnsubscribeSecret.Value()) < 32 {
+		p.add("MAIL_UNSUBSCRIBE_SECRET must be at least 32 bytes (got %d)", len(c.UnsubscribeSecret.Value()))
+	}
+}
+
+func (c *WebhookConfig) validate(p *problems) {
+	if c.MaxAttempts < 1 {
+		p.add("WEBHOOK_MAX_ATTEMPTS must be at least 1 (got %d)", c.MaxAttempts)
+	}
+	if c.RetryBackoff <= 0 {
+		p.add("WEBHOOK_RETRY_BACKOFF must be positive (got %s)", c.RetryBackoff)
+	}
+	if c.Timeout <= 0 || c.Timeout > time.Minute {
+		p.add("WEBHOOK_TIMEOUT must be positive and at most 1m (got %s)", c.Timeout)
+	}
+	if c.PollInterval <= 0 {
+		p.add("WEBHOOK_POLL_INTERVAL must be positive (got %s)", c.PollInterval)
+	}
+	if c.DeliveryRetention < 24*time.Hour {
+		p.add("WEBHOOK_DELIVERY_RETENTION must be at least 24h (got %s)", c.DeliveryRetention)
+	}
+}
+
+func (c *PushConfig) validate(p *problems) {
+	if !c.Enabled {
+		return
+	}
+	if !c.VAPIDPrivateKey.IsSet() {
+		p.add("PUSH_VAPID_PRIVATE_KEY is required when PUSH_ENABLED is true")
+	} else if _, err := webpush.NewVAPID(c.VAPIDPrivateKey.Value(), c.VAPIDSubject); err != nil {
+		p.add("PUSH_VAPID_PRIVATE_KEY must be a base64url P-256 private key (generate one with \"push keys\")")
+	}
+	if !strings.HasPrefix(c.VAPIDSubject, "mailto:") && !strings.HasPrefix(c.VAPIDSubject, "https://") {
+		p.add("PUSH_VAPID_SUBJECT must be a mailto: or https:// contact (got %q)", c.VAPIDSubject)
+	}
+	if c.TTL <= 0 || c.TTL > 28*24*time.Hour {
+		p.add("PUSH_TTL must be positive and at most 4 weeks (got %s)", c.TTL)
+	}
+}
+
+func (c *LogConfig) validate(p *problems) {
+	switch c.Level {
+	case "debug", "info", "warn", "error", "fatal", "panic":
+	default:
+		p.add("LOG_LEVEL must be one of debug, info, warn, error, fatal, panic (got %q)", c.Level)
+	}
+	switch c.Format {
+	case "json", "console":
+	default:
+		p.add("LOG_FORMAT must be one of json, console (got %q)", c.Format)
+	}
+	switch c.Output {
+	case "stdout", "stderr":
+	case "file":
+		if c.FilePath == "" {
+			p.add("LOG_FILE_PATH is required when LOG_OUTPUT is file")
+		}
+	default:
+		p.add("LOG_OUTPUT must be one of stdout, stderr, file (got %q)", c.Output)
+	}
+	if c.SamplingRate < 0 || c.SamplingRate > 1 {
+		p.add("LOG_SAMPLING_RATE must be between 0.0 and 1.0 (got %v)", c.SamplingRate)
+	}
+	switch c.MaskEmails {
+	case "none", "partial", "full":
+	default:
+		p.add("LOG_MASK_EMAILS must be one of none, partial, full (got %q)", c.MaskEmails)
+	}
+}
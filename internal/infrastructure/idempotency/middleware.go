@@ -0,0 +1,162 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"zen-connect/internal/infrastructure/logger"
+	"zen-connect/internal/infrastructure/problem"
+)
+
+// Idempotency headers
+const (
+	HeaderIdempotencyKey = "Idempotency-Key"
+	HeaderReplayed       = "Idempotent-Replayed"
+)
+
+// maxKeyLength is the longest accepted Idempotency-Key value
+const maxKeyLength = 255
+
+// Config configures the idempotency middleware
+type Config struct {
+	Store Store
+	// TTL is how long a key is remembered
+	TTL time.Duration
+	// Scope returns the owner of the key so that clients cannot collide;
+	// typically the authenticated user ID
+	Scope func(c echo.Context) string
+}
+
+// Middleware returns a middleware that honours the Idempotency-Key header.
+//
+// The first request with a key is executed and its response recorded; a
+// repeated request with the same key and body receives the recorded
+// response. Reusing a key with a different body is rejected with 422, and a
+// duplicate that arrives while the first is still running gets 409.
+// Requests without the header are passed through unchanged.
+//
+// The outcome is stored even when the client has disconnected, since that is
+// when it is most likely to retry, and a key whose handler panics is released.
+func Middleware(config Config) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := c.Request().Header.Get(HeaderIdempotencyKey)
+			if key == "" {
+				return next(c)
+			}
+			if len(key) > maxKeyLength {
+				return problem.New(http.StatusBadRequest, problem.CodeIdempotencyKeyInvalid)
+			}
+
+			ctx := c.Request().Context()
+			logCtx := logger.WithContext(ctx)
+			storeCtx := context.WithoutCancel(ctx)
+
+			body, err := io.ReadAll(c.Request().Body)
+			if err != nil {
+				return err
+			}
+			c.Request().Body = io.NopCloser(bytes.NewReader(body))
+
+			record := Record{
+				Scope:       config.Scope(c),
+				Key:         key,
+				Fingerprint: fingerprint(c.Request(), body),
+				ExpiresAt:   time.Now().Add(config.TTL),
+			}
+
+			existing, err := config.Store.Begin(ctx, record)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				return replay(c, record, existing)
+			}
+
+			defer func() {
+				if r := recover(); r != nil {
+					if err := config.Store.Release(storeCtx, record.Scope, record.Key); err != nil {
+						logCtx.Error("Failed to release idempotency key", zap.Error(err))
+					}
+					panic(r)
+				}
+			}()
+
+			// Execute the handler while recording the response
+			recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = recorder
+			handlerErr := next(c)
+			if handlerErr != nil {
+				// Render the error now so that its response can be recorded;
+				// it is still returned for upstream logging
+				c.Error(handlerErr)
+			}
+
+			status := c.Response().Status
+			if status >= http.StatusInternalServerError || !c.Response().Committed {
+				// Server failures are not recorded so that the client can retry
+				if err := config.Store.Release(storeCtx, record.Scope, record.Key); err != nil {
+					logCtx.Error("Failed to release idempotency key", zap.Error(err))
+				}
+				return handlerErr
+			}
+
+			response := Response{
+				StatusCode:  status,
+				ContentType: c.Response().Header().Get(echo.HeaderContentType),
+				Body:        recorder.body.Bytes(),
+			}
+			if err := config.Store.Complete(storeCtx, record.Scope, record.Key, response); err != nil {
+				logCtx.Error("Failed to store idempotent response", zap.Error(err))
+			}
+			return handlerErr
+		}
+	}
+}
+
+// replay answers a request whose key has been seen before
+func replay(c echo.Context, record Record, existing *Record) error {
+	if existing.Fingerprint != record.Fingerprint {
+		logger.GetGlobalLogger().LogSecurityEvent(c.Request().Context(), "idempotency_key_reused", "low",
+			"Idempotency-Key reused with a different request",
+			zap.String("path", c.Request().URL.Path),
+		)
+		return problem.New(http.StatusUnprocessableEntity, problem.CodeIdempotencyKeyReused)
+	}
+
+	if existing.Status != StatusCompleted || existing.Response == nil {
+		return problem.New(http.StatusConflict, problem.CodeIdempotencyInProgress)
+	}
+
+	c.Response().Header().Set(HeaderReplayed, "true")
+	return c.Blob(existing.Response.StatusCode, existing.Response.ContentType, existing.Response.Body)
+}
+
+// fingerprint hashes the parts of the request that must match on replay
+func fingerprint(req *http.Request, body []byte) string {
+	h := sha256.New()
+	io.WriteString(h, req.Method)
+	h.Write([]byte{0})
+	io.WriteString(h, req.URL.Path)
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder copies the response body while it is written
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
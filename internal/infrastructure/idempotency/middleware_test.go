@@ -0,0 +1,229 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"zen-connect/internal/infrastructure/problem"
+)
+
+func newIdempotentServer(store Store, calls *int32, status int) *echo.Echo {
+	e := echo.New()
+	e.HTTPErrorHandler = problem.NewErrorHandler(problem.Config{})
+	e.POST("/experiences", func(c echo.Context) error {
+		n := atomic.AddInt32(calls, 1)
+		if status >= http.StatusInternalServerError {
+			return echo.NewHTTPError(status, "boom")
+		}
+		return c.JSON(status, map[string]int32{"call": n})
+	}, Middleware(Config{
+		Store: store,
+		TTL:   time.Hour,
+		Scope: func(c echo.Context) string { return c.Request().Header.Get("X-Test-User") },
+	}))
+	return e
+}
+
+func post(e *echo.Echo, key, user, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/experiences", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("X-Test-User", user)
+	if key != "" {
+		req.Header.Set(HeaderIdempotencyKey, key)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddleware_ShouldReplayDuplicateRequest(t *testing.T) {
+	// given
+	var calls int32
+	e := newIdempotentServer(NewMemoryStore(), &calls, http.StatusCreated)
+	first := post(e, "key-1", "alice", `{"note":"a"}`)
+
+	// when
+	second := post(e, "key-1", "alice", `{"note":"a"}`)
+
+	// then
+	if calls != 1 {
+		t.Errorf("Expected handler to run once, ran %d times", calls)
+	}
+	if second.Code != http.StatusCreated || second.Body.String() != first.Body.String() {
+		t.Errorf("Expected replayed response %d %q, got %d %q", first.Code, first.Body, second.Code, second.Body)
+	}
+	if second.Header().Get(HeaderReplayed) != "true" {
+		t.Error("Expected replayed response to be marked")
+	}
+	if !strings.HasPrefix(second.Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		t.Errorf("Expected JSON content type, got %q", second.Header().Get(echo.HeaderContentType))
+	}
+}
+
+func TestMiddleware_ShouldRejectKeyReuseWithDifferentBody(t *testing.T) {
+	// given
+	var calls int32
+	e := newIdempotentServer(NewMemoryStore(), &calls, http.StatusCreated)
+	post(e, "key-1", "alice", `{"note":"a"}`)
+
+	// when
+	rec := post(e, "key-1", "alice", `{"note":"b"}`)
+
+	// then
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected status 422, got %d", rec.Code)
+	}
+	if calls != 1 {
+		t.Errorf("Expected handler to run once, ran %d times", calls)
+	}
+}
+
+func TestMiddleware_ShouldScopeKeysAndPassThroughWithoutKey(t *testing.T) {
+	// given
+	var calls int32
+	e := newIdempotentServer(NewMemoryStore(), &calls, http.StatusCreated)
+	post(e, "key-1", "alice", `{}`)
+
+	// when
+	post(e, "key-1", "bob", `{}`)
+	post(e, "", "alice", `{}`)
+	post(e, "", "alice", `{}`)
+
+	// then
+	if calls != 4 {
+		t.Errorf("Expected handler to run 4 times, ran %d times", calls)
+	}
+}
+
+func TestMiddleware_ShouldRejectConcurrentDuplicate(t *testing.T) {
+	// given
+	store := NewMemoryStore()
+	store.Begin(context.Background(), Record{Scope: "alice", Key: "key-1", Fingerprint: fingerprint(httptest.NewRequest(http.MethodPost, "/experiences", nil), []byte(`{}`)), ExpiresAt: time.Now().Add(time.Hour)})
+	var calls int32
+	e := newIdempotentServer(store, &calls, http.StatusCreated)
+
+	// when
+	rec := post(e, "key-1", "alice", `{}`)
+
+	// then
+	if rec.Code != http.StatusConflict {
+		t.Errorf("Expected status 409, got %d", rec.Code)
+	}
+	if calls != 0 {
+		t.Error("Expected handler not to run")
+	}
+}
+
+func TestMiddleware_ShouldAllowRetryAfterServerError(t *testing.T) {
+	// given
+	var calls int32
+	e := newIdempotentServer(NewMemoryStore(), &calls, http.StatusInternalServerError)
+	first := post(e, "key-1", "alice", `{}`)
+
+	// when
+	second := post(e, "key-1", "alice", `{}`)
+
+	// then
+	if first.Code != http.StatusInternalServerError || second.Code != http.StatusInternalServerError {
+		t.Errorf("Expected both attempts to reach the handler, got %d and %d", first.Code, second.Code)
+	}
+	if calls != 2 {
+		t.Errorf("Expected handler to run twice, ran %d times", calls)
+	}
+}
+
+// cancelAwareStore fails writes made with a cancelled context, like a database would
+type cancelAwareStore struct {
+	*MemoryStore
+}
+
+func (s cancelAwareStore) Complete(ctx context.Context, scope, key string, response Response) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return s.MemoryStore.Complete(ctx, scope, key, response)
+}
+
+func (s cancelAwareStore) Release(ctx context.Context, scope, key string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return s.MemoryStore.Release(ctx, scope, key)
+}
+
+func TestMiddleware_ShouldRecordResponseAfterClientDisconnects(t *testing.T) {
+	// given
+	var calls int32
+	store := cancelAwareStore{NewMemoryStore()}
+	e := echo.New()
+	e.POST("/experiences", func(c echo.Context) error {
+		atomic.AddInt32(&calls, 1)
+		c.Get("cancel").(context.CancelFunc)()
+		return c.JSON(http.StatusCreated, map[string]string{"id": "1"})
+	}, func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx, cancel := context.WithCancel(c.Request().Context())
+			c.SetRequest(c.Request().WithContext(ctx))
+			c.Set("cancel", cancel)
+			return next(c)
+		}
+	}, Middleware(Config{Store: store, TTL: time.Hour, Scope: func(echo.Context) string { return "alice" }}))
+	post(e, "key-1", "alice", `{}`)
+
+	// when
+	retry := post(e, "key-1", "alice", `{}`)
+
+	// then
+	if retry.Code != http.StatusCreated || retry.Header().Get(HeaderReplayed) != "true" {
+		t.Errorf("Expected the recorded response to be replayed, got %d", retry.Code)
+	}
+	if calls != 1 {
+		t.Errorf("Expected handler to run once, ran %d times", calls)
+	}
+}
+
+func TestMiddleware_ShouldReleaseKeyWhenHandlerPanics(t *testing.T) {
+	// given
+	var calls int32
+	e := echo.New()
+	e.Use(middleware.Recover())
+	e.POST("/experiences", func(c echo.Context) error {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			panic("boom")
+		}
+		return c.NoContent(http.StatusCreated)
+	}, Middleware(Config{Store: NewMemoryStore(), TTL: time.Hour, Scope: func(echo.Context) string { return "alice" }}))
+	first := post(e, "key-1", "alice", `{}`)
+
+	// when
+	retry := post(e, "key-1", "alice", `{}`)
+
+	// then
+	if first.Code != http.StatusInternalServerError || retry.Code != http.StatusCreated {
+		t.Errorf("Expected the retry to run after the panic, got %d and %d", first.Code, retry.Code)
+	}
+}
+
+func TestMemoryStore_ShouldForgetExpiredKeys(t *testing.T) {
+	// given
+	store := NewMemoryStore()
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	store.Begin(context.Background(), Record{Scope: "alice", Key: "key-1", Fingerprint: "a", ExpiresAt: now.Add(time.Minute)})
+
+	// when
+	store.now = func() time.Time { return now.Add(2 * time.Minute) }
+	existing, _ := store.Begin(context.Background(), Record{Scope: "alice", Key: "key-1", Fingerprint: "b", ExpiresAt: now.Add(time.Hour)})
+
+	// then
+	if existing != nil {
+		t.Error("Expected expired key to be reusable")
+	}
+}
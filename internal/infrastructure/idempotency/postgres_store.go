@@ -0,0 +1,128 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresStore is a Store backed by the idempotency_keys table
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore creates a PostgreSQL idempotency store
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{
+		pool: pool,
+	}
+}
+
+// maxBeginAttempts bounds how often Begin retries a key that keeps being
+// released between its insert and lookup
+const maxBeginAttempts = 3
+
+// Begin claims scope/key unless an unexpired record exists. Expired
+// records are taken over in the same statement.
+func (s *PostgresStore) Begin(ctx context.Context, record Record) (*Record, error) {
+	for attempt := 1; ; attempt++ {
+		existing, err := s.begin(ctx, record)
+		if errors.Is(err, pgx.ErrNoRows) && attempt < maxBeginAttempts {
+			// Released between the insert and the lookup; claim it again
+			continue
+		}
+		return existing, err
+	}
+}
+
+// begin makes one attempt to claim scope/key; pgx.ErrNoRows when the
+// existing record disappeared before it could be read
+func (s *PostgresStore) begin(ctx context.Context, record Record) (*Record, error) {
+	query := `
+		INSERT INTO idempotency_keys (scope, key, fingerprint, status, expires_at)
+		VALUES ($1, $2, $3, 'processing', $4)
+		ON CONFLICT (scope, key) DO UPDATE SET
+			fingerprint = EXCLUDED.fingerprint,
+			status = 'processing',
+			response_code = NULL,
+			response_content_type = NULL,
+			response_body = NULL,
+			created_at = NOW(),
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= NOW()
+	`
+
+	tag, err := s.pool.Exec(ctx, query, record.Scope, record.Key, record.Fingerprint, record.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 1 {
+		return nil, nil
+	}
+
+	return s.find(ctx, record.Scope, record.Key)
+}
+
+// find loads a record by scope and key
+func (s *PostgresStore) find(ctx context.Context, scope, key string) (*Record, error) {
+	query := `
+		SELECT fingerprint, status, response_code, response_content_type, response_body, expires_at
+		FROM idempotency_keys
+		WHERE scope = $1 AND key = $2
+	`
+
+	record := Record{Scope: scope, Key: key}
+	var code *int
+	var contentType *string
+	var body []byte
+
+	err := s.pool.QueryRow(ctx, query, scope, key).Scan(
+		&record.Fingerprint,
+		&record.Status,
+		&code,
+		&contentType,
+		&body,
+		&record.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Status == StatusCompleted && code != nil {
+		record.Response = &Response{StatusCode: *code, Body: body}
+		if contentType != nil {
+			record.Response.ContentType = *contentType
+		}
+	}
+
+	return &record, nil
+}
+
+// Complete stores the response for a claimed key
+func (s *PostgresStore) Complete(ctx context.Context, scope, key string, response Response) error {
+	query := `
+		UPDATE idempotency_keys
+		SET status = 'completed', response_code = $3, response_content_type = $4, response_body = $5
+		WHERE scope = $1 AND key = $2
+	`
+
+	_, err := s.pool.Exec(ctx, query, scope, key, response.StatusCode, response.ContentType, response.Body)
+	return err
+}
+
+// Release removes a claimed key
+func (s *PostgresStore) Release(ctx context.Context, scope, key string) error {
+	_, err := s.pool.Exec(ctx, `DELETE FROM idempotency_keys WHERE scope = $1 AND key = $2`, scope, key)
+	return err
+}
+
+// DeleteExpired removes expired records and returns how many were deleted
+func (s *PostgresStore) DeleteExpired(ctx context.Context) (int64, error) {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= NOW()`)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
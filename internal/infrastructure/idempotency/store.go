@@ -0,0 +1,96 @@
+// Package idempotency makes retried POST requests safe by replaying the
+// response recorded for a client-supplied Idempotency-Key.
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Record states
+const (
+	StatusProcessing = "processing"
+	StatusCompleted  = "completed"
+)
+
+// Record is the stored state of one idempotency key
+type Record struct {
+	Scope       string // owner of the key, e.g. the user ID
+	Key         string
+	Fingerprint string // hash of the request the key was first used with
+	Status      string
+	Response    *Response
+	ExpiresAt   time.Time
+}
+
+// Response is the recorded response replayed for duplicate requests
+type Response struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// Store persists idempotency records
+type Store interface {
+	// Begin claims scope/key for a new request. If an unexpired record
+	// already exists it is returned instead and nothing is written.
+	Begin(ctx context.Context, record Record) (existing *Record, err error)
+	// Complete stores the response for a claimed key
+	Complete(ctx context.Context, scope, key string, response Response) error
+	// Release removes a claimed key so the request can be retried
+	Release(ctx context.Context, scope, key string) error
+}
+
+// MemoryStore is a Store kept in process memory, for tests and single-instance setups
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]*Record
+	now     func() time.Time
+}
+
+// NewMemoryStore creates an in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		records: make(map[string]*Record),
+		now:     time.Now,
+	}
+}
+
+// Begin claims scope/key unless an unexpired record exists
+func (s *MemoryStore) Begin(_ context.Context, record Record) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := record.Scope + "\x00" + record.Key
+	if existing, ok := s.records[id]; ok && s.now().Before(existing.ExpiresAt) {
+		copied := *existing
+		return &copied, nil
+	}
+
+	record.Status = StatusProcessing
+	record.Response = nil
+	s.records[id] = &record
+	return nil, nil
+}
+
+// Complete stores the response for a claimed key
+func (s *MemoryStore) Complete(_ context.Context, scope, key string, response Response) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[scope+"\x00"+key]; ok {
+		record.Status = StatusCompleted
+		record.Response = &response
+	}
+	return nil
+}
+
+// Release removes a claimed key
+func (s *MemoryStore) Release(_ context.Context, scope, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, scope+"\x00"+key)
+	return nil
+}
@@ -1,8 +1,6 @@
 package logger
 
 import (
-	"os"
-	"strconv"
 	"strings"
 
 	"go.uber.org/zap"
@@ -65,23 +63,23 @@ type Config struct {
 	Environment    string       `json:"environment"`
 }
 
-// NewConfig creates a new logger configuration from environment variables
+// NewConfig creates a new logger configuration with default values
 func NewConfig() *Config {
 	return &Config{
-		Level:          parseLogLevel(getEnvOrDefault("LOG_LEVEL", "info")),
-		Format:         parseLogFormat(getEnvOrDefault("LOG_FORMAT", "console")),
-		Output:         parseLogOutput(getEnvOrDefault("LOG_OUTPUT", "stdout")),
-		FilePath:       getEnvOrDefault("LOG_FILE_PATH", "/var/log/zenconnect.log"),
-		MaxSize:        getEnvIntOrDefault("LOG_MAX_SIZE", 100),
-		MaxAge:         getEnvIntOrDefault("LOG_MAX_AGE", 30),
-		MaxBackups:     getEnvIntOrDefault("LOG_MAX_BACKUPS", 10),
-		SamplingRate:   getEnvFloatOrDefault("LOG_SAMPLING_RATE", 1.0),
-		MaskPasswords:  getEnvBoolOrDefault("LOG_MASK_PASSWORDS", true),
-		MaskTokens:     getEnvBoolOrDefault("LOG_MASK_TOKENS", true),
-		MaskEmails:     parseMaskingLevel(getEnvOrDefault("LOG_MASK_EMAILS", "partial")),
-		ServiceName:    getEnvOrDefault("SERVICE_NAME", "zen-connect"),
-		ServiceVersion: getEnvOrDefault("SERVICE_VERSION", "1.0.0"),
-		Environment:    getEnvOrDefault("ENVIRONMENT", "development"),
+		Level:          InfoLevel,
+		Format:         ConsoleFormat,
+		Output:         StdoutOutput,
+		FilePath:       "/var/log/zenconnect.log",
+		MaxSize:        100,
+		MaxAge:         30,
+		MaxBackups:     10,
+		SamplingRate:   1.0,
+		MaskPasswords:  true,
+		MaskTokens:     true,
+		MaskEmails:     MaskingPartial,
+		ServiceName:    "zen-connect",
+		ServiceVersion: "1.0.0",
+		Environment:    "development",
 	}
 }
 
@@ -183,43 +181,10 @@ func (c *Config) IsProductionMode() bool {
 	return c.Environment == "production"
 }
 
-// Helper functions for environment variable parsing
+// Helper functions for parsing configuration values
 
-func getEnvOrDefault(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
-	}
-	return defaultValue
-}
-
-func getEnvIntOrDefault(key string, defaultValue int) int {
-	if value := os.Getenv(key); value != "" {
-		if parsed, err := strconv.Atoi(value); err == nil {
-			return parsed
-		}
-	}
-	return defaultValue
-}
-
-func getEnvFloatOrDefault(key string, defaultValue float64) float64 {
-	if value := os.Getenv(key); value != "" {
-		if parsed, err := strconv.ParseFloat(value, 64); err == nil {
-			return parsed
-		}
-	}
-	return defaultValue
-}
-
-func getEnvBoolOrDefault(key string, defaultValue bool) bool {
-	if value := os.Getenv(key); value != "" {
-		if parsed, err := strconv.ParseBool(value); err == nil {
-			return parsed
-		}
-	}
-	return defaultValue
-}
-
-func parseLogLevel(level string) LogLevel {
+// ParseLogLevel converts a level name to LogLevel, defaulting to info
+func ParseLogLevel(level string) LogLevel {
 	switch strings.ToLower(level) {
 	case "debug":
 		return DebugLevel
@@ -238,7 +203,8 @@ func parseLogLevel(level string) LogLevel {
 	}
 }
 
-func parseLogFormat(format string) LogFormat {
+// ParseLogFormat converts a format name to LogFormat, defaulting to console
+func ParseLogFormat(format string) LogFormat {
 	switch strings.ToLower(format) {
 	case "json":
 		return JSONFormat
@@ -249,7 +215,8 @@ func parseLogFormat(format string) LogFormat {
 	}
 }
 
-func parseLogOutput(output string) LogOutput {
+// ParseLogOutput converts an output name to LogOutput, defaulting to stdout
+func ParseLogOutput(output string) LogOutput {
 	switch strings.ToLower(output) {
 	case "stdout":
 		return StdoutOutput
@@ -262,7 +229,8 @@ func parseLogOutput(output string) LogOutput {
 	}
 }
 
-func parseMaskingLevel(level string) MaskingLevel {
+// ParseMaskingLevel converts a masking level name to MaskingLevel, defaulting to partial
+func ParseMaskingLevel(level string) MaskingLevel {
 	switch strings.ToLower(level) {
 	case "none":
 		return MaskingNone
@@ -2,6 +2,7 @@ package logger
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"net/http"
 	"strconv"
@@ -71,8 +72,9 @@ func RequestLoggerWithConfig(config EchoLoggerConfig) echo.MiddlewareFunc {
 			if requestID == "" {
 				requestID = generateRequestID()
 				req.Header.Set(config.RequestIDHeader, requestID)
-				res.Header().Set(config.RequestIDHeader, requestID)
 			}
+			// Echo the ID so clients can quote it when reporting problems
+			res.Header().Set(config.RequestIDHeader, requestID)
 
 			// Extract correlation ID
 			correlationID := req.Header.Get(config.CorrelationIDHeader)
@@ -185,6 +187,12 @@ func ErrorLoggerMiddleware() echo.MiddlewareFunc {
 						zap.String(FieldErrorType, "http_error"),
 						zap.Any(FieldError, httpError.Message),
 					)
+				} else if status, ok := errorStatus(err); ok {
+					fields = append(fields,
+						zap.Int(FieldErrorCode, status),
+						zap.String(FieldErrorType, "http_error"),
+						zap.Error(err),
+					)
 				} else {
 					fields = append(fields,
 						zap.String(FieldErrorType, "internal_error"),
@@ -192,7 +200,12 @@ func ErrorLoggerMiddleware() echo.MiddlewareFunc {
 					)
 				}
 
-				logger.Error("Request error", fields...)
+				// Client errors are expected; only server errors are logged as errors
+				if status, ok := errorStatus(err); ok && status < http.StatusInternalServerError {
+					logger.Warn("Request error", fields...)
+				} else {
+					logger.Error("Request error", fields...)
+				}
 			}
 
 			return err
@@ -256,6 +269,20 @@ func shouldSkipPath(path string, skipPaths []string, hideHealthChecks bool) bool
 	return false
 }
 
+// errorStatus returns the HTTP status an error will be rendered with, for
+// errors that carry one
+func errorStatus(err error) (int, bool) {
+	var httpError *echo.HTTPError
+	if errors.As(err, &httpError) {
+		return httpError.Code, true
+	}
+	var statusError interface{ StatusCode() int }
+	if errors.As(err, &statusError) {
+		return statusError.StatusCode(), true
+	}
+	return 0, false
+}
+
 func generateRequestID() string {
 	return uuid.New().String()
 }
@@ -339,7 +366,10 @@ func logRequestComplete(logCtx *LogContext, req *http.Request, res *echo.Respons
 	// Lower log level for frequently accessed endpoints
 	isFrequentEndpoint := req.URL.Path == "/auth/me" || req.URL.Path == "/health"
 	
-	if err != nil {
+	if status, ok := errorStatus(err); ok && status < http.StatusInternalServerError {
+		fields = append(fields, zap.Error(err))
+		logCtx.Warn(message, fields...)
+	} else if err != nil {
 		fields = append(fields, zap.Error(err))
 		logCtx.Error(message, fields...)
 	} else if res.Status >= 500 {
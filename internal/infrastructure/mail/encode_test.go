@@ -0,0 +1,58 @@
+package mail
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	netmail "net/mail"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMessage_EncodeShouldProduceReadableMultipartMessage(t *testing.T) {
+	// given
+	msg := &Message{
+		ID:      "message-1",
+		From:    Address{Name: "ZenConnect", Email: "no-reply@example.com"},
+		To:      Address{Name: "山田 花子", Email: "hanako@example.com"},
+		Subject: "今週の瞑想のまとめ",
+		Text:    "今週は3回瞑想しました。",
+		HTML:    "<p>今週は3回瞑想しました。</p>",
+		Headers: map[string]string{"List-Unsubscribe": "<https://api.example.com/u?token=x>\r\nBcc: evil@example.com"},
+	}
+
+	// when
+	data, err := msg.Encode(time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC))
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	parsed, err := netmail.ReadMessage(strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatalf("Expected a parsable message, got %v", err)
+	}
+	subject, _ := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	if subject != msg.Subject || parsed.Header.Get("Message-Id") != "<message-1@example.com>" {
+		t.Errorf("Expected decoded subject and message ID, got %q and %q", subject, parsed.Header.Get("Message-Id"))
+	}
+	if parsed.Header.Get("Bcc") != "" {
+		t.Error("Expected header values not to inject headers")
+	}
+
+	_, params, _ := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+	var parts []string
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			break
+		}
+		body, _ := io.ReadAll(part)
+		parts = append(parts, part.Header.Get("Content-Type")+" "+string(body))
+	}
+	if len(parts) != 2 || parts[0] != "text/plain; charset=utf-8 "+msg.Text || parts[1] != "text/html; charset=utf-8 "+msg.HTML {
+		t.Errorf("Expected decoded text and HTML parts, got %q", parts)
+	}
+}
@@ -0,0 +1,51 @@
+// Package mail queues and delivers transactional email.
+//
+// Messages are rendered from localized templates, stored in a queue and sent
+// by a background worker that retries failed deliveries with backoff. The
+// sender is pluggable: SMTP in production, files or memory in development
+// and tests.
+package mail
+
+import (
+	"context"
+	"fmt"
+	netmail "net/mail"
+)
+
+// Address is a mailbox with an optional display name
+type Address struct {
+	Name  string
+	Email string
+}
+
+// ParseAddress parses an RFC 5322 address such as "ZenConnect <no-reply@example.com>"
+func ParseAddress(raw string) (Address, error) {
+	parsed, err := netmail.ParseAddress(raw)
+	if err != nil {
+		return Address{}, fmt.Errorf("invalid mail address %q: %w", raw, err)
+	}
+	return Address{Name: parsed.Name, Email: parsed.Address}, nil
+}
+
+// String formats the address for a header, encoding a non-ASCII name
+func (a Address) String() string {
+	return (&netmail.Address{Name: a.Name, Address: a.Email}).String()
+}
+
+// Message is an email with a plain text and an HTML alternative
+type Message struct {
+	// ID identifies the message across retries and becomes its Message-ID
+	ID      string
+	From    Address
+	To      Address
+	Subject string
+	Text    string
+	HTML    string
+	// Headers are additional headers such as List-Unsubscribe
+	Headers map[string]string
+}
+
+// Sender delivers a message to the recipient's mail server
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
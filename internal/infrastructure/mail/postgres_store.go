@@ -0,0 +1,191 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresStore is a Store backed by the mail_queue table
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore creates a PostgreSQL mail queue store
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{
+		pool: pool,
+	}
+}
+
+// messageRecord is the JSON form of a message
+type messageRecord struct {
+	FromName  string            `json:"from_name,omitempty"`
+	FromEmail string            `json:"from_email"`
+	ToName    string            `json:"to_name,omitempty"`
+	ToEmail   string            `json:"to_email"`
+	Subject   string            `json:"subject"`
+	Text      string            `json:"text"`
+	HTML      string            `json:"html,omitempty"`
+	Headers   map[string]string `json:"headers,omitempty"`
+}
+
+const queueColumns = `
+	id, dedupe_key, message, status, attempts, next_attempt_at, last_error, created_at, sent_at
+`
+
+// Add queues a message unless its dedupe key was used before
+func (s *PostgresStore) Add(ctx context.Context, msg *QueuedMessage) (bool, error) {
+	m := msg.Message
+	message, err := json.Marshal(messageRecord{
+		FromName:  m.From.Name,
+		FromEmail: m.From.Email,
+		ToName:    m.To.Name,
+		ToEmail:   m.To.Email,
+		Subject:   m.Subject,
+		Text:      m.Text,
+		HTML:      m.HTML,
+		Headers:   m.Headers,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	query := `
+		INSERT INTO mail_queue (` + queueColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (dedupe_key) DO NOTHING
+	`
+	tag, err := s.pool.Exec(ctx, query,
+		m.ID,
+		nullString(msg.DedupeKey),
+		message,
+		msg.Status,
+		msg.Attempts,
+		msg.NextAttemptAt,
+		msg.LastError,
+		msg.CreatedAt,
+		nullTime(msg.SentAt),
+	)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// ClaimDue leases the pending messages due at now, oldest first. Rows locked
+// by another worker are skipped instead of waited for.
+func (s *PostgresStore) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*QueuedMessage, error) {
+	query := `
+		UPDATE mail_queue SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM mail_queue
+			WHERE status = 'pending' AND next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + queueColumns
+	rows, err := s.pool.Query(ctx, query, now, leaseUntil, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []*QueuedMessage
+	for rows.Next() {
+		msg, err := scanQueuedMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+	return messages, rows.Err()
+}
+
+// Update stores the outcome of a delivery attempt
+func (s *PostgresStore) Update(ctx context.Context, msg *QueuedMessage) error {
+	query := `
+		UPDATE mail_queue SET
+			status = $2,
+			attempts = $3,
+			next_attempt_at = $4,
+			last_error = $5,
+			sent_at = $6
+		WHERE id = $1
+	`
+	_, err := s.pool.Exec(ctx, query,
+		msg.Message.ID,
+		msg.Status,
+		msg.Attempts,
+		msg.NextAttemptAt,
+		msg.LastError,
+		nullTime(msg.SentAt),
+	)
+	return err
+}
+
+// scanQueuedMessage reconstructs a queued message from a result row
+func scanQueuedMessage(row pgx.Row) (*QueuedMessage, error) {
+	var msg QueuedMessage
+	var id string
+	var dedupeKey *string
+	var message []byte
+	var sentAt *time.Time
+
+	err := row.Scan(
+		&id,
+		&dedupeKey,
+		&message,
+		&msg.Status,
+		&msg.Attempts,
+		&msg.NextAttemptAt,
+		&msg.LastError,
+		&msg.CreatedAt,
+		&sentAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var record messageRecord
+	if err := json.Unmarshal(message, &record); err != nil {
+		return nil, fmt.Errorf("failed to decode queued message %s: %w", id, err)
+	}
+	msg.Message = Message{
+		ID:      id,
+		From:    Address{Name: record.FromName, Email: record.FromEmail},
+		To:      Address{Name: record.ToName, Email: record.ToEmail},
+		Subject: record.Subject,
+		Text:    record.Text,
+		HTML:    record.HTML,
+		Headers: record.Headers,
+	}
+	if dedupeKey != nil {
+		msg.DedupeKey = *dedupeKey
+	}
+	if sentAt != nil {
+		msg.SentAt = *sentAt
+	}
+	return &msg, nil
+}
+
+// nullString maps the empty string to NULL
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// nullTime maps the zero time to NULL
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
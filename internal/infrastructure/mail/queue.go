@@ -0,0 +1,242 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Queued message states
+const (
+	StatusPending = "pending"
+	StatusSent    = "sent"
+	StatusFailed  = "failed"
+)
+
+// QueuedMessage is a message waiting in the queue and its delivery state
+type QueuedMessage struct {
+	Message Message
+	// DedupeKey makes queueing idempotent: a second message with the same
+	// key is dropped. Empty keys are never deduplicated.
+	DedupeKey     string
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+	SentAt        time.Time
+}
+
+// Store persists the mail queue
+type Store interface {
+	// Add queues a message; false when its dedupe key was used before
+	Add(ctx context.Context, msg *QueuedMessage) (bool, error)
+	// ClaimDue returns up to limit pending messages due at now and postpones
+	// them until leaseUntil, so no other worker sends them meanwhile
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*QueuedMessage, error)
+	// Update stores the outcome of a delivery attempt
+	Update(ctx context.Context, msg *QueuedMessage) error
+}
+
+// Outbox puts messages into the queue
+type Outbox struct {
+	store Store
+	from  Address
+	now   func() time.Time
+}
+
+// NewOutbox creates an outbox sending from the given address
+func NewOutbox(store Store, from Address) *Outbox {
+	return &Outbox{
+		store: store,
+		from:  from,
+		now:   time.Now,
+	}
+}
+
+// Enqueue queues a message for delivery; false when dedupeKey was used before
+func (o *Outbox) Enqueue(ctx context.Context, dedupeKey string, msg Message) (bool, error) {
+	now := o.now()
+	msg.ID = uuid.New().String()
+	msg.From = o.from
+	return o.store.Add(ctx, &QueuedMessage{
+		Message:       msg,
+		DedupeKey:     dedupeKey,
+		Status:        StatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	})
+}
+
+// WorkerConfig controls delivery and retries
+type WorkerConfig struct {
+	// MaxAttempts is how often a message is tried before it is given up
+	MaxAttempts int
+	// Backoff is the wait before the first retry; it doubles with every attempt
+	Backoff time.Duration
+	// BatchSize is the number of messages sent per run
+	BatchSize int
+	// Lease is how long a claimed message is hidden from other workers
+	Lease time.Duration
+}
+
+// maxBackoff caps the wait between retries
+const maxBackoff = 6 * time.Hour
+
+// Worker sends queued messages and schedules retries for failed ones
+type Worker struct {
+	store  Store
+	sender Sender
+	config WorkerConfig
+	now    func() time.Time
+}
+
+// NewWorker creates a worker
+func NewWorker(store Store, sender Sender, config WorkerConfig) *Worker {
+	if config.BatchSize <= 0 {
+		config.BatchSize = 50
+	}
+	if config.Lease <= 0 {
+		config.Lease = 5 * time.Minute
+	}
+	return &Worker{
+		store:  store,
+		sender: sender,
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// ProcessDue sends the messages that are due and returns how many were sent.
+// A failed delivery is retried later unless it failed permanently or ran
+// out of attempts.
+func (w *Worker) ProcessDue(ctx context.Context) (int, error) {
+	now := w.now()
+	messages, err := w.store.ClaimDue(ctx, now, now.Add(w.config.Lease), w.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	var errs []error
+	for _, msg := range messages {
+		sendErr := w.sender.Send(ctx, &msg.Message)
+		w.record(msg, sendErr)
+		if err := w.store.Update(ctx, msg); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update message %s: %w", msg.Message.ID, err))
+			continue
+		}
+		if sendErr == nil {
+			sent++
+		}
+	}
+	return sent, errors.Join(errs...)
+}
+
+// record applies the outcome of a delivery attempt
+func (w *Worker) record(msg *QueuedMessage, sendErr error) {
+	now := w.now()
+	msg.Attempts++
+	if sendErr == nil {
+		msg.Status = StatusSent
+		msg.SentAt = now
+		msg.LastError = ""
+		return
+	}
+
+	msg.LastError = sendErr.Error()
+	if IsPermanent(sendErr) || msg.Attempts >= w.config.MaxAttempts {
+		msg.Status = StatusFailed
+		return
+	}
+	backoff := w.config.Backoff << (msg.Attempts - 1)
+	if backoff <= 0 || backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	msg.NextAttemptAt = now.Add(backoff)
+}
+
+// MemoryStore is a Store kept in process memory, for tests
+type MemoryStore struct {
+	mu       sync.Mutex
+	messages []*QueuedMessage
+}
+
+// NewMemoryStore creates an in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// Add queues a message unless its dedupe key was used before
+func (s *MemoryStore) Add(_ context.Context, msg *QueuedMessage) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if msg.DedupeKey != "" {
+		for _, queued := range s.messages {
+			if queued.DedupeKey == msg.DedupeKey {
+				return false, nil
+			}
+		}
+	}
+	copied := *msg
+	s.messages = append(s.messages, &copied)
+	return true, nil
+}
+
+// ClaimDue returns the pending messages due at now, oldest first
+func (s *MemoryStore) ClaimDue(_ context.Context, now, leaseUntil time.Time, limit int) ([]*QueuedMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []*QueuedMessage
+	for _, msg := range s.messages {
+		if msg.Status == StatusPending && !msg.NextAttemptAt.After(now) {
+			due = append(due, msg)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool { return due[i].NextAttemptAt.Before(due[j].NextAttemptAt) })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	claimed := make([]*QueuedMessage, 0, len(due))
+	for _, msg := range due {
+		msg.NextAttemptAt = leaseUntil
+		copied := *msg
+		claimed = append(claimed, &copied)
+	}
+	return claimed, nil
+}
+
+// Update stores the outcome of a delivery attempt
+func (s *MemoryStore) Update(_ context.Context, msg *QueuedMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, queued := range s.messages {
+		if queued.Message.ID == msg.Message.ID {
+			copied := *msg
+			s.messages[i] = &copied
+			return nil
+		}
+	}
+	return fmt.Errorf("message %s is not queued", msg.Message.ID)
+}
+
+// Messages returns a copy of every queued message, for tests
+func (s *MemoryStore) Messages() []QueuedMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	messages := make([]QueuedMessage, 0, len(s.messages))
+	for _, msg := range s.messages {
+		messages = append(messages, *msg)
+	}
+	return messages
+}
@@ -0,0 +1,115 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"net/textproto"
+	"testing"
+	"time"
+)
+
+type fakeClock struct{ now time.Time }
+
+func (f *fakeClock) Now() time.Time { return f.now }
+
+// failingSender fails the first failures deliveries with err
+type failingSender struct {
+	MemorySender
+	failures int
+	err      error
+}
+
+func (s *failingSender) Send(ctx context.Context, msg *Message) error {
+	if s.failures > 0 {
+		s.failures--
+		return s.err
+	}
+	return s.MemorySender.Send(ctx, msg)
+}
+
+func newTestQueue(sender Sender) (*Outbox, *Worker, *MemoryStore, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := NewMemoryStore()
+	outbox := NewOutbox(store, Address{Name: "ZenConnect", Email: "no-reply@example.com"})
+	outbox.now = clock.Now
+	worker := NewWorker(store, sender, WorkerConfig{MaxAttempts: 3, Backoff: time.Minute})
+	worker.now = clock.Now
+	return outbox, worker, store, clock
+}
+
+func TestOutbox_ShouldDropDuplicateDedupeKeys(t *testing.T) {
+	// given
+	outbox, _, store, _ := newTestQueue(NewMemorySender())
+	msg := Message{To: Address{Email: "user@example.com"}, Subject: "ようこそ"}
+
+	// when
+	first, _ := outbox.Enqueue(context.Background(), "welcome:user", msg)
+	second, _ := outbox.Enqueue(context.Background(), "welcome:user", msg)
+	outbox.Enqueue(context.Background(), "", msg)
+	outbox.Enqueue(context.Background(), "", msg)
+
+	// then
+	if !first || second {
+		t.Errorf("Expected only the first keyed message queued, got %v and %v", first, second)
+	}
+	if got := len(store.Messages()); got != 3 {
+		t.Errorf("Expected 3 queued messages, got %d", got)
+	}
+}
+
+func TestWorker_ShouldRetryWithBackoffUntilSent(t *testing.T) {
+	// given
+	sender := &failingSender{failures: 2, err: errors.New("connection refused")}
+	outbox, worker, store, clock := newTestQueue(sender)
+	outbox.Enqueue(context.Background(), "", Message{To: Address{Email: "user@example.com"}})
+
+	// when
+	worker.ProcessDue(context.Background())
+	clock.now = clock.now.Add(59 * time.Second)
+	early, _ := worker.ProcessDue(context.Background())
+	clock.now = clock.now.Add(time.Second)
+	worker.ProcessDue(context.Background())
+	afterSecondFailure := store.Messages()[0]
+	clock.now = clock.now.Add(2 * time.Minute)
+	sent, _ := worker.ProcessDue(context.Background())
+
+	// then
+	if early != 0 {
+		t.Error("Expected no delivery before the backoff elapsed")
+	}
+	if !afterSecondFailure.NextAttemptAt.Equal(clock.now) || afterSecondFailure.LastError != "connection refused" {
+		t.Errorf("Expected the backoff to double, got %+v", afterSecondFailure)
+	}
+	msg := store.Messages()[0]
+	if sent != 1 || msg.Status != StatusSent || msg.Attempts != 3 || len(sender.Sent()) != 1 {
+		t.Errorf("Expected sent on the third attempt, got %+v", msg)
+	}
+	if sender.Sent()[0].From.Email != "no-reply@example.com" || sender.Sent()[0].ID == "" {
+		t.Errorf("Expected the outbox sender address and an ID, got %+v", sender.Sent()[0])
+	}
+}
+
+func TestWorker_ShouldGiveUpAfterMaxAttemptsOrPermanentFailure(t *testing.T) {
+	// given
+	transient := &failingSender{failures: 10, err: errors.New("timeout")}
+	outbox, worker, store, clock := newTestQueue(transient)
+	outbox.Enqueue(context.Background(), "", Message{To: Address{Email: "user@example.com"}})
+	permanent := &failingSender{failures: 1, err: &textproto.Error{Code: 550, Msg: "no such user"}}
+	rejectOutbox, rejectWorker, rejectStore, _ := newTestQueue(permanent)
+	rejectOutbox.Enqueue(context.Background(), "", Message{To: Address{Email: "missing@example.com"}})
+
+	// when
+	for i := 0; i < 5; i++ {
+		worker.ProcessDue(context.Background())
+		clock.now = clock.now.Add(time.Hour)
+	}
+	rejectWorker.ProcessDue(context.Background())
+
+	// then
+	if msg := store.Messages()[0]; msg.Status != StatusFailed || msg.Attempts != 3 {
+		t.Errorf("Expected failed after 3 attempts, got %+v", msg)
+	}
+	if msg := rejectStore.Messages()[0]; msg.Status != StatusFailed || msg.Attempts != 1 {
+		t.Errorf("Expected a 5xx reply to fail at once, got %+v", msg)
+	}
+}
@@ -0,0 +1,164 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// smtpTimeout bounds a whole SMTP conversation when the context has no deadline
+const smtpTimeout = 30 * time.Second
+
+// SMTPConfig holds the SMTP server settings
+type SMTPConfig struct {
+	Host string
+	Port int
+	// Username and Password enable PLAIN authentication; leave empty for
+	// servers that accept mail without it
+	Username string
+	Password string
+}
+
+// SMTPSender delivers messages through an SMTP server, upgrading the
+// connection with STARTTLS when the server offers it
+type SMTPSender struct {
+	config SMTPConfig
+	now    func() time.Time
+}
+
+// NewSMTPSender creates an SMTP sender
+func NewSMTPSender(config SMTPConfig) *SMTPSender {
+	return &SMTPSender{
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// Send delivers one message
+func (s *SMTPSender) Send(ctx context.Context, msg *Message) error {
+	data, err := msg.Encode(s.now())
+	if err != nil {
+		return err
+	}
+
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port)))
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = s.now().Add(smtpTimeout)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.config.Host}); err != nil {
+			return fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+	if s.config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)); err != nil {
+			return fmt.Errorf("SMTP authentication failed: %w", err)
+		}
+	}
+	if err := client.Mail(msg.From.Email); err != nil {
+		return err
+	}
+	if err := client.Rcpt(msg.To.Email); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// IsPermanent reports whether retrying cannot help, such as when the server
+// rejected the recipient with a 5xx reply
+func IsPermanent(err error) bool {
+	var smtpErr *textproto.Error
+	return errors.As(err, &smtpErr) && smtpErr.Code >= 500
+}
+
+// FileSender writes each message as an .eml file for local development
+type FileSender struct {
+	dir string
+	now func() time.Time
+}
+
+// NewFileSender creates a sender writing into dir, creating it if needed
+func NewFileSender(dir string) (*FileSender, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create mail directory: %w", err)
+	}
+	return &FileSender{
+		dir: dir,
+		now: time.Now,
+	}, nil
+}
+
+// Send writes the message to <dir>/<time>-<id>.eml
+func (s *FileSender) Send(_ context.Context, msg *Message) error {
+	now := s.now()
+	data, err := msg.Encode(now)
+	if err != nil {
+		return err
+	}
+	name := now.UTC().Format("20060102T150405") + "-" + msg.ID + ".eml"
+	return os.WriteFile(filepath.Join(s.dir, name), data, 0o644)
+}
+
+// MemorySender keeps sent messages in memory, for tests
+type MemorySender struct {
+	mu   sync.Mutex
+	sent []Message
+}
+
+// NewMemorySender creates an in-memory sender
+func NewMemorySender() *MemorySender {
+	return &MemorySender{}
+}
+
+// Send records the message
+func (s *MemorySender) Send(_ context.Context, msg *Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sent = append(s.sent, *msg)
+	return nil
+}
+
+// Sent returns the messages sent so far
+func (s *MemorySender) Sent() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Message(nil), s.sent...)
+}
@@ -0,0 +1,108 @@
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Templates renders localized messages. Each message has a text template
+// <name>.<language>.txt defining its subject in a "subject" block, and an
+// HTML template <name>.<language>.html.
+type Templates struct {
+	text            map[string]*texttemplate.Template
+	html            map[string]*htmltemplate.Template
+	defaultLanguage string
+}
+
+// Content is a rendered message
+type Content struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// NewTemplates parses every template in fsys. Each message must exist in
+// defaultLanguage, which is used when the requested language is missing.
+func NewTemplates(fsys fs.FS, defaultLanguage string) (*Templates, error) {
+	t := &Templates{
+		text:            map[string]*texttemplate.Template{},
+		html:            map[string]*htmltemplate.Template{},
+		defaultLanguage: defaultLanguage,
+	}
+
+	err := fs.WalkDir(fsys, ".", func(file string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return err
+		}
+		key := strings.TrimSuffix(path.Base(file), path.Ext(file))
+		switch path.Ext(file) {
+		case ".txt":
+			tmpl, err := texttemplate.New(key).Option("missingkey=error").Parse(string(data))
+			if err != nil {
+				return err
+			}
+			if tmpl.Lookup("subject") == nil {
+				return fmt.Errorf("mail template %s has no subject block", file)
+			}
+			t.text[key] = tmpl
+		case ".html":
+			tmpl, err := htmltemplate.New(key).Option("missingkey=error").Parse(string(data))
+			if err != nil {
+				return err
+			}
+			t.html[key] = tmpl
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load mail templates: %w", err)
+	}
+
+	for key := range t.text {
+		name, language, _ := strings.Cut(key, ".")
+		if _, ok := t.html[key]; !ok {
+			return nil, fmt.Errorf("mail template %s has no HTML version in %s", name, language)
+		}
+		if _, ok := t.text[name+"."+defaultLanguage]; !ok {
+			return nil, fmt.Errorf("mail template %s has no version in the default language %s", name, defaultLanguage)
+		}
+	}
+	return t, nil
+}
+
+// Render renders the named message in language, falling back to the default language
+func (t *Templates) Render(name, language string, data any) (*Content, error) {
+	key := name + "." + language
+	if _, ok := t.text[key]; !ok {
+		key = name + "." + t.defaultLanguage
+	}
+	text, ok := t.text[key]
+	if !ok {
+		return nil, fmt.Errorf("unknown mail template %s", name)
+	}
+
+	var subject, body, html bytes.Buffer
+	if err := text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("failed to render subject of %s: %w", key, err)
+	}
+	if err := text.Execute(&body, data); err != nil {
+		return nil, fmt.Errorf("failed to render %s: %w", key, err)
+	}
+	if err := t.html[key].Execute(&html, data); err != nil {
+		return nil, fmt.Errorf("failed to render HTML of %s: %w", key, err)
+	}
+	return &Content{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(body.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
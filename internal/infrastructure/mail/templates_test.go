@@ -0,0 +1,58 @@
+package mail
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func testTemplateFS() fstest.MapFS {
+	return fstest.MapFS{
+		"welcome.ja.txt":  {Data: []byte(`{{define "subject"}}ようこそ、{{.Name}}さん{{end}}` + "\n{{.Name}}さん、ようこそ。\n")},
+		"welcome.ja.html": {Data: []byte(`<p>{{.Name}}さん、ようこそ。</p>`)},
+		"welcome.en.txt":  {Data: []byte(`{{define "subject"}}Welcome, {{.Name}}{{end}}` + "\nWelcome, {{.Name}}.\n")},
+		"welcome.en.html": {Data: []byte(`<p>Welcome, {{.Name}}.</p>`)},
+	}
+}
+
+func TestTemplates_ShouldRenderLanguageAndFallBackToDefault(t *testing.T) {
+	// given
+	templates, err := NewTemplates(testTemplateFS(), "ja")
+	if err != nil {
+		t.Fatalf("Expected templates to load, got %v", err)
+	}
+	data := map[string]string{"Name": "<b>Hanako</b>"}
+
+	// when
+	english, _ := templates.Render("welcome", "en", data)
+	fallback, _ := templates.Render("welcome", "fr", data)
+
+	// then
+	if english.Subject != "Welcome, <b>Hanako</b>" || english.Text != "Welcome, <b>Hanako</b>.\n" {
+		t.Errorf("Expected English subject and text, got %+v", english)
+	}
+	if english.HTML != "<p>Welcome, &lt;b&gt;Hanako&lt;/b&gt;.</p>" {
+		t.Errorf("Expected escaped HTML, got %q", english.HTML)
+	}
+	if !strings.HasPrefix(fallback.Subject, "ようこそ") {
+		t.Errorf("Expected the default language, got %q", fallback.Subject)
+	}
+}
+
+func TestNewTemplates_ShouldRejectIncompleteTemplates(t *testing.T) {
+	// given
+	noDefault := testTemplateFS()
+	delete(noDefault, "welcome.ja.txt")
+	delete(noDefault, "welcome.ja.html")
+	noHTML := testTemplateFS()
+	delete(noHTML, "welcome.en.html")
+
+	// when
+	_, noDefaultErr := NewTemplates(noDefault, "ja")
+	_, noHTMLErr := NewTemplates(noHTML, "ja")
+
+	// then
+	if noDefaultErr == nil || noHTMLErr == nil {
+		t.Errorf("Expected errors for missing templates, got %v and %v", noDefaultErr, noHTMLErr)
+	}
+}
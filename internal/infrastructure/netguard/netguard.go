@@ -0,0 +1,47 @@
+// Package netguard keeps requests to user-supplied URLs (webhooks, push
+// endpoints) on the public internet, so users cannot make the server probe
+// internal services.
+package netguard
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// ErrPrivateAddress is returned when a request would reach a loopback,
+// private or link-local address
+var ErrPrivateAddress = errors.New("address is not on the public internet")
+
+// IsPublic reports whether an address is reachable on the internet
+func IsPublic(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast())
+}
+
+// NewTransport returns a transport that only dials public addresses, unless
+// allowPrivate is set for development and tests
+func NewTransport(timeout time.Duration, allowPrivate bool) *http.Transport {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		// Checked on the resolved address, so DNS names pointing inside are caught too
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !IsPublic(ip) {
+				return ErrPrivateAddress
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would be dialed instead of the destination and escape the check above
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return transport
+}
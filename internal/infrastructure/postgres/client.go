@@ -4,35 +4,42 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// Config holds PostgreSQL connection settings
+type Config struct {
+	URL             string
+	MaxConns        int32
+	MinConns        int32
+	MaxConnLifetime time.Duration
+	MaxConnIdleTime time.Duration
+}
+
 // Client wraps pgx connection pool
 type Client struct {
 	Pool *pgxpool.Pool
 }
 
 // NewClient creates a new PostgreSQL client
-func NewClient(ctx context.Context) (*Client, error) {
-	databaseURL := os.Getenv("DATABASE_URL")
-	if databaseURL == "" {
-		return nil, fmt.Errorf("DATABASE_URL environment variable is required")
+func NewClient(ctx context.Context, cfg Config) (*Client, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("database URL is required")
 	}
 
 	// Configure connection pool
-	config, err := pgxpool.ParseConfig(databaseURL)
+	config, err := pgxpool.ParseConfig(cfg.URL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse database URL: %w", err)
 	}
 
 	// Set connection pool settings
-	config.MaxConns = 30
-	config.MinConns = 5
-	config.MaxConnLifetime = time.Hour
-	config.MaxConnIdleTime = time.Minute * 30
+	config.MaxConns = cfg.MaxConns
+	config.MinConns = cfg.MinConns
+	config.MaxConnLifetime = cfg.MaxConnLifetime
+	config.MaxConnIdleTime = cfg.MaxConnIdleTime
 	config.HealthCheckPeriod = time.Minute * 5
 
 	// Create connection pool
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"zen-connect/migrations"
+)
+
+func TestLoadMigrations_ShouldPairAndSortByVersion(t *testing.T) {
+	// given
+	fsys := fstest.MapFS{
+		"002_add_bio.up.sql":        {Data: []byte("ALTER TABLE users ADD bio TEXT;")},
+		"002_add_bio.down.sql":      {Data: []byte("ALTER TABLE users DROP bio;")},
+		"001_create_users.up.sql":   {Data: []byte("CREATE TABLE users ();")},
+		"001_create_users.down.sql": {Data: []byte("DROP TABLE users;")},
+		"migrations.go":             {Data: []byte("package migrations")},
+	}
+
+	// when
+	result, err := LoadMigrations(fsys)
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(result) != 2 {
+		t.Fatalf("Expected 2 migrations, got %d", len(result))
+	}
+	if result[0].Version != 1 || result[0].Name != "create_users" {
+		t.Errorf("Expected first migration to be 001_create_users, got %03d_%s", result[0].Version, result[0].Name)
+	}
+	if result[1].UpSQL != "ALTER TABLE users ADD bio TEXT;" || result[1].DownSQL != "ALTER TABLE users DROP bio;" {
+		t.Error("Expected up and down scripts to be paired by version")
+	}
+}
+
+func TestLoadMigrations_ShouldReturnErrorWhenDownFileIsMissing(t *testing.T) {
+	// given
+	fsys := fstest.MapFS{
+		"001_create_users.up.sql": {Data: []byte("CREATE TABLE users ();")},
+	}
+
+	// when
+	_, err := LoadMigrations(fsys)
+
+	// then
+	if err == nil {
+		t.Error("Expected error for migration without down file")
+	}
+}
+
+func TestLoadMigrations_ShouldReturnErrorWhenFileNameIsInvalid(t *testing.T) {
+	// given
+	fsys := fstest.MapFS{
+		"create_users.sql": {Data: []byte("CREATE TABLE users ();")},
+	}
+
+	// when
+	_, err := LoadMigrations(fsys)
+
+	// then
+	if err == nil {
+		t.Error("Expected error for unversioned migration file")
+	}
+}
+
+func TestLoadMigrations_EmbeddedMigrationsShouldBeValid(t *testing.T) {
+	// when
+	result, err := LoadMigrations(migrations.FS)
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected embedded migrations to be valid, got %v", err)
+	}
+	if len(result) == 0 {
+		t.Error("Expected at least one embedded migration")
+	}
+}
+
+func TestCreateMigration_ShouldUseNextVersion(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "001_create_users.up.sql"), []byte("--"), 0o644)
+	os.WriteFile(filepath.Join(dir, "001_create_users.down.sql"), []byte("--"), 0o644)
+
+	// when
+	upPath, downPath, err := CreateMigration(dir, "Add Experiences")
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if filepath.Base(upPath) != "002_add_experiences.up.sql" {
+		t.Errorf("Expected 002_add_experiences.up.sql, got %s", filepath.Base(upPath))
+	}
+	if filepath.Base(downPath) != "002_add_experiences.down.sql" {
+		t.Errorf("Expected 002_add_experiences.down.sql, got %s", filepath.Base(downPath))
+	}
+}
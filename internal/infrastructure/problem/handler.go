@@ -0,0 +1,145 @@
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"zen-connect/internal/infrastructure/logger"
+)
+
+// requestIDHeader carries the request ID set by the request logger
+const requestIDHeader = "X-Request-ID"
+
+// Mapping associates a domain error with its HTTP representation
+type Mapping struct {
+	Err      error
+	Status   int
+	Code     string
+	Messages Messages
+}
+
+// Config configures the error handler
+type Config struct {
+	// Mappings translate domain errors; they are matched with errors.Is in order
+	Mappings []Mapping
+	// DefaultLanguage is used when Accept-Language names no supported language
+	DefaultLanguage string
+}
+
+// NewErrorHandler returns an Echo HTTPErrorHandler that renders every error
+// as an RFC 7807 problem.
+//
+// Errors are resolved in this order: *Error values, domain errors listed in
+// Mappings, *echo.HTTPError by status, and anything else as a 500 whose
+// message is never exposed.
+func NewErrorHandler(config Config) echo.HTTPErrorHandler {
+	if config.DefaultLanguage == "" || !supportedLanguages[config.DefaultLanguage] {
+		config.DefaultLanguage = LanguageJapanese
+	}
+
+	catalog := make(map[string]Messages, len(builtinMessages)+len(config.Mappings))
+	for code, messages := range builtinMessages {
+		catalog[code] = messages
+	}
+	for _, mapping := range config.Mappings {
+		catalog[mapping.Code] = mapping.Messages
+	}
+
+	return func(err error, c echo.Context) {
+		// A response was already written, e.g. by a middleware that renders
+		// the error itself before returning it for logging
+		if c.Response().Committed {
+			return
+		}
+
+		status, code, fields := resolve(err, config.Mappings)
+		language := negotiateLanguage(c.Request().Header.Get("Accept-Language"), config.DefaultLanguage)
+
+		details := Details{
+			Type:      TypeURI(code),
+			Title:     http.StatusText(status),
+			Status:    status,
+			Detail:    message(catalog, code, status, language),
+			Instance:  c.Request().URL.Path,
+			Code:      code,
+			RequestID: requestID(c),
+		}
+		for _, field := range fields {
+			details.Errors = append(details.Errors, FieldDetails{
+				Field:  field.Field,
+				Code:   field.Rule,
+				Detail: strings.ReplaceAll(localize(field.Messages, language, "The value is invalid."), "{param}", field.Param),
+			})
+		}
+
+		c.Response().Header().Set("Content-Language", language)
+		if c.Request().Method == http.MethodHead {
+			err = c.NoContent(status)
+		} else {
+			var body []byte
+			body, err = json.Marshal(details)
+			if err == nil {
+				err = c.Blob(status, MIMEProblemJSON, body)
+			}
+		}
+		if err != nil {
+			logger.WithContext(c.Request().Context()).Error("Failed to write error response",
+				zap.String("code", code),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// resolve determines the status, code and invalid fields of err
+func resolve(err error, mappings []Mapping) (int, string, []FieldError) {
+	var problemErr *Error
+	if errors.As(err, &problemErr) {
+		return problemErr.Status, problemErr.Code, problemErr.Fields
+	}
+
+	for _, mapping := range mappings {
+		if errors.Is(err, mapping.Err) {
+			return mapping.Status, mapping.Code, nil
+		}
+	}
+
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code, codeForStatus(httpErr.Code), nil
+	}
+
+	return http.StatusInternalServerError, CodeInternal, nil
+}
+
+// message looks up the localized message of code
+func message(catalog map[string]Messages, code string, status int, language string) string {
+	messages, ok := catalog[code]
+	if !ok {
+		messages = catalog[codeForStatus(status)]
+	}
+	return localize(messages, language, http.StatusText(status))
+}
+
+// localize picks the message for language, falling back to English
+func localize(messages Messages, language, fallback string) string {
+	if text, ok := messages[language]; ok {
+		return text
+	}
+	if text, ok := messages[LanguageEnglish]; ok {
+		return text
+	}
+	return fallback
+}
+
+// requestID returns the ID assigned by the request logger, if any
+func requestID(c echo.Context) string {
+	if id := c.Response().Header().Get(requestIDHeader); id != "" {
+		return id
+	}
+	return c.Request().Header.Get(requestIDHeader)
+}
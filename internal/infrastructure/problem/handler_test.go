@@ -0,0 +1,172 @@
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+var errWidgetNotFound = errors.New("widget not found")
+
+func serveError(handlerErr error, acceptLanguage string) (*httptest.ResponseRecorder, Details) {
+	e := echo.New()
+	e.HTTPErrorHandler = NewErrorHandler(Config{
+		Mappings: []Mapping{{
+			Err: errWidgetNotFound, Status: http.StatusNotFound, Code: "widget_not_found",
+			Messages: Messages{LanguageJapanese: "ウィジェットが見つかりません。", LanguageEnglish: "The widget was not found."},
+		}},
+	})
+	e.GET("/widgets", func(c echo.Context) error { return handlerErr })
+
+	req := httptest.NewRequest(http.MethodGet, "/widgets", nil)
+	req.Header.Set("X-Request-ID", "req-123")
+	if acceptLanguage != "" {
+		req.Header.Set("Accept-Language", acceptLanguage)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	var details Details
+	json.Unmarshal(rec.Body.Bytes(), &details)
+	return rec, details
+}
+
+func TestErrorHandler_ShouldMapWrappedDomainError(t *testing.T) {
+	// given
+	err := fmt.Errorf("loading widget 42: %w", errWidgetNotFound)
+
+	// when
+	rec, details := serveError(err, "en-US,en;q=0.9")
+
+	// then
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", rec.Code)
+	}
+	if rec.Header().Get(echo.HeaderContentType) != MIMEProblemJSON {
+		t.Errorf("Expected %s, got %q", MIMEProblemJSON, rec.Header().Get(echo.HeaderContentType))
+	}
+	expected := Details{
+		Type:      "urn:zen-connect:problem:widget_not_found",
+		Title:     "Not Found",
+		Status:    http.StatusNotFound,
+		Detail:    "The widget was not found.",
+		Instance:  "/widgets",
+		Code:      "widget_not_found",
+		RequestID: "req-123",
+	}
+	if !reflect.DeepEqual(details, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, details)
+	}
+}
+
+func TestErrorHandler_ShouldNotLeakInternalErrors(t *testing.T) {
+	// given
+	err := errors.New("pq: password authentication failed for user admin")
+
+	// when
+	rec, details := serveError(err, "")
+
+	// then
+	if rec.Code != http.StatusInternalServerError || details.Code != CodeInternal {
+		t.Errorf("Expected 500 internal_error, got %d %s", rec.Code, details.Code)
+	}
+	if strings.Contains(rec.Body.String(), "password") {
+		t.Errorf("Expected internal error text to be hidden, got %s", rec.Body.String())
+	}
+	if details.Detail != builtinMessages[CodeInternal][LanguageJapanese] {
+		t.Errorf("Expected Japanese message by default, got %q", details.Detail)
+	}
+}
+
+func TestErrorHandler_ShouldRenderExplicitAndEchoErrors(t *testing.T) {
+	cases := []struct {
+		err    error
+		status int
+		code   string
+	}{
+		{New(http.StatusTooManyRequests, CodeRateLimited), http.StatusTooManyRequests, CodeRateLimited},
+		{Wrap(errors.New("bad json"), http.StatusBadRequest, CodeInvalidRequestBody), http.StatusBadRequest, CodeInvalidRequestBody},
+		{echo.ErrMethodNotAllowed, http.StatusMethodNotAllowed, CodeMethodNotAllowed},
+		{echo.NewHTTPError(http.StatusBadRequest, "Syntax error: offset=3"), http.StatusBadRequest, CodeBadRequest},
+	}
+
+	for _, tc := range cases {
+		// when
+		rec, details := serveError(tc.err, "en")
+
+		// then
+		if rec.Code != tc.status || details.Code != tc.code {
+			t.Errorf("Expected %d %s for %v, got %d %s", tc.status, tc.code, tc.err, rec.Code, details.Code)
+		}
+		if strings.Contains(rec.Body.String(), "bad json") || strings.Contains(rec.Body.String(), "Syntax error") {
+			t.Errorf("Expected error text to be hidden, got %s", rec.Body.String())
+		}
+	}
+}
+
+func TestErrorHandler_ShouldNotOverwriteCommittedResponse(t *testing.T) {
+	// given
+	e := echo.New()
+	e.HTTPErrorHandler = NewErrorHandler(Config{})
+	e.GET("/", func(c echo.Context) error {
+		c.String(http.StatusConflict, "already written")
+		return errors.New("reported for logging")
+	})
+	rec := httptest.NewRecorder()
+
+	// when
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	// then
+	if rec.Code != http.StatusConflict || rec.Body.String() != "already written" {
+		t.Errorf("Expected committed response to be kept, got %d %q", rec.Code, rec.Body.String())
+	}
+}
+
+func TestNegotiateLanguage(t *testing.T) {
+	cases := map[string]string{
+		"":                        LanguageJapanese,
+		"en":                      LanguageEnglish,
+		"ja-JP,ja;q=0.9,en;q=0.8": LanguageJapanese,
+		"fr-FR,en;q=0.5,ja;q=0.7": LanguageJapanese,
+		"fr,en;q=0.1":             LanguageEnglish,
+		"de,fr":                   LanguageJapanese,
+		"en;q=0,ja;q=0.1":         LanguageJapanese,
+	}
+	for header, expected := range cases {
+		// when
+		got := negotiateLanguage(header, LanguageJapanese)
+
+		// then
+		if got != expected {
+			t.Errorf("Expected %q for %q, got %q", expected, header, got)
+		}
+	}
+}
+
+func TestErrorHandler_ShouldLocalizeFieldErrors(t *testing.T) {
+	// given
+	err := Validation([]FieldError{{
+		Field: "password", Rule: "min", Param: "8",
+		Messages: Messages{LanguageJapanese: "{param}文字以上で入力してください。", LanguageEnglish: "Must be at least {param} characters long."},
+	}})
+
+	// when
+	rec, details := serveError(err, "ja")
+
+	// then
+	if rec.Code != http.StatusBadRequest || details.Code != CodeValidationFailed {
+		t.Errorf("Expected 400 validation_failed, got %d %s", rec.Code, details.Code)
+	}
+	expected := []FieldDetails{{Field: "password", Code: "min", Detail: "8文字以上で入力してください。"}}
+	if !reflect.DeepEqual(details.Errors, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, details.Errors)
+	}
+}
@@ -0,0 +1,139 @@
+package problem
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Supported response languages
+const (
+	LanguageJapanese = "ja"
+	LanguageEnglish  = "en"
+)
+
+// Messages holds the localized message of an error code keyed by language
+type Messages map[string]string
+
+// builtinMessages are the messages of the codes declared in this package
+var builtinMessages = map[string]Messages{
+	CodeBadRequest: {
+		LanguageJapanese: "リクエストが不正です。",
+		LanguageEnglish:  "The request is invalid.",
+	},
+	CodeInvalidRequestBody: {
+		LanguageJapanese: "リクエストボディを解析できません。",
+		LanguageEnglish:  "The request body could not be parsed.",
+	},
+	CodeUnauthenticated: {
+		LanguageJapanese: "認証が必要です。",
+		LanguageEnglish:  "Authentication is required.",
+	},
+	CodeForbidden: {
+		LanguageJapanese: "この操作を行う権限がありません。",
+		LanguageEnglish:  "You are not allowed to perform this operation.",
+	},
+	CodeCSRFTokenInvalid: {
+		LanguageJapanese: "CSRFトークンが無効です。",
+		LanguageEnglish:  "The CSRF token is missing or invalid.",
+	},
+	CodeNotFound: {
+		LanguageJapanese: "リソースが見つかりません。",
+		LanguageEnglish:  "The resource was not found.",
+	},
+	CodeMethodNotAllowed: {
+		LanguageJapanese: "このメソッドは許可されていません。",
+		LanguageEnglish:  "The method is not allowed for this resource.",
+	},
+	CodeConflict: {
+		LanguageJapanese: "リソースの状態と競合しています。",
+		LanguageEnglish:  "The request conflicts with the current state of the resource.",
+	},
+	CodeRequestTooLarge: {
+		LanguageJapanese: "リクエストが大きすぎます。",
+		LanguageEnglish:  "The request is too large.",
+	},
+	CodeUnsupportedMediaType: {
+		LanguageJapanese: "サポートされていないContent-Typeです。",
+		LanguageEnglish:  "The content type is not supported.",
+	},
+	CodeUnprocessableEntity: {
+		LanguageJapanese: "リクエストを処理できません。",
+		LanguageEnglish:  "The request could not be processed.",
+	},
+	CodeValidationFailed: {
+		LanguageJapanese: "入力内容に誤りがあります。",
+		LanguageEnglish:  "The request contains invalid fields.",
+	},
+	CodeIdempotencyKeyInvalid: {
+		LanguageJapanese: "Idempotency-Keyが不正です。",
+		LanguageEnglish:  "The Idempotency-Key header is invalid.",
+	},
+	CodeIdempotencyKeyReused: {
+		LanguageJapanese: "このIdempotency-Keyは別のリクエストで使用済みです。",
+		LanguageEnglish:  "The Idempotency-Key was already used with a different request.",
+	},
+	CodeIdempotencyInProgress: {
+		LanguageJapanese: "同じIdempotency-Keyのリクエストを処理中です。",
+		LanguageEnglish:  "A request with this Idempotency-Key is still being processed.",
+	},
+	CodeRateLimited: {
+		LanguageJapanese: "リクエストが多すぎます。しばらくしてから再試行してください。",
+		LanguageEnglish:  "Too many requests. Please retry later.",
+	},
+	CodeInternal: {
+		LanguageJapanese: "サーバー内部でエラーが発生しました。",
+		LanguageEnglish:  "An internal server error occurred.",
+	},
+	CodeServiceUnavailable: {
+		LanguageJapanese: "サービスを一時的に利用できません。",
+		LanguageEnglish:  "The service is temporarily unavailable.",
+	},
+}
+
+var supportedLanguages = map[string]bool{
+	LanguageJapanese: true,
+	LanguageEnglish:  true,
+}
+
+// negotiateLanguage picks the supported language the client prefers most
+// according to an Accept-Language header, falling back to fallback
+func negotiateLanguage(acceptLanguage, fallback string) string {
+	type candidate struct {
+		language string
+		quality  float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.ToLower(strings.TrimSpace(fields[0]))
+		if tag == "" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if q, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					quality = q
+				}
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+		// Only the primary subtag matters: ja-JP is ja
+		primary := strings.SplitN(tag, "-", 2)[0]
+		candidates = append(candidates, candidate{language: primary, quality: quality})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+	for _, c := range candidates {
+		if supportedLanguages[c.language] {
+			return c.language
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,152 @@
+// Package problem renders API errors as RFC 7807 problem details.
+//
+// Handlers and middleware return errors instead of writing error bodies
+// themselves; the Echo error handler built by NewErrorHandler turns them into
+// application/problem+json responses with a stable machine-readable code, a
+// localized message and the request ID. Internal error text never reaches
+// the client.
+package problem
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// MIMEProblemJSON is the media type of problem detail responses
+const MIMEProblemJSON = "application/problem+json"
+
+// typeURIPrefix namespaces the problem type URI of each error code
+const typeURIPrefix = "urn:zen-connect:problem:"
+
+// Stable error codes shared by every context. Domain specific codes are
+// declared next to the domain errors they represent (see Mapping).
+const (
+	CodeBadRequest            = "bad_request"
+	CodeInvalidRequestBody    = "invalid_request_body"
+	CodeUnauthenticated       = "unauthenticated"
+	CodeForbidden             = "forbidden"
+	CodeCSRFTokenInvalid      = "csrf_token_invalid"
+	CodeNotFound              = "not_found"
+	CodeMethodNotAllowed      = "method_not_allowed"
+	CodeConflict              = "conflict"
+	CodeRequestTooLarge       = "request_too_large"
+	CodeUnsupportedMediaType  = "unsupported_media_type"
+	CodeUnprocessableEntity   = "unprocessable_entity"
+	CodeValidationFailed      = "validation_failed"
+	CodeIdempotencyKeyInvalid = "idempotency_key_invalid"
+	CodeIdempotencyKeyReused  = "idempotency_key_reused"
+	CodeIdempotencyInProgress = "idempotency_request_in_progress"
+	CodeRateLimited           = "rate_limited"
+	CodeInternal              = "internal_error"
+	CodeServiceUnavailable    = "service_unavailable"
+)
+
+// Details is the RFC 7807 response body
+type Details struct {
+	Type      string `json:"type"`
+	Title     string `json:"title"`
+	Status    int    `json:"status"`
+	Detail    string `json:"detail"`
+	Instance  string `json:"instance,omitempty"`
+	Code      string `json:"code"`
+	RequestID string `json:"request_id,omitempty"`
+	// Errors lists the invalid fields of a validation failure
+	Errors []FieldDetails `json:"errors,omitempty"`
+}
+
+// FieldDetails describes one invalid request field
+type FieldDetails struct {
+	Field  string `json:"field"`
+	Code   string `json:"code"`
+	Detail string `json:"detail"`
+}
+
+// FieldError is a failed rule on one request field. Its messages may contain
+// a {param} placeholder that is replaced with Param.
+type FieldError struct {
+	Field    string
+	Rule     string
+	Param    string
+	Messages Messages
+}
+
+// Error is an error with an explicit HTTP status and error code
+type Error struct {
+	Status int
+	Code   string
+	// Internal is the underlying cause; it is logged but never sent
+	Internal error
+	// Fields lists the invalid fields of a validation failure
+	Fields []FieldError
+}
+
+// New returns an error rendered with the given status and code
+func New(status int, code string) *Error {
+	return &Error{Status: status, Code: code}
+}
+
+// Validation returns a 400 validation error for the given fields
+func Validation(fields []FieldError) *Error {
+	return &Error{Status: http.StatusBadRequest, Code: CodeValidationFailed, Fields: fields}
+}
+
+// Wrap returns an error rendered with the given status and code that keeps
+// err as its cause
+func Wrap(err error, status int, code string) *Error {
+	return &Error{Status: status, Code: code, Internal: err}
+}
+
+func (e *Error) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s (%d): %v", e.Code, e.Status, e.Internal)
+	}
+	return fmt.Sprintf("%s (%d)", e.Code, e.Status)
+}
+
+// StatusCode returns the HTTP status the error is rendered with
+func (e *Error) StatusCode() int {
+	return e.Status
+}
+
+// Unwrap returns the underlying cause
+func (e *Error) Unwrap() error {
+	return e.Internal
+}
+
+// TypeURI returns the problem type URI for an error code
+func TypeURI(code string) string {
+	return typeURIPrefix + code
+}
+
+// codeForStatus is the generic code used for errors that carry only a status,
+// such as Echo's own routing and binding errors
+func codeForStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeBadRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthenticated
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusMethodNotAllowed:
+		return CodeMethodNotAllowed
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusRequestEntityTooLarge:
+		return CodeRequestTooLarge
+	case http.StatusUnsupportedMediaType:
+		return CodeUnsupportedMediaType
+	case http.StatusUnprocessableEntity:
+		return CodeUnprocessableEntity
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
+	case http.StatusServiceUnavailable:
+		return CodeServiceUnavailable
+	}
+	if status >= http.StatusInternalServerError {
+		return CodeInternal
+	}
+	return CodeBadRequest
+}
@@ -0,0 +1,133 @@
+package ratelimit
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	echoMiddleware "github.com/labstack/echo/v4/middleware"
+	"go.uber.org/zap"
+	"zen-connect/internal/infrastructure/logger"
+	"zen-connect/internal/infrastructure/problem"
+)
+
+// Rate limit response headers (draft-ietf-httpapi-ratelimit-headers)
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+)
+
+// Config configures the rate limit middleware
+type Config struct {
+	// Name identifies the limiter in keys and logs, e.g. "api" or "login"
+	Name  string
+	Store Store
+	Limit Limit
+
+	// Lockout blocks a key for this long once its bucket is exhausted.
+	// Zero disables lockouts.
+	Lockout time.Duration
+
+	// SharedAcrossRoutes uses one bucket per client for every route the
+	// middleware applies to instead of one per route
+	SharedAcrossRoutes bool
+
+	// UserID resolves the authenticated user, if any. Requests from a
+	// known user are limited per user, others per client IP.
+	UserID func(c echo.Context) (string, bool)
+
+	// Skipper defines a function to skip the middleware
+	Skipper echoMiddleware.Skipper
+}
+
+// Middleware returns a token bucket rate limit middleware keyed by limiter
+// name, route and user ID (or client IP for anonymous requests)
+func Middleware(config Config) echo.MiddlewareFunc {
+	if config.Skipper == nil {
+		config.Skipper = echoMiddleware.DefaultSkipper
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if config.Skipper(c) {
+				return next(c)
+			}
+
+			ctx := c.Request().Context()
+			key := config.key(c)
+
+			result, err := config.Store.Take(ctx, key, config.Limit)
+			if err != nil {
+				// Fail open: an unavailable store must not take the API down
+				logger.WithContext(ctx).Error("Rate limit store failed",
+					zap.String("limiter", config.Name),
+					zap.Error(err),
+				)
+				return next(c)
+			}
+
+			setHeaders(c, result)
+			if result.Allowed {
+				return next(c)
+			}
+
+			if !result.Blocked && config.Lockout > 0 {
+				if err := config.Store.Block(ctx, key, config.Lockout); err != nil {
+					logger.WithContext(ctx).Error("Failed to apply rate limit lockout",
+						zap.String("limiter", config.Name),
+						zap.Error(err),
+					)
+				} else {
+					result.RetryAfter = config.Lockout
+					logger.GetGlobalLogger().LogSecurityEvent(ctx, "rate_limit_lockout", "high",
+						"Client locked out after exceeding rate limit",
+						zap.String("limiter", config.Name),
+						zap.String("key", key),
+						zap.String("path", c.Path()),
+						zap.String("remote_addr", c.RealIP()),
+						zap.Duration("lockout", config.Lockout),
+					)
+				}
+			} else if !result.Blocked {
+				logger.GetGlobalLogger().LogSecurityEvent(ctx, "rate_limit_exceeded", "low",
+					"Request rejected by rate limit",
+					zap.String("limiter", config.Name),
+					zap.String("key", key),
+					zap.String("path", c.Path()),
+				)
+			}
+
+			c.Response().Header().Set(echo.HeaderRetryAfter, formatSeconds(result.RetryAfter))
+			return problem.New(http.StatusTooManyRequests, problem.CodeRateLimited)
+		}
+	}
+}
+
+// key builds the bucket key for the request
+func (config Config) key(c echo.Context) string {
+	subject := "ip:" + c.RealIP()
+	if config.UserID != nil {
+		if userID, ok := config.UserID(c); ok && userID != "" {
+			subject = "user:" + userID
+		}
+	}
+	if config.SharedAcrossRoutes {
+		return config.Name + "|" + subject
+	}
+	return config.Name + "|" + c.Request().Method + " " + c.Path() + "|" + subject
+}
+
+func setHeaders(c echo.Context, result Result) {
+	header := c.Response().Header()
+	header.Set(HeaderRateLimitLimit, strconv.Itoa(result.Limit))
+	header.Set(HeaderRateLimitRemaining, strconv.Itoa(result.Remaining))
+	header.Set(HeaderRateLimitReset, formatSeconds(result.Reset))
+}
+
+// formatSeconds renders d as whole seconds, rounding up
+func formatSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
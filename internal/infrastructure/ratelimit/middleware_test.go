@@ -0,0 +1,115 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/infrastructure/problem"
+)
+
+func newLimitedServer(config Config) *echo.Echo {
+	e := echo.New()
+	e.HTTPErrorHandler = problem.NewErrorHandler(problem.Config{})
+	e.Use(Middleware(config))
+	handler := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+	e.GET("/a", handler)
+	e.GET("/b", handler)
+	return e
+}
+
+func doRequest(e *echo.Echo, path, ip, user string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.RemoteAddr = ip + ":1234"
+	if user != "" {
+		req.Header.Set("X-Test-User", user)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func testUserID(c echo.Context) (string, bool) {
+	user := c.Request().Header.Get("X-Test-User")
+	return user, user != ""
+}
+
+func TestMiddleware_ShouldSetHeadersAndRejectWhenExhausted(t *testing.T) {
+	// given
+	store, _ := newTestStore()
+	e := newLimitedServer(Config{Name: "api", Store: store, Limit: Limit{Requests: 2, Period: time.Minute}})
+
+	// when
+	first := doRequest(e, "/a", "10.0.0.1", "")
+	doRequest(e, "/a", "10.0.0.1", "")
+	rejected := doRequest(e, "/a", "10.0.0.1", "")
+
+	// then
+	if first.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", first.Code)
+	}
+	if first.Header().Get(HeaderRateLimitLimit) != "2" || first.Header().Get(HeaderRateLimitRemaining) != "1" {
+		t.Errorf("Unexpected rate limit headers: %v", first.Header())
+	}
+	if first.Header().Get(HeaderRateLimitReset) != "30" {
+		t.Errorf("Expected reset in 30s, got %q", first.Header().Get(HeaderRateLimitReset))
+	}
+	if rejected.Code != http.StatusTooManyRequests {
+		t.Errorf("Expected status 429, got %d", rejected.Code)
+	}
+	if rejected.Header().Get(echo.HeaderRetryAfter) != "30" {
+		t.Errorf("Expected Retry-After 30, got %q", rejected.Header().Get(echo.HeaderRetryAfter))
+	}
+}
+
+func TestMiddleware_ShouldKeyByRouteUserAndIP(t *testing.T) {
+	// given
+	store, _ := newTestStore()
+	e := newLimitedServer(Config{Name: "api", Store: store, Limit: Limit{Requests: 1, Period: time.Minute}, UserID: testUserID})
+	doRequest(e, "/a", "10.0.0.1", "")
+	doRequest(e, "/a", "10.0.0.1", "alice")
+
+	// when
+	otherRoute := doRequest(e, "/b", "10.0.0.1", "")
+	otherIP := doRequest(e, "/a", "10.0.0.2", "")
+	otherUser := doRequest(e, "/a", "10.0.0.1", "bob")
+	sameUserOtherIP := doRequest(e, "/a", "10.0.0.3", "alice")
+
+	// then
+	for name, rec := range map[string]*httptest.ResponseRecorder{"route": otherRoute, "IP": otherIP, "user": otherUser} {
+		if rec.Code != http.StatusOK {
+			t.Errorf("Expected a different %s to have its own bucket, got %d", name, rec.Code)
+		}
+	}
+	if sameUserOtherIP.Code != http.StatusTooManyRequests {
+		t.Errorf("Expected user limit to follow the user across IPs, got %d", sameUserOtherIP.Code)
+	}
+}
+
+func TestMiddleware_ShouldLockOutAcrossRoutes(t *testing.T) {
+	// given
+	store, clock := newTestStore()
+	e := newLimitedServer(Config{
+		Name:               "login",
+		Store:              store,
+		Limit:              Limit{Requests: 1, Period: time.Second},
+		Lockout:            15 * time.Minute,
+		SharedAcrossRoutes: true,
+	})
+	doRequest(e, "/a", "10.0.0.1", "")
+
+	// when
+	exceeded := doRequest(e, "/b", "10.0.0.1", "")
+	clock.now = clock.now.Add(time.Minute)
+	stillLocked := doRequest(e, "/a", "10.0.0.1", "")
+
+	// then
+	if exceeded.Code != http.StatusTooManyRequests || exceeded.Header().Get(echo.HeaderRetryAfter) != "900" {
+		t.Errorf("Expected lockout with Retry-After 900, got %d %q", exceeded.Code, exceeded.Header().Get(echo.HeaderRetryAfter))
+	}
+	if stillLocked.Code != http.StatusTooManyRequests {
+		t.Errorf("Expected client to remain locked out, got %d", stillLocked.Code)
+	}
+}
@@ -0,0 +1,151 @@
+// Package ratelimit provides token bucket rate limiting for HTTP handlers.
+//
+// Buckets live in a Store so that the in-memory implementation used by a
+// single instance can later be replaced by a shared one.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit describes a token bucket: Burst tokens at most, refilled at
+// Requests tokens per Period
+type Limit struct {
+	Requests int
+	Period   time.Duration
+	Burst    int
+}
+
+// capacity returns the bucket size
+func (l Limit) capacity() float64 {
+	if l.Burst > 0 {
+		return float64(l.Burst)
+	}
+	return float64(l.Requests)
+}
+
+// refillRate returns the number of tokens added per second
+func (l Limit) refillRate() float64 {
+	return float64(l.Requests) / l.Period.Seconds()
+}
+
+// Result is the outcome of taking a token from a bucket
+type Result struct {
+	Allowed    bool
+	Limit      int           // bucket capacity
+	Remaining  int           // tokens left after this request
+	Reset      time.Duration // until the bucket is full again
+	RetryAfter time.Duration // until the next request may succeed; zero when allowed
+	Blocked    bool          // true when the key is locked out
+}
+
+// Store keeps rate limit state per key
+type Store interface {
+	// Take consumes one token for key under the given limit
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+	// Block rejects every request for key until the duration has passed
+	Block(ctx context.Context, key string, d time.Duration) error
+}
+
+// bucket is the in-memory state of a single key
+type bucket struct {
+	tokens       float64
+	updatedAt    time.Time
+	blockedUntil time.Time
+}
+
+// MemoryStore is a Store kept in process memory
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	now       func() time.Time
+	idleTTL   time.Duration
+	lastSweep time.Time
+}
+
+// NewMemoryStore creates an in-memory store. Buckets untouched for idleTTL
+// are discarded; a full bucket behaves the same as a missing one.
+func NewMemoryStore(idleTTL time.Duration) *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+		idleTTL: idleTTL,
+	}
+}
+
+// Take consumes one token for key
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	capacity := limit.capacity()
+	rate := limit.refillRate()
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: capacity, updatedAt: now}
+		s.buckets[key] = b
+	}
+
+	// Refill for the time elapsed since the last request
+	b.tokens = math.Min(capacity, b.tokens+now.Sub(b.updatedAt).Seconds()*rate)
+	b.updatedAt = now
+
+	result := Result{Limit: int(capacity)}
+
+	if now.Before(b.blockedUntil) {
+		result.Blocked = true
+		result.RetryAfter = b.blockedUntil.Sub(now)
+		result.Reset = result.RetryAfter
+		return result, nil
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = secondsToDuration((1 - b.tokens) / rate)
+	}
+	result.Remaining = int(b.tokens)
+	result.Reset = secondsToDuration((capacity - b.tokens) / rate)
+
+	return result, nil
+}
+
+// Block locks key out for d
+func (s *MemoryStore) Block(_ context.Context, key string, d time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{updatedAt: now}
+		s.buckets[key] = b
+	}
+	b.blockedUntil = now.Add(d)
+	return nil
+}
+
+// sweep drops idle buckets at most once per idleTTL
+func (s *MemoryStore) sweep(now time.Time) {
+	if s.idleTTL <= 0 || now.Sub(s.lastSweep) < s.idleTTL {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if now.Sub(b.updatedAt) > s.idleTTL && now.After(b.blockedUntil) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Ceil(seconds * float64(time.Second)))
+}
@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type fakeClock struct{ now time.Time }
+
+func (f *fakeClock) Now() time.Time { return f.now }
+
+func newTestStore() (*MemoryStore, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := NewMemoryStore(time.Hour)
+	store.now = clock.Now
+	return store, clock
+}
+
+func TestMemoryStore_ShouldAllowBurstThenReject(t *testing.T) {
+	// given
+	store, _ := newTestStore()
+	limit := Limit{Requests: 1, Period: time.Second, Burst: 3}
+
+	// when
+	var results []Result
+	for i := 0; i < 4; i++ {
+		result, _ := store.Take(context.Background(), "k", limit)
+		results = append(results, result)
+	}
+
+	// then
+	for i := 0; i < 3; i++ {
+		if !results[i].Allowed {
+			t.Errorf("Expected request %d to be allowed", i+1)
+		}
+	}
+	if results[2].Remaining != 0 {
+		t.Errorf("Expected no remaining tokens, got %d", results[2].Remaining)
+	}
+	if results[3].Allowed {
+		t.Error("Expected request beyond burst to be rejected")
+	}
+	if results[3].RetryAfter != time.Second {
+		t.Errorf("Expected retry after 1s, got %s", results[3].RetryAfter)
+	}
+}
+
+func TestMemoryStore_ShouldRefillOverTime(t *testing.T) {
+	// given
+	store, clock := newTestStore()
+	limit := Limit{Requests: 60, Period: time.Minute, Burst: 1}
+	store.Take(context.Background(), "k", limit)
+
+	// when
+	clock.now = clock.now.Add(500 * time.Millisecond)
+	early, _ := store.Take(context.Background(), "k", limit)
+	clock.now = clock.now.Add(time.Second)
+	later, _ := store.Take(context.Background(), "k", limit)
+
+	// then
+	if early.Allowed {
+		t.Error("Expected request before refill to be rejected")
+	}
+	if !later.Allowed {
+		t.Error("Expected request after refill to be allowed")
+	}
+}
+
+func TestMemoryStore_ShouldRejectBlockedKeys(t *testing.T) {
+	// given
+	store, clock := newTestStore()
+	limit := Limit{Requests: 10, Period: time.Second}
+	store.Block(context.Background(), "k", time.Minute)
+
+	// when
+	blocked, _ := store.Take(context.Background(), "k", limit)
+	other, _ := store.Take(context.Background(), "other", limit)
+	clock.now = clock.now.Add(time.Minute + time.Second)
+	released, _ := store.Take(context.Background(), "k", limit)
+
+	// then
+	if blocked.Allowed || !blocked.Blocked || blocked.RetryAfter != time.Minute {
+		t.Errorf("Expected key to be blocked for 1m, got %+v", blocked)
+	}
+	if !other.Allowed {
+		t.Error("Expected other keys to be unaffected")
+	}
+	if !released.Allowed {
+		t.Error("Expected key to be released after the lockout")
+	}
+}
@@ -0,0 +1,93 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Leader decides which instance runs the jobs
+type Leader interface {
+	// IsLeader reports whether this instance leads, trying to become leader if it does not
+	IsLeader(ctx context.Context) (bool, error)
+	// Release gives up leadership so another instance can take over
+	Release(ctx context.Context)
+}
+
+// SingleLeader always leads, for tests and single-instance setups
+type SingleLeader struct{}
+
+// IsLeader always reports true
+func (SingleLeader) IsLeader(context.Context) (bool, error) { return true, nil }
+
+// Release does nothing
+func (SingleLeader) Release(context.Context) {}
+
+// AdvisoryLockLeader elects a leader through a Postgres session-level
+// advisory lock. The leader keeps one pooled connection for as long as it
+// leads; if that connection breaks, the lock is released by the server and
+// another instance takes over on its next check.
+type AdvisoryLockLeader struct {
+	pool *pgxpool.Pool
+	key  int64
+
+	mu   sync.Mutex
+	conn *pgxpool.Conn
+}
+
+// NewAdvisoryLockLeader creates a leader elected through the advisory lock with the key
+func NewAdvisoryLockLeader(pool *pgxpool.Pool, key int64) *AdvisoryLockLeader {
+	return &AdvisoryLockLeader{
+		pool: pool,
+		key:  key,
+	}
+}
+
+// IsLeader checks the held lock is still alive, or tries to take it
+func (l *AdvisoryLockLeader) IsLeader(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn != nil {
+		if _, err := l.conn.Exec(ctx, `SELECT 1`); err == nil {
+			return true, nil
+		}
+		// The session is gone and the lock with it; never return the
+		// connection to the pool, it may still hold the lock
+		l.conn.Hijack().Close(ctx)
+		l.conn = nil
+	}
+
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	var locked bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock($1)`, l.key).Scan(&locked); err != nil {
+		conn.Release()
+		return false, err
+	}
+	if !locked {
+		conn.Release()
+		return false, nil
+	}
+	l.conn = conn
+	return true, nil
+}
+
+// Release unlocks the advisory lock and returns the connection to the pool
+func (l *AdvisoryLockLeader) Release(ctx context.Context) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return
+	}
+	if _, err := l.conn.Exec(ctx, `SELECT pg_advisory_unlock($1)`, l.key); err != nil {
+		l.conn.Hijack().Close(ctx)
+	} else {
+		l.conn.Release()
+	}
+	l.conn = nil
+}
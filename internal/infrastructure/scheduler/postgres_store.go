@@ -0,0 +1,75 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresStore is a Store backed by the scheduled_jobs table
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore creates a PostgreSQL job state store
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{
+		pool: pool,
+	}
+}
+
+// Ensure stores a state for the job unless it already has one
+func (s *PostgresStore) Ensure(ctx context.Context, name string, nextRunAt time.Time) error {
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO scheduled_jobs (name, next_run_at) VALUES ($1, $2)
+		ON CONFLICT (name) DO NOTHING
+	`, name, nextRunAt)
+	return err
+}
+
+// Due returns the jobs whose next run is at or before now, earliest first
+func (s *PostgresStore) Due(ctx context.Context, now time.Time) ([]*State, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT name, next_run_at, last_run_at, failures, last_error
+		FROM scheduled_jobs
+		WHERE next_run_at <= $1
+		ORDER BY next_run_at
+	`, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var due []*State
+	for rows.Next() {
+		var state State
+		var lastRunAt *time.Time
+		if err := rows.Scan(&state.Name, &state.NextRunAt, &lastRunAt, &state.Failures, &state.LastError); err != nil {
+			return nil, err
+		}
+		if lastRunAt != nil {
+			state.LastRunAt = *lastRunAt
+		}
+		due = append(due, &state)
+	}
+	return due, rows.Err()
+}
+
+// Save stores the job's state
+func (s *PostgresStore) Save(ctx context.Context, state *State) error {
+	var lastRunAt *time.Time
+	if !state.LastRunAt.IsZero() {
+		lastRunAt = &state.LastRunAt
+	}
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO scheduled_jobs (name, next_run_at, last_run_at, failures, last_error)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (name) DO UPDATE SET
+			next_run_at = EXCLUDED.next_run_at,
+			last_run_at = EXCLUDED.last_run_at,
+			failures = EXCLUDED.failures,
+			last_error = EXCLUDED.last_error
+	`, state.Name, state.NextRunAt, lastRunAt, state.Failures, state.LastError)
+	return err
+}
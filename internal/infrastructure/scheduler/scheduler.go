@@ -0,0 +1,225 @@
+// Package scheduler runs recurring background jobs on exactly one instance.
+//
+// Job state (when each job runs next, how it failed) is kept in a Store so
+// it survives restarts. Instances compete for leadership through a Leader,
+// a Postgres advisory lock in production, and only the leader runs jobs. A
+// job's next run is only moved forward after it succeeded, so a run that is
+// interrupted by a crash or a change of leader is repeated: execution is at
+// least once, and jobs must be safe to repeat.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Schedule decides when a job runs next
+type Schedule interface {
+	// Next returns the first run time after the given time
+	Next(after time.Time) time.Time
+}
+
+type every time.Duration
+
+// Every runs a job at a fixed interval
+func Every(interval time.Duration) Schedule {
+	return every(interval)
+}
+
+func (e every) Next(after time.Time) time.Time {
+	return after.Add(time.Duration(e))
+}
+
+type weekly struct {
+	day    time.Weekday
+	offset time.Duration
+}
+
+// Weekly runs a job once a week on the day, offset from midnight UTC
+func Weekly(day time.Weekday, offset time.Duration) Schedule {
+	return weekly{day: day, offset: offset}
+}
+
+func (w weekly) Next(after time.Time) time.Time {
+	after = after.UTC()
+	midnight := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, time.UTC)
+	days := (int(w.day) - int(after.Weekday()) + 7) % 7
+	next := midnight.AddDate(0, 0, days).Add(w.offset)
+	if !next.After(after) {
+		next = next.AddDate(0, 0, 7)
+	}
+	return next
+}
+
+// Config controls how jobs are run
+type Config struct {
+	// PollInterval is how often due jobs are looked for
+	PollInterval time.Duration
+	// RetryBackoff is the wait before a failed job is retried; it doubles
+	// with every failure but never goes past the job's next regular run
+	RetryBackoff time.Duration
+	// OnError is told about failed jobs and scheduler errors (job is empty for the latter)
+	OnError func(job string, err error)
+}
+
+// job is a registered job
+type job struct {
+	name     string
+	schedule Schedule
+	run      func(ctx context.Context) error
+}
+
+// Scheduler runs the registered jobs when they are due
+type Scheduler struct {
+	store  Store
+	leader Leader
+	config Config
+	now    func() time.Time
+
+	mu      sync.Mutex
+	jobs    []*job
+	ensured bool
+}
+
+// New creates a scheduler
+func New(store Store, leader Leader, config Config) *Scheduler {
+	if config.PollInterval <= 0 {
+		config.PollInterval = 5 * time.Second
+	}
+	if config.RetryBackoff <= 0 {
+		config.RetryBackoff = time.Minute
+	}
+	if config.OnError == nil {
+		config.OnError = func(string, error) {}
+	}
+	return &Scheduler{
+		store:  store,
+		leader: leader,
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// Register adds a job. Its first run is one schedule step after it is first
+// seen; from then on the stored state decides, so a job that was due while
+// no instance was running runs as soon as one starts.
+func (s *Scheduler) Register(name string, schedule Schedule, run func(ctx context.Context) error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.jobs = append(s.jobs, &job{name: name, schedule: schedule, run: run})
+	s.ensured = false
+}
+
+// Run runs due jobs until the context is cancelled, then gives up leadership
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.PollInterval)
+	defer ticker.Stop()
+	defer s.leader.Release(context.Background())
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.RunDue(ctx); err != nil {
+				s.config.OnError("", err)
+			}
+		}
+	}
+}
+
+// RunDue runs the jobs that are due if this instance is the leader and
+// returns how many ran. Failed jobs are reported to OnError and retried later.
+func (s *Scheduler) RunDue(ctx context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	leader, err := s.leader.IsLeader(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check leadership: %w", err)
+	}
+	if !leader {
+		return 0, nil
+	}
+	if err := s.ensureJobs(ctx); err != nil {
+		return 0, err
+	}
+
+	due, err := s.store.Due(ctx, s.now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to load due jobs: %w", err)
+	}
+	ran := 0
+	var errs []error
+	for _, state := range due {
+		job := s.find(state.Name)
+		if job == nil {
+			// Jobs that are no longer registered keep their state until they come back
+			continue
+		}
+		runErr := job.run(ctx)
+		s.record(job, state, runErr)
+		if runErr != nil {
+			s.config.OnError(job.name, runErr)
+		}
+		if err := s.store.Save(ctx, state); err != nil {
+			errs = append(errs, fmt.Errorf("failed to save job %s: %w", job.name, err))
+			continue
+		}
+		ran++
+	}
+	return ran, errors.Join(errs...)
+}
+
+// ensureJobs stores a state for every registered job that has none yet
+func (s *Scheduler) ensureJobs(ctx context.Context) error {
+	if s.ensured {
+		return nil
+	}
+	now := s.now()
+	for _, job := range s.jobs {
+		if err := s.store.Ensure(ctx, job.name, job.schedule.Next(now)); err != nil {
+			return fmt.Errorf("failed to register job %s: %w", job.name, err)
+		}
+	}
+	s.ensured = true
+	return nil
+}
+
+func (s *Scheduler) find(name string) *job {
+	for _, job := range s.jobs {
+		if job.name == name {
+			return job
+		}
+	}
+	return nil
+}
+
+// maxBackoffDoublings limits how often the retry backoff doubles
+const maxBackoffDoublings = 16
+
+// record applies the outcome of a run to the job's state
+func (s *Scheduler) record(job *job, state *State, runErr error) {
+	now := s.now()
+	next := job.schedule.Next(now)
+	state.LastRunAt = now
+	if runErr == nil {
+		state.NextRunAt = next
+		state.Failures = 0
+		state.LastError = ""
+		return
+	}
+
+	state.Failures++
+	state.LastError = runErr.Error()
+	// The doubling is capped so that the shift cannot overflow after many failures
+	retry := now.Add(s.config.RetryBackoff << min(state.Failures-1, maxBackoffDoublings))
+	if !retry.After(now) || retry.After(next) {
+		retry = next
+	}
+	state.NextRunAt = retry
+}
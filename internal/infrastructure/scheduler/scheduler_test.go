@@ -0,0 +1,178 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeClock struct{ now time.Time }
+
+func (f *fakeClock) Now() time.Time { return f.now }
+
+// switchLeader leads while leading is true
+type switchLeader struct{ leading bool }
+
+func (l *switchLeader) IsLeader(context.Context) (bool, error) { return l.leading, nil }
+
+func (l *switchLeader) Release(context.Context) { l.leading = false }
+
+func newTestScheduler(leader Leader) (*Scheduler, *MemoryStore, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := NewMemoryStore()
+	scheduler := New(store, leader, Config{RetryBackoff: time.Minute})
+	scheduler.now = clock.Now
+	return scheduler, store, clock
+}
+
+func TestScheduler_ShouldRunJobWhenDue(t *testing.T) {
+	// given
+	scheduler, store, clock := newTestScheduler(SingleLeader{})
+	runs := 0
+	scheduler.Register("count", Every(time.Hour), func(context.Context) error {
+		runs++
+		return nil
+	})
+
+	// when
+	early, _ := scheduler.RunDue(context.Background())
+	clock.now = clock.now.Add(time.Hour)
+	due, err := scheduler.RunDue(context.Background())
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if early != 0 || due != 1 || runs != 1 {
+		t.Errorf("Expected one run once the hour passed, got %d before and %d after (%d runs)", early, due, runs)
+	}
+	if next := store.State("count").NextRunAt; !next.Equal(clock.now.Add(time.Hour)) {
+		t.Errorf("Expected the next run an hour later, got %v", next)
+	}
+}
+
+func TestScheduler_ShouldOnlyRunOnLeader(t *testing.T) {
+	// given
+	leader := &switchLeader{leading: true}
+	scheduler, _, clock := newTestScheduler(leader)
+	runs := 0
+	scheduler.Register("count", Every(time.Minute), func(context.Context) error {
+		runs++
+		return nil
+	})
+	scheduler.RunDue(context.Background())
+	leader.leading = false
+	clock.now = clock.now.Add(time.Hour)
+
+	// when
+	follower, _ := scheduler.RunDue(context.Background())
+	leader.leading = true
+	leading, _ := scheduler.RunDue(context.Background())
+
+	// then
+	if follower != 0 || leading != 1 || runs != 1 {
+		t.Errorf("Expected only the leader to run the job, got %d as follower and %d as leader", follower, leading)
+	}
+}
+
+func TestScheduler_ShouldRetryFailedJobWithBackoff(t *testing.T) {
+	// given
+	var reported []string
+	scheduler, store, clock := newTestScheduler(SingleLeader{})
+	scheduler.config.OnError = func(job string, err error) { reported = append(reported, job) }
+	failures := 2
+	scheduler.Register("flaky", Every(time.Hour), func(context.Context) error {
+		if failures > 0 {
+			failures--
+			return errors.New("unavailable")
+		}
+		return nil
+	})
+	scheduler.RunDue(context.Background())
+	clock.now = clock.now.Add(time.Hour)
+
+	// when
+	scheduler.RunDue(context.Background())
+	first := store.State("flaky")
+	clock.now = first.NextRunAt
+	scheduler.RunDue(context.Background())
+	second := store.State("flaky")
+	clock.now = second.NextRunAt
+	scheduler.RunDue(context.Background())
+	recovered := store.State("flaky")
+
+	// then
+	if first.Failures != 1 || first.LastError != "unavailable" || first.NextRunAt.Sub(first.LastRunAt) != time.Minute {
+		t.Errorf("Expected a retry after a minute, got %+v", first)
+	}
+	if second.Failures != 2 || second.NextRunAt.Sub(second.LastRunAt) != 2*time.Minute {
+		t.Errorf("Expected the backoff to double, got %+v", second)
+	}
+	if recovered.Failures != 0 || recovered.LastError != "" || recovered.NextRunAt.Sub(recovered.LastRunAt) != time.Hour {
+		t.Errorf("Expected the schedule to resume after a success, got %+v", recovered)
+	}
+	if len(reported) != 2 || reported[0] != "flaky" {
+		t.Errorf("Expected both failures to be reported, got %v", reported)
+	}
+}
+
+func TestScheduler_ShouldRetryOnScheduleAfterManyFailures(t *testing.T) {
+	// given
+	scheduler, store, clock := newTestScheduler(SingleLeader{})
+	scheduler.Register("broken", Every(time.Hour), func(context.Context) error {
+		return errors.New("unavailable")
+	})
+	scheduler.RunDue(context.Background())
+	state := store.State("broken")
+	state.Failures = 100
+	store.Save(context.Background(), state)
+	clock.now = state.NextRunAt
+
+	// when
+	scheduler.RunDue(context.Background())
+	failed := store.State("broken")
+
+	// then
+	if failed.Failures != 101 || failed.NextRunAt.Sub(failed.LastRunAt) != time.Hour {
+		t.Errorf("Expected the retry to wait for the next scheduled run, got %+v", failed)
+	}
+}
+
+func TestScheduler_ShouldRunMissedJobAfterRestart(t *testing.T) {
+	// given
+	scheduler, store, clock := newTestScheduler(SingleLeader{})
+	store.Ensure(context.Background(), "weekly", clock.now.Add(-24*time.Hour))
+	runs := 0
+	scheduler.Register("weekly", Weekly(time.Monday, 0), func(context.Context) error {
+		runs++
+		return nil
+	})
+
+	// when
+	ran, err := scheduler.RunDue(context.Background())
+
+	// then
+	if err != nil || ran != 1 || runs != 1 {
+		t.Errorf("Expected the missed run to happen at once, got %d runs (%v)", runs, err)
+	}
+}
+
+func TestWeekly_ShouldReturnNextOccurrence(t *testing.T) {
+	// given
+	schedule := Weekly(time.Monday, 6*time.Hour)
+	wednesday := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	monday := time.Date(2025, 1, 6, 6, 0, 0, 0, time.UTC)
+
+	// when
+	fromWednesday := schedule.Next(wednesday)
+	fromMonday := schedule.Next(monday)
+
+	// then
+	if !fromWednesday.Equal(monday) {
+		t.Errorf("Expected %v, got %v", monday, fromWednesday)
+	}
+	if !fromMonday.Equal(monday.AddDate(0, 0, 7)) {
+		t.Errorf("Expected the following Monday, got %v", fromMonday)
+	}
+}
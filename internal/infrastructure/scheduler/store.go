@@ -0,0 +1,90 @@
+package scheduler
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// State is the stored state of one job
+type State struct {
+	Name      string
+	NextRunAt time.Time
+	LastRunAt time.Time
+	// Failures counts the failed runs since the last success
+	Failures  int
+	LastError string
+}
+
+// Store persists job states
+type Store interface {
+	// Ensure stores a state for the job unless it already has one
+	Ensure(ctx context.Context, name string, nextRunAt time.Time) error
+	// Due returns the jobs whose next run is at or before now, earliest first
+	Due(ctx context.Context, now time.Time) ([]*State, error)
+	Save(ctx context.Context, state *State) error
+}
+
+// MemoryStore is a Store kept in process memory, for tests and single-instance setups
+type MemoryStore struct {
+	mu     sync.Mutex
+	states map[string]*State
+}
+
+// NewMemoryStore creates an in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		states: make(map[string]*State),
+	}
+}
+
+// Ensure stores a state for the job unless it already has one
+func (s *MemoryStore) Ensure(_ context.Context, name string, nextRunAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.states[name]; !ok {
+		s.states[name] = &State{Name: name, NextRunAt: nextRunAt}
+	}
+	return nil
+}
+
+// Due returns copies of the jobs that are due, earliest first
+func (s *MemoryStore) Due(_ context.Context, now time.Time) ([]*State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []*State
+	for _, state := range s.states {
+		if !state.NextRunAt.After(now) {
+			copied := *state
+			due = append(due, &copied)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].NextRunAt.Before(due[j].NextRunAt) })
+	return due, nil
+}
+
+// Save stores the job's state
+func (s *MemoryStore) Save(_ context.Context, state *State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	copied := *state
+	s.states[state.Name] = &copied
+	return nil
+}
+
+// State returns a copy of the job's state, or nil if the job was never registered
+func (s *MemoryStore) State(name string) *State {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.states[name]
+	if !ok {
+		return nil
+	}
+	copied := *state
+	return &copied
+}
@@ -0,0 +1,136 @@
+package security
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	echoMiddleware "github.com/labstack/echo/v4/middleware"
+)
+
+// CORSConfig holds cross-origin settings for browser clients
+type CORSConfig struct {
+	// AllowOrigins lists exact origins ("https://app.example.com") and
+	// wildcard subdomain origins ("https://*.example.com")
+	AllowOrigins     []string
+	AllowMethods     []string
+	AllowHeaders     []string
+	ExposeHeaders    []string
+	AllowCredentials bool
+	MaxAge           time.Duration
+}
+
+// OriginMatcher decides whether a request origin is allowed
+type OriginMatcher struct {
+	any       bool
+	exact     map[string]bool
+	wildcards []wildcardOrigin
+}
+
+// wildcardOrigin matches any subdomain of suffix with the given scheme and port
+type wildcardOrigin struct {
+	scheme string
+	suffix string // e.g. ".example.com" or ".example.com:8443"
+}
+
+// NewOriginMatcher parses the allowed origin patterns
+func NewOriginMatcher(patterns []string) (*OriginMatcher, error) {
+	m := &OriginMatcher{exact: make(map[string]bool)}
+
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(strings.TrimRight(strings.TrimSpace(pattern), "/"))
+		if pattern == "*" {
+			m.any = true
+			continue
+		}
+
+		scheme, host, ok := strings.Cut(pattern, "://")
+		if !ok || (scheme != "http" && scheme != "https") || host == "" {
+			return nil, fmt.Errorf("invalid origin %q", pattern)
+		}
+
+		if strings.HasPrefix(host, "*.") {
+			// Require at least a registrable domain so "https://*.com" is rejected
+			suffix := host[1:]
+			domain, _, _ := strings.Cut(suffix[1:], ":")
+			if strings.ContainsAny(suffix, "*/?#@") || (!strings.Contains(domain, ".") && domain != "localhost") {
+				return nil, fmt.Errorf("invalid wildcard origin %q", pattern)
+			}
+			m.wildcards = append(m.wildcards, wildcardOrigin{scheme: scheme, suffix: suffix})
+			continue
+		}
+
+		if strings.ContainsAny(host, "*/?#@") {
+			return nil, fmt.Errorf("invalid origin %q", pattern)
+		}
+		m.exact[scheme+"://"+host] = true
+	}
+
+	return m, nil
+}
+
+// Match returns true if origin is allowed
+func (m *OriginMatcher) Match(origin string) bool {
+	origin = strings.ToLower(origin)
+	if m.any || m.exact[origin] {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" || u.Path != "" || u.RawQuery != "" || u.User != nil {
+		return false
+	}
+
+	for _, w := range m.wildcards {
+		if u.Scheme != w.scheme || !strings.HasSuffix(u.Host, w.suffix) {
+			continue
+		}
+		if isValidSubdomain(strings.TrimSuffix(u.Host, w.suffix)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isValidSubdomain checks that label is one or more DNS labels
+func isValidSubdomain(label string) bool {
+	if label == "" {
+		return false
+	}
+	for _, part := range strings.Split(label, ".") {
+		if part == "" || strings.HasPrefix(part, "-") || strings.HasSuffix(part, "-") {
+			return false
+		}
+		for _, r := range part {
+			if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// CORS returns the CORS middleware for the given configuration
+func CORS(config CORSConfig) (echo.MiddlewareFunc, error) {
+	matcher, err := NewOriginMatcher(config.AllowOrigins)
+	if err != nil {
+		return nil, err
+	}
+	if matcher.any && config.AllowCredentials {
+		return nil, fmt.Errorf("wildcard origin \"*\" cannot be combined with credentials")
+	}
+
+	return echoMiddleware.CORSWithConfig(echoMiddleware.CORSConfig{
+		AllowOriginFunc: func(origin string) (bool, error) {
+			return matcher.Match(origin), nil
+		},
+		AllowMethods:     config.AllowMethods,
+		AllowHeaders:     config.AllowHeaders,
+		ExposeHeaders:    config.ExposeHeaders,
+		AllowCredentials: config.AllowCredentials,
+		MaxAge:           int(config.MaxAge.Seconds()),
+	}), nil
+}
@@ -0,0 +1,156 @@
+package security
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func newCORSServer(t *testing.T, config CORSConfig) *echo.Echo {
+	t.Helper()
+	e := echo.New()
+	middleware, err := CORS(config)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	e.Use(middleware)
+	e.GET("/resource", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	e.PATCH("/resource", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	return e
+}
+
+func preflight(e *echo.Echo, origin, method string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodOptions, "/resource", nil)
+	req.Header.Set(echo.HeaderOrigin, origin)
+	req.Header.Set(echo.HeaderAccessControlRequestMethod, method)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func testCORSConfig() CORSConfig {
+	return CORSConfig{
+		AllowOrigins:     []string{"https://app.example.com", "https://*.preview.example.com"},
+		AllowMethods:     []string{http.MethodGet, http.MethodPatch},
+		AllowHeaders:     []string{"Content-Type", "Authorization"},
+		ExposeHeaders:    []string{"X-Request-ID"},
+		AllowCredentials: true,
+		MaxAge:           10 * time.Minute,
+	}
+}
+
+func TestCORS_PreflightFromAllowedOrigin(t *testing.T) {
+	// given
+	e := newCORSServer(t, testCORSConfig())
+
+	// when
+	rec := preflight(e, "https://app.example.com", http.MethodPatch)
+
+	// then
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("Expected status 204, got %d", rec.Code)
+	}
+	header := rec.Header()
+	if header.Get(echo.HeaderAccessControlAllowOrigin) != "https://app.example.com" {
+		t.Errorf("Expected origin to be echoed, got %q", header.Get(echo.HeaderAccessControlAllowOrigin))
+	}
+	if header.Get(echo.HeaderAccessControlAllowCredentials) != "true" {
+		t.Error("Expected credentials to be allowed")
+	}
+	if !strings.Contains(header.Get(echo.HeaderAccessControlAllowMethods), http.MethodPatch) {
+		t.Errorf("Expected PATCH in allowed methods, got %q", header.Get(echo.HeaderAccessControlAllowMethods))
+	}
+	if header.Get(echo.HeaderAccessControlAllowHeaders) != "Content-Type,Authorization" {
+		t.Errorf("Expected configured headers, got %q", header.Get(echo.HeaderAccessControlAllowHeaders))
+	}
+	if header.Get(echo.HeaderAccessControlMaxAge) != "600" {
+		t.Errorf("Expected max age 600, got %q", header.Get(echo.HeaderAccessControlMaxAge))
+	}
+}
+
+func TestCORS_PreflightFromWildcardSubdomain(t *testing.T) {
+	// given
+	e := newCORSServer(t, testCORSConfig())
+
+	// when
+	rec := preflight(e, "https://pr-42.preview.example.com", http.MethodGet)
+
+	// then
+	if rec.Header().Get(echo.HeaderAccessControlAllowOrigin) != "https://pr-42.preview.example.com" {
+		t.Errorf("Expected wildcard subdomain to be allowed, got %q", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+	}
+}
+
+func TestCORS_PreflightFromDisallowedOrigin(t *testing.T) {
+	// given
+	e := newCORSServer(t, testCORSConfig())
+	origins := []string{
+		"https://evil.example.com",
+		"http://app.example.com",
+		"https://preview.example.com",
+		"https://evilpreview.example.com",
+		"https://a.preview.example.com.evil.com",
+	}
+
+	for _, origin := range origins {
+		// when
+		rec := preflight(e, origin, http.MethodGet)
+
+		// then
+		if rec.Header().Get(echo.HeaderAccessControlAllowOrigin) != "" {
+			t.Errorf("Expected %s to be rejected", origin)
+		}
+		if rec.Header().Get(echo.HeaderAccessControlAllowMethods) != "" {
+			t.Errorf("Expected no allowed methods for %s", origin)
+		}
+	}
+}
+
+func TestCORS_SimpleRequestExposesHeaders(t *testing.T) {
+	// given
+	e := newCORSServer(t, testCORSConfig())
+	req := httptest.NewRequest(http.MethodGet, "/resource", nil)
+	req.Header.Set(echo.HeaderOrigin, "https://app.example.com")
+	rec := httptest.NewRecorder()
+
+	// when
+	e.ServeHTTP(rec, req)
+
+	// then
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", rec.Code)
+	}
+	if rec.Header().Get(echo.HeaderAccessControlExposeHeaders) != "X-Request-ID" {
+		t.Errorf("Expected exposed headers, got %q", rec.Header().Get(echo.HeaderAccessControlExposeHeaders))
+	}
+}
+
+func TestCORS_ShouldRejectAnyOriginWithCredentials(t *testing.T) {
+	// given
+	config := testCORSConfig()
+	config.AllowOrigins = []string{"*"}
+
+	// when
+	_, err := CORS(config)
+
+	// then
+	if err == nil {
+		t.Error("Expected error for \"*\" with credentials")
+	}
+}
+
+func TestNewOriginMatcher_ShouldRejectInvalidPatterns(t *testing.T) {
+	for _, pattern := range []string{"app.example.com", "ftp://example.com", "https://*.com", "https://a.*.example.com", "https://*"} {
+		// when
+		_, err := NewOriginMatcher([]string{pattern})
+
+		// then
+		if err == nil {
+			t.Errorf("Expected %q to be rejected", pattern)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package security
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func serveWithHeaders(config HeadersConfig, req *http.Request) *httptest.ResponseRecorder {
+	e := echo.New()
+	e.Use(SecurityHeaders(config))
+	e.GET("/", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestSecurityHeaders_ShouldSetDefaults(t *testing.T) {
+	// given
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(echo.HeaderXForwardedProto, "https")
+
+	// when
+	rec := serveWithHeaders(DefaultHeadersConfig(), req)
+
+	// then
+	expected := map[string]string{
+		echo.HeaderStrictTransportSecurity: "max-age=31536000; includeSubDomains",
+		echo.HeaderContentSecurityPolicy:   "default-src 'none'; frame-ancestors 'none'",
+		echo.HeaderXFrameOptions:           "DENY",
+		echo.HeaderReferrerPolicy:          "strict-origin-when-cross-origin",
+		echo.HeaderXContentTypeOptions:     "nosniff",
+	}
+	for name, value := range expected {
+		if got := rec.Header().Get(name); got != value {
+			t.Errorf("Expected %s to be %q, got %q", name, value, got)
+		}
+	}
+}
+
+func TestSecurityHeaders_ShouldOmitHSTSOverPlainHTTP(t *testing.T) {
+	// given
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	// when
+	rec := serveWithHeaders(DefaultHeadersConfig(), req)
+
+	// then
+	if rec.Header().Get(echo.HeaderStrictTransportSecurity) != "" {
+		t.Error("Expected no HSTS header over plain HTTP")
+	}
+}
+
+func TestSecurityHeaders_ShouldSkipEmptyValues(t *testing.T) {
+	// given
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(echo.HeaderXForwardedProto, "https")
+
+	// when
+	rec := serveWithHeaders(HeadersConfig{FrameOptions: "SAMEORIGIN"}, req)
+
+	// then
+	if rec.Header().Get(echo.HeaderXFrameOptions) != "SAMEORIGIN" {
+		t.Errorf("Expected SAMEORIGIN, got %q", rec.Header().Get(echo.HeaderXFrameOptions))
+	}
+	for _, name := range []string{echo.HeaderStrictTransportSecurity, echo.HeaderContentSecurityPolicy, echo.HeaderReferrerPolicy, echo.HeaderXContentTypeOptions} {
+		if rec.Header().Get(name) != "" {
+			t.Errorf("Expected %s to be omitted", name)
+		}
+	}
+}
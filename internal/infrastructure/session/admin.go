@@ -0,0 +1,41 @@
+package session
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"zen-connect/internal/infrastructure/logger"
+	"zen-connect/internal/infrastructure/problem"
+)
+
+// RequireAdmin returns a middleware that only lets the configured
+// administrators through. It must run after RequireAuth, which puts the
+// authenticated user ID on the request context.
+func (m *Middleware) RequireAdmin(adminUserIDs []string) echo.MiddlewareFunc {
+	admins := make(map[string]bool, len(adminUserIDs))
+	for _, id := range adminUserIDs {
+		admins[id] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			userID, ok := GetUserIDFromContext(req.Context())
+			if !ok || userID == "" {
+				return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+			}
+			if !admins[userID] {
+				logger.GetGlobalLogger().LogSecurityEvent(req.Context(), "admin_access_denied", "medium",
+					"Non-administrator attempted to use the admin API",
+					zap.String("method", req.Method),
+					zap.String("path", req.URL.Path),
+					zap.String("user_id", userID),
+					zap.String("remote_addr", c.RealIP()),
+				)
+				return problem.New(http.StatusForbidden, problem.CodeForbidden)
+			}
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package session
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/infrastructure/problem"
+)
+
+func serveAdmin(store *CookieStore, admins []string, req *http.Request) int {
+	e := echo.New()
+	e.HTTPErrorHandler = problem.NewErrorHandler(problem.Config{})
+	m := NewMiddleware(store)
+	e.GET("/admin/resource", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}, m.RequireAuth(), m.RequireAdmin(admins))
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestRequireAdmin_ShouldOnlyAllowConfiguredUsers(t *testing.T) {
+	// given
+	store := newTestStore(t)
+	cookie, _ := sessionCookie(t, store)
+
+	cases := map[string]struct {
+		admins   []string
+		cookie   bool
+		expected int
+	}{
+		"admin":         {admins: []string{"user-1"}, cookie: true, expected: http.StatusOK},
+		"non-admin":     {admins: []string{"user-2"}, cookie: true, expected: http.StatusForbidden},
+		"no admins":     {admins: nil, cookie: true, expected: http.StatusForbidden},
+		"not logged in": {admins: []string{"user-1"}, cookie: false, expected: http.StatusUnauthorized},
+	}
+	for name, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, "/admin/resource", nil)
+		if tc.cookie {
+			req.AddCookie(cookie)
+		}
+
+		// when
+		status := serveAdmin(store, tc.admins, req)
+
+		// then
+		if status != tc.expected {
+			t.Errorf("%s: expected status %d, got %d", name, tc.expected, status)
+		}
+	}
+}
@@ -3,8 +3,6 @@ package session
 import (
 	"fmt"
 	"net/http"
-	"os"
-	"strconv"
 	"time"
 
 	"github.com/gorilla/securecookie"
@@ -19,6 +17,7 @@ type SessionData struct {
 	Name         string    `json:"name"`
 	AccessToken  string    `json:"access_token"`
 	RefreshToken string    `json:"refresh_token"`
+	CSRFToken    string    `json:"csrf"` // Synchronizer token for state-changing requests
 	ExpiresAt    time.Time `json:"exp"`
 }
 
@@ -34,58 +33,56 @@ type CookieStore struct {
 	maxAge         int
 }
 
+// Config holds session cookie settings
+type Config struct {
+	Secret         string
+	CookieName     string
+	CookieDomain   string
+	CookiePath     string
+	CookieSecure   bool
+	CookieHTTPOnly bool
+	CookieSameSite http.SameSite
+	MaxAge         int // seconds
+}
+
 // NewCookieStore creates a new cookie-based session store
-func NewCookieStore() (*CookieStore, error) {
-	// Get secret key from environment
-	secretKey := os.Getenv("SESSION_SECRET")
-	if secretKey == "" {
-		return nil, fmt.Errorf("SESSION_SECRET environment variable is required")
+func NewCookieStore(cfg Config) (*CookieStore, error) {
+	if cfg.Secret == "" {
+		return nil, fmt.Errorf("session secret is required")
 	}
 
 	// Ensure secret key is 32 bytes for AES-256
-	if len(secretKey) != 32 {
-		return nil, fmt.Errorf("SESSION_SECRET must be exactly 32 bytes")
+	if len(cfg.Secret) != 32 {
+		return nil, fmt.Errorf("session secret must be exactly 32 bytes")
 	}
 
 	// Create secure cookie with encryption and authentication
-	sc := securecookie.New([]byte(secretKey), nil)
+	sc := securecookie.New([]byte(cfg.Secret), nil)
 	sc.SetSerializer(securecookie.JSONEncoder{})
 
-	// Get cookie settings from environment
-	cookieName := getEnvOrDefault("SESSION_COOKIE_NAME", "zen_session")
-	cookieDomain := getEnvOrDefault("SESSION_COOKIE_DOMAIN", "localhost")
-	cookiePath := getEnvOrDefault("SESSION_COOKIE_PATH", "/")
-	cookieSecure := getEnvBoolOrDefault("SESSION_COOKIE_SECURE", false)
-	cookieHTTPOnly := getEnvBoolOrDefault("SESSION_COOKIE_HTTP_ONLY", true)
-	
-	// Parse SameSite setting
-	sameSiteStr := getEnvOrDefault("SESSION_COOKIE_SAME_SITE", "lax")
-	var sameSite http.SameSite
-	switch sameSiteStr {
-	case "strict":
-		sameSite = http.SameSiteStrictMode
-	case "none":
-		sameSite = http.SameSiteNoneMode
-	default:
-		sameSite = http.SameSiteLaxMode
-	}
-
-	maxAge := getEnvIntOrDefault("SESSION_MAX_AGE", 86400) // 24 hours default
-
 	return &CookieStore{
 		secureCookie:   sc,
-		cookieName:     cookieName,
-		cookieDomain:   cookieDomain,
-		cookiePath:     cookiePath,
-		cookieSecure:   cookieSecure,
-		cookieHTTPOnly: cookieHTTPOnly,
-		cookieSameSite: sameSite,
-		maxAge:         maxAge,
+		cookieName:     cfg.CookieName,
+		cookieDomain:   cfg.CookieDomain,
+		cookiePath:     cfg.CookiePath,
+		cookieSecure:   cfg.CookieSecure,
+		cookieHTTPOnly: cfg.CookieHTTPOnly,
+		cookieSameSite: cfg.CookieSameSite,
+		maxAge:         cfg.MaxAge,
 	}, nil
 }
 
 // SetSession encrypts and stores session data in a cookie
 func (cs *CookieStore) SetSession(c echo.Context, data SessionData) error {
+	// Issue a CSRF token for new sessions
+	if data.CSRFToken == "" {
+		token, err := generateCSRFToken()
+		if err != nil {
+			return err
+		}
+		data.CSRFToken = token
+	}
+
 	// Set expiration time
 	data.ExpiresAt = time.Now().Add(time.Duration(cs.maxAge) * time.Second)
 
@@ -149,29 +146,3 @@ func (cs *CookieStore) ClearSession(c echo.Context) {
 
 	c.SetCookie(cookie)
 }
-
-// Helper functions for environment variables
-func getEnvOrDefault(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
-	}
-	return defaultValue
-}
-
-func getEnvBoolOrDefault(key string, defaultValue bool) bool {
-	if value := os.Getenv(key); value != "" {
-		if parsed, err := strconv.ParseBool(value); err == nil {
-			return parsed
-		}
-	}
-	return defaultValue
-}
-
-func getEnvIntOrDefault(key string, defaultValue int) int {
-	if value := os.Getenv(key); value != "" {
-		if parsed, err := strconv.Atoi(value); err == nil {
-			return parsed
-		}
-	}
-	return defaultValue
-}
\ No newline at end of file
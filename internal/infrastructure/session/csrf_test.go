@@ -0,0 +1,151 @@
+package session
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/infrastructure/problem"
+)
+
+func newTestStore(t *testing.T) *CookieStore {
+	t.Helper()
+	store, err := NewCookieStore(Config{
+		Secret:         "0123456789abcdef0123456789abcdef",
+		CookieName:     "zen_session",
+		CookiePath:     "/",
+		CookieHTTPOnly: true,
+		CookieSameSite: http.SameSiteLaxMode,
+		MaxAge:         3600,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return store
+}
+
+// sessionCookie creates a session and returns its cookie and CSRF token
+func sessionCookie(t *testing.T, store *CookieStore) (*http.Cookie, string) {
+	t.Helper()
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	if err := store.SetSession(c, SessionData{UserID: "user-1"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	cookie := rec.Result().Cookies()[0]
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(cookie)
+	data, err := store.GetSession(e.NewContext(req, httptest.NewRecorder()))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return cookie, data.CSRFToken
+}
+
+func serveWithCSRF(store *CookieStore, req *http.Request) int {
+	e := echo.New()
+	e.HTTPErrorHandler = problem.NewErrorHandler(problem.Config{})
+	e.Use(NewMiddleware(store).RequireCSRF())
+	handler := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+	e.GET("/resource", handler)
+	e.POST("/resource", handler)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestRequireCSRF_ShouldRejectCookieRequestWithoutToken(t *testing.T) {
+	// given
+	store := newTestStore(t)
+	cookie, _ := sessionCookie(t, store)
+	req := httptest.NewRequest(http.MethodPost, "/resource", nil)
+	req.AddCookie(cookie)
+
+	// when
+	status := serveWithCSRF(store, req)
+
+	// then
+	if status != http.StatusForbidden {
+		t.Errorf("Expected status 403, got %d", status)
+	}
+}
+
+func TestRequireCSRF_ShouldRejectWrongToken(t *testing.T) {
+	// given
+	store := newTestStore(t)
+	cookie, _ := sessionCookie(t, store)
+	req := httptest.NewRequest(http.MethodPost, "/resource", nil)
+	req.AddCookie(cookie)
+	req.Header.Set(CSRFHeaderName, "forged")
+
+	// when
+	status := serveWithCSRF(store, req)
+
+	// then
+	if status != http.StatusForbidden {
+		t.Errorf("Expected status 403, got %d", status)
+	}
+}
+
+func TestRequireCSRF_ShouldAcceptMatchingToken(t *testing.T) {
+	// given
+	store := newTestStore(t)
+	cookie, token := sessionCookie(t, store)
+	req := httptest.NewRequest(http.MethodPost, "/resource", nil)
+	req.AddCookie(cookie)
+	req.Header.Set(CSRFHeaderName, token)
+
+	// when
+	status := serveWithCSRF(store, req)
+
+	// then
+	if token == "" {
+		t.Fatal("Expected SetSession to issue a CSRF token")
+	}
+	if status != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", status)
+	}
+}
+
+func TestRequireCSRF_ShouldExemptSafeAndCookielessRequests(t *testing.T) {
+	// given
+	store := newTestStore(t)
+	cookie, _ := sessionCookie(t, store)
+
+	get := httptest.NewRequest(http.MethodGet, "/resource", nil)
+	get.AddCookie(cookie)
+	bearer := httptest.NewRequest(http.MethodPost, "/resource", nil)
+	bearer.Header.Set(echo.HeaderAuthorization, "Bearer some-token")
+	anonymous := httptest.NewRequest(http.MethodPost, "/resource", nil)
+
+	for name, req := range map[string]*http.Request{"GET": get, "Bearer": bearer, "no cookie": anonymous} {
+		// when
+		status := serveWithCSRF(store, req)
+
+		// then
+		if status != http.StatusOK {
+			t.Errorf("Expected %s request to be exempt, got %d", name, status)
+		}
+	}
+}
+
+func TestRequireCSRF_ShouldCheckCookieRequestsThatAlsoCarryCredentialHeaders(t *testing.T) {
+	// given
+	store := newTestStore(t)
+	cookie, _ := sessionCookie(t, store)
+	req := httptest.NewRequest(http.MethodPost, "/resource", nil)
+	req.AddCookie(cookie)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer some-token")
+	req.Header.Set("X-API-Key", "some-key")
+
+	// when
+	status := serveWithCSRF(store, req)
+
+	// then
+	if status != http.StatusForbidden {
+		t.Errorf("Expected status 403, got %d", status)
+	}
+}
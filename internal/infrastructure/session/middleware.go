@@ -6,11 +6,19 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"zen-connect/internal/infrastructure/problem"
 )
 
+// AccountGuard decides whether an authenticated user may use the API,
+// e.g. rejecting suspended accounts
+type AccountGuard interface {
+	CheckAccount(ctx context.Context, userID string) error
+}
+
 // Middleware provides session-based authentication middleware
 type Middleware struct {
 	cookieStore *CookieStore
+	guard       AccountGuard
 }
 
 // NewMiddleware creates a new session middleware
@@ -20,6 +28,12 @@ func NewMiddleware(cookieStore *CookieStore) *Middleware {
 	}
 }
 
+// UseAccountGuard makes RequireAuth reject users the guard refuses.
+// The guard's error is returned as is, so it should be mapped to a problem.
+func (m *Middleware) UseAccountGuard(guard AccountGuard) {
+	m.guard = guard
+}
+
 // RequireAuth returns a middleware function that validates session cookies
 func (m *Middleware) RequireAuth() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
@@ -28,9 +42,12 @@ func (m *Middleware) RequireAuth() echo.MiddlewareFunc {
 			sessionData, err := m.cookieStore.GetSession(c)
 			if err != nil {
 				log.Printf("Session validation failed: %v", err)
-				return c.JSON(http.StatusUnauthorized, map[string]string{
-					"error": "Authentication required",
-				})
+				return problem.Wrap(err, http.StatusUnauthorized, problem.CodeUnauthenticated)
+			}
+			if m.guard != nil {
+				if err := m.guard.CheckAccount(c.Request().Context(), sessionData.UserID); err != nil {
+					return err
+				}
 			}
 
 			// Add session data to context
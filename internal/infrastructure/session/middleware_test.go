@@ -0,0 +1,60 @@
+package session
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/infrastructure/problem"
+)
+
+// fakeAccountGuard rejects the listed users
+type fakeAccountGuard struct {
+	rejected map[string]bool
+}
+
+func (g *fakeAccountGuard) CheckAccount(ctx context.Context, userID string) error {
+	if g.rejected[userID] {
+		return problem.New(http.StatusForbidden, problem.CodeForbidden)
+	}
+	return nil
+}
+
+func TestRequireAuth_ShouldRejectUsersRefusedByAccountGuard(t *testing.T) {
+	// given
+	store := newTestStore(t)
+	cookie, _ := sessionCookie(t, store)
+
+	cases := map[string]struct {
+		guard    AccountGuard
+		expected int
+	}{
+		"no guard":      {guard: nil, expected: http.StatusOK},
+		"allowed user":  {guard: &fakeAccountGuard{rejected: map[string]bool{"user-2": true}}, expected: http.StatusOK},
+		"rejected user": {guard: &fakeAccountGuard{rejected: map[string]bool{"user-1": true}}, expected: http.StatusForbidden},
+	}
+	for name, tc := range cases {
+		e := echo.New()
+		e.HTTPErrorHandler = problem.NewErrorHandler(problem.Config{})
+		m := NewMiddleware(store)
+		if tc.guard != nil {
+			m.UseAccountGuard(tc.guard)
+		}
+		e.GET("/resource", func(c echo.Context) error {
+			return c.NoContent(http.StatusOK)
+		}, m.RequireAuth())
+		req := httptest.NewRequest(http.MethodGet, "/resource", nil)
+		req.AddCookie(cookie)
+		rec := httptest.NewRecorder()
+
+		// when
+		e.ServeHTTP(rec, req)
+
+		// then
+		if rec.Code != tc.expected {
+			t.Errorf("%s: expected status %d, got %d", name, tc.expected, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package validation
+
+import "zen-connect/internal/infrastructure/problem"
+
+// fallbackMessages are used for tags without messages
+var fallbackMessages = problem.Messages{
+	problem.LanguageJapanese: "値が不正です。",
+	problem.LanguageEnglish:  "The value is invalid.",
+}
+
+// builtinMessages are the messages of the validator's built-in tags. A
+// "<tag>.string" entry applies when the field is a string.
+var builtinMessages = map[string]problem.Messages{
+	"required": {
+		problem.LanguageJapanese: "必須項目です。",
+		problem.LanguageEnglish:  "This field is required.",
+	},
+	"email": {
+		problem.LanguageJapanese: "メールアドレスの形式が不正です。",
+		problem.LanguageEnglish:  "Must be a valid email address.",
+	},
+	"url": {
+		problem.LanguageJapanese: "URLの形式が不正です。",
+		problem.LanguageEnglish:  "Must be a valid URL.",
+	},
+	"uuid": {
+		problem.LanguageJapanese: "UUIDの形式が不正です。",
+		problem.LanguageEnglish:  "Must be a valid UUID.",
+	},
+	"oneof": {
+		problem.LanguageJapanese: "次のいずれかを指定してください: {param}",
+		problem.LanguageEnglish:  "Must be one of: {param}",
+	},
+	"min": {
+		problem.LanguageJapanese: "{param}以上を指定してください。",
+		problem.LanguageEnglish:  "Must be at least {param}.",
+	},
+	"min.string": {
+		problem.LanguageJapanese: "{param}文字以上で入力してください。",
+		problem.LanguageEnglish:  "Must be at least {param} characters long.",
+	},
+	"max": {
+		problem.LanguageJapanese: "{param}以下を指定してください。",
+		problem.LanguageEnglish:  "Must be at most {param}.",
+	},
+	"max.string": {
+		problem.LanguageJapanese: "{param}文字以内で入力してください。",
+		problem.LanguageEnglish:  "Must be at most {param} characters long.",
+	},
+	"len.string": {
+		problem.LanguageJapanese: "{param}文字で入力してください。",
+		problem.LanguageEnglish:  "Must be exactly {param} characters long.",
+	},
+	"gt": {
+		problem.LanguageJapanese: "{param}より大きい値を指定してください。",
+		problem.LanguageEnglish:  "Must be greater than {param}.",
+	},
+	"gte": {
+		problem.LanguageJapanese: "{param}以上を指定してください。",
+		problem.LanguageEnglish:  "Must be at least {param}.",
+	},
+	"lt": {
+		problem.LanguageJapanese: "{param}より小さい値を指定してください。",
+		problem.LanguageEnglish:  "Must be less than {param}.",
+	},
+	"lte": {
+		problem.LanguageJapanese: "{param}以下を指定してください。",
+		problem.LanguageEnglish:  "Must be at most {param}.",
+	},
+	"gtfield": {
+		problem.LanguageJapanese: "{param}より後の値を指定してください。",
+		problem.LanguageEnglish:  "Must be after {param}.",
+	},
+}
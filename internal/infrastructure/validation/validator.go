@@ -0,0 +1,112 @@
+// Package validation validates request DTOs through Echo's Validator hook.
+//
+// DTO fields declare their constraints with `validate` struct tags. Failed
+// constraints are returned as a problem validation error listing every
+// invalid field by its JSON name, with Japanese and English messages.
+// Bounded contexts contribute their own tags as Rules.
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"zen-connect/internal/infrastructure/problem"
+)
+
+// Rule is a custom validation tag
+type Rule struct {
+	Tag string
+	// Func reports whether the field satisfies the rule
+	Func validator.Func
+	// Messages may refer to the tag parameter as {param}
+	Messages problem.Messages
+}
+
+// Validator implements echo.Validator
+type Validator struct {
+	validate *validator.Validate
+	messages map[string]problem.Messages
+}
+
+// New creates a validator with the built-in tags and the given rules
+func New(rules ...Rule) (*Validator, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	validate.RegisterTagNameFunc(jsonFieldName)
+
+	messages := make(map[string]problem.Messages, len(builtinMessages)+len(rules))
+	for tag, m := range builtinMessages {
+		messages[tag] = m
+	}
+	for _, rule := range rules {
+		if err := validate.RegisterValidation(rule.Tag, rule.Func); err != nil {
+			return nil, fmt.Errorf("failed to register validation rule %q: %w", rule.Tag, err)
+		}
+		messages[rule.Tag] = rule.Messages
+	}
+
+	return &Validator{validate: validate, messages: messages}, nil
+}
+
+// Validate validates a struct and returns a *problem.Error listing the
+// invalid fields when it does not satisfy its tags
+func (v *Validator) Validate(i interface{}) error {
+	err := v.validate.Struct(i)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		// Not a struct or a misconfigured tag: a programming error
+		return err
+	}
+
+	fields := make([]problem.FieldError, 0, len(validationErrors))
+	for _, fe := range validationErrors {
+		fields = append(fields, problem.FieldError{
+			Field:    fieldPath(fe.Namespace()),
+			Rule:     fe.Tag(),
+			Param:    fe.Param(),
+			Messages: v.messagesFor(fe),
+		})
+	}
+	return problem.Validation(fields)
+}
+
+// messagesFor returns the messages of a failed tag, preferring the variant
+// for string lengths when there is one
+func (v *Validator) messagesFor(fe validator.FieldError) problem.Messages {
+	if fe.Kind() == reflect.String {
+		if m, ok := v.messages[fe.Tag()+".string"]; ok {
+			return m
+		}
+	}
+	if m, ok := v.messages[fe.Tag()]; ok {
+		return m
+	}
+	return fallbackMessages
+}
+
+// jsonFieldName reports fields by the name clients send
+func jsonFieldName(field reflect.StructField) string {
+	name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	}
+	return name
+}
+
+// fieldPath drops the struct name from a namespace such as
+// CreateExperienceRequest.end_time
+func fieldPath(namespace string) string {
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
@@ -0,0 +1,75 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"zen-connect/internal/infrastructure/problem"
+)
+
+type signupRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required,min=8"`
+	Plan     string `json:"plan" validate:"omitempty,plan"`
+	Internal string `json:"-" validate:"required"`
+}
+
+func newTestValidator(t *testing.T) *Validator {
+	t.Helper()
+	v, err := New(Rule{
+		Tag:      "plan",
+		Func:     func(fl validator.FieldLevel) bool { return fl.Field().String() == "free" },
+		Messages: problem.Messages{problem.LanguageEnglish: "Unknown plan."},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return v
+}
+
+func TestValidate_ShouldReportFieldsByJSONName(t *testing.T) {
+	// given
+	v := newTestValidator(t)
+	req := signupRequest{Email: "not-an-email", Password: "short", Plan: "gold", Internal: "x"}
+
+	// when
+	err := v.Validate(&req)
+
+	// then
+	var problemErr *problem.Error
+	if !errors.As(err, &problemErr) {
+		t.Fatalf("Expected *problem.Error, got %v", err)
+	}
+	if problemErr.Code != problem.CodeValidationFailed || len(problemErr.Fields) != 3 {
+		t.Fatalf("Expected 3 invalid fields, got %+v", problemErr)
+	}
+	expected := []struct{ field, rule, ja string }{
+		{"email", "email", "メールアドレスの形式が不正です。"},
+		{"password", "min", "{param}文字以上で入力してください。"},
+		{"plan", "plan", ""},
+	}
+	for i, e := range expected {
+		got := problemErr.Fields[i]
+		if got.Field != e.field || got.Rule != e.rule || got.Messages[problem.LanguageJapanese] != e.ja {
+			t.Errorf("Expected %+v, got %+v", e, got)
+		}
+	}
+	if problemErr.Fields[1].Param != "8" {
+		t.Errorf("Expected min parameter 8, got %q", problemErr.Fields[1].Param)
+	}
+}
+
+func TestValidate_ShouldAcceptValidStruct(t *testing.T) {
+	// given
+	v := newTestValidator(t)
+	req := signupRequest{Email: "zen@example.com", Password: "long enough", Internal: "x"}
+
+	// when
+	err := v.Validate(&req)
+
+	// then
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
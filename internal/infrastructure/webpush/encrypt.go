@@ -0,0 +1,121 @@
+package webpush
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	// recordSize is the aes128gcm record size; the whole payload is one record
+	recordSize = 4096
+	// headerSize is salt (16) + record size (4) + key ID length (1) + key ID (65)
+	headerSize = 16 + 4 + 1 + 65
+	// MaxPayloadSize is the largest payload push services must accept once encrypted
+	MaxPayloadSize = recordSize - headerSize - 16 - 1
+)
+
+// ErrPayloadTooLarge is returned for payloads over MaxPayloadSize
+var ErrPayloadTooLarge = errors.New("push payload too large")
+
+// Keys are the subscription's keys from PushSubscription.getKey()
+type Keys struct {
+	// P256dh is the browser's public key (base64url, uncompressed P-256 point)
+	P256dh string
+	// Auth is the browser's 16-byte authentication secret (base64url)
+	Auth string
+}
+
+// ValidateKeys checks the subscription keys can be encrypted for
+func ValidateKeys(keys Keys) error {
+	_, _, err := parseKeys(keys)
+	return err
+}
+
+func parseKeys(keys Keys) (*ecdh.PublicKey, []byte, error) {
+	raw, err := decode(keys.P256dh)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid p256dh key: %w", err)
+	}
+	publicKey, err := ecdh.P256().NewPublicKey(raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid p256dh key: %w", err)
+	}
+	auth, err := decode(keys.Auth)
+	if err != nil || len(auth) != 16 {
+		return nil, nil, errors.New("invalid auth secret")
+	}
+	return publicKey, auth, nil
+}
+
+// encrypt encrypts the payload for the subscription as a single aes128gcm record (RFC 8291)
+func encrypt(keys Keys, payload []byte) ([]byte, error) {
+	if len(payload) > MaxPayloadSize {
+		return nil, ErrPayloadTooLarge
+	}
+	uaPublic, authSecret, err := parseKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	// A fresh key pair and salt for every message
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	sharedSecret, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, err
+	}
+	asPublic := asPrivate.PublicKey().Bytes()
+
+	cek, nonce, err := deriveKeys(sharedSecret, authSecret, salt, uaPublic.Bytes(), asPublic)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	body := make([]byte, 0, headerSize+len(payload)+1+gcm.Overhead())
+	body = append(body, salt...)
+	body = binary.BigEndian.AppendUint32(body, recordSize)
+	body = append(body, byte(len(asPublic)))
+	body = append(body, asPublic...)
+	// 0x02 marks the last (and only) record
+	plaintext := append(append([]byte{}, payload...), 0x02)
+	return gcm.Seal(body, nonce, plaintext, nil), nil
+}
+
+// deriveKeys derives the content encryption key and nonce (RFC 8291 section 3.4)
+func deriveKeys(sharedSecret, authSecret, salt, uaPublic, asPublic []byte) ([]byte, []byte, error) {
+	keyInfo := append(append([]byte("WebPush: info\x00"), uaPublic...), asPublic...)
+	ikm, err := hkdf.Key(sha256.New, sharedSecret, authSecret, string(keyInfo), 32)
+	if err != nil {
+		return nil, nil, err
+	}
+	cek, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cek, nonce, nil
+}
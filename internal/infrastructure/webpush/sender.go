@@ -0,0 +1,119 @@
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"zen-connect/internal/infrastructure/netguard"
+)
+
+// ErrSubscriptionGone is returned when the push service no longer knows the
+// subscription (404 or 410); it will never work again and should be deleted
+var ErrSubscriptionGone = errors.New("push subscription is gone")
+
+// Urgency tells the push service how soon the message must reach the device (RFC 8030 section 5.3)
+type Urgency string
+
+const (
+	UrgencyVeryLow Urgency = "very-low"
+	UrgencyLow     Urgency = "low"
+	UrgencyNormal  Urgency = "normal"
+	UrgencyHigh    Urgency = "high"
+)
+
+// Subscription is a browser's push subscription
+type Subscription struct {
+	Endpoint string
+	Keys     Keys
+}
+
+// Message is a push message
+type Message struct {
+	Payload []byte
+	// TTL is how long the push service keeps the message for an offline device
+	TTL     time.Duration
+	Urgency Urgency
+	// Topic replaces an undelivered message with the same topic
+	Topic string
+}
+
+// StatusError is a push service response other than success or a gone subscription
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("push service responded %d: %s", e.StatusCode, e.Body)
+}
+
+// Sender delivers encrypted messages to push services
+type Sender struct {
+	vapid  *VAPID
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewSender creates a sender; a nil client uses one with a 30 second timeout
+// that only reaches public addresses, since endpoints come from browsers
+func NewSender(vapid *VAPID, client *http.Client) *Sender {
+	if client == nil {
+		client = &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: netguard.NewTransport(30*time.Second, false),
+		}
+	}
+	return &Sender{
+		vapid:  vapid,
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// Send encrypts the message for the subscription and posts it to its push service
+func (s *Sender) Send(ctx context.Context, subscription Subscription, msg Message) error {
+	body, err := encrypt(subscription.Keys, msg.Payload)
+	if err != nil {
+		return err
+	}
+	authorization, err := s.vapid.Authorization(subscription.Endpoint, s.now())
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(msg.TTL/time.Second)))
+	if msg.Urgency != "" {
+		req.Header.Set("Urgency", string(msg.Urgency))
+	}
+	if msg.Topic != "" {
+		req.Header.Set("Topic", msg.Topic)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return ErrSubscriptionGone
+	}
+	text, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return &StatusError{StatusCode: resp.StatusCode, Body: string(bytes.TrimSpace(text))}
+}
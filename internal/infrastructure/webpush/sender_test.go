@@ -0,0 +1,217 @@
+package webpush
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"zen-connect/internal/infrastructure/netguard"
+)
+
+// browser is the user agent side of a subscription
+type browser struct {
+	private *ecdh.PrivateKey
+	auth    []byte
+}
+
+func newBrowser(t *testing.T) *browser {
+	t.Helper()
+	private, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := make([]byte, 16)
+	rand.Read(auth)
+	return &browser{private: private, auth: auth}
+}
+
+func (b *browser) keys() Keys {
+	return Keys{P256dh: encode(b.private.PublicKey().Bytes()), Auth: encode(b.auth)}
+}
+
+// decrypt reverses encrypt as a browser would
+func (b *browser) decrypt(t *testing.T, body []byte) []byte {
+	t.Helper()
+	salt := body[:16]
+	keyIDLength := int(body[20])
+	asPublicBytes := body[21 : 21+keyIDLength]
+	asPublic, err := ecdh.P256().NewPublicKey(asPublicBytes)
+	if err != nil {
+		t.Fatalf("Expected the sender's public key in the header, got %v", err)
+	}
+	if size := binary.BigEndian.Uint32(body[16:20]); size != recordSize {
+		t.Errorf("Expected record size %d, got %d", recordSize, size)
+	}
+	sharedSecret, _ := b.private.ECDH(asPublic)
+	cek, nonce, _ := deriveKeys(sharedSecret, b.auth, salt, b.private.PublicKey().Bytes(), asPublicBytes)
+	block, _ := aes.NewCipher(cek)
+	gcm, _ := cipher.NewGCM(block)
+	plaintext, err := gcm.Open(nil, nonce, body[21+keyIDLength:], nil)
+	if err != nil {
+		t.Fatalf("Expected the payload to decrypt, got %v", err)
+	}
+	if plaintext[len(plaintext)-1] != 0x02 {
+		t.Errorf("Expected the last record delimiter, got %x", plaintext[len(plaintext)-1])
+	}
+	return plaintext[:len(plaintext)-1]
+}
+
+// verifyVAPID checks the Authorization header is signed by the key
+func verifyVAPID(t *testing.T, header, audience string, vapid *VAPID) {
+	t.Helper()
+	token, key, ok := strings.Cut(strings.TrimPrefix(header, "vapid t="), ", k=")
+	if !ok || key != vapid.PublicKey() {
+		t.Fatalf("Expected vapid t=..., k=<public key>, got %q", header)
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("Expected a JWT, got %q", token)
+	}
+	signature, _ := decode(parts[2])
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+	if !ecdsa.Verify(&vapid.privateKey.PublicKey, digest[:], r, s) {
+		t.Error("Expected a valid ES256 signature")
+	}
+	var claims struct {
+		Aud string `json:"aud"`
+		Sub string `json:"sub"`
+	}
+	raw, _ := decode(parts[1])
+	json.Unmarshal(raw, &claims)
+	if claims.Aud != audience || claims.Sub != "mailto:ops@example.com" {
+		t.Errorf("Expected aud %q and the subject, got %+v", audience, claims)
+	}
+}
+
+func newTestVAPID(t *testing.T) *VAPID {
+	t.Helper()
+	_, privateKey, err := GenerateVAPIDKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vapid, err := NewVAPID(privateKey, "mailto:ops@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return vapid
+}
+
+func TestSender_ShouldDeliverEncryptedSignedMessage(t *testing.T) {
+	// given
+	vapid := newTestVAPID(t)
+	browser := newBrowser(t)
+	var received *http.Request
+	var body []byte
+	pushService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer pushService.Close()
+	sender := NewSender(vapid, pushService.Client())
+
+	// when
+	err := sender.Send(context.Background(), Subscription{Endpoint: pushService.URL + "/push/abc", Keys: browser.keys()}, Message{
+		Payload: []byte(`{"type":"meditation_reminder"}`),
+		TTL:     time.Hour,
+		Urgency: UrgencyNormal,
+	})
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := string(browser.decrypt(t, body)); got != `{"type":"meditation_reminder"}` {
+		t.Errorf("Expected the payload, got %q", got)
+	}
+	if received.Header.Get("Content-Encoding") != "aes128gcm" || received.Header.Get("TTL") != "3600" || received.Header.Get("Urgency") != "normal" {
+		t.Errorf("Expected Web Push headers, got %v", received.Header)
+	}
+	verifyVAPID(t, received.Header.Get("Authorization"), pushService.URL, vapid)
+}
+
+func TestSender_ShouldReportGoneSubscription(t *testing.T) {
+	// given
+	pushService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer pushService.Close()
+	sender := NewSender(newTestVAPID(t), pushService.Client())
+
+	// when
+	err := sender.Send(context.Background(), Subscription{Endpoint: pushService.URL, Keys: newBrowser(t).keys()}, Message{Payload: []byte("{}")})
+
+	// then
+	if !errors.Is(err, ErrSubscriptionGone) {
+		t.Errorf("Expected ErrSubscriptionGone, got %v", err)
+	}
+}
+
+func TestSender_ShouldRefuseEndpointsInsideTheNetworkByDefault(t *testing.T) {
+	// given
+	called := false
+	pushService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer pushService.Close()
+	sender := NewSender(newTestVAPID(t), nil)
+
+	// when
+	err := sender.Send(context.Background(), Subscription{Endpoint: pushService.URL, Keys: newBrowser(t).keys()}, Message{Payload: []byte("{}")})
+
+	// then
+	if !errors.Is(err, netguard.ErrPrivateAddress) || called {
+		t.Errorf("Expected a loopback endpoint to be refused, got %v", err)
+	}
+}
+
+func TestSender_ShouldReturnStatusErrorForOtherFailures(t *testing.T) {
+	// given
+	pushService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "slow down", http.StatusTooManyRequests)
+	}))
+	defer pushService.Close()
+	sender := NewSender(newTestVAPID(t), pushService.Client())
+
+	// when
+	err := sender.Send(context.Background(), Subscription{Endpoint: pushService.URL, Keys: newBrowser(t).keys()}, Message{Payload: []byte("{}")})
+
+	// then
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusTooManyRequests || statusErr.Body != "slow down" {
+		t.Errorf("Expected a 429 StatusError, got %v", err)
+	}
+}
+
+func TestEncrypt_ShouldRejectOversizedPayloadAndInvalidKeys(t *testing.T) {
+	// given
+	keys := newBrowser(t).keys()
+
+	// when
+	_, sizeErr := encrypt(keys, make([]byte, MaxPayloadSize+1))
+	keyErr := ValidateKeys(Keys{P256dh: encode([]byte("short")), Auth: keys.Auth})
+	authErr := ValidateKeys(Keys{P256dh: keys.P256dh, Auth: encode([]byte("short"))})
+
+	// then
+	if !errors.Is(sizeErr, ErrPayloadTooLarge) {
+		t.Errorf("Expected ErrPayloadTooLarge, got %v", sizeErr)
+	}
+	if keyErr == nil || authErr == nil {
+		t.Errorf("Expected invalid keys to be rejected, got %v and %v", keyErr, authErr)
+	}
+}
@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"time"
+
+	"zen-connect/internal/moderation/domain"
+)
+
+// FromReport converts a report to the reporter's DTO
+func FromReport(report *domain.Report) ReportDTO {
+	return ReportDTO{
+		ReportID:   report.ID(),
+		TargetType: string(report.Target().Type),
+		TargetID:   report.Target().ID,
+		Reason:     string(report.Reason()),
+		Status:     string(report.Status()),
+		CreatedAt:  report.CreatedAt(),
+	}
+}
+
+// FromReportDetail converts a report to the moderator's DTO
+func FromReportDetail(report *domain.Report) ReportDetailDTO {
+	return ReportDetailDTO{
+		ReportID:   report.ID(),
+		ReporterID: report.ReporterID(),
+		Reason:     string(report.Reason()),
+		Detail:     report.Detail(),
+		Excerpt:    report.Excerpt(),
+		CreatedAt:  report.CreatedAt(),
+	}
+}
+
+// FromQueueEntry converts a queue entry to DTO
+func FromQueueEntry(entry *domain.QueueEntry) QueueEntryDTO {
+	reasons := make(map[string]int, len(entry.Reasons))
+	for reason, count := range entry.Reasons {
+		reasons[string(reason)] = count
+	}
+	return QueueEntryDTO{
+		TargetType:      string(entry.Target.Type),
+		TargetID:        entry.Target.ID,
+		TargetOwnerID:   entry.OwnerID,
+		ReportCount:     entry.ReportCount,
+		Reasons:         reasons,
+		Excerpt:         entry.Excerpt,
+		FirstReportedAt: entry.FirstReportedAt,
+		LastReportedAt:  entry.LastReportedAt,
+	}
+}
+
+// FromModerationAction converts a moderation action to DTO
+func FromModerationAction(action *domain.ModerationAction) ModerationActionDTO {
+	return ModerationActionDTO{
+		ActionID:        action.ID(),
+		TargetType:      string(action.Target().Type),
+		TargetID:        action.Target().ID,
+		TargetOwnerID:   action.OwnerID(),
+		Action:          string(action.Action()),
+		ModeratorID:     action.ModeratorID(),
+		Note:            action.Note(),
+		SuspendedUntil:  optionalTime(action.SuspendedUntil()),
+		ResolvedReports: action.ResolvedReports(),
+		CreatedAt:       action.CreatedAt(),
+	}
+}
+
+// FromSuspension converts a suspension to DTO
+func FromSuspension(suspension *domain.Suspension) *SuspensionDTO {
+	return &SuspensionDTO{
+		UserID:      suspension.UserID(),
+		Reason:      suspension.Reason(),
+		SuspendedAt: suspension.SuspendedAt(),
+		Until:       optionalTime(suspension.Until()),
+	}
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
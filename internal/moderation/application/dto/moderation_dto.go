@@ -0,0 +1,108 @@
+package dto
+
+import "time"
+
+// SubmitReportRequest 通報リクエスト
+type SubmitReportRequest struct {
+	ReporterID string `json:"-"`
+	TargetType string `json:"target_type" validate:"required,oneof=experience comment user"`
+	TargetID   string `json:"target_id" validate:"required,uuid"`
+	// Reason spam, harassment, hate_speech, sexual_content, self_harm, misinformation, impersonation, other
+	Reason string `json:"reason" validate:"required,oneof=spam harassment hate_speech sexual_content self_harm misinformation impersonation other"`
+	// Detail 補足説明（reason が other の場合は必須）
+	Detail string `json:"detail,omitempty" validate:"max=1000"`
+}
+
+// ReportDTO 通報者に返す通報の受付結果
+type ReportDTO struct {
+	ReportID   string    `json:"report_id"`
+	TargetType string    `json:"target_type"`
+	TargetID   string    `json:"target_id"`
+	Reason     string    `json:"reason"`
+	Status     string    `json:"status"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ListQueueRequest モデレーションキューの取得条件（クエリパラメータ）
+type ListQueueRequest struct {
+	Limit  int `query:"limit" validate:"omitempty,gte=1,lte=100"`
+	Offset int `query:"offset" validate:"gte=0"`
+}
+
+// QueueEntryDTO 未対応の通報がある対象
+type QueueEntryDTO struct {
+	TargetType    string `json:"target_type"`
+	TargetID      string `json:"target_id"`
+	TargetOwnerID string `json:"target_owner_id"`
+	ReportCount   int    `json:"report_count"`
+	// Reasons 理由コードごとの通報件数
+	Reasons map[string]int `json:"reasons"`
+	// Excerpt 最後に通報された時点の本文の抜粋（ユーザーの通報では表示名）
+	Excerpt         string    `json:"excerpt"`
+	FirstReportedAt time.Time `json:"first_reported_at"`
+	LastReportedAt  time.Time `json:"last_reported_at"`
+}
+
+// ModerationQueueResponse モデレーションキュー（通報の多い順）
+type ModerationQueueResponse struct {
+	Entries []QueueEntryDTO `json:"entries"`
+	// NextOffset 続きがある場合に次のページを取得する offset
+	NextOffset *int `json:"next_offset,omitempty"`
+}
+
+// ReportDetailDTO モデレーター向けの通報の詳細
+type ReportDetailDTO struct {
+	ReportID   string    `json:"report_id"`
+	ReporterID string    `json:"reporter_id"`
+	Reason     string    `json:"reason"`
+	Detail     string    `json:"detail,omitempty"`
+	Excerpt    string    `json:"excerpt"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ModerationActionDTO モデレーターの対応の記録
+type ModerationActionDTO struct {
+	ActionID        string     `json:"action_id"`
+	TargetType      string     `json:"target_type"`
+	TargetID        string     `json:"target_id"`
+	TargetOwnerID   string     `json:"target_owner_id"`
+	Action          string     `json:"action"`
+	ModeratorID     string     `json:"moderator_id"`
+	Note            string     `json:"note,omitempty"`
+	SuspendedUntil  *time.Time `json:"suspended_until,omitempty"`
+	ResolvedReports int        `json:"resolved_reports"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// SuspensionDTO アカウント停止
+type SuspensionDTO struct {
+	UserID      string    `json:"user_id"`
+	Reason      string    `json:"reason,omitempty"`
+	SuspendedAt time.Time `json:"suspended_at"`
+	// Until 停止の終了日時（無期限の場合は省略）
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// ModerationCaseResponse 対象ごとの未対応の通報と、責任者へのこれまでの対応
+type ModerationCaseResponse struct {
+	TargetType string            `json:"target_type"`
+	TargetID   string            `json:"target_id"`
+	Reports    []ReportDetailDTO `json:"reports"`
+	// OwnerHistory 対象の投稿者（ユーザーの通報では本人）へのこれまでの対応（新しい順）
+	OwnerHistory []ModerationActionDTO `json:"owner_history"`
+	// ActiveSuspension 投稿者が停止中の場合のみ
+	ActiveSuspension *SuspensionDTO `json:"active_suspension,omitempty"`
+}
+
+// TakeActionRequest モデレーターの対応リクエスト（対象の未対応の通報をすべて解決する）
+type TakeActionRequest struct {
+	ModeratorID string `json:"-"`
+	TargetType  string `json:"target_type" validate:"required,oneof=experience comment user"`
+	TargetID    string `json:"target_id" validate:"required,uuid"`
+	// Action hide（体験記録・コメントのみ）, warn, suspend, dismiss
+	Action string `json:"action" validate:"required,oneof=hide warn suspend dismiss"`
+	// Note 投稿者に伝える理由
+	Note string `json:"note,omitempty" validate:"max=1000"`
+	// SuspendDays suspend の停止日数（省略または0で無期限）
+	SuspendDays int `json:"suspend_days,omitempty" validate:"gte=0,lte=3650"`
+}
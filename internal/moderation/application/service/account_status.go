@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"zen-connect/internal/moderation/domain"
+)
+
+// AccountStatusService 停止中のアカウントを判定するサービス
+// 認証済みリクエストごとに呼ばれるため、停止中のアカウントの一覧をキャッシュする
+// 同じプロセス内での停止・解除は即時に、他のインスタンスでは ttl 経過後に反映される
+type AccountStatusService struct {
+	repo domain.SuspensionRepository
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu          sync.Mutex
+	suspensions map[string]*domain.Suspension
+	loadedAt    time.Time
+}
+
+// NewAccountStatusService コンストラクタ
+func NewAccountStatusService(repo domain.SuspensionRepository, ttl time.Duration) *AccountStatusService {
+	return &AccountStatusService{
+		repo: repo,
+		ttl:  ttl,
+		now:  time.Now,
+	}
+}
+
+// CheckAccount 停止中のアカウントなら domain.ErrAccountSuspended を返す
+func (s *AccountStatusService) CheckAccount(ctx context.Context, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.suspensions == nil || now.Sub(s.loadedAt) >= s.ttl {
+		active, err := s.repo.FindActive(ctx, now)
+		if err != nil {
+			return err
+		}
+		s.suspensions = make(map[string]*domain.Suspension, len(active))
+		for _, suspension := range active {
+			s.suspensions[suspension.UserID()] = suspension
+		}
+		s.loadedAt = now
+	}
+
+	// 期限付きの停止はキャッシュ中でも期限が来たら解除される
+	if suspension, ok := s.suspensions[userID]; ok && suspension.IsActive(now) {
+		return domain.ErrAccountSuspended
+	}
+	return nil
+}
+
+// Invalidate キャッシュを破棄し、次回の判定で再読み込みさせる
+func (s *AccountStatusService) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.suspensions = nil
+}
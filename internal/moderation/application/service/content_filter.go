@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+
+	"zen-connect/internal/moderation/domain"
+)
+
+// ContentFilterService 保存前の文章を禁止語フィルターにかけるサービス
+// 体験記録のメモ・振り返り・コメントの保存時に使われ、禁止語を含む場合は保存させない
+// 非公開のメモも対象になるが、本文をモデレーターに送ることはしない
+type ContentFilterService struct {
+	filter *domain.KeywordFilter
+}
+
+// NewContentFilterService コンストラクタ
+func NewContentFilterService(blockedKeywords []string) *ContentFilterService {
+	return &ContentFilterService{
+		filter: domain.NewKeywordFilter(blockedKeywords),
+	}
+}
+
+// Screen 禁止語を含む文章があれば domain.ErrContentRejected を返す
+func (s *ContentFilterService) Screen(ctx context.Context, texts ...string) error {
+	return s.filter.Check(texts...)
+}
@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"zen-connect/internal/moderation/application/dto"
+	"zen-connect/internal/moderation/application/service"
+	"zen-connect/internal/moderation/domain"
+)
+
+// defaultQueueLimit 件数を指定しない場合に返すキューの件数
+const defaultQueueLimit = 20
+
+// ownerHistoryLimit 対応画面に表示する投稿者へのこれまでの対応の件数
+const ownerHistoryLimit = 20
+
+// ModerationQueueUseCase モデレーションキューの参照ユースケース（管理者用）
+type ModerationQueueUseCase struct {
+	reportRepo     domain.ReportRepository
+	actionRepo     domain.ActionRepository
+	suspensionRepo domain.SuspensionRepository
+}
+
+// NewModerationQueueUseCase コンストラクタ
+func NewModerationQueueUseCase(reportRepo domain.ReportRepository, actionRepo domain.ActionRepository, suspensionRepo domain.SuspensionRepository) *ModerationQueueUseCase {
+	return &ModerationQueueUseCase{
+		reportRepo:     reportRepo,
+		actionRepo:     actionRepo,
+		suspensionRepo: suspensionRepo,
+	}
+}
+
+// List 未対応の通報がある対象を通報の多い順に取得
+func (uc *ModerationQueueUseCase) List(ctx context.Context, req *dto.ListQueueRequest) (*dto.ModerationQueueResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultQueueLimit
+	}
+	entries, err := uc.reportRepo.Queue(ctx, limit+1, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ModerationQueueResponse{
+		Entries: []dto.QueueEntryDTO{},
+	}
+	if len(entries) > limit {
+		entries = entries[:limit]
+		nextOffset := req.Offset + limit
+		response.NextOffset = &nextOffset
+	}
+	for _, entry := range entries {
+		response.Entries = append(response.Entries, dto.FromQueueEntry(entry))
+	}
+	return response, nil
+}
+
+// Case 対象の未対応の通報と、投稿者へのこれまでの対応を取得
+func (uc *ModerationQueueUseCase) Case(ctx context.Context, targetType, targetID string) (*dto.ModerationCaseResponse, error) {
+	target, err := domain.NewTarget(targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	reports, err := uc.reportRepo.FindOpenByTarget(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return nil, domain.ErrNoOpenReports
+	}
+
+	ownerID := reports[0].OwnerID()
+	history, err := uc.actionRepo.FindByOwnerID(ctx, ownerID, ownerHistoryLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ModerationCaseResponse{
+		TargetType:   string(target.Type),
+		TargetID:     target.ID,
+		Reports:      make([]dto.ReportDetailDTO, 0, len(reports)),
+		OwnerHistory: make([]dto.ModerationActionDTO, 0, len(history)),
+	}
+	for _, report := range reports {
+		response.Reports = append(response.Reports, dto.FromReportDetail(report))
+	}
+	for _, action := range history {
+		response.OwnerHistory = append(response.OwnerHistory, dto.FromModerationAction(action))
+	}
+
+	suspension, err := uc.suspensionRepo.FindByUserID(ctx, ownerID)
+	if err != nil && !errors.Is(err, domain.ErrSuspensionNotFound) {
+		return nil, err
+	}
+	if suspension != nil && suspension.IsActive(time.Now()) {
+		response.ActiveSuspension = dto.FromSuspension(suspension)
+	}
+	return response, nil
+}
+
+// TakeActionUseCase 通報された対象への対応ユースケース（管理者用）
+type TakeActionUseCase struct {
+	reportRepo    domain.ReportRepository
+	actionRepo    domain.ActionRepository
+	gateway       ContentGateway
+	accountStatus *service.AccountStatusService
+	publisher     EventPublisher
+}
+
+// NewTakeActionUseCase コンストラクタ
+func NewTakeActionUseCase(
+	reportRepo domain.ReportRepository,
+	actionRepo domain.ActionRepository,
+	gateway ContentGateway,
+	accountStatus *service.AccountStatusService,
+	publisher EventPublisher,
+) *TakeActionUseCase {
+	return &TakeActionUseCase{
+		reportRepo:    reportRepo,
+		actionRepo:    actionRepo,
+		gateway:       gateway,
+		accountStatus: accountStatus,
+		publisher:     publisher,
+	}
+}
+
+// Execute 対象を非表示・投稿者に警告・アカウント停止・通報を却下し、対象の未対応の通報をすべて解決する
+func (uc *TakeActionUseCase) Execute(ctx context.Context, req *dto.TakeActionRequest) (*dto.ModerationActionDTO, error) {
+	target, err := domain.NewTarget(req.TargetType, req.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	actionType, err := domain.ParseActionType(req.Action)
+	if err != nil {
+		return nil, err
+	}
+	reports, err := uc.reportRepo.FindOpenByTarget(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	suspendFor := time.Duration(req.SuspendDays) * 24 * time.Hour
+	action, suspension, err := domain.TakeAction(reports, actionType, req.ModeratorID, req.Note, suspendFor, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// 非表示にできなかった場合は通報を解決しないまま残す
+	if action.Action() == domain.ActionHide {
+		if err := uc.gateway.Hide(ctx, target); err != nil {
+			return nil, err
+		}
+	}
+	if err := uc.actionRepo.Record(ctx, action, reports, suspension); err != nil {
+		return nil, err
+	}
+	if suspension != nil {
+		uc.accountStatus.Invalidate()
+	}
+	uc.publisher.Publish(ctx, action.Events()...)
+
+	response := dto.FromModerationAction(action)
+	return &response, nil
+}
+
+// LiftSuspensionUseCase アカウント停止の解除ユースケース（管理者用）
+type LiftSuspensionUseCase struct {
+	suspensionRepo domain.SuspensionRepository
+	accountStatus  *service.AccountStatusService
+	publisher      EventPublisher
+}
+
+// NewLiftSuspensionUseCase コンストラクタ
+func NewLiftSuspensionUseCase(suspensionRepo domain.SuspensionRepository, accountStatus *service.AccountStatusService, publisher EventPublisher) *LiftSuspensionUseCase {
+	return &LiftSuspensionUseCase{
+		suspensionRepo: suspensionRepo,
+		accountStatus:  accountStatus,
+		publisher:      publisher,
+	}
+}
+
+// Execute 停止中のアカウントを期限前に解除
+func (uc *LiftSuspensionUseCase) Execute(ctx context.Context, userID, moderatorID string) error {
+	suspension, err := uc.suspensionRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := suspension.Lift(moderatorID, time.Now()); err != nil {
+		return err
+	}
+	if err := uc.suspensionRepo.Save(ctx, suspension); err != nil {
+		return err
+	}
+	uc.accountStatus.Invalidate()
+	uc.publisher.Publish(ctx, suspension.Events()...)
+	return nil
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	"zen-connect/internal/moderation/domain"
+)
+
+// ContentGateway 通報対象の確認と非表示を体験記録・ユーザーのコンテキストに委ねる
+type ContentGateway interface {
+	// FindReported 通報者から見える対象の責任者と抜粋を取得（見えない対象は domain.ErrTargetNotFound）
+	FindReported(ctx context.Context, target domain.Target, reporterID string) (*domain.ReportedTarget, error)
+	// Hide 体験記録・コメントを投稿者以外から見えなくする
+	Hide(ctx context.Context, target domain.Target) error
+}
+
+// EventPublisher ドメインイベントを他のコンテキストに伝える（モデレーターや投稿者への通知）
+// 保存後に呼ばれるため、配信の失敗はリクエストの失敗にしない
+type EventPublisher interface {
+	Publish(ctx context.Context, events ...domain.DomainEvent)
+}
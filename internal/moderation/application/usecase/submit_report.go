@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/moderation/application/dto"
+	"zen-connect/internal/moderation/domain"
+)
+
+// SubmitReportUseCase 体験記録・コメント・ユーザーの通報ユースケース
+type SubmitReportUseCase struct {
+	reportRepo domain.ReportRepository
+	gateway    ContentGateway
+	publisher  EventPublisher
+}
+
+// NewSubmitReportUseCase コンストラクタ
+func NewSubmitReportUseCase(reportRepo domain.ReportRepository, gateway ContentGateway, publisher EventPublisher) *SubmitReportUseCase {
+	return &SubmitReportUseCase{
+		reportRepo: reportRepo,
+		gateway:    gateway,
+		publisher:  publisher,
+	}
+}
+
+// Execute 通報をモデレーションキューに追加
+// 通報時点の本文の抜粋を残すので、後で編集・削除されてもモデレーターは内容を確認できる
+func (uc *SubmitReportUseCase) Execute(ctx context.Context, req *dto.SubmitReportRequest) (*dto.ReportDTO, error) {
+	target, err := domain.NewTarget(req.TargetType, req.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	reason, err := domain.ParseReasonCode(req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	reported, err := uc.gateway.FindReported(ctx, target, req.ReporterID)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := domain.NewReport(req.ReporterID, target, *reported, reason, req.Detail, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.reportRepo.Create(ctx, report); err != nil {
+		return nil, err
+	}
+	uc.publisher.Publish(ctx, report.Events()...)
+
+	response := dto.FromReport(report)
+	return &response, nil
+}
@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// ActionType is what a moderator did about a reported target
+type ActionType string
+
+const (
+	// ActionHide hides an experience or comment from everyone but its author
+	ActionHide ActionType = "hide"
+	// ActionWarn warns the owner of the target
+	ActionWarn ActionType = "warn"
+	// ActionSuspend suspends the owner's account
+	ActionSuspend ActionType = "suspend"
+	// ActionDismiss closes the reports without doing anything
+	ActionDismiss ActionType = "dismiss"
+)
+
+// MaxActionNoteLength is the maximum length of a moderator's note to the owner
+const MaxActionNoteLength = 1000
+
+// Domain errors for moderation actions
+var (
+	ErrInvalidAction             = errors.New("invalid moderation action")
+	ErrActionNotApplicable       = errors.New("moderation action is not applicable to the target")
+	ErrNoOpenReports             = errors.New("target has no open reports")
+	ErrActionNoteTooLong         = errors.New("moderation note is too long")
+	ErrInvalidSuspensionDuration = errors.New("invalid suspension duration")
+	ErrSuspensionNotFound        = errors.New("active suspension not found")
+	ErrAccountSuspended          = errors.New("account is suspended")
+)
+
+// ParseActionType validates an action type
+func ParseActionType(s string) (ActionType, error) {
+	switch a := ActionType(s); a {
+	case ActionHide, ActionWarn, ActionSuspend, ActionDismiss:
+		return a, nil
+	}
+	return "", ErrInvalidAction
+}
+
+// ModerationAction is the audit record of a moderator's decision on a target (aggregate root)
+type ModerationAction struct {
+	id              string
+	target          Target
+	ownerID         string
+	action          ActionType
+	moderatorID     string
+	note            string
+	suspendedUntil  time.Time
+	resolvedReports int
+	createdAt       time.Time
+	events          []DomainEvent
+}
+
+// TakeAction decides on the open reports of a single target.
+// Every report is resolved with the action; suspending also returns the
+// owner's suspension, which lasts suspendFor or indefinitely when it is zero.
+func TakeAction(reports []*Report, action ActionType, moderatorID, note string, suspendFor time.Duration, now time.Time) (*ModerationAction, *Suspension, error) {
+	if len(reports) == 0 {
+		return nil, nil, ErrNoOpenReports
+	}
+	target := reports[0].Target()
+	if action == ActionHide && target.Type == TargetUser {
+		return nil, nil, ErrActionNotApplicable
+	}
+	if suspendFor < 0 || (suspendFor > 0 && action != ActionSuspend) {
+		return nil, nil, ErrInvalidSuspensionDuration
+	}
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > MaxActionNoteLength {
+		return nil, nil, ErrActionNoteTooLong
+	}
+
+	for _, report := range reports {
+		if report.Target() != target {
+			return nil, nil, ErrActionNotApplicable
+		}
+		if !report.IsOpen() {
+			return nil, nil, ErrReportAlreadyResolved
+		}
+	}
+	for _, report := range reports {
+		report.resolve(action, now)
+	}
+
+	decision := &ModerationAction{
+		id:              uuid.New().String(),
+		target:          target,
+		ownerID:         reports[0].OwnerID(),
+		action:          action,
+		moderatorID:     moderatorID,
+		note:            note,
+		resolvedReports: len(reports),
+		createdAt:       now,
+	}
+
+	var suspension *Suspension
+	if action == ActionSuspend {
+		suspension = &Suspension{
+			userID:      decision.ownerID,
+			actionID:    decision.id,
+			reason:      note,
+			suspendedAt: now,
+		}
+		if suspendFor > 0 {
+			suspension.until = now.Add(suspendFor)
+		}
+		decision.suspendedUntil = suspension.until
+	}
+
+	decision.events = append(decision.events, NewModerationActionTaken(decision.id, target, decision.ownerID, action, note, decision.suspendedUntil, now))
+	return decision, suspension, nil
+}
+
+// ReconstructModerationAction recreates a moderation action from persisted data
+func ReconstructModerationAction(
+	id string,
+	target Target,
+	ownerID string,
+	action ActionType,
+	moderatorID, note string,
+	suspendedUntil time.Time,
+	resolvedReports int,
+	createdAt time.Time,
+) *ModerationAction {
+	return &ModerationAction{
+		id:              id,
+		target:          target,
+		ownerID:         ownerID,
+		action:          action,
+		moderatorID:     moderatorID,
+		note:            note,
+		suspendedUntil:  suspendedUntil,
+		resolvedReports: resolvedReports,
+		createdAt:       createdAt,
+	}
+}
+
+func (a *ModerationAction) ID() string                { return a.id }
+func (a *ModerationAction) Target() Target            { return a.target }
+func (a *ModerationAction) OwnerID() string           { return a.ownerID }
+func (a *ModerationAction) Action() ActionType        { return a.action }
+func (a *ModerationAction) ModeratorID() string       { return a.moderatorID }
+func (a *ModerationAction) Note() string              { return a.note }
+func (a *ModerationAction) SuspendedUntil() time.Time { return a.suspendedUntil }
+func (a *ModerationAction) ResolvedReports() int      { return a.resolvedReports }
+func (a *ModerationAction) CreatedAt() time.Time      { return a.createdAt }
+func (a *ModerationAction) Events() []DomainEvent     { return a.events }
+
+// Suspension keeps a user from using the API until it ends or is lifted.
+// A user has at most one suspension; suspending again replaces it.
+type Suspension struct {
+	userID      string
+	actionID    string
+	reason      string
+	suspendedAt time.Time
+	until       time.Time
+	liftedAt    time.Time
+	liftedBy    string
+	events      []DomainEvent
+}
+
+// ReconstructSuspension recreates a suspension from persisted data
+func ReconstructSuspension(userID, actionID, reason string, suspendedAt, until, liftedAt time.Time, liftedBy string) *Suspension {
+	return &Suspension{
+		userID:      userID,
+		actionID:    actionID,
+		reason:      reason,
+		suspendedAt: suspendedAt,
+		until:       until,
+		liftedAt:    liftedAt,
+		liftedBy:    liftedBy,
+	}
+}
+
+func (s *Suspension) UserID() string         { return s.userID }
+func (s *Suspension) ActionID() string       { return s.actionID }
+func (s *Suspension) Reason() string         { return s.reason }
+func (s *Suspension) SuspendedAt() time.Time { return s.suspendedAt }
+func (s *Suspension) Until() time.Time       { return s.until }
+func (s *Suspension) LiftedAt() time.Time    { return s.liftedAt }
+func (s *Suspension) LiftedBy() string       { return s.liftedBy }
+func (s *Suspension) Events() []DomainEvent  { return s.events }
+
+// IsIndefinite reports whether the suspension lasts until it is lifted
+func (s *Suspension) IsIndefinite() bool {
+	return s.until.IsZero()
+}
+
+// IsActive reports whether the suspension is in effect at the given time
+func (s *Suspension) IsActive(now time.Time) bool {
+	return s.liftedAt.IsZero() && (s.IsIndefinite() || now.Before(s.until))
+}
+
+// Lift ends the suspension early
+func (s *Suspension) Lift(moderatorID string, now time.Time) error {
+	if !s.IsActive(now) {
+		return ErrSuspensionNotFound
+	}
+	s.liftedAt = now
+	s.liftedBy = moderatorID
+	s.events = append(s.events, NewSuspensionLifted(s.userID, moderatorID, now))
+	return nil
+}
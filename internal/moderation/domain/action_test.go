@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTakeAction_ShouldResolveEveryOpenReport(t *testing.T) {
+	// given
+	target, _ := NewTarget("comment", "comment-1")
+	reports := []*Report{
+		newTestReport(t, "reporter-1", target, ReasonSpam),
+		newTestReport(t, "reporter-2", target, ReasonHarassment),
+	}
+	now := time.Now()
+
+	// when
+	action, suspension, err := TakeAction(reports, ActionHide, "moderator", "ガイドライン違反のため", 0, now)
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if suspension != nil {
+		t.Errorf("Expected no suspension when hiding, got %+v", suspension)
+	}
+	for _, report := range reports {
+		if report.IsOpen() || report.Resolution() != ActionHide || !report.ResolvedAt().Equal(now) {
+			t.Errorf("Expected report resolved by hide, got %s %s", report.Status(), report.Resolution())
+		}
+	}
+	taken, ok := action.Events()[0].(*ModerationActionTaken)
+	if !ok || action.ResolvedReports() != 2 || taken.OwnerID() != "owner" || taken.Action() != ActionHide {
+		t.Errorf("Expected ModerationActionTaken for the owner, got %+v", action.Events())
+	}
+}
+
+func TestTakeAction_SuspendShouldSuspendOwnerForGivenDuration(t *testing.T) {
+	// given
+	target, _ := NewTarget("user", "owner")
+	now := time.Now()
+
+	// when
+	action, suspension, err := TakeAction([]*Report{newTestReport(t, "reporter", target, ReasonImpersonation)}, ActionSuspend, "moderator", "なりすまし", 7*24*time.Hour, now)
+	_, indefinite, indefiniteErr := TakeAction([]*Report{newTestReport(t, "reporter", target, ReasonSpam)}, ActionSuspend, "moderator", "", 0, now)
+
+	// then
+	if err != nil || indefiniteErr != nil {
+		t.Fatalf("Expected no error, got %v / %v", err, indefiniteErr)
+	}
+	if suspension.UserID() != "owner" || !suspension.Until().Equal(now.Add(7*24*time.Hour)) || !action.SuspendedUntil().Equal(suspension.Until()) {
+		t.Errorf("Expected owner suspended for a week, got %+v", suspension)
+	}
+	if !suspension.IsActive(now.Add(6*24*time.Hour)) || suspension.IsActive(now.Add(7*24*time.Hour)) {
+		t.Error("Expected suspension to end after a week")
+	}
+	if !indefinite.IsIndefinite() || !indefinite.IsActive(now.AddDate(10, 0, 0)) {
+		t.Error("Expected indefinite suspension without a duration")
+	}
+}
+
+func TestTakeAction_ShouldRejectInvalidActions(t *testing.T) {
+	// given
+	user, _ := NewTarget("user", "owner")
+	experience, _ := NewTarget("experience", "experience-1")
+	now := time.Now()
+
+	cases := map[string]struct {
+		reports    []*Report
+		action     ActionType
+		suspendFor time.Duration
+		expected   error
+	}{
+		"no reports":               {reports: nil, action: ActionWarn, expected: ErrNoOpenReports},
+		"hide a user":              {reports: []*Report{newTestReport(t, "reporter", user, ReasonSpam)}, action: ActionHide, expected: ErrActionNotApplicable},
+		"duration without suspend": {reports: []*Report{newTestReport(t, "reporter", experience, ReasonSpam)}, action: ActionWarn, suspendFor: time.Hour, expected: ErrInvalidSuspensionDuration},
+		"negative duration":        {reports: []*Report{newTestReport(t, "reporter", experience, ReasonSpam)}, action: ActionSuspend, suspendFor: -time.Hour, expected: ErrInvalidSuspensionDuration},
+	}
+	for name, tc := range cases {
+		// when
+		_, _, err := TakeAction(tc.reports, tc.action, "moderator", "", tc.suspendFor, now)
+
+		// then
+		if !errors.Is(err, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", name, tc.expected, err)
+		}
+		for _, report := range tc.reports {
+			if !report.IsOpen() {
+				t.Errorf("%s: expected report to stay open", name)
+			}
+		}
+	}
+}
+
+func TestSuspension_LiftShouldEndSuspensionOnce(t *testing.T) {
+	// given
+	target, _ := NewTarget("user", "owner")
+	now := time.Now()
+	_, suspension, _ := TakeAction([]*Report{newTestReport(t, "reporter", target, ReasonHarassment)}, ActionSuspend, "moderator", "", 0, now)
+
+	// when
+	err := suspension.Lift("moderator", now.Add(time.Hour))
+	againErr := suspension.Lift("moderator", now.Add(2*time.Hour))
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if suspension.IsActive(now.Add(time.Hour)) || suspension.LiftedBy() != "moderator" {
+		t.Errorf("Expected lifted suspension, got %+v", suspension)
+	}
+	if !errors.Is(againErr, ErrSuspensionNotFound) || len(suspension.Events()) != 1 {
+		t.Errorf("Expected ErrSuspensionNotFound and a single SuspensionLifted, got %v / %d events", againErr, len(suspension.Events()))
+	}
+}
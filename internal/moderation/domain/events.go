@@ -0,0 +1,94 @@
+package domain
+
+import "time"
+
+// DomainEvent represents a domain event interface
+type DomainEvent interface {
+	EventName() string
+	AggregateID() string
+	OccurredAt() time.Time
+}
+
+// ReportSubmitted event fired when a user reports a target, so moderators can be notified
+type ReportSubmitted struct {
+	eventName   string
+	aggregateID string
+	occurredAt  time.Time
+	target      Target
+	ownerID     string
+	reason      ReasonCode
+}
+
+func NewReportSubmitted(aggregateID string, target Target, ownerID string, reason ReasonCode, occurredAt time.Time) *ReportSubmitted {
+	return &ReportSubmitted{
+		eventName:   "ReportSubmitted",
+		aggregateID: aggregateID,
+		occurredAt:  occurredAt,
+		target:      target,
+		ownerID:     ownerID,
+		reason:      reason,
+	}
+}
+
+func (e *ReportSubmitted) EventName() string     { return e.eventName }
+func (e *ReportSubmitted) AggregateID() string   { return e.aggregateID }
+func (e *ReportSubmitted) OccurredAt() time.Time { return e.occurredAt }
+func (e *ReportSubmitted) Target() Target        { return e.target }
+func (e *ReportSubmitted) OwnerID() string       { return e.ownerID }
+func (e *ReportSubmitted) Reason() ReasonCode    { return e.reason }
+
+// ModerationActionTaken event fired when a moderator decides on a target, so its owner can be notified
+type ModerationActionTaken struct {
+	eventName      string
+	aggregateID    string
+	occurredAt     time.Time
+	target         Target
+	ownerID        string
+	action         ActionType
+	note           string
+	suspendedUntil time.Time
+}
+
+func NewModerationActionTaken(aggregateID string, target Target, ownerID string, action ActionType, note string, suspendedUntil, occurredAt time.Time) *ModerationActionTaken {
+	return &ModerationActionTaken{
+		eventName:      "ModerationActionTaken",
+		aggregateID:    aggregateID,
+		occurredAt:     occurredAt,
+		target:         target,
+		ownerID:        ownerID,
+		action:         action,
+		note:           note,
+		suspendedUntil: suspendedUntil,
+	}
+}
+
+func (e *ModerationActionTaken) EventName() string         { return e.eventName }
+func (e *ModerationActionTaken) AggregateID() string       { return e.aggregateID }
+func (e *ModerationActionTaken) OccurredAt() time.Time     { return e.occurredAt }
+func (e *ModerationActionTaken) Target() Target            { return e.target }
+func (e *ModerationActionTaken) OwnerID() string           { return e.ownerID }
+func (e *ModerationActionTaken) Action() ActionType        { return e.action }
+func (e *ModerationActionTaken) Note() string              { return e.note }
+func (e *ModerationActionTaken) SuspendedUntil() time.Time { return e.suspendedUntil }
+
+// SuspensionLifted event fired when a moderator lifts a suspension early
+type SuspensionLifted struct {
+	eventName   string
+	aggregateID string
+	occurredAt  time.Time
+	moderatorID string
+}
+
+func NewSuspensionLifted(userID, moderatorID string, occurredAt time.Time) *SuspensionLifted {
+	return &SuspensionLifted{
+		eventName:   "SuspensionLifted",
+		aggregateID: userID,
+		occurredAt:  occurredAt,
+		moderatorID: moderatorID,
+	}
+}
+
+func (e *SuspensionLifted) EventName() string     { return e.eventName }
+func (e *SuspensionLifted) AggregateID() string   { return e.aggregateID }
+func (e *SuspensionLifted) OccurredAt() time.Time { return e.occurredAt }
+func (e *SuspensionLifted) ModeratorID() string   { return e.moderatorID }
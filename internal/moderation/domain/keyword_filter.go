@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ErrContentRejected is returned when a text contains a blocked keyword
+var ErrContentRejected = errors.New("content contains a blocked keyword")
+
+// KeywordFilter rejects texts containing any of the blocked keywords.
+// Matching is case-insensitive and NFKC-normalized, so full-width letters
+// and half-width katakana match their usual forms.
+type KeywordFilter struct {
+	keywords []string
+}
+
+// NewKeywordFilter normalizes the keywords; blank ones are ignored
+func NewKeywordFilter(keywords []string) *KeywordFilter {
+	filter := &KeywordFilter{}
+	for _, keyword := range keywords {
+		if keyword = normalizeText(strings.TrimSpace(keyword)); keyword != "" {
+			filter.keywords = append(filter.keywords, keyword)
+		}
+	}
+	return filter
+}
+
+// Check returns ErrContentRejected if any text contains a blocked keyword
+func (f *KeywordFilter) Check(texts ...string) error {
+	for _, text := range texts {
+		normalized := normalizeText(text)
+		for _, keyword := range f.keywords {
+			if strings.Contains(normalized, keyword) {
+				return ErrContentRejected
+			}
+		}
+	}
+	return nil
+}
+
+// IsEmpty reports whether no keyword is blocked
+func (f *KeywordFilter) IsEmpty() bool {
+	return len(f.keywords) == 0
+}
+
+func normalizeText(s string) string {
+	return strings.ToLower(norm.NFKC.String(s))
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestKeywordFilter_ShouldMatchRegardlessOfCaseAndWidth(t *testing.T) {
+	// given
+	filter := NewKeywordFilter([]string{" Casino ", "", "カジノ"})
+
+	cases := map[string]struct {
+		texts    []string
+		rejected bool
+	}{
+		"clean":               {texts: []string{"静かな朝でした", "呼吸に集中"}, rejected: false},
+		"upper case":          {texts: []string{"Visit CASINO now"}, rejected: true},
+		"full-width letters":  {texts: []string{"ｃａｓｉｎｏ"}, rejected: true},
+		"half-width katakana": {texts: []string{"ｶｼﾞﾉ"}, rejected: true},
+		"any of the texts":    {texts: []string{"静かな朝", "カジノの話"}, rejected: true},
+	}
+	for name, tc := range cases {
+		// when
+		err := filter.Check(tc.texts...)
+
+		// then
+		if tc.rejected != errors.Is(err, ErrContentRejected) {
+			t.Errorf("%s: expected rejected=%v, got %v", name, tc.rejected, err)
+		}
+	}
+}
+
+func TestKeywordFilter_WithoutKeywordsShouldAcceptEverything(t *testing.T) {
+	// given
+	filter := NewKeywordFilter(nil)
+
+	// when
+	err := filter.Check("何でも")
+
+	// then
+	if err != nil || !filter.IsEmpty() {
+		t.Errorf("Expected empty filter to accept everything, got %v", err)
+	}
+}
@@ -0,0 +1,200 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// TargetType is the kind of thing a report is about
+type TargetType string
+
+const (
+	TargetExperience TargetType = "experience"
+	TargetComment    TargetType = "comment"
+	TargetUser       TargetType = "user"
+)
+
+// ReasonCode is why a user reported something
+type ReasonCode string
+
+const (
+	ReasonSpam           ReasonCode = "spam"
+	ReasonHarassment     ReasonCode = "harassment"
+	ReasonHateSpeech     ReasonCode = "hate_speech"
+	ReasonSexualContent  ReasonCode = "sexual_content"
+	ReasonSelfHarm       ReasonCode = "self_harm"
+	ReasonMisinformation ReasonCode = "misinformation"
+	ReasonImpersonation  ReasonCode = "impersonation"
+	// ReasonOther requires a detail
+	ReasonOther ReasonCode = "other"
+)
+
+// ReportStatus is the state of a report in the moderation queue
+type ReportStatus string
+
+const (
+	ReportStatusOpen     ReportStatus = "open"
+	ReportStatusResolved ReportStatus = "resolved"
+)
+
+// MaxReportDetailLength is the maximum length of the reporter's explanation
+const MaxReportDetailLength = 1000
+
+// Domain errors for Report
+var (
+	ErrReportNotFound         = errors.New("report not found")
+	ErrTargetNotFound         = errors.New("report target not found")
+	ErrInvalidTargetType      = errors.New("invalid report target type")
+	ErrInvalidReason          = errors.New("invalid report reason")
+	ErrReportDetailRequired   = errors.New("report detail is required for the other reason")
+	ErrReportDetailTooLong    = errors.New("report detail is too long")
+	ErrCannotReportOwnContent = errors.New("users cannot report themselves or their own content")
+	ErrAlreadyReported        = errors.New("target has already been reported by the user")
+	ErrReportAlreadyResolved  = errors.New("report has already been resolved")
+)
+
+// Target identifies a reported experience, comment or user
+type Target struct {
+	Type TargetType
+	ID   string
+}
+
+// NewTarget validates the target type
+func NewTarget(targetType, id string) (Target, error) {
+	switch t := TargetType(targetType); t {
+	case TargetExperience, TargetComment, TargetUser:
+		return Target{Type: t, ID: id}, nil
+	}
+	return Target{}, ErrInvalidTargetType
+}
+
+// ReasonCodes returns every reason code in display order
+func ReasonCodes() []ReasonCode {
+	return []ReasonCode{
+		ReasonSpam, ReasonHarassment, ReasonHateSpeech, ReasonSexualContent,
+		ReasonSelfHarm, ReasonMisinformation, ReasonImpersonation, ReasonOther,
+	}
+}
+
+// ParseReasonCode validates a reason code
+func ParseReasonCode(s string) (ReasonCode, error) {
+	for _, reason := range ReasonCodes() {
+		if string(reason) == s {
+			return reason, nil
+		}
+	}
+	return "", ErrInvalidReason
+}
+
+// ReportedTarget is what the reporter saw when reporting: who is responsible
+// for the target (the user itself for user reports) and an excerpt of it
+type ReportedTarget struct {
+	OwnerID string
+	Excerpt string
+}
+
+// Report is a user's report of an experience, comment or user (aggregate root)
+type Report struct {
+	id         string
+	reporterID string
+	target     Target
+	ownerID    string
+	reason     ReasonCode
+	detail     string
+	excerpt    string
+	status     ReportStatus
+	resolution ActionType
+	createdAt  time.Time
+	resolvedAt time.Time
+	events     []DomainEvent
+}
+
+// NewReport files a report for the moderation queue
+func NewReport(reporterID string, target Target, reported ReportedTarget, reason ReasonCode, detail string, now time.Time) (*Report, error) {
+	if reporterID == reported.OwnerID {
+		return nil, ErrCannotReportOwnContent
+	}
+	detail = strings.TrimSpace(detail)
+	if reason == ReasonOther && detail == "" {
+		return nil, ErrReportDetailRequired
+	}
+	if utf8.RuneCountInString(detail) > MaxReportDetailLength {
+		return nil, ErrReportDetailTooLong
+	}
+
+	report := &Report{
+		id:         uuid.New().String(),
+		reporterID: reporterID,
+		target:     target,
+		ownerID:    reported.OwnerID,
+		reason:     reason,
+		detail:     detail,
+		excerpt:    reported.Excerpt,
+		status:     ReportStatusOpen,
+		createdAt:  now,
+	}
+	report.events = append(report.events, NewReportSubmitted(report.id, target, reported.OwnerID, reason, now))
+	return report, nil
+}
+
+// ReconstructReport recreates a report from persisted data
+func ReconstructReport(
+	id, reporterID string,
+	target Target,
+	ownerID string,
+	reason ReasonCode,
+	detail, excerpt string,
+	status ReportStatus,
+	resolution ActionType,
+	createdAt, resolvedAt time.Time,
+) *Report {
+	return &Report{
+		id:         id,
+		reporterID: reporterID,
+		target:     target,
+		ownerID:    ownerID,
+		reason:     reason,
+		detail:     detail,
+		excerpt:    excerpt,
+		status:     status,
+		resolution: resolution,
+		createdAt:  createdAt,
+		resolvedAt: resolvedAt,
+	}
+}
+
+func (r *Report) ID() string             { return r.id }
+func (r *Report) ReporterID() string     { return r.reporterID }
+func (r *Report) Target() Target         { return r.target }
+func (r *Report) OwnerID() string        { return r.ownerID }
+func (r *Report) Reason() ReasonCode     { return r.reason }
+func (r *Report) Detail() string         { return r.detail }
+func (r *Report) Excerpt() string        { return r.excerpt }
+func (r *Report) Status() ReportStatus   { return r.status }
+func (r *Report) Resolution() ActionType { return r.resolution }
+func (r *Report) CreatedAt() time.Time   { return r.createdAt }
+func (r *Report) ResolvedAt() time.Time  { return r.resolvedAt }
+func (r *Report) Events() []DomainEvent  { return r.events }
+func (r *Report) IsOpen() bool           { return r.status == ReportStatusOpen }
+
+// resolve closes an open report with the moderator's action
+func (r *Report) resolve(action ActionType, now time.Time) {
+	r.status = ReportStatusResolved
+	r.resolution = action
+	r.resolvedAt = now
+}
+
+// QueueEntry is a target with open reports in the moderation queue
+type QueueEntry struct {
+	Target          Target
+	OwnerID         string
+	ReportCount     int
+	Reasons         map[ReasonCode]int
+	Excerpt         string
+	FirstReportedAt time.Time
+	LastReportedAt  time.Time
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestReport(t *testing.T, reporterID string, target Target, reason ReasonCode) *Report {
+	t.Helper()
+	report, err := NewReport(reporterID, target, ReportedTarget{OwnerID: "owner", Excerpt: "本文"}, reason, "", time.Now())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return report
+}
+
+func TestNewReport_ShouldNotifyModerators(t *testing.T) {
+	// given
+	target, _ := NewTarget("comment", "comment-1")
+
+	// when
+	report, err := NewReport("reporter", target, ReportedTarget{OwnerID: "owner", Excerpt: "本文"}, ReasonSpam, "  宣伝です  ", time.Now())
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !report.IsOpen() || report.Detail() != "宣伝です" || report.Excerpt() != "本文" {
+		t.Errorf("Expected open report with trimmed detail, got %+v", report)
+	}
+	submitted, ok := report.Events()[0].(*ReportSubmitted)
+	if !ok || submitted.Target() != target || submitted.OwnerID() != "owner" || submitted.Reason() != ReasonSpam {
+		t.Errorf("Expected ReportSubmitted, got %+v", report.Events())
+	}
+}
+
+func TestNewReport_ShouldRejectInvalidReports(t *testing.T) {
+	// given
+	target, _ := NewTarget("experience", "experience-1")
+	reported := ReportedTarget{OwnerID: "owner"}
+	now := time.Now()
+
+	cases := map[string]struct {
+		reporterID string
+		reason     ReasonCode
+		detail     string
+		expected   error
+	}{
+		"own content":          {reporterID: "owner", reason: ReasonSpam, expected: ErrCannotReportOwnContent},
+		"other without detail": {reporterID: "reporter", reason: ReasonOther, detail: "  ", expected: ErrReportDetailRequired},
+		"detail too long":      {reporterID: "reporter", reason: ReasonSpam, detail: strings.Repeat("禅", MaxReportDetailLength+1), expected: ErrReportDetailTooLong},
+	}
+	for name, tc := range cases {
+		// when
+		_, err := NewReport(tc.reporterID, target, reported, tc.reason, tc.detail, now)
+
+		// then
+		if !errors.Is(err, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", name, tc.expected, err)
+		}
+	}
+}
+
+func TestParseReasonCodeAndTarget_ShouldRejectUnknownValues(t *testing.T) {
+	// when
+	reason, reasonErr := ParseReasonCode("hate_speech")
+	_, unknownReasonErr := ParseReasonCode("boring")
+	_, targetErr := NewTarget("room", "room-1")
+
+	// then
+	if reasonErr != nil || reason != ReasonHateSpeech {
+		t.Errorf("Expected hate_speech, got %s (%v)", reason, reasonErr)
+	}
+	if !errors.Is(unknownReasonErr, ErrInvalidReason) || !errors.Is(targetErr, ErrInvalidTargetType) {
+		t.Errorf("Expected ErrInvalidReason and ErrInvalidTargetType, got %v / %v", unknownReasonErr, targetErr)
+	}
+}
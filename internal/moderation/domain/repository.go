@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// ReportRepository defines the interface for report persistence
+type ReportRepository interface {
+	// Create stores a new report; ErrAlreadyReported if the reporter has an open report on the same target
+	Create(ctx context.Context, report *Report) error
+	// FindOpenByTarget returns the open reports on a target, oldest first
+	FindOpenByTarget(ctx context.Context, target Target) ([]*Report, error)
+	// Queue returns the targets with open reports, most reported first
+	Queue(ctx context.Context, limit, offset int) ([]*QueueEntry, error)
+}
+
+// ActionRepository defines the interface for moderation action persistence
+type ActionRepository interface {
+	// Record stores an action, the reports it resolved and the suspension it imposed in one transaction
+	Record(ctx context.Context, action *ModerationAction, reports []*Report, suspension *Suspension) error
+	// FindByOwnerID returns the actions taken against a user's content or account, newest first
+	FindByOwnerID(ctx context.Context, ownerID string, limit int) ([]*ModerationAction, error)
+}
+
+// SuspensionRepository defines the interface for account suspension persistence
+type SuspensionRepository interface {
+	// FindByUserID returns the user's latest suspension; ErrSuspensionNotFound if never suspended
+	FindByUserID(ctx context.Context, userID string) (*Suspension, error)
+	// FindActive returns every suspension in effect at the given time
+	FindActive(ctx context.Context, now time.Time) ([]*Suspension, error)
+	// Save stores a suspension
+	Save(ctx context.Context, suspension *Suspension) error
+}
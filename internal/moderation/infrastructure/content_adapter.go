@@ -0,0 +1,76 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+
+	experienceusecase "zen-connect/internal/experience/application/usecase"
+	experiencedomain "zen-connect/internal/experience/domain"
+	"zen-connect/internal/moderation/domain"
+	userservice "zen-connect/internal/user/application/service"
+	userdomain "zen-connect/internal/user/domain"
+)
+
+// ContentAdapter connects moderation to the experience and user contexts:
+// it looks up what a reporter saw and hides experiences and comments
+type ContentAdapter struct {
+	content     *experienceusecase.ModerateContentUseCase
+	userService userservice.UserService
+}
+
+// NewContentAdapter creates a new content adapter
+func NewContentAdapter(content *experienceusecase.ModerateContentUseCase, userService userservice.UserService) *ContentAdapter {
+	return &ContentAdapter{
+		content:     content,
+		userService: userService,
+	}
+}
+
+// FindReported returns the owner and an excerpt of a target the reporter can see
+func (a *ContentAdapter) FindReported(ctx context.Context, target domain.Target, reporterID string) (*domain.ReportedTarget, error) {
+	var content *experienceusecase.ReportedContent
+	var err error
+	switch target.Type {
+	case domain.TargetExperience:
+		content, err = a.content.FindExperience(ctx, target.ID, reporterID)
+	case domain.TargetComment:
+		content, err = a.content.FindComment(ctx, target.ID, reporterID)
+	case domain.TargetUser:
+		user, err := a.userService.GetUserByID(ctx, target.ID)
+		if err != nil {
+			return nil, targetError(err)
+		}
+		// The display name is what other users see of an account
+		return &domain.ReportedTarget{OwnerID: user.ID(), Excerpt: user.Profile().DisplayName()}, nil
+	default:
+		return nil, domain.ErrInvalidTargetType
+	}
+	if err != nil {
+		return nil, targetError(err)
+	}
+	return &domain.ReportedTarget{OwnerID: content.AuthorID, Excerpt: content.Excerpt}, nil
+}
+
+// Hide hides an experience or comment from everyone but its author
+func (a *ContentAdapter) Hide(ctx context.Context, target domain.Target) error {
+	var err error
+	switch target.Type {
+	case domain.TargetExperience:
+		err = a.content.HideExperience(ctx, target.ID)
+	case domain.TargetComment:
+		err = a.content.HideComment(ctx, target.ID)
+	default:
+		return domain.ErrActionNotApplicable
+	}
+	return targetError(err)
+}
+
+// targetError maps the other contexts' not-found errors to ErrTargetNotFound
+func targetError(err error) error {
+	if errors.Is(err, experiencedomain.ErrExperienceNotFound) ||
+		errors.Is(err, experiencedomain.ErrCommentNotFound) ||
+		errors.Is(err, userdomain.ErrUserNotFound) {
+		return domain.ErrTargetNotFound
+	}
+	return err
+}
@@ -0,0 +1,424 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"zen-connect/internal/moderation/domain"
+)
+
+// uniqueViolation is the PostgreSQL error code for unique constraint violations
+const uniqueViolation = "23505"
+
+// PostgresReportRepository implements ReportRepository interface
+type PostgresReportRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresReportRepository creates a new PostgreSQL report repository
+func NewPostgresReportRepository(pool *pgxpool.Pool) *PostgresReportRepository {
+	return &PostgresReportRepository{
+		pool: pool,
+	}
+}
+
+const reportColumns = `
+	id, reporter_id, target_type, target_id, target_owner_id, reason, detail, excerpt,
+	status, resolution, created_at, resolved_at
+`
+
+// Create stores a new report
+func (r *PostgresReportRepository) Create(ctx context.Context, report *domain.Report) error {
+	query := `
+		INSERT INTO moderation_reports (` + reportColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+	_, err := r.pool.Exec(ctx, query,
+		report.ID(),
+		report.ReporterID(),
+		string(report.Target().Type),
+		report.Target().ID,
+		report.OwnerID(),
+		string(report.Reason()),
+		report.Detail(),
+		report.Excerpt(),
+		string(report.Status()),
+		nullString(string(report.Resolution())),
+		report.CreatedAt(),
+		nullTime(report.ResolvedAt()),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return domain.ErrAlreadyReported
+	}
+	return err
+}
+
+// FindOpenByTarget returns the open reports on a target, oldest first
+func (r *PostgresReportRepository) FindOpenByTarget(ctx context.Context, target domain.Target) ([]*domain.Report, error) {
+	query := `
+		SELECT ` + reportColumns + ` FROM moderation_reports
+		WHERE target_type = $1 AND target_id = $2 AND status = 'open'
+		ORDER BY created_at, id
+	`
+	rows, err := r.pool.Query(ctx, query, string(target.Type), target.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []*domain.Report
+	for rows.Next() {
+		report, err := scanReport(rows)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, rows.Err()
+}
+
+// Queue groups the open reports by target, most reported first and then oldest first
+func (r *PostgresReportRepository) Queue(ctx context.Context, limit, offset int) ([]*domain.QueueEntry, error) {
+	query := `
+		SELECT
+			target_type,
+			target_id,
+			(ARRAY_AGG(target_owner_id ORDER BY created_at DESC))[1],
+			COUNT(*),
+			ARRAY_AGG(reason),
+			(ARRAY_AGG(excerpt ORDER BY created_at DESC))[1],
+			MIN(created_at),
+			MAX(created_at)
+		FROM moderation_reports
+		WHERE status = 'open'
+		GROUP BY target_type, target_id
+		ORDER BY COUNT(*) DESC, MIN(created_at), target_id
+		LIMIT $1 OFFSET $2
+	`
+	rows, err := r.pool.Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*domain.QueueEntry
+	for rows.Next() {
+		var targetType, targetID string
+		var reasons []string
+		entry := &domain.QueueEntry{Reasons: map[domain.ReasonCode]int{}}
+		if err := rows.Scan(
+			&targetType,
+			&targetID,
+			&entry.OwnerID,
+			&entry.ReportCount,
+			&reasons,
+			&entry.Excerpt,
+			&entry.FirstReportedAt,
+			&entry.LastReportedAt,
+		); err != nil {
+			return nil, err
+		}
+		entry.Target = domain.Target{Type: domain.TargetType(targetType), ID: targetID}
+		for _, reason := range reasons {
+			entry.Reasons[domain.ReasonCode(reason)]++
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// scanReport reconstructs a report from a result row
+func scanReport(row pgx.Row) (*domain.Report, error) {
+	var id, reporterID, targetType, targetID, ownerID, reason, detail, excerpt, status string
+	var resolution *string
+	var createdAt time.Time
+	var resolvedAt *time.Time
+
+	err := row.Scan(
+		&id,
+		&reporterID,
+		&targetType,
+		&targetID,
+		&ownerID,
+		&reason,
+		&detail,
+		&excerpt,
+		&status,
+		&resolution,
+		&createdAt,
+		&resolvedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstructReport(
+		id,
+		reporterID,
+		domain.Target{Type: domain.TargetType(targetType), ID: targetID},
+		ownerID,
+		domain.ReasonCode(reason),
+		detail,
+		excerpt,
+		domain.ReportStatus(status),
+		domain.ActionType(stringValue(resolution)),
+		createdAt,
+		timeValue(resolvedAt),
+	), nil
+}
+
+// PostgresActionRepository implements ActionRepository interface
+type PostgresActionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresActionRepository creates a new PostgreSQL moderation action repository
+func NewPostgresActionRepository(pool *pgxpool.Pool) *PostgresActionRepository {
+	return &PostgresActionRepository{
+		pool: pool,
+	}
+}
+
+const actionColumns = `
+	id, target_type, target_id, target_owner_id, action, moderator_id, note,
+	suspended_until, resolved_reports, created_at
+`
+
+// Record stores an action, resolves its reports and replaces the owner's suspension in one transaction
+func (r *PostgresActionRepository) Record(ctx context.Context, action *domain.ModerationAction, reports []*domain.Report, suspension *domain.Suspension) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	insertAction := `
+		INSERT INTO moderation_actions (` + actionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+	if _, err := tx.Exec(ctx, insertAction,
+		action.ID(),
+		string(action.Target().Type),
+		action.Target().ID,
+		action.OwnerID(),
+		string(action.Action()),
+		action.ModeratorID(),
+		action.Note(),
+		nullTime(action.SuspendedUntil()),
+		action.ResolvedReports(),
+		action.CreatedAt(),
+	); err != nil {
+		return fmt.Errorf("failed to save moderation action: %w", err)
+	}
+
+	// Reports resolved concurrently by another moderator are left as they are
+	resolveReport := `
+		UPDATE moderation_reports SET status = $2, resolution = $3, resolved_at = $4, action_id = $5
+		WHERE id = $1 AND status = 'open'
+	`
+	for _, report := range reports {
+		if _, err := tx.Exec(ctx, resolveReport,
+			report.ID(),
+			string(report.Status()),
+			string(report.Resolution()),
+			report.ResolvedAt(),
+			action.ID(),
+		); err != nil {
+			return fmt.Errorf("failed to resolve report: %w", err)
+		}
+	}
+
+	if suspension != nil {
+		if err := saveSuspension(ctx, tx, suspension); err != nil {
+			return fmt.Errorf("failed to save suspension: %w", err)
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// FindByOwnerID returns the actions taken against a user's content or account, newest first
+func (r *PostgresActionRepository) FindByOwnerID(ctx context.Context, ownerID string, limit int) ([]*domain.ModerationAction, error) {
+	query := `
+		SELECT ` + actionColumns + ` FROM moderation_actions
+		WHERE target_owner_id = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2
+	`
+	rows, err := r.pool.Query(ctx, query, ownerID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var actions []*domain.ModerationAction
+	for rows.Next() {
+		var id, targetType, targetID, targetOwnerID, actionType, moderatorID, note string
+		var suspendedUntil *time.Time
+		var resolvedReports int
+		var createdAt time.Time
+		if err := rows.Scan(
+			&id,
+			&targetType,
+			&targetID,
+			&targetOwnerID,
+			&actionType,
+			&moderatorID,
+			&note,
+			&suspendedUntil,
+			&resolvedReports,
+			&createdAt,
+		); err != nil {
+			return nil, err
+		}
+		actions = append(actions, domain.ReconstructModerationAction(
+			id,
+			domain.Target{Type: domain.TargetType(targetType), ID: targetID},
+			targetOwnerID,
+			domain.ActionType(actionType),
+			moderatorID,
+			note,
+			timeValue(suspendedUntil),
+			resolvedReports,
+			createdAt,
+		))
+	}
+	return actions, rows.Err()
+}
+
+// PostgresSuspensionRepository implements SuspensionRepository interface
+type PostgresSuspensionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresSuspensionRepository creates a new PostgreSQL suspension repository
+func NewPostgresSuspensionRepository(pool *pgxpool.Pool) *PostgresSuspensionRepository {
+	return &PostgresSuspensionRepository{
+		pool: pool,
+	}
+}
+
+const suspensionColumns = `user_id, action_id, reason, suspended_at, until, lifted_at, lifted_by`
+
+// FindByUserID returns the user's latest suspension
+func (r *PostgresSuspensionRepository) FindByUserID(ctx context.Context, userID string) (*domain.Suspension, error) {
+	suspension, err := scanSuspension(r.pool.QueryRow(ctx, `SELECT `+suspensionColumns+` FROM account_suspensions WHERE user_id = $1`, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrSuspensionNotFound
+		}
+		return nil, err
+	}
+	return suspension, nil
+}
+
+// FindActive returns every suspension in effect at the given time
+func (r *PostgresSuspensionRepository) FindActive(ctx context.Context, now time.Time) ([]*domain.Suspension, error) {
+	query := `
+		SELECT ` + suspensionColumns + ` FROM account_suspensions
+		WHERE lifted_at IS NULL AND (until IS NULL OR until > $1)
+	`
+	rows, err := r.pool.Query(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var suspensions []*domain.Suspension
+	for rows.Next() {
+		suspension, err := scanSuspension(rows)
+		if err != nil {
+			return nil, err
+		}
+		suspensions = append(suspensions, suspension)
+	}
+	return suspensions, rows.Err()
+}
+
+// Save stores a suspension, replacing the user's previous one
+func (r *PostgresSuspensionRepository) Save(ctx context.Context, suspension *domain.Suspension) error {
+	return saveSuspension(ctx, r.pool, suspension)
+}
+
+// execer is satisfied by both the pool and a transaction
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
+func saveSuspension(ctx context.Context, db execer, suspension *domain.Suspension) error {
+	query := `
+		INSERT INTO account_suspensions (` + suspensionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id) DO UPDATE SET
+			action_id = EXCLUDED.action_id,
+			reason = EXCLUDED.reason,
+			suspended_at = EXCLUDED.suspended_at,
+			until = EXCLUDED.until,
+			lifted_at = EXCLUDED.lifted_at,
+			lifted_by = EXCLUDED.lifted_by
+	`
+	_, err := db.Exec(ctx, query,
+		suspension.UserID(),
+		nullString(suspension.ActionID()),
+		suspension.Reason(),
+		suspension.SuspendedAt(),
+		nullTime(suspension.Until()),
+		nullTime(suspension.LiftedAt()),
+		nullString(suspension.LiftedBy()),
+	)
+	return err
+}
+
+// scanSuspension reconstructs a suspension from a result row
+func scanSuspension(row pgx.Row) (*domain.Suspension, error) {
+	var userID, reason string
+	var actionID, liftedBy *string
+	var suspendedAt time.Time
+	var until, liftedAt *time.Time
+
+	if err := row.Scan(&userID, &actionID, &reason, &suspendedAt, &until, &liftedAt, &liftedBy); err != nil {
+		return nil, err
+	}
+	return domain.ReconstructSuspension(
+		userID,
+		stringValue(actionID),
+		reason,
+		suspendedAt,
+		timeValue(until),
+		timeValue(liftedAt),
+		stringValue(liftedBy),
+	), nil
+}
+
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,126 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/moderation/domain"
+)
+
+// ErrorMappings モデレーションドメインのエラーとHTTPレスポンスの対応
+func ErrorMappings() []problem.Mapping {
+	return []problem.Mapping{
+		{
+			Err: domain.ErrTargetNotFound, Status: http.StatusNotFound, Code: "report_target_not_found",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "通報の対象が見つかりません。",
+				problem.LanguageEnglish:  "The reported content or user was not found.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidTargetType, Status: http.StatusBadRequest, Code: "invalid_report_target",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "通報の対象は experience、comment、user のいずれかです。",
+				problem.LanguageEnglish:  "The target type must be experience, comment or user.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidReason, Status: http.StatusBadRequest, Code: "invalid_report_reason",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "通報の理由が正しくありません。",
+				problem.LanguageEnglish:  "The report reason is not valid.",
+			},
+		},
+		{
+			Err: domain.ErrReportDetailRequired, Status: http.StatusBadRequest, Code: "report_detail_required",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "「その他」の通報には理由の説明が必要です。",
+				problem.LanguageEnglish:  "Please describe the problem when reporting for another reason.",
+			},
+		},
+		{
+			Err: domain.ErrReportDetailTooLong, Status: http.StatusBadRequest, Code: "report_detail_too_long",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "通報の説明は1000文字以内で入力してください。",
+				problem.LanguageEnglish:  "The report detail must be at most 1000 characters.",
+			},
+		},
+		{
+			Err: domain.ErrCannotReportOwnContent, Status: http.StatusBadRequest, Code: "cannot_report_own_content",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "自分自身や自分の投稿は通報できません。",
+				problem.LanguageEnglish:  "You cannot report yourself or your own content.",
+			},
+		},
+		{
+			Err: domain.ErrAlreadyReported, Status: http.StatusConflict, Code: "already_reported",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "この対象はすでに通報済みです。",
+				problem.LanguageEnglish:  "You have already reported this.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidAction, Status: http.StatusBadRequest, Code: "invalid_moderation_action",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "対応は hide、warn、suspend、dismiss のいずれかです。",
+				problem.LanguageEnglish:  "The action must be hide, warn, suspend or dismiss.",
+			},
+		},
+		{
+			Err: domain.ErrActionNotApplicable, Status: http.StatusBadRequest, Code: "moderation_action_not_applicable",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "この対象にはその対応を取れません。",
+				problem.LanguageEnglish:  "The action cannot be taken on this target.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidSuspensionDuration, Status: http.StatusBadRequest, Code: "invalid_suspension_duration",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "停止日数はアカウント停止の場合のみ指定できます。",
+				problem.LanguageEnglish:  "Suspension days can only be given when suspending.",
+			},
+		},
+		{
+			Err: domain.ErrActionNoteTooLong, Status: http.StatusBadRequest, Code: "moderation_note_too_long",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "対応の理由は1000文字以内で入力してください。",
+				problem.LanguageEnglish:  "The note must be at most 1000 characters.",
+			},
+		},
+		{
+			Err: domain.ErrNoOpenReports, Status: http.StatusNotFound, Code: "no_open_reports",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "この対象に未対応の通報はありません。",
+				problem.LanguageEnglish:  "There are no open reports on this target.",
+			},
+		},
+		{
+			Err: domain.ErrReportAlreadyResolved, Status: http.StatusConflict, Code: "report_already_resolved",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "通報はすでに対応済みです。",
+				problem.LanguageEnglish:  "The report has already been resolved.",
+			},
+		},
+		{
+			Err: domain.ErrSuspensionNotFound, Status: http.StatusNotFound, Code: "suspension_not_found",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "停止中のアカウントではありません。",
+				problem.LanguageEnglish:  "The account is not suspended.",
+			},
+		},
+		{
+			Err: domain.ErrAccountSuspended, Status: http.StatusForbidden, Code: "account_suspended",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "このアカウントは利用停止中です。",
+				problem.LanguageEnglish:  "This account is suspended.",
+			},
+		},
+		{
+			Err: domain.ErrContentRejected, Status: http.StatusUnprocessableEntity, Code: "content_rejected",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "使用できない言葉が含まれているため保存できません。",
+				problem.LanguageEnglish:  "The text contains words that are not allowed.",
+			},
+		},
+	}
+}
@@ -0,0 +1,216 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/moderation/application/dto"
+	"zen-connect/internal/moderation/application/usecase"
+	"zen-connect/internal/shared/openapi"
+)
+
+// ModerationHandler 通報とモデレーション関連のHTTPハンドラー
+type ModerationHandler struct {
+	submitReportUseCase   *usecase.SubmitReportUseCase
+	queueUseCase          *usecase.ModerationQueueUseCase
+	takeActionUseCase     *usecase.TakeActionUseCase
+	liftSuspensionUseCase *usecase.LiftSuspensionUseCase
+}
+
+// NewModerationHandler コンストラクタ
+func NewModerationHandler(
+	submitReportUseCase *usecase.SubmitReportUseCase,
+	queueUseCase *usecase.ModerationQueueUseCase,
+	takeActionUseCase *usecase.TakeActionUseCase,
+	liftSuspensionUseCase *usecase.LiftSuspensionUseCase,
+) *ModerationHandler {
+	return &ModerationHandler{
+		submitReportUseCase:   submitReportUseCase,
+		queueUseCase:          queueUseCase,
+		takeActionUseCase:     takeActionUseCase,
+		liftSuspensionUseCase: liftSuspensionUseCase,
+	}
+}
+
+// SetupRoutes 通報とモデレーション関連のルーティング設定
+// idempotency は通報に適用するIdempotency-Keyミドルウェア、requireAdmin は管理者APIに適用する管理者チェックミドルウェア
+func (h *ModerationHandler) SetupRoutes(e *echo.Echo, sessionMiddleware *session.Middleware, idempotency, requireAdmin echo.MiddlewareFunc) {
+	e.POST("/reports", h.SubmitReport, sessionMiddleware.RequireAuth(), idempotency)
+
+	// モデレーションキュー（管理者のみ）
+	adminGroup := e.Group("/admin/moderation", sessionMiddleware.RequireAuth(), requireAdmin)
+	adminGroup.GET("/queue", h.ListQueue)
+	adminGroup.GET("/queue/:target_type/:target_id", h.GetCase)
+	adminGroup.POST("/actions", h.TakeAction)
+	adminGroup.DELETE("/suspensions/:user_id", h.LiftSuspension)
+}
+
+// Endpoints OpenAPIドキュメント用のエンドポイント定義
+func (h *ModerationHandler) Endpoints() []openapi.Endpoint {
+	adminTags := []string{"admin"}
+	security := []string{openapi.SecuritySession}
+	return []openapi.Endpoint{
+		{
+			Method: http.MethodPost, Path: "/reports", Tags: []string{"reports"},
+			Summary: "Report an experience, comment or user to the moderators",
+			Description: "reason is spam, harassment, hate_speech, sexual_content, self_harm, misinformation, impersonation or other (detail required). " +
+				"Only content you can see can be reported, and each target only once while your report is open.",
+			Security: security,
+			Headers:  []openapi.Parameter{openapi.IdempotencyKeyHeader},
+			Request:  dto.SubmitReportRequest{},
+			Responses: map[int]interface{}{
+				http.StatusCreated:      dto.ReportDTO{},
+				http.StatusBadRequest:   nil,
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+				http.StatusConflict:     nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/admin/moderation/queue", Tags: adminTags,
+			Summary:  "List reported targets with open reports, most reported first",
+			Security: security,
+			Query: []openapi.Parameter{
+				{Name: "limit", Description: "1-100, default 20", Schema: &openapi.Schema{Type: "integer"}},
+				{Name: "offset", Description: "next_offset of the previous page", Schema: &openapi.Schema{Type: "integer"}},
+			},
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.ModerationQueueResponse{},
+				http.StatusBadRequest:   nil,
+				http.StatusUnauthorized: nil,
+				http.StatusForbidden:    nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/admin/moderation/queue/:target_type/:target_id", Tags: adminTags,
+			Summary:     "Get the open reports on a target and the owner's moderation history",
+			Description: "target_type is experience, comment or user.",
+			Security:    security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.ModerationCaseResponse{},
+				http.StatusBadRequest:   nil,
+				http.StatusUnauthorized: nil,
+				http.StatusForbidden:    nil,
+				http.StatusNotFound:     nil,
+			},
+		},
+		{
+			Method: http.MethodPost, Path: "/admin/moderation/actions", Tags: adminTags,
+			Summary: "Act on a reported target and resolve its open reports",
+			Description: "hide hides an experience or comment from everyone but its author, warn warns the owner, " +
+				"suspend suspends the owner's account for suspend_days (indefinitely if omitted) and dismiss closes the reports.",
+			Security: security,
+			Request:  dto.TakeActionRequest{},
+			Responses: map[int]interface{}{
+				http.StatusCreated:      dto.ModerationActionDTO{},
+				http.StatusBadRequest:   nil,
+				http.StatusUnauthorized: nil,
+				http.StatusForbidden:    nil,
+				http.StatusNotFound:     nil,
+			},
+		},
+		{
+			Method: http.MethodDelete, Path: "/admin/moderation/suspensions/:user_id", Tags: adminTags,
+			Summary:  "Lift a suspension early",
+			Security: security,
+			Responses: map[int]interface{}{
+				http.StatusNoContent:    nil,
+				http.StatusUnauthorized: nil,
+				http.StatusForbidden:    nil,
+				http.StatusNotFound:     nil,
+			},
+		},
+	}
+}
+
+// SubmitReport 体験記録・コメント・ユーザーを通報
+func (h *ModerationHandler) SubmitReport(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.SubmitReportRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.ReporterID = userID
+
+	response, err := h.submitReportUseCase.Execute(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// ListQueue モデレーションキューを取得
+func (h *ModerationHandler) ListQueue(c echo.Context) error {
+	var req dto.ListQueueRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+
+	response, err := h.queueUseCase.List(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetCase 対象の未対応の通報を取得
+func (h *ModerationHandler) GetCase(c echo.Context) error {
+	response, err := h.queueUseCase.Case(c.Request().Context(), c.Param("target_type"), c.Param("target_id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// TakeAction 通報された対象に対応
+func (h *ModerationHandler) TakeAction(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.TakeActionRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.ModeratorID = userID
+
+	response, err := h.takeActionUseCase.Execute(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// LiftSuspension アカウント停止を解除
+func (h *ModerationHandler) LiftSuspension(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	if err := h.liftSuspensionUseCase.Execute(c.Request().Context(), c.Param("user_id"), userID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
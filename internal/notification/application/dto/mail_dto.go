@@ -0,0 +1,69 @@
+package dto
+
+import "time"
+
+// Recipient メールの宛先
+type Recipient struct {
+	UserID  string
+	Address string
+	Name    string
+	// Verified 確認済みのアドレスか（未確認のアドレスにはお知らせのメールを送らない）
+	Verified bool
+}
+
+// Mail テンプレートから作成して送信キューに入れるメール
+type Mail struct {
+	// DedupeKey 同じメールを二度送らないためのキー（再実行や複数インスタンスでの重複を防ぐ）
+	DedupeKey string
+	To        Recipient
+	// Template テンプレート名（welcome, email_confirmed, notification_digest, weekly_summary）
+	Template string
+	// Language 空ならサービスの既定の言語
+	Language string
+	Data     any
+	// UnsubscribeToken 配信停止リンクのトークン（アカウントに関わるメールでは空）
+	UnsubscribeToken string
+}
+
+// DigestItem ダイジェストメールに載せる通知
+type DigestItem struct {
+	Type string
+	// ActorName 通知のきっかけになったユーザーの表示名（モデレーターやシステムからの通知では空）
+	ActorName string
+	Data      map[string]string
+	CreatedAt time.Time
+}
+
+// DigestMailData 通知のダイジェストメールの内容
+type DigestMailData struct {
+	Items []DigestItem
+}
+
+// PracticeSummary 期間中のユーザーの瞑想の集計
+type PracticeSummary struct {
+	UserID         string
+	Sessions       int
+	TotalMinutes   int
+	LongestMinutes int
+	Days           int
+}
+
+// WeeklySummaryMailData 週間サマリーメールの内容
+type WeeklySummaryMailData struct {
+	// WeekStart WeekEnd 集計した週の月曜日と日曜日（2006-01-02 形式）
+	WeekStart string
+	WeekEnd   string
+	PracticeSummary
+}
+
+// UnsubscribeRequest メールの配信停止リクエスト
+// ワンクリックの配信停止（RFC 8058）ではトークンがクエリパラメータで送られる
+type UnsubscribeRequest struct {
+	Token string `json:"token" form:"token" validate:"required,max=512"`
+}
+
+// UnsubscribeResponse 配信を停止した通知の種類
+type UnsubscribeResponse struct {
+	Channel string   `json:"channel"`
+	Types   []string `json:"types"`
+}
@@ -0,0 +1,70 @@
+package dto
+
+import "zen-connect/internal/notification/domain"
+
+// FromNotification converts a notification to DTO
+func FromNotification(notification *domain.Notification) NotificationDTO {
+	response := NotificationDTO{
+		NotificationID: notification.ID(),
+		Type:           string(notification.Type()),
+		ActorID:        notification.ActorID(),
+		Data:           notification.Data(),
+		IsRead:         notification.IsRead(),
+		CreatedAt:      notification.CreatedAt(),
+	}
+	if notification.IsRead() {
+		readAt := notification.ReadAt()
+		response.ReadAt = &readAt
+	}
+	return response
+}
+
+// FromPreferences lists every type on every channel it is delivered on with the user's choice
+func FromPreferences(prefs *domain.Preferences, channels []domain.Channel) PreferencesResponse {
+	response := PreferencesResponse{
+		Preferences: []PreferenceDTO{},
+		Language:    prefs.Language(),
+	}
+	for _, kind := range domain.Types() {
+		for _, channel := range channels {
+			if !kind.SupportsChannel(channel) {
+				continue
+			}
+			response.Preferences = append(response.Preferences, PreferenceDTO{
+				Type:    string(kind),
+				Channel: string(channel),
+				Enabled: prefs.Allows(kind, channel),
+				Locked:  kind.IsMandatory(),
+			})
+		}
+	}
+	return response
+}
+
+// FromReminder converts a reminder to DTO
+func FromReminder(reminder *domain.Reminder) ReminderResponse {
+	response := ReminderResponse{
+		Time:     reminder.TimeOfDay(),
+		TimeZone: reminder.TimeZone(),
+		Enabled:  reminder.IsEnabled(),
+	}
+	if reminder.IsEnabled() {
+		nextAt := reminder.NextAt()
+		response.NextAt = &nextAt
+	}
+	return response
+}
+
+// FromPushSubscription converts a push subscription to DTO without its endpoint and keys
+func FromPushSubscription(subscription *domain.PushSubscription) PushSubscriptionDTO {
+	response := PushSubscriptionDTO{
+		SubscriptionID: subscription.ID(),
+		UserAgent:      subscription.UserAgent(),
+		CreatedAt:      subscription.CreatedAt(),
+	}
+	if !subscription.ExpiresAt().IsZero() {
+		expiresAt := subscription.ExpiresAt()
+		response.ExpiresAt = &expiresAt
+	}
+	return response
+}
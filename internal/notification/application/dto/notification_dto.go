@@ -0,0 +1,130 @@
+package dto
+
+import "time"
+
+// NotificationDTO 受信箱の通知
+type NotificationDTO struct {
+	NotificationID string `json:"notification_id"`
+	Type           string `json:"type"`
+	// ActorID 通知のきっかけになったユーザー（モデレーターやシステムからの通知では省略）
+	ActorID string `json:"actor_id,omitempty"`
+	// Data 通知の種類ごとの表示・リンク用の値（experience_id, comment_id など）
+	Data      map[string]string `json:"data"`
+	IsRead    bool              `json:"is_read"`
+	CreatedAt time.Time         `json:"created_at"`
+	ReadAt    *time.Time        `json:"read_at,omitempty"`
+}
+
+// ListNotificationsRequest 受信箱の取得条件（クエリパラメータ）
+type ListNotificationsRequest struct {
+	UserID     string `query:"-"`
+	UnreadOnly bool   `query:"unread"`
+	Limit      int    `query:"limit" validate:"omitempty,gte=1,lte=100"`
+	Offset     int    `query:"offset" validate:"gte=0"`
+}
+
+// ListNotificationsResponse 受信箱（新しい順）
+type ListNotificationsResponse struct {
+	Notifications []NotificationDTO `json:"notifications"`
+	UnreadCount   int               `json:"unread_count"`
+	// NextOffset 続きがある場合に次のページを取得する offset
+	NextOffset *int `json:"next_offset,omitempty"`
+}
+
+// MarkAllReadResponse すべて既読にした結果
+type MarkAllReadResponse struct {
+	Marked int `json:"marked"`
+}
+
+// PreferenceDTO 通知の種類と配信手段ごとの設定
+type PreferenceDTO struct {
+	Type    string `json:"type"`
+	Channel string `json:"channel"`
+	Enabled bool   `json:"enabled"`
+	// Locked アカウントに関わる通知は無効にできない
+	Locked bool `json:"locked"`
+}
+
+// PreferencesResponse 通知設定の一覧
+type PreferencesResponse struct {
+	Preferences []PreferenceDTO `json:"preferences"`
+	// Language メールの言語（未設定ならサービスの既定の言語）
+	Language string `json:"language,omitempty"`
+}
+
+// PreferenceUpdate 通知設定の変更
+type PreferenceUpdate struct {
+	Type    string `json:"type" validate:"required,max=50"`
+	Channel string `json:"channel" validate:"required,max=50"`
+	Enabled *bool  `json:"enabled" validate:"required"`
+}
+
+// UpdatePreferencesRequest 通知設定の変更リクエスト（指定しなかった設定はそのまま）
+type UpdatePreferencesRequest struct {
+	UserID      string             `json:"-"`
+	Preferences []PreferenceUpdate `json:"preferences" validate:"max=100,dive"`
+	Language    *string            `json:"language,omitempty" validate:"omitempty,oneof=ja en"`
+}
+
+// ReminderResponse 毎日の瞑想リマインダー
+type ReminderResponse struct {
+	// Time 通知する時刻（HH:MM、TimeZone の現地時刻）
+	Time     string `json:"time"`
+	TimeZone string `json:"time_zone"`
+	Enabled  bool   `json:"enabled"`
+	// NextAt 次に通知する日時（無効の場合は省略）
+	NextAt *time.Time `json:"next_at,omitempty"`
+}
+
+// SetReminderRequest リマインダーの設定リクエスト
+type SetReminderRequest struct {
+	UserID string `json:"-"`
+	Time   string `json:"time" validate:"required,max=5"`
+	// TimeZone IANAのタイムゾーン名（Asia/Tokyo など）
+	TimeZone string `json:"time_zone" validate:"required,max=64"`
+	// Enabled 省略時は有効
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// NotifyCommand ドメインイベントから通知を作成するコマンド
+type NotifyCommand struct {
+	Type string
+	// ActorID 通知のきっかけになったユーザー（本人には通知しない）
+	ActorID    string
+	Recipients []string
+	Data       map[string]string
+}
+
+// PushKeyResponse 購読時に applicationServerKey として渡す VAPID 公開鍵
+type PushKeyResponse struct {
+	PublicKey string `json:"public_key"`
+}
+
+// PushSubscriptionKeys 購読の暗号化鍵（base64url）
+type PushSubscriptionKeys struct {
+	P256dh string `json:"p256dh" validate:"required,max=128"`
+	Auth   string `json:"auth" validate:"required,max=64"`
+}
+
+// SubscribePushRequest ブラウザの PushSubscription.toJSON() の形の購読リクエスト
+type SubscribePushRequest struct {
+	UserID    string `json:"-"`
+	UserAgent string `json:"-"`
+	Endpoint  string `json:"endpoint" validate:"required,url,max=2048"`
+	// ExpirationTime 購読の有効期限（UNIX ミリ秒、期限がなければ null）
+	ExpirationTime *int64               `json:"expirationTime,omitempty"`
+	Keys           PushSubscriptionKeys `json:"keys"`
+}
+
+// PushSubscriptionDTO 購読しているブラウザ
+type PushSubscriptionDTO struct {
+	SubscriptionID string     `json:"subscription_id"`
+	UserAgent      string     `json:"user_agent,omitempty"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// ListPushSubscriptionsResponse 購読しているブラウザの一覧（古い順）
+type ListPushSubscriptionsResponse struct {
+	Subscriptions []PushSubscriptionDTO `json:"subscriptions"`
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"zen-connect/internal/notification/domain"
+)
+
+// Channel 通知の配信手段（アプリ内の受信箱、メール、プッシュ通知など）
+type Channel interface {
+	Name() domain.Channel
+	Deliver(ctx context.Context, notification *domain.Notification) error
+}
+
+// Dispatcher 通知をユーザーが有効にしている配信手段に届けるサービス
+type Dispatcher struct {
+	prefRepo domain.PreferenceRepository
+	channels []Channel
+}
+
+// NewDispatcher コンストラクタ
+// channels は登録順に配信される（受信箱を先頭にする）
+func NewDispatcher(prefRepo domain.PreferenceRepository, channels ...Channel) *Dispatcher {
+	return &Dispatcher{
+		prefRepo: prefRepo,
+		channels: channels,
+	}
+}
+
+// Register 配信手段を追加（起動時のみ呼ぶ）
+func (d *Dispatcher) Register(channel Channel) {
+	d.channels = append(d.channels, channel)
+}
+
+// Channels 登録されている配信手段
+func (d *Dispatcher) Channels() []domain.Channel {
+	names := make([]domain.Channel, 0, len(d.channels))
+	for _, channel := range d.channels {
+		names = append(names, channel.Name())
+	}
+	return names
+}
+
+// HasChannel 配信手段が登録されているか
+func (d *Dispatcher) HasChannel(name domain.Channel) bool {
+	for _, channel := range d.channels {
+		if channel.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Dispatch 通知を配信する
+// 一つの配信手段が失敗しても他の配信手段には届ける
+func (d *Dispatcher) Dispatch(ctx context.Context, notification *domain.Notification) error {
+	prefs, err := d.prefRepo.FindByUserID(ctx, notification.UserID())
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, channel := range d.channels {
+		if !prefs.Allows(notification.Type(), channel.Name()) {
+			continue
+		}
+		if err := channel.Deliver(ctx, notification); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", channel.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	"zen-connect/internal/notification/domain"
+)
+
+// EmailChannel 通知をメールのダイジェストにまとめる配信手段
+// 通知ごとには送らず、ダイジェストの送信まで貯めておく
+type EmailChannel struct {
+	digestRepo domain.DigestRepository
+}
+
+// NewEmailChannel コンストラクタ
+func NewEmailChannel(digestRepo domain.DigestRepository) *EmailChannel {
+	return &EmailChannel{
+		digestRepo: digestRepo,
+	}
+}
+
+// Name 配信手段の名前
+func (c *EmailChannel) Name() domain.Channel {
+	return domain.ChannelEmail
+}
+
+// Deliver 次のダイジェストに追加
+func (c *EmailChannel) Deliver(ctx context.Context, notification *domain.Notification) error {
+	return c.digestRepo.Add(ctx, notification)
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	"zen-connect/internal/notification/domain"
+)
+
+// InboxChannel アプリ内の受信箱に通知を保存する配信手段
+type InboxChannel struct {
+	repo domain.NotificationRepository
+}
+
+// NewInboxChannel コンストラクタ
+func NewInboxChannel(repo domain.NotificationRepository) *InboxChannel {
+	return &InboxChannel{
+		repo: repo,
+	}
+}
+
+// Name 配信手段の名前
+func (c *InboxChannel) Name() domain.Channel {
+	return domain.ChannelInApp
+}
+
+// Deliver 受信箱に保存
+func (c *InboxChannel) Deliver(ctx context.Context, notification *domain.Notification) error {
+	return c.repo.Save(ctx, notification)
+}
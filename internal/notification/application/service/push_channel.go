@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"zen-connect/internal/notification/application/dto"
+	"zen-connect/internal/notification/domain"
+)
+
+// PushSender 暗号化したメッセージをブラウザのプッシュサービスに送る
+type PushSender interface {
+	// Send 購読がブラウザ側で解除されている場合は domain.ErrPushSubscriptionGone を返す
+	Send(ctx context.Context, subscription *domain.PushSubscription, payload []byte) error
+}
+
+// PushChannel ユーザーが購読しているすべてのブラウザに Web Push で通知する配信手段
+// 本文は受信箱と同じ形の JSON で、表示は Service Worker に任せる
+type PushChannel struct {
+	subscriptionRepo domain.PushSubscriptionRepository
+	sender           PushSender
+}
+
+// NewPushChannel コンストラクタ
+func NewPushChannel(subscriptionRepo domain.PushSubscriptionRepository, sender PushSender) *PushChannel {
+	return &PushChannel{
+		subscriptionRepo: subscriptionRepo,
+		sender:           sender,
+	}
+}
+
+// Name 配信手段の名前
+func (c *PushChannel) Name() domain.Channel {
+	return domain.ChannelPush
+}
+
+// Deliver 購読ごとに送信
+// 解除されていた購読は削除し、一つの購読が失敗しても他の購読には届ける
+func (c *PushChannel) Deliver(ctx context.Context, notification *domain.Notification) error {
+	subscriptions, err := c.subscriptionRepo.FindByUserID(ctx, notification.UserID())
+	if err != nil || len(subscriptions) == 0 {
+		return err
+	}
+	payload, err := json.Marshal(dto.FromNotification(notification))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, subscription := range subscriptions {
+		err := c.sender.Send(ctx, subscription, payload)
+		if errors.Is(err, domain.ErrPushSubscriptionGone) {
+			err = c.subscriptionRepo.Delete(ctx, subscription.ID())
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("subscription %s: %w", subscription.ID(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
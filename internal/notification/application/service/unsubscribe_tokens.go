@@ -0,0 +1,69 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+
+	"zen-connect/internal/notification/domain"
+)
+
+// UnsubscribeTokens メールの配信停止リンクのトークンを発行・検証するサービス
+// トークンはユーザーIDと通知の種類（空ならメールのダイジェスト全体）を署名したもので、
+// メールを開いた時点でログインしていなくても使えるよう期限は設けない
+type UnsubscribeTokens struct {
+	secret []byte
+}
+
+// NewUnsubscribeTokens コンストラクタ
+func NewUnsubscribeTokens(secret string) *UnsubscribeTokens {
+	return &UnsubscribeTokens{
+		secret: []byte(secret),
+	}
+}
+
+// Issue 通知の種類のメールを停止するトークンを発行（kind が空ならダイジェスト全体）
+func (t *UnsubscribeTokens) Issue(userID string, kind domain.Type) string {
+	payload := []byte(userID + ":" + string(kind))
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(t.sign(payload))
+}
+
+// Parse トークンを検証し、ユーザーIDと通知の種類を返す
+// 秘密鍵が設定されていない（メールを送っていない）場合はどのトークンも受け付けない
+func (t *UnsubscribeTokens) Parse(token string) (string, domain.Type, error) {
+	if len(t.secret) == 0 {
+		return "", "", domain.ErrInvalidUnsubscribeToken
+	}
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return "", "", domain.ErrInvalidUnsubscribeToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return "", "", domain.ErrInvalidUnsubscribeToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, t.sign(payload)) {
+		return "", "", domain.ErrInvalidUnsubscribeToken
+	}
+
+	userID, kind, ok := strings.Cut(string(payload), ":")
+	if !ok || userID == "" {
+		return "", "", domain.ErrInvalidUnsubscribeToken
+	}
+	if kind == "" {
+		return userID, "", nil
+	}
+	parsed, err := domain.ParseType(kind)
+	if err != nil {
+		return "", "", domain.ErrInvalidUnsubscribeToken
+	}
+	return userID, parsed, nil
+}
+
+func (t *UnsubscribeTokens) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, t.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+
+	"zen-connect/internal/notification/application/dto"
+	"zen-connect/internal/notification/domain"
+)
+
+// AccountMailUseCase 登録やメールアドレスの確認を知らせるメールのユースケース
+// アカウントに関わるメールのため、配信停止の設定に関わらず送る
+type AccountMailUseCase struct {
+	prefRepo  domain.PreferenceRepository
+	directory RecipientDirectory
+	mailer    Mailer
+}
+
+// NewAccountMailUseCase コンストラクタ
+func NewAccountMailUseCase(prefRepo domain.PreferenceRepository, directory RecipientDirectory, mailer Mailer) *AccountMailUseCase {
+	return &AccountMailUseCase{
+		prefRepo:  prefRepo,
+		directory: directory,
+		mailer:    mailer,
+	}
+}
+
+// Welcome 登録したユーザーへのウェルカムメール
+func (uc *AccountMailUseCase) Welcome(ctx context.Context, userID string) error {
+	return uc.send(ctx, userID, "welcome")
+}
+
+// EmailConfirmed メールアドレスの確認が済んだことを知らせるメール
+func (uc *AccountMailUseCase) EmailConfirmed(ctx context.Context, userID string) error {
+	return uc.send(ctx, userID, "email_confirmed")
+}
+
+// send ユーザーごとに一度だけ送る
+func (uc *AccountMailUseCase) send(ctx context.Context, userID, template string) error {
+	recipient, err := uc.directory.FindRecipient(ctx, userID)
+	if err != nil || recipient == nil {
+		return err
+	}
+	prefs, err := uc.prefRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return uc.mailer.Send(ctx, &dto.Mail{
+		DedupeKey: template + ":" + userID,
+		To:        *recipient,
+		Template:  template,
+		Language:  prefs.Language(),
+	})
+}
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"zen-connect/internal/notification/application/dto"
+	"zen-connect/internal/notification/application/service"
+	"zen-connect/internal/notification/domain"
+)
+
+// digestBatchSize 一度に送るダイジェストの数
+const digestBatchSize = 100
+
+// DigestUseCase メールの配信手段に届いた通知をダイジェストにまとめて送るユースケース
+type DigestUseCase struct {
+	digestRepo domain.DigestRepository
+	prefRepo   domain.PreferenceRepository
+	directory  RecipientDirectory
+	mailer     Mailer
+	tokens     *service.UnsubscribeTokens
+	interval   time.Duration
+}
+
+// NewDigestUseCase コンストラクタ
+// interval ダイジェストの間隔（最も古い通知がこれより古くなったら送る）
+func NewDigestUseCase(
+	digestRepo domain.DigestRepository,
+	prefRepo domain.PreferenceRepository,
+	directory RecipientDirectory,
+	mailer Mailer,
+	tokens *service.UnsubscribeTokens,
+	interval time.Duration,
+) *DigestUseCase {
+	return &DigestUseCase{
+		digestRepo: digestRepo,
+		prefRepo:   prefRepo,
+		directory:  directory,
+		mailer:     mailer,
+		tokens:     tokens,
+		interval:   interval,
+	}
+}
+
+// SendDue 送る時期になったダイジェストを送信キューに入れ、送ったダイジェストの数を返す
+// 一人の失敗で他のユーザーのダイジェストは止めない
+func (uc *DigestUseCase) SendDue(ctx context.Context) (int, error) {
+	userIDs, err := uc.digestRepo.FindDueUserIDs(ctx, time.Now().Add(-uc.interval), digestBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	var errs []error
+	for _, userID := range userIDs {
+		ok, err := uc.send(ctx, userID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("digest for %s: %w", userID, err))
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, errors.Join(errs...)
+}
+
+// send ユーザーのダイジェストを送り、載せた通知を取り除く
+// 貯めた後にメールを止めた種類は載せず、確認済みのアドレスがなければ送らずに捨てる
+func (uc *DigestUseCase) send(ctx context.Context, userID string) (bool, error) {
+	notifications, err := uc.digestRepo.FindByUserID(ctx, userID)
+	if err != nil || len(notifications) == 0 {
+		return false, err
+	}
+	ids := make([]string, 0, len(notifications))
+	for _, notification := range notifications {
+		ids = append(ids, notification.ID())
+	}
+
+	prefs, err := uc.prefRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	recipient, err := uc.directory.FindRecipient(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	data := dto.DigestMailData{Items: []dto.DigestItem{}}
+	if recipient != nil && recipient.Verified {
+		actorNames := map[string]string{}
+		for _, notification := range notifications {
+			if !prefs.Allows(notification.Type(), domain.ChannelEmail) {
+				continue
+			}
+			item := dto.DigestItem{
+				Type:      string(notification.Type()),
+				Data:      notification.Data(),
+				CreatedAt: notification.CreatedAt(),
+			}
+			if actorID := notification.ActorID(); actorID != "" {
+				if _, ok := actorNames[actorID]; !ok {
+					actorNames[actorID] = uc.actorName(ctx, actorID)
+				}
+				item.ActorName = actorNames[actorID]
+			}
+			data.Items = append(data.Items, item)
+		}
+	}
+
+	if len(data.Items) > 0 {
+		err := uc.mailer.Send(ctx, &dto.Mail{
+			// 最後の通知までのダイジェストとして一度だけ送る
+			DedupeKey:        "notification_digest:" + userID + ":" + ids[len(ids)-1],
+			To:               *recipient,
+			Template:         "notification_digest",
+			Language:         prefs.Language(),
+			Data:             data,
+			UnsubscribeToken: uc.tokens.Issue(userID, ""),
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+	if err := uc.digestRepo.Remove(ctx, userID, ids); err != nil {
+		return false, err
+	}
+	return len(data.Items) > 0, nil
+}
+
+// actorName 通知のきっかけになったユーザーの表示名（見つからなければ空）
+func (uc *DigestUseCase) actorName(ctx context.Context, actorID string) string {
+	actor, err := uc.directory.FindRecipient(ctx, actorID)
+	if err != nil || actor == nil {
+		return ""
+	}
+	return actor.Name
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/notification/application/dto"
+	"zen-connect/internal/notification/domain"
+)
+
+// defaultInboxLimit 件数を指定しない場合に返す通知の件数
+const defaultInboxLimit = 20
+
+// InboxUseCase 受信箱の参照と既読のユースケース
+type InboxUseCase struct {
+	repo domain.NotificationRepository
+}
+
+// NewInboxUseCase コンストラクタ
+func NewInboxUseCase(repo domain.NotificationRepository) *InboxUseCase {
+	return &InboxUseCase{
+		repo: repo,
+	}
+}
+
+// List 自分の通知を新しい順に取得
+func (uc *InboxUseCase) List(ctx context.Context, req *dto.ListNotificationsRequest) (*dto.ListNotificationsResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultInboxLimit
+	}
+	notifications, err := uc.repo.FindByUserID(ctx, req.UserID, req.UnreadOnly, limit+1, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	unread, err := uc.repo.CountUnread(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListNotificationsResponse{
+		Notifications: []dto.NotificationDTO{},
+		UnreadCount:   unread,
+	}
+	if len(notifications) > limit {
+		notifications = notifications[:limit]
+		nextOffset := req.Offset + limit
+		response.NextOffset = &nextOffset
+	}
+	for _, notification := range notifications {
+		response.Notifications = append(response.Notifications, dto.FromNotification(notification))
+	}
+	return response, nil
+}
+
+// MarkRead 自分の通知を既読にする
+func (uc *InboxUseCase) MarkRead(ctx context.Context, notificationID, userID string) (*dto.NotificationDTO, error) {
+	notification, err := uc.repo.FindByID(ctx, notificationID)
+	if err != nil {
+		return nil, err
+	}
+	if !notification.BelongsTo(userID) {
+		return nil, domain.ErrNotificationNotFound
+	}
+
+	if !notification.IsRead() {
+		notification.MarkRead(time.Now())
+		if err := uc.repo.Save(ctx, notification); err != nil {
+			return nil, err
+		}
+	}
+
+	response := dto.FromNotification(notification)
+	return &response, nil
+}
+
+// MarkAllRead 自分の未読の通知をすべて既読にする
+func (uc *InboxUseCase) MarkAllRead(ctx context.Context, userID string) (*dto.MarkAllReadResponse, error) {
+	marked, err := uc.repo.MarkAllRead(ctx, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &dto.MarkAllReadResponse{Marked: marked}, nil
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"zen-connect/internal/notification/application/dto"
+	"zen-connect/internal/notification/application/service"
+	"zen-connect/internal/notification/domain"
+)
+
+// NotifyUseCase ドメインイベントをきっかけに通知を作成して配信するユースケース
+type NotifyUseCase struct {
+	dispatcher *service.Dispatcher
+}
+
+// NewNotifyUseCase コンストラクタ
+func NewNotifyUseCase(dispatcher *service.Dispatcher) *NotifyUseCase {
+	return &NotifyUseCase{
+		dispatcher: dispatcher,
+	}
+}
+
+// Execute 受信者ごとに通知を作成して配信
+// きっかけになった本人と重複した受信者には通知しない
+func (uc *NotifyUseCase) Execute(ctx context.Context, cmd *dto.NotifyCommand) error {
+	kind, err := domain.ParseType(cmd.Type)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	notified := map[string]bool{}
+	var errs []error
+	for _, recipient := range cmd.Recipients {
+		if recipient == "" || recipient == cmd.ActorID || notified[recipient] {
+			continue
+		}
+		notified[recipient] = true
+
+		notification := domain.NewNotification(recipient, kind, cmd.ActorID, cmd.Data, now)
+		if err := uc.dispatcher.Dispatch(ctx, notification); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
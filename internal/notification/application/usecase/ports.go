@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/notification/application/dto"
+)
+
+// Mailer メールをテンプレートから作成して送信キューに入れる（送信は非同期で、失敗すると再試行される）
+type Mailer interface {
+	Send(ctx context.Context, mail *dto.Mail) error
+}
+
+// RecipientDirectory ユーザーのメールアドレスと表示名をユーザーのコンテキストから引く
+type RecipientDirectory interface {
+	// FindRecipient 退会などでユーザーが見つからない場合は nil を返す
+	FindRecipient(ctx context.Context, userID string) (*dto.Recipient, error)
+}
+
+// PracticeSummaries 瞑想の実践状況を体験記録のコンテキストから集計する
+type PracticeSummaries interface {
+	// Summarize 期間 [from, to) に瞑想したユーザーごとの集計（日数は location の暦日で数える）
+	Summarize(ctx context.Context, from, to time.Time, location *time.Location) ([]dto.PracticeSummary, error)
+	// HasPracticed 期間 [from, to) にユーザーが瞑想したか
+	HasPracticed(ctx context.Context, userID string, from, to time.Time) (bool, error)
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"zen-connect/internal/notification/application/dto"
+	"zen-connect/internal/notification/application/service"
+	"zen-connect/internal/notification/domain"
+)
+
+// PreferencesUseCase 通知設定のユースケース
+type PreferencesUseCase struct {
+	prefRepo   domain.PreferenceRepository
+	dispatcher *service.Dispatcher
+}
+
+// NewPreferencesUseCase コンストラクタ
+func NewPreferencesUseCase(prefRepo domain.PreferenceRepository, dispatcher *service.Dispatcher) *PreferencesUseCase {
+	return &PreferencesUseCase{
+		prefRepo:   prefRepo,
+		dispatcher: dispatcher,
+	}
+}
+
+// Get 通知の種類と配信手段ごとの設定を取得
+func (uc *PreferencesUseCase) Get(ctx context.Context, userID string) (*dto.PreferencesResponse, error) {
+	prefs, err := uc.prefRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	response := dto.FromPreferences(prefs, uc.dispatcher.Channels())
+	return &response, nil
+}
+
+// Update 通知の種類ごとに配信手段を有効・無効にし、メールの言語を変える（すべて反映できる場合のみ保存）
+func (uc *PreferencesUseCase) Update(ctx context.Context, req *dto.UpdatePreferencesRequest) (*dto.PreferencesResponse, error) {
+	prefs, err := uc.prefRepo.FindByUserID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, update := range req.Preferences {
+		kind, err := domain.ParseType(update.Type)
+		if err != nil {
+			return nil, err
+		}
+		channel := domain.Channel(update.Channel)
+		if !uc.dispatcher.HasChannel(channel) {
+			return nil, domain.ErrUnknownChannel
+		}
+		if err := prefs.Set(kind, channel, *update.Enabled); err != nil {
+			return nil, err
+		}
+	}
+	if req.Language != nil {
+		prefs.SetLanguage(*req.Language)
+	}
+	if err := uc.prefRepo.Save(ctx, prefs); err != nil {
+		return nil, err
+	}
+
+	response := dto.FromPreferences(prefs, uc.dispatcher.Channels())
+	return &response, nil
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/notification/application/dto"
+	"zen-connect/internal/notification/domain"
+)
+
+// maxUserAgentLength 保存する User-Agent の長さ
+const maxUserAgentLength = 255
+
+// PushSubscriptionUseCase Web Push の購読のユースケース
+type PushSubscriptionUseCase struct {
+	subscriptionRepo domain.PushSubscriptionRepository
+	// publicKey VAPID 公開鍵（プッシュ通知が無効なら空）
+	publicKey string
+}
+
+// NewPushSubscriptionUseCase コンストラクタ
+func NewPushSubscriptionUseCase(subscriptionRepo domain.PushSubscriptionRepository, publicKey string) *PushSubscriptionUseCase {
+	return &PushSubscriptionUseCase{
+		subscriptionRepo: subscriptionRepo,
+		publicKey:        publicKey,
+	}
+}
+
+// PublicKey ブラウザで購読するための VAPID 公開鍵
+func (uc *PushSubscriptionUseCase) PublicKey() (*dto.PushKeyResponse, error) {
+	if uc.publicKey == "" {
+		return nil, domain.ErrPushNotConfigured
+	}
+	return &dto.PushKeyResponse{PublicKey: uc.publicKey}, nil
+}
+
+// Subscribe ブラウザの購読を登録
+// 同じブラウザが購読し直した場合（同じ endpoint）は置き換える
+func (uc *PushSubscriptionUseCase) Subscribe(ctx context.Context, req *dto.SubscribePushRequest) (*dto.PushSubscriptionDTO, error) {
+	if uc.publicKey == "" {
+		return nil, domain.ErrPushNotConfigured
+	}
+	var expiresAt time.Time
+	if req.ExpirationTime != nil {
+		expiresAt = time.UnixMilli(*req.ExpirationTime)
+	}
+	userAgent := req.UserAgent
+	if runes := []rune(userAgent); len(runes) > maxUserAgentLength {
+		userAgent = string(runes[:maxUserAgentLength])
+	}
+	subscription, err := domain.NewPushSubscription(req.UserID, req.Endpoint, req.Keys.P256dh, req.Keys.Auth, userAgent, expiresAt, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.subscriptionRepo.FindByUserID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= domain.MaxPushSubscriptions && !hasEndpoint(existing, req.Endpoint) {
+		return nil, domain.ErrTooManyPushSubscriptions
+	}
+	if err := uc.subscriptionRepo.Save(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	response := dto.FromPushSubscription(subscription)
+	return &response, nil
+}
+
+// List 自分の購読の一覧
+func (uc *PushSubscriptionUseCase) List(ctx context.Context, userID string) (*dto.ListPushSubscriptionsResponse, error) {
+	subscriptions, err := uc.subscriptionRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	response := &dto.ListPushSubscriptionsResponse{
+		Subscriptions: make([]dto.PushSubscriptionDTO, 0, len(subscriptions)),
+	}
+	for _, subscription := range subscriptions {
+		response.Subscriptions = append(response.Subscriptions, dto.FromPushSubscription(subscription))
+	}
+	return response, nil
+}
+
+// Unsubscribe 自分の購読を削除（他人の購読は見つからない扱い）
+func (uc *PushSubscriptionUseCase) Unsubscribe(ctx context.Context, userID, subscriptionID string) error {
+	subscription, err := uc.subscriptionRepo.FindByID(ctx, subscriptionID)
+	if err != nil {
+		return err
+	}
+	if !subscription.BelongsTo(userID) {
+		return domain.ErrPushSubscriptionNotFound
+	}
+	return uc.subscriptionRepo.Delete(ctx, subscription.ID())
+}
+
+// PruneExpired 有効期限の過ぎた購読を削除し、削除した数を返す
+func (uc *PushSubscriptionUseCase) PruneExpired(ctx context.Context) (int, error) {
+	return uc.subscriptionRepo.DeleteExpired(ctx, time.Now())
+}
+
+func hasEndpoint(subscriptions []*domain.PushSubscription, endpoint string) bool {
+	for _, subscription := range subscriptions {
+		if subscription.Endpoint() == endpoint {
+			return true
+		}
+	}
+	return false
+}
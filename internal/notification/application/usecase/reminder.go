@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"zen-connect/internal/notification/application/dto"
+	"zen-connect/internal/notification/domain"
+)
+
+// reminderBatchSize 一度に読み込むリマインダーの数
+const reminderBatchSize = 500
+
+// ReminderUseCase 毎日の瞑想リマインダーの設定のユースケース
+type ReminderUseCase struct {
+	reminderRepo domain.ReminderRepository
+}
+
+// NewReminderUseCase コンストラクタ
+func NewReminderUseCase(reminderRepo domain.ReminderRepository) *ReminderUseCase {
+	return &ReminderUseCase{
+		reminderRepo: reminderRepo,
+	}
+}
+
+// Get 自分のリマインダーを取得
+func (uc *ReminderUseCase) Get(ctx context.Context, userID string) (*dto.ReminderResponse, error) {
+	reminder, err := uc.reminderRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	response := dto.FromReminder(reminder)
+	return &response, nil
+}
+
+// Set リマインダーの時刻とタイムゾーンを設定（なければ作成）
+func (uc *ReminderUseCase) Set(ctx context.Context, req *dto.SetReminderRequest) (*dto.ReminderResponse, error) {
+	enabled := req.Enabled == nil || *req.Enabled
+	now := time.Now()
+
+	reminder, err := uc.reminderRepo.FindByUserID(ctx, req.UserID)
+	switch {
+	case errors.Is(err, domain.ErrReminderNotFound):
+		reminder, err = domain.NewReminder(req.UserID, req.Time, req.TimeZone, enabled, now)
+	case err == nil:
+		err = reminder.Change(req.Time, req.TimeZone, enabled, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.reminderRepo.Save(ctx, reminder); err != nil {
+		return nil, err
+	}
+
+	response := dto.FromReminder(reminder)
+	return &response, nil
+}
+
+// Delete リマインダーを削除
+func (uc *ReminderUseCase) Delete(ctx context.Context, userID string) error {
+	return uc.reminderRepo.Delete(ctx, userID)
+}
+
+// SendRemindersUseCase 時刻になったリマインダーを通知するユースケース（スケジューラーから定期的に実行）
+type SendRemindersUseCase struct {
+	reminderRepo domain.ReminderRepository
+	practice     PracticeSummaries
+	notify       *NotifyUseCase
+}
+
+// NewSendRemindersUseCase コンストラクタ
+func NewSendRemindersUseCase(reminderRepo domain.ReminderRepository, practice PracticeSummaries, notify *NotifyUseCase) *SendRemindersUseCase {
+	return &SendRemindersUseCase{
+		reminderRepo: reminderRepo,
+		practice:     practice,
+		notify:       notify,
+	}
+}
+
+// Execute 時刻になったリマインダーを通知し、通知した数を返す
+// 通知してから次の時刻に進めるため、途中で止まった場合は同じ日のリマインダーがもう一度届くことがある
+func (uc *SendRemindersUseCase) Execute(ctx context.Context) (int, error) {
+	sent := 0
+	for {
+		now := time.Now()
+		reminders, err := uc.reminderRepo.FindDue(ctx, now, reminderBatchSize)
+		if err != nil {
+			return sent, err
+		}
+
+		var errs []error
+		for _, reminder := range reminders {
+			ok, err := uc.remind(ctx, reminder, now)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("reminder for %s: %w", reminder.UserID(), err))
+				continue
+			}
+			if ok {
+				sent++
+			}
+		}
+		// 失敗したリマインダーは次の実行で送り直す
+		if len(errs) > 0 || len(reminders) < reminderBatchSize {
+			return sent, errors.Join(errs...)
+		}
+	}
+}
+
+// remind その日にまだ瞑想していなければ通知して、次の日に進める
+// サーバーが止まっていたなどで日付が変わってしまったリマインダーは送らない
+func (uc *SendRemindersUseCase) remind(ctx context.Context, reminder *domain.Reminder, now time.Time) (bool, error) {
+	dayStart, dayEnd := reminder.Day()
+	send := now.Before(dayEnd)
+	if send {
+		practiced, err := uc.practice.HasPracticed(ctx, reminder.UserID(), dayStart, dayEnd)
+		if err != nil {
+			return false, err
+		}
+		send = !practiced
+	}
+	if send {
+		err := uc.notify.Execute(ctx, &dto.NotifyCommand{
+			Type:       string(domain.TypeMeditationReminder),
+			Recipients: []string{reminder.UserID()},
+			Data: map[string]string{
+				"date": dayStart.Format("2006-01-02"),
+				"time": reminder.TimeOfDay(),
+			},
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	reminder.Advance(now)
+	if err := uc.reminderRepo.Save(ctx, reminder); err != nil {
+		return false, err
+	}
+	return send, nil
+}
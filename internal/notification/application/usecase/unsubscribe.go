@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+
+	"zen-connect/internal/notification/application/dto"
+	"zen-connect/internal/notification/application/service"
+	"zen-connect/internal/notification/domain"
+)
+
+// UnsubscribeUseCase メールの配信停止リンクのユースケース（ログインせずに使える）
+type UnsubscribeUseCase struct {
+	prefRepo domain.PreferenceRepository
+	tokens   *service.UnsubscribeTokens
+}
+
+// NewUnsubscribeUseCase コンストラクタ
+func NewUnsubscribeUseCase(prefRepo domain.PreferenceRepository, tokens *service.UnsubscribeTokens) *UnsubscribeUseCase {
+	return &UnsubscribeUseCase{
+		prefRepo: prefRepo,
+		tokens:   tokens,
+	}
+}
+
+// Execute トークンの種類のメールを止める（種類のないトークンはダイジェスト全体）
+// 同じリンクを何度開いても結果は同じ
+func (uc *UnsubscribeUseCase) Execute(ctx context.Context, req *dto.UnsubscribeRequest) (*dto.UnsubscribeResponse, error) {
+	userID, kind, err := uc.tokens.Parse(req.Token)
+	if err != nil {
+		return nil, err
+	}
+	prefs, err := uc.prefRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var disabled []domain.Type
+	if kind == "" {
+		disabled = prefs.DisableChannel(domain.ChannelEmail)
+	} else {
+		if err := prefs.Set(kind, domain.ChannelEmail, false); err != nil {
+			return nil, err
+		}
+		disabled = []domain.Type{kind}
+	}
+	if err := uc.prefRepo.Save(ctx, prefs); err != nil {
+		return nil, err
+	}
+
+	response := &dto.UnsubscribeResponse{
+		Channel: string(domain.ChannelEmail),
+		Types:   make([]string, 0, len(disabled)),
+	}
+	for _, kind := range disabled {
+		response.Types = append(response.Types, string(kind))
+	}
+	return response, nil
+}
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"zen-connect/internal/notification/application/dto"
+	"zen-connect/internal/notification/application/service"
+	"zen-connect/internal/notification/domain"
+)
+
+// WeeklySummaryUseCase 前の週（location の月曜日から日曜日）に瞑想したユーザーへの週間サマリーのユースケース
+type WeeklySummaryUseCase struct {
+	practice  PracticeSummaries
+	prefRepo  domain.PreferenceRepository
+	directory RecipientDirectory
+	mailer    Mailer
+	tokens    *service.UnsubscribeTokens
+	location  *time.Location
+
+	mu sync.Mutex
+	// sentWeek このプロセスで送り終えた週（同じ週の集計を繰り返さない）
+	sentWeek time.Time
+}
+
+// NewWeeklySummaryUseCase コンストラクタ
+func NewWeeklySummaryUseCase(
+	practice PracticeSummaries,
+	prefRepo domain.PreferenceRepository,
+	directory RecipientDirectory,
+	mailer Mailer,
+	tokens *service.UnsubscribeTokens,
+	location *time.Location,
+) *WeeklySummaryUseCase {
+	return &WeeklySummaryUseCase{
+		practice:  practice,
+		prefRepo:  prefRepo,
+		directory: directory,
+		mailer:    mailer,
+		tokens:    tokens,
+		location:  location,
+	}
+}
+
+// Execute 前の週のサマリーをまだ送っていなければ送信キューに入れ、送った数を返す
+// 何度実行しても（複数のインスタンスからでも）一人に一週一通
+func (uc *WeeklySummaryUseCase) Execute(ctx context.Context) (int, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	weekStart := StartOfWeek(time.Now(), uc.location).AddDate(0, 0, -7)
+	if uc.sentWeek.Equal(weekStart) {
+		return 0, nil
+	}
+	summaries, err := uc.practice.Summarize(ctx, weekStart, weekStart.AddDate(0, 0, 7), uc.location)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	var errs []error
+	for _, summary := range summaries {
+		ok, err := uc.send(ctx, weekStart, summary)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("weekly summary for %s: %w", summary.UserID, err))
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+	// 失敗したユーザーは次の実行で送り直す（送れたユーザーには重複しない）
+	if len(errs) == 0 {
+		uc.sentWeek = weekStart
+	}
+	return sent, errors.Join(errs...)
+}
+
+// send 週間サマリーを止めていない、確認済みのアドレスを持つユーザーに送る
+func (uc *WeeklySummaryUseCase) send(ctx context.Context, weekStart time.Time, summary dto.PracticeSummary) (bool, error) {
+	prefs, err := uc.prefRepo.FindByUserID(ctx, summary.UserID)
+	if err != nil {
+		return false, err
+	}
+	if !prefs.Allows(domain.TypeWeeklySummary, domain.ChannelEmail) {
+		return false, nil
+	}
+	recipient, err := uc.directory.FindRecipient(ctx, summary.UserID)
+	if err != nil || recipient == nil || !recipient.Verified {
+		return false, err
+	}
+
+	week := weekStart.Format("2006-01-02")
+	err = uc.mailer.Send(ctx, &dto.Mail{
+		DedupeKey: "weekly_summary:" + summary.UserID + ":" + week,
+		To:        *recipient,
+		Template:  "weekly_summary",
+		Language:  prefs.Language(),
+		Data: dto.WeeklySummaryMailData{
+			WeekStart:       week,
+			WeekEnd:         weekStart.AddDate(0, 0, 6).Format("2006-01-02"),
+			PracticeSummary: summary,
+		},
+		UnsubscribeToken: uc.tokens.Issue(summary.UserID, domain.TypeWeeklySummary),
+	})
+	return err == nil, err
+}
+
+// StartOfWeek t を含む週の月曜日 0:00（location の時刻）
+func StartOfWeek(t time.Time, location *time.Location) time.Time {
+	t = t.In(location)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, location)
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
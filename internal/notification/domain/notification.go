@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Type is what a notification is about; preferences are kept per type
+type Type string
+
+const (
+	// TypeReactionReceived someone reacted to the user's experience
+	TypeReactionReceived Type = "reaction_received"
+	// TypeCommentReceived someone commented on the user's experience
+	TypeCommentReceived Type = "comment_received"
+	// TypeCommentReplied someone replied to the user's comment
+	TypeCommentReplied Type = "comment_replied"
+	// TypeReportSubmitted a user reported something (moderators only)
+	TypeReportSubmitted Type = "report_submitted"
+	// TypeModerationAction a moderator hid the user's content, warned or suspended the user
+	TypeModerationAction Type = "moderation_action"
+	// TypeSuspensionLifted a moderator lifted the user's suspension
+	TypeSuspensionLifted Type = "suspension_lifted"
+	// TypeWeeklySummary the user's practice in the past week (email only)
+	TypeWeeklySummary Type = "weekly_summary"
+	// TypeMeditationReminder the user's daily reminder to meditate (not by email)
+	TypeMeditationReminder Type = "meditation_reminder"
+)
+
+// Domain errors for Notification
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+	ErrInvalidType          = errors.New("invalid notification type")
+)
+
+// Types returns every notification type in display order
+func Types() []Type {
+	return []Type{
+		TypeReactionReceived,
+		TypeCommentReceived,
+		TypeCommentReplied,
+		TypeReportSubmitted,
+		TypeModerationAction,
+		TypeSuspensionLifted,
+		TypeWeeklySummary,
+		TypeMeditationReminder,
+	}
+}
+
+// ParseType validates a notification type
+func ParseType(s string) (Type, error) {
+	for _, t := range Types() {
+		if string(t) == s {
+			return t, nil
+		}
+	}
+	return "", ErrInvalidType
+}
+
+// IsMandatory reports whether the user must be told regardless of preferences.
+// Moderation decisions concern the account itself, so they cannot be turned off.
+func (t Type) IsMandatory() bool {
+	return t == TypeModerationAction || t == TypeSuspensionLifted
+}
+
+// SupportsChannel reports whether notifications of the type are delivered on the channel
+func (t Type) SupportsChannel(channel Channel) bool {
+	switch t {
+	case TypeWeeklySummary:
+		return channel == ChannelEmail
+	case TypeMeditationReminder:
+		// A reminder in an email digest would arrive too late to be useful
+		return channel != ChannelEmail
+	}
+	return true
+}
+
+// Notification is a message in a user's inbox (aggregate root)
+type Notification struct {
+	id        string
+	userID    string
+	kind      Type
+	actorID   string
+	data      map[string]string
+	createdAt time.Time
+	readAt    time.Time
+}
+
+// NewNotification creates an unread notification.
+// actorID is the user who caused it, empty for the system or moderators;
+// data holds the IDs and values clients need to render and link it.
+func NewNotification(userID string, kind Type, actorID string, data map[string]string, now time.Time) *Notification {
+	if data == nil {
+		data = map[string]string{}
+	}
+	return &Notification{
+		id:        uuid.New().String(),
+		userID:    userID,
+		kind:      kind,
+		actorID:   actorID,
+		data:      data,
+		createdAt: now,
+	}
+}
+
+// ReconstructNotification recreates a notification from persisted data
+func ReconstructNotification(id, userID string, kind Type, actorID string, data map[string]string, createdAt, readAt time.Time) *Notification {
+	return &Notification{
+		id:        id,
+		userID:    userID,
+		kind:      kind,
+		actorID:   actorID,
+		data:      data,
+		createdAt: createdAt,
+		readAt:    readAt,
+	}
+}
+
+func (n *Notification) ID() string              { return n.id }
+func (n *Notification) UserID() string          { return n.userID }
+func (n *Notification) Type() Type              { return n.kind }
+func (n *Notification) ActorID() string         { return n.actorID }
+func (n *Notification) Data() map[string]string { return n.data }
+func (n *Notification) CreatedAt() time.Time    { return n.createdAt }
+func (n *Notification) ReadAt() time.Time       { return n.readAt }
+
+// IsRead reports whether the user has read the notification
+func (n *Notification) IsRead() bool {
+	return !n.readAt.IsZero()
+}
+
+// BelongsTo checks if the notification is in the user's inbox
+func (n *Notification) BelongsTo(userID string) bool {
+	return n.userID == userID
+}
+
+// MarkRead marks the notification read; marking it again keeps the first time
+func (n *Notification) MarkRead(now time.Time) {
+	if !n.IsRead() {
+		n.readAt = now
+	}
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNotification_MarkReadShouldKeepFirstReadTime(t *testing.T) {
+	// given
+	now := time.Now()
+	notification := NewNotification("user", TypeCommentReceived, "guest", nil, now)
+
+	// when
+	notification.MarkRead(now.Add(time.Minute))
+	notification.MarkRead(now.Add(time.Hour))
+
+	// then
+	if !notification.IsRead() || !notification.ReadAt().Equal(now.Add(time.Minute)) {
+		t.Errorf("Expected read at the first time, got %v", notification.ReadAt())
+	}
+	if notification.Data() == nil {
+		t.Error("Expected empty data instead of nil")
+	}
+}
+
+func TestPreferences_ShouldDefaultToOnAndLockAccountNotifications(t *testing.T) {
+	// given
+	prefs := NewPreferences("user")
+
+	// when
+	offErr := prefs.Set(TypeReactionReceived, ChannelInApp, false)
+	lockedErr := prefs.Set(TypeModerationAction, ChannelInApp, false)
+
+	// then
+	if offErr != nil || prefs.Allows(TypeReactionReceived, ChannelInApp) {
+		t.Errorf("Expected reactions turned off, got %v", offErr)
+	}
+	if !prefs.Allows(TypeCommentReceived, ChannelInApp) || !prefs.Allows(TypeReactionReceived, "email") {
+		t.Error("Expected other types and channels to stay on")
+	}
+	if !errors.Is(lockedErr, ErrPreferenceLocked) || !prefs.Allows(TypeModerationAction, ChannelInApp) {
+		t.Errorf("Expected ErrPreferenceLocked, got %v", lockedErr)
+	}
+}
+
+func TestReconstructPreferences_ShouldRoundTripDisabledChannels(t *testing.T) {
+	// given
+	prefs := NewPreferences("user")
+	_ = prefs.Set(TypeCommentReplied, ChannelInApp, false)
+	_ = prefs.Set(TypeCommentReplied, "email", false)
+	_ = prefs.Set(TypeCommentReplied, "email", true)
+
+	// when
+	restored := ReconstructPreferences("user", "en", prefs.Disabled())
+
+	// then
+	if restored.Allows(TypeCommentReplied, ChannelInApp) || !restored.Allows(TypeCommentReplied, "email") {
+		t.Errorf("Expected only in-app replies off, got %v", restored.Disabled())
+	}
+}
+
+func TestPushSubscription_ShouldValidateBrowserSubscription(t *testing.T) {
+	// given
+	now := time.Now()
+	p256dh := "BNcRdreALRFXTkOOUHK1EtK2wtaz5Ry4YfYCA_0QTpQtUbVlUls0VJXg7A8u-Ts1XbjhazAkj7I99e8QcYP7DkM"
+	auth := "tBHItJI5svbpez7KI4CCXg"
+
+	// when
+	valid, validErr := NewPushSubscription("user", "https://push.example.com/send/abc", p256dh, auth, "Firefox", time.Time{}, now)
+	_, insecureErr := NewPushSubscription("user", "http://push.example.com/send/abc", p256dh, auth, "", time.Time{}, now)
+	_, keyErr := NewPushSubscription("user", "https://push.example.com/send/abc", auth, auth, "", time.Time{}, now)
+	_, expiredErr := NewPushSubscription("user", "https://push.example.com/send/abc", p256dh, auth, "", now.Add(-time.Minute), now)
+
+	// then
+	if validErr != nil || !valid.BelongsTo("user") {
+		t.Errorf("Expected a valid subscription, got %v", validErr)
+	}
+	for _, err := range []error{insecureErr, keyErr, expiredErr} {
+		if !errors.Is(err, ErrInvalidPushSubscription) {
+			t.Errorf("Expected ErrInvalidPushSubscription, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package domain
+
+import "errors"
+
+// Channel is a way of delivering notifications
+type Channel string
+
+const (
+	// ChannelInApp stores notifications in the user's inbox
+	ChannelInApp Channel = "in_app"
+	// ChannelEmail mails notifications to the user in a periodic digest
+	ChannelEmail Channel = "email"
+	// ChannelPush sends notifications to the user's browsers through Web Push
+	ChannelPush Channel = "push"
+)
+
+// Domain errors for Preferences
+var (
+	ErrUnknownChannel          = errors.New("unknown notification channel")
+	ErrChannelNotSupported     = errors.New("notification type is not delivered on the channel")
+	ErrPreferenceLocked        = errors.New("notification type cannot be turned off")
+	ErrInvalidUnsubscribeToken = errors.New("invalid unsubscribe token")
+)
+
+// Preferences are a user's choices of which notification types to receive on
+// which channels, and the language of emails. Everything is on until the
+// user turns it off.
+type Preferences struct {
+	userID   string
+	language string
+	disabled map[Type]map[Channel]bool
+}
+
+// NewPreferences creates the default preferences with everything on
+func NewPreferences(userID string) *Preferences {
+	return &Preferences{
+		userID:   userID,
+		disabled: map[Type]map[Channel]bool{},
+	}
+}
+
+// ReconstructPreferences recreates preferences from the email language and
+// the channels turned off for each type
+func ReconstructPreferences(userID, language string, disabled map[Type][]Channel) *Preferences {
+	prefs := NewPreferences(userID)
+	prefs.language = language
+	for kind, channels := range disabled {
+		for _, channel := range channels {
+			prefs.disable(kind, channel)
+		}
+	}
+	return prefs
+}
+
+func (p *Preferences) UserID() string { return p.userID }
+
+// Language returns the language of emails, empty for the service default
+func (p *Preferences) Language() string { return p.language }
+
+// Disabled returns the channels the user turned off for each type
+func (p *Preferences) Disabled() map[Type][]Channel {
+	disabled := map[Type][]Channel{}
+	for kind, channels := range p.disabled {
+		for channel := range channels {
+			disabled[kind] = append(disabled[kind], channel)
+		}
+	}
+	return disabled
+}
+
+// Allows reports whether the user wants notifications of the type on the channel
+func (p *Preferences) Allows(kind Type, channel Channel) bool {
+	if !kind.SupportsChannel(channel) {
+		return false
+	}
+	return kind.IsMandatory() || !p.disabled[kind][channel]
+}
+
+// Set turns a notification type on or off for a channel
+func (p *Preferences) Set(kind Type, channel Channel, enabled bool) error {
+	if !kind.SupportsChannel(channel) {
+		return ErrChannelNotSupported
+	}
+	if enabled {
+		delete(p.disabled[kind], channel)
+		return nil
+	}
+	if kind.IsMandatory() {
+		return ErrPreferenceLocked
+	}
+	p.disable(kind, channel)
+	return nil
+}
+
+// SetLanguage sets the language of emails
+func (p *Preferences) SetLanguage(language string) {
+	p.language = language
+}
+
+// DisableChannel turns off every type that can be turned off on the channel,
+// as unsubscribing from a digest does, and returns those types
+func (p *Preferences) DisableChannel(channel Channel) []Type {
+	var disabled []Type
+	for _, kind := range Types() {
+		if kind.IsMandatory() || !kind.SupportsChannel(channel) {
+			continue
+		}
+		p.disable(kind, channel)
+		disabled = append(disabled, kind)
+	}
+	return disabled
+}
+
+func (p *Preferences) disable(kind Type, channel Channel) {
+	if p.disabled[kind] == nil {
+		p.disabled[kind] = map[Channel]bool{}
+	}
+	p.disabled[kind][channel] = true
+}
@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxPushSubscriptions is how many browsers a user can receive push notifications on
+const MaxPushSubscriptions = 10
+
+// Domain errors for PushSubscription
+var (
+	ErrPushSubscriptionNotFound = errors.New("push subscription not found")
+	ErrInvalidPushSubscription  = errors.New("invalid push subscription")
+	ErrTooManyPushSubscriptions = errors.New("too many push subscriptions")
+	// ErrPushSubscriptionGone the push service no longer accepts messages for the subscription
+	ErrPushSubscriptionGone = errors.New("push subscription is gone")
+	ErrPushNotConfigured    = errors.New("push notifications are not configured")
+)
+
+// PushSubscription is a browser's Web Push subscription (aggregate root).
+// The endpoint is the push service URL messages are posted to; the keys
+// encrypt the messages for that browser only.
+type PushSubscription struct {
+	id        string
+	userID    string
+	endpoint  string
+	p256dh    string
+	auth      string
+	userAgent string
+	expiresAt time.Time
+	createdAt time.Time
+}
+
+// NewPushSubscription validates a subscription as the browser reported it.
+// expiresAt is zero when the browser gave no expiration time.
+func NewPushSubscription(userID, endpoint, p256dh, auth, userAgent string, expiresAt, now time.Time) (*PushSubscription, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		return nil, ErrInvalidPushSubscription
+	}
+	// p256dh is an uncompressed P-256 point and auth a 16-byte secret
+	if key, err := decodeKey(p256dh); err != nil || len(key) != 65 || key[0] != 0x04 {
+		return nil, ErrInvalidPushSubscription
+	}
+	if secret, err := decodeKey(auth); err != nil || len(secret) != 16 {
+		return nil, ErrInvalidPushSubscription
+	}
+	if !expiresAt.IsZero() && !expiresAt.After(now) {
+		return nil, ErrInvalidPushSubscription
+	}
+	return &PushSubscription{
+		id:        uuid.New().String(),
+		userID:    userID,
+		endpoint:  endpoint,
+		p256dh:    p256dh,
+		auth:      auth,
+		userAgent: userAgent,
+		expiresAt: expiresAt,
+		createdAt: now,
+	}, nil
+}
+
+// ReconstructPushSubscription recreates a subscription from persisted data
+func ReconstructPushSubscription(id, userID, endpoint, p256dh, auth, userAgent string, expiresAt, createdAt time.Time) *PushSubscription {
+	return &PushSubscription{
+		id:        id,
+		userID:    userID,
+		endpoint:  endpoint,
+		p256dh:    p256dh,
+		auth:      auth,
+		userAgent: userAgent,
+		expiresAt: expiresAt,
+		createdAt: createdAt,
+	}
+}
+
+// decodeKey decodes base64url with or without padding, as browsers vary
+func decodeKey(s string) ([]byte, error) {
+	if b, err := base64.RawURLEncoding.DecodeString(s); err == nil {
+		return b, nil
+	}
+	return base64.URLEncoding.DecodeString(s)
+}
+
+func (s *PushSubscription) ID() string           { return s.id }
+func (s *PushSubscription) UserID() string       { return s.userID }
+func (s *PushSubscription) Endpoint() string     { return s.endpoint }
+func (s *PushSubscription) P256dh() string       { return s.p256dh }
+func (s *PushSubscription) Auth() string         { return s.auth }
+func (s *PushSubscription) UserAgent() string    { return s.userAgent }
+func (s *PushSubscription) ExpiresAt() time.Time { return s.expiresAt }
+func (s *PushSubscription) CreatedAt() time.Time { return s.createdAt }
+
+// BelongsTo reports whether the subscription is the user's
+func (s *PushSubscription) BelongsTo(userID string) bool {
+	return s.userID == userID
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Domain errors for Reminder
+var (
+	ErrReminderNotFound    = errors.New("reminder not found")
+	ErrInvalidReminderTime = errors.New("reminder time must be HH:MM")
+	ErrInvalidTimeZone     = errors.New("invalid time zone")
+)
+
+// reminderTimeLayout is the layout of the time of day a reminder is sent at
+const reminderTimeLayout = "15:04"
+
+// Reminder is a user's daily reminder to meditate (aggregate root).
+// It is sent at a time of day in the user's own time zone, so it follows
+// daylight saving time changes.
+type Reminder struct {
+	userID    string
+	timeOfDay string
+	hour      int
+	minute    int
+	location  *time.Location
+	enabled   bool
+	nextAt    time.Time
+}
+
+// NewReminder creates a reminder whose first run is the next occurrence of timeOfDay
+func NewReminder(userID, timeOfDay, timeZone string, enabled bool, now time.Time) (*Reminder, error) {
+	reminder := &Reminder{userID: userID}
+	if err := reminder.Change(timeOfDay, timeZone, enabled, now); err != nil {
+		return nil, err
+	}
+	return reminder, nil
+}
+
+// ReconstructReminder recreates a reminder from persisted data
+func ReconstructReminder(userID, timeOfDay, timeZone string, enabled bool, nextAt time.Time) (*Reminder, error) {
+	reminder := &Reminder{userID: userID, enabled: enabled, nextAt: nextAt}
+	if err := reminder.setTime(timeOfDay, timeZone); err != nil {
+		return nil, err
+	}
+	return reminder, nil
+}
+
+// Change sets a new time, time zone and state; the next run is recalculated
+func (r *Reminder) Change(timeOfDay, timeZone string, enabled bool, now time.Time) error {
+	if err := r.setTime(timeOfDay, timeZone); err != nil {
+		return err
+	}
+	r.enabled = enabled
+	r.Advance(now)
+	return nil
+}
+
+func (r *Reminder) setTime(timeOfDay, timeZone string) error {
+	parsed, err := time.Parse(reminderTimeLayout, timeOfDay)
+	if err != nil {
+		return ErrInvalidReminderTime
+	}
+	// The server's own zone means nothing to the user
+	if timeZone == "" || timeZone == "Local" {
+		return ErrInvalidTimeZone
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return ErrInvalidTimeZone
+	}
+	r.timeOfDay = parsed.Format(reminderTimeLayout)
+	r.hour, r.minute = parsed.Hour(), parsed.Minute()
+	r.location = location
+	return nil
+}
+
+// Advance moves the next run to the first occurrence of the time of day after now
+func (r *Reminder) Advance(now time.Time) {
+	local := now.In(r.location)
+	next := time.Date(local.Year(), local.Month(), local.Day(), r.hour, r.minute, 0, 0, r.location)
+	if !next.After(now) {
+		next = time.Date(local.Year(), local.Month(), local.Day()+1, r.hour, r.minute, 0, 0, r.location)
+	}
+	r.nextAt = next
+}
+
+// IsDue reports whether the reminder should be sent at now
+func (r *Reminder) IsDue(now time.Time) bool {
+	return r.enabled && !r.nextAt.After(now)
+}
+
+// Day returns the start and end of the user's local day of the next run
+func (r *Reminder) Day() (time.Time, time.Time) {
+	local := r.nextAt.In(r.location)
+	start := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, r.location)
+	end := time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, r.location)
+	return start, end
+}
+
+func (r *Reminder) UserID() string    { return r.userID }
+func (r *Reminder) TimeOfDay() string { return r.timeOfDay }
+func (r *Reminder) TimeZone() string  { return r.location.String() }
+func (r *Reminder) IsEnabled() bool   { return r.enabled }
+func (r *Reminder) NextAt() time.Time { return r.nextAt }
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestReminder_ShouldRunAtLocalTimeOfDay(t *testing.T) {
+	// given
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	now := time.Date(2025, 1, 1, 8, 0, 0, 0, tokyo)
+
+	// when
+	later, errLater := NewReminder("user", "21:30", "Asia/Tokyo", true, now)
+	passed, errPassed := NewReminder("user", "07:00", "Asia/Tokyo", true, now)
+
+	// then
+	if errLater != nil || errPassed != nil {
+		t.Fatalf("Expected no errors, got %v and %v", errLater, errPassed)
+	}
+	if want := time.Date(2025, 1, 1, 21, 30, 0, 0, tokyo); !later.NextAt().Equal(want) {
+		t.Errorf("Expected %v, got %v", want, later.NextAt())
+	}
+	if want := time.Date(2025, 1, 2, 7, 0, 0, 0, tokyo); !passed.NextAt().Equal(want) {
+		t.Errorf("Expected tomorrow %v, got %v", want, passed.NextAt())
+	}
+}
+
+func TestReminder_ShouldFollowDaylightSavingTime(t *testing.T) {
+	// given
+	newYork, _ := time.LoadLocation("America/New_York")
+	// The day before clocks go forward on 2025-03-09
+	now := time.Date(2025, 3, 8, 8, 0, 0, 0, newYork)
+
+	// when
+	reminder, _ := NewReminder("user", "07:00", "America/New_York", true, now)
+	start, end := reminder.Day()
+
+	// then
+	next := reminder.NextAt().In(newYork)
+	if next.Day() != 9 || next.Hour() != 7 || next.Minute() != 0 {
+		t.Errorf("Expected 07:00 local time after the change, got %v", next)
+	}
+	if end.Sub(start) != 23*time.Hour {
+		t.Errorf("Expected the short day to be 23 hours, got %v", end.Sub(start))
+	}
+}
+
+func TestReminder_ShouldRejectInvalidTimeAndZone(t *testing.T) {
+	// given
+	now := time.Now()
+
+	// when
+	_, timeErr := NewReminder("user", "25:00", "UTC", true, now)
+	_, zoneErr := NewReminder("user", "07:00", "Mars/Olympus_Mons", true, now)
+	_, localErr := NewReminder("user", "07:00", "Local", true, now)
+
+	// then
+	if !errors.Is(timeErr, ErrInvalidReminderTime) {
+		t.Errorf("Expected ErrInvalidReminderTime, got %v", timeErr)
+	}
+	if !errors.Is(zoneErr, ErrInvalidTimeZone) || !errors.Is(localErr, ErrInvalidTimeZone) {
+		t.Errorf("Expected ErrInvalidTimeZone, got %v and %v", zoneErr, localErr)
+	}
+}
+
+func TestReminder_DisabledShouldNeverBeDue(t *testing.T) {
+	// given
+	now := time.Now()
+	reminder, _ := NewReminder("user", "07:00", "UTC", false, now)
+
+	// when
+	due := reminder.IsDue(now.Add(48 * time.Hour))
+
+	// then
+	if due {
+		t.Error("Expected a disabled reminder not to be due")
+	}
+}
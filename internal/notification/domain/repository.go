@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// NotificationRepository defines the interface for notification persistence
+type NotificationRepository interface {
+	Save(ctx context.Context, notification *Notification) error
+	FindByID(ctx context.Context, id string) (*Notification, error)
+	// FindByUserID returns the user's notifications, newest first
+	FindByUserID(ctx context.Context, userID string, unreadOnly bool, limit, offset int) ([]*Notification, error)
+	CountUnread(ctx context.Context, userID string) (int, error)
+	// MarkAllRead marks every unread notification of the user read and returns how many changed
+	MarkAllRead(ctx context.Context, userID string, now time.Time) (int, error)
+}
+
+// PreferenceRepository defines the interface for notification preference persistence
+type PreferenceRepository interface {
+	// FindByUserID returns the user's preferences, or the defaults if the user never changed them
+	FindByUserID(ctx context.Context, userID string) (*Preferences, error)
+	// Save replaces the user's stored preferences
+	Save(ctx context.Context, prefs *Preferences) error
+}
+
+// DigestRepository holds the notifications waiting for a user's next email digest
+type DigestRepository interface {
+	Add(ctx context.Context, notification *Notification) error
+	// FindDueUserIDs returns users whose oldest waiting notification was created before the time
+	FindDueUserIDs(ctx context.Context, createdBefore time.Time, limit int) ([]string, error)
+	// FindByUserID returns the notifications waiting for the user, oldest first
+	FindByUserID(ctx context.Context, userID string) ([]*Notification, error)
+	// Remove deletes the notifications once they have been mailed
+	Remove(ctx context.Context, userID string, ids []string) error
+}
+
+// ReminderRepository defines the interface for reminder persistence
+type ReminderRepository interface {
+	// FindByUserID returns the user's reminder, or ErrReminderNotFound
+	FindByUserID(ctx context.Context, userID string) (*Reminder, error)
+	Save(ctx context.Context, reminder *Reminder) error
+	Delete(ctx context.Context, userID string) error
+	// FindDue returns enabled reminders whose next run is at or before now, earliest first
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*Reminder, error)
+}
+
+// PushSubscriptionRepository defines the interface for push subscription persistence
+type PushSubscriptionRepository interface {
+	// Save stores a subscription, replacing any stored one with the same endpoint
+	// (a browser that subscribes again keeps its endpoint)
+	Save(ctx context.Context, subscription *PushSubscription) error
+	FindByID(ctx context.Context, id string) (*PushSubscription, error)
+	// FindByUserID returns the user's subscriptions, oldest first
+	FindByUserID(ctx context.Context, userID string) ([]*PushSubscription, error)
+	Delete(ctx context.Context, id string) error
+	// DeleteExpired removes subscriptions whose expiration time has passed and returns how many
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
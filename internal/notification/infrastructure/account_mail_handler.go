@@ -0,0 +1,37 @@
+package infrastructure
+
+import (
+	"context"
+
+	"zen-connect/internal/notification/application/usecase"
+	"zen-connect/internal/shared/event"
+)
+
+// AccountMailHandler sends the account emails when users register and confirm their address
+type AccountMailHandler struct {
+	accountMail *usecase.AccountMailUseCase
+}
+
+// NewAccountMailHandler creates a new account mail event handler
+func NewAccountMailHandler(accountMail *usecase.AccountMailUseCase) *AccountMailHandler {
+	return &AccountMailHandler{
+		accountMail: accountMail,
+	}
+}
+
+// Subscribe registers the handler for the user events that trigger account emails
+func (h *AccountMailHandler) Subscribe(bus event.EventBus) {
+	bus.Register("UserRegistered", h)
+	bus.Register("EmailVerified", h)
+}
+
+// Handle implements event.EventHandler
+func (h *AccountMailHandler) Handle(ctx context.Context, e event.DomainEvent) error {
+	switch e.EventName() {
+	case "UserRegistered":
+		return h.accountMail.Welcome(ctx, e.AggregateID())
+	case "EmailVerified":
+		return h.accountMail.EmailConfirmed(ctx, e.AggregateID())
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	experiencedomain "zen-connect/internal/experience/domain"
+	moderationdomain "zen-connect/internal/moderation/domain"
+	"zen-connect/internal/notification/application/dto"
+	"zen-connect/internal/notification/application/usecase"
+	"zen-connect/internal/notification/domain"
+	"zen-connect/internal/shared/event"
+)
+
+// EventHandler subscribes to the event bus and turns the domain events of
+// other contexts into notifications for the users they concern
+type EventHandler struct {
+	notify       *usecase.NotifyUseCase
+	moderatorIDs []string
+}
+
+// NewEventHandler creates a new notification event handler.
+// moderatorIDs receive the notifications about new reports.
+func NewEventHandler(notify *usecase.NotifyUseCase, moderatorIDs []string) *EventHandler {
+	return &EventHandler{
+		notify:       notify,
+		moderatorIDs: moderatorIDs,
+	}
+}
+
+// Subscribe registers the handler for every event it turns into notifications
+func (h *EventHandler) Subscribe(bus event.EventBus) {
+	for _, name := range []string{
+		"ReactionAdded",
+		"CommentAdded",
+		"ReportSubmitted",
+		"ModerationActionTaken",
+		"SuspensionLifted",
+	} {
+		bus.Register(name, h)
+	}
+}
+
+// Handle implements event.EventHandler
+func (h *EventHandler) Handle(ctx context.Context, e event.DomainEvent) error {
+	var errs []error
+	for _, cmd := range h.commands(e) {
+		if err := h.notify.Execute(ctx, cmd); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// commands decides who is told about an event and what they need to render it
+func (h *EventHandler) commands(e event.DomainEvent) []*dto.NotifyCommand {
+	switch e := e.(type) {
+	case *experiencedomain.ReactionAdded:
+		return []*dto.NotifyCommand{{
+			Type:       string(domain.TypeReactionReceived),
+			ActorID:    e.UserID(),
+			Recipients: []string{e.ExperienceOwnerID()},
+			Data: map[string]string{
+				"experience_id": e.AggregateID(),
+				"reaction":      string(e.Kind()),
+			},
+		}}
+
+	case *experiencedomain.CommentAdded:
+		data := map[string]string{
+			"experience_id": e.ExperienceID(),
+			"comment_id":    e.AggregateID(),
+		}
+		var commands []*dto.NotifyCommand
+		// The author of the parent is told about the reply; an owner replied to
+		// on their own experience is not told twice
+		if e.ParentAuthorID() != "" {
+			commands = append(commands, &dto.NotifyCommand{
+				Type:       string(domain.TypeCommentReplied),
+				ActorID:    e.AuthorID(),
+				Recipients: []string{e.ParentAuthorID()},
+				Data:       data,
+			})
+		}
+		if e.ExperienceOwnerID() != e.ParentAuthorID() {
+			commands = append(commands, &dto.NotifyCommand{
+				Type:       string(domain.TypeCommentReceived),
+				ActorID:    e.AuthorID(),
+				Recipients: []string{e.ExperienceOwnerID()},
+				Data:       data,
+			})
+		}
+		return commands
+
+	case *moderationdomain.ReportSubmitted:
+		// Reporters stay anonymous, even to moderators' inboxes
+		return []*dto.NotifyCommand{{
+			Type:       string(domain.TypeReportSubmitted),
+			Recipients: h.moderatorIDs,
+			Data: map[string]string{
+				"report_id":   e.AggregateID(),
+				"target_type": string(e.Target().Type),
+				"target_id":   e.Target().ID,
+				"reason":      string(e.Reason()),
+			},
+		}}
+
+	case *moderationdomain.ModerationActionTaken:
+		// Dismissed reports change nothing for the owner
+		if e.Action() == moderationdomain.ActionDismiss {
+			return nil
+		}
+		data := map[string]string{
+			"action":      string(e.Action()),
+			"target_type": string(e.Target().Type),
+			"target_id":   e.Target().ID,
+		}
+		if e.Note() != "" {
+			data["note"] = e.Note()
+		}
+		if !e.SuspendedUntil().IsZero() {
+			data["suspended_until"] = e.SuspendedUntil().UTC().Format(time.RFC3339)
+		}
+		return []*dto.NotifyCommand{{
+			Type:       string(domain.TypeModerationAction),
+			Recipients: []string{e.OwnerID()},
+			Data:       data,
+		}}
+
+	case *moderationdomain.SuspensionLifted:
+		return []*dto.NotifyCommand{{
+			Type:       string(domain.TypeSuspensionLifted),
+			Recipients: []string{e.AggregateID()},
+		}}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	experiencedomain "zen-connect/internal/experience/domain"
+	moderationdomain "zen-connect/internal/moderation/domain"
+	"zen-connect/internal/notification/application/service"
+	"zen-connect/internal/notification/application/usecase"
+	"zen-connect/internal/notification/domain"
+)
+
+// recordingChannel keeps every delivered notification
+type recordingChannel struct {
+	delivered []*domain.Notification
+}
+
+func (c *recordingChannel) Name() domain.Channel { return domain.ChannelInApp }
+
+func (c *recordingChannel) Deliver(ctx context.Context, notification *domain.Notification) error {
+	c.delivered = append(c.delivered, notification)
+	return nil
+}
+
+// defaultPreferences gives every user the default preferences
+type defaultPreferences struct{}
+
+func (defaultPreferences) FindByUserID(ctx context.Context, userID string) (*domain.Preferences, error) {
+	return domain.NewPreferences(userID), nil
+}
+
+func (defaultPreferences) Save(ctx context.Context, prefs *domain.Preferences) error { return nil }
+
+func newTestEventHandler(moderatorIDs ...string) (*EventHandler, *recordingChannel) {
+	channel := &recordingChannel{}
+	dispatcher := service.NewDispatcher(defaultPreferences{}, channel)
+	return NewEventHandler(usecase.NewNotifyUseCase(dispatcher), moderatorIDs), channel
+}
+
+func recipients(notifications []*domain.Notification) map[string]domain.Type {
+	types := map[string]domain.Type{}
+	for _, n := range notifications {
+		types[n.UserID()] = n.Type()
+	}
+	return types
+}
+
+func TestEventHandler_CommentAddedShouldNotifyOwnerAndParentAuthor(t *testing.T) {
+	// given
+	handler, channel := newTestEventHandler()
+	now := time.Now()
+
+	// when
+	err := handler.Handle(context.Background(), experiencedomain.NewCommentAdded("comment-2", "experience-1", "owner", "guest-2", "guest-1", now))
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	got := recipients(channel.delivered)
+	if len(got) != 2 || got["guest-1"] != domain.TypeCommentReplied || got["owner"] != domain.TypeCommentReceived {
+		t.Errorf("Expected reply and comment notifications, got %v", got)
+	}
+	if channel.delivered[0].Data()["comment_id"] != "comment-2" || channel.delivered[0].ActorID() != "guest-2" {
+		t.Errorf("Expected comment data and actor, got %+v", channel.delivered[0])
+	}
+}
+
+func TestEventHandler_ShouldNotNotifyActorOrOwnerTwice(t *testing.T) {
+	// given
+	handler, channel := newTestEventHandler()
+	now := time.Now()
+
+	// when
+	_ = handler.Handle(context.Background(), experiencedomain.NewReactionAdded("experience-1", "owner", "owner", experiencedomain.ReactionGassho, now))
+	_ = handler.Handle(context.Background(), experiencedomain.NewCommentAdded("comment-2", "experience-1", "owner", "guest", "owner", now))
+
+	// then
+	got := recipients(channel.delivered)
+	if len(channel.delivered) != 1 || got["owner"] != domain.TypeCommentReplied {
+		t.Errorf("Expected a single reply notification for the owner, got %v", got)
+	}
+}
+
+func TestEventHandler_ModerationEventsShouldReachModeratorsAndOwners(t *testing.T) {
+	// given
+	handler, channel := newTestEventHandler("moderator-1", "moderator-2")
+	target, _ := moderationdomain.NewTarget("comment", "comment-1")
+	now := time.Now()
+
+	// when
+	_ = handler.Handle(context.Background(), moderationdomain.NewReportSubmitted("report-1", target, "owner", moderationdomain.ReasonSpam, now))
+	_ = handler.Handle(context.Background(), moderationdomain.NewModerationActionTaken("action-1", target, "owner", moderationdomain.ActionDismiss, "", time.Time{}, now))
+	_ = handler.Handle(context.Background(), moderationdomain.NewModerationActionTaken("action-2", target, "owner", moderationdomain.ActionHide, "宣伝", time.Time{}, now))
+
+	// then
+	got := recipients(channel.delivered)
+	if len(channel.delivered) != 3 || got["moderator-1"] != domain.TypeReportSubmitted || got["moderator-2"] != domain.TypeReportSubmitted {
+		t.Errorf("Expected both moderators and the owner once, got %v", got)
+	}
+	if got["owner"] != domain.TypeModerationAction || channel.delivered[2].Data()["action"] != "hide" || channel.delivered[2].Data()["note"] != "宣伝" {
+		t.Errorf("Expected hide notification with the note for the owner, got %+v", channel.delivered[2].Data())
+	}
+}
@@ -0,0 +1,91 @@
+package infrastructure
+
+import (
+	"context"
+	"embed"
+	"io/fs"
+	"net/url"
+	"strings"
+
+	"zen-connect/internal/infrastructure/mail"
+	"zen-connect/internal/notification/application/dto"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// LoadMailTemplates parses the embedded notification email templates
+func LoadMailTemplates(defaultLanguage string) (*mail.Templates, error) {
+	templates, err := fs.Sub(templateFS, "templates")
+	if err != nil {
+		return nil, err
+	}
+	return mail.NewTemplates(templates, defaultLanguage)
+}
+
+// MailLinks are the URLs put into emails
+type MailLinks struct {
+	// AppURL is the frontend the emails link to; it also serves the
+	// unsubscribe page at /unsubscribe?token=...
+	AppURL string
+	// UnsubscribeURL is the API endpoint mail clients post to for one-click unsubscribe (RFC 8058)
+	UnsubscribeURL string
+}
+
+// TemplateMailer renders notification emails and puts them into the mail queue
+type TemplateMailer struct {
+	outbox    *mail.Outbox
+	templates *mail.Templates
+	links     MailLinks
+}
+
+// NewTemplateMailer creates a new template mailer
+func NewTemplateMailer(outbox *mail.Outbox, templates *mail.Templates, links MailLinks) *TemplateMailer {
+	links.AppURL = strings.TrimRight(links.AppURL, "/")
+	return &TemplateMailer{
+		outbox:    outbox,
+		templates: templates,
+		links:     links,
+	}
+}
+
+// templateData is what every email template receives
+type templateData struct {
+	Name           string
+	AppURL         string
+	UnsubscribeURL string
+	Data           any
+}
+
+// Send renders the email in the recipient's language and queues it. Emails
+// with an unsubscribe token link to the unsubscribe page and carry the
+// List-Unsubscribe headers mail clients show as an unsubscribe button.
+func (m *TemplateMailer) Send(ctx context.Context, msg *dto.Mail) error {
+	data := templateData{
+		Name:   msg.To.Name,
+		AppURL: m.links.AppURL,
+		Data:   msg.Data,
+	}
+	var headers map[string]string
+	if msg.UnsubscribeToken != "" {
+		token := url.QueryEscape(msg.UnsubscribeToken)
+		data.UnsubscribeURL = m.links.AppURL + "/unsubscribe?token=" + token
+		headers = map[string]string{
+			"List-Unsubscribe":      "<" + m.links.UnsubscribeURL + "?token=" + token + ">",
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		}
+	}
+
+	content, err := m.templates.Render(msg.Template, msg.Language, data)
+	if err != nil {
+		return err
+	}
+	_, err = m.outbox.Enqueue(ctx, msg.DedupeKey, mail.Message{
+		To:      mail.Address{Name: msg.To.Name, Email: msg.To.Address},
+		Subject: content.Subject,
+		Text:    content.Text,
+		HTML:    content.HTML,
+		Headers: headers,
+	})
+	return err
+}
@@ -0,0 +1,97 @@
+package infrastructure
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"zen-connect/internal/infrastructure/mail"
+	"zen-connect/internal/notification/application/dto"
+)
+
+func newTestMailer(t *testing.T) (*TemplateMailer, *mail.MemoryStore) {
+	t.Helper()
+	templates, err := LoadMailTemplates("ja")
+	if err != nil {
+		t.Fatalf("Expected embedded templates to load, got %v", err)
+	}
+	store := mail.NewMemoryStore()
+	outbox := mail.NewOutbox(store, mail.Address{Name: "ZenConnect", Email: "no-reply@example.com"})
+	links := MailLinks{AppURL: "https://app.example.com/", UnsubscribeURL: "https://api.example.com/notifications/unsubscribe"}
+	return NewTemplateMailer(outbox, templates, links), store
+}
+
+func TestTemplateMailer_ShouldRenderEveryTemplateInEveryLanguage(t *testing.T) {
+	// given
+	mailer, store := newTestMailer(t)
+	data := map[string]any{
+		"welcome":         nil,
+		"email_confirmed": nil,
+		"notification_digest": dto.DigestMailData{Items: []dto.DigestItem{
+			{Type: "comment_received", ActorName: "Aoi", Data: map[string]string{"experience_id": "experience-1"}, CreatedAt: time.Now()},
+		}},
+		"weekly_summary": dto.WeeklySummaryMailData{
+			WeekStart:       "2024-01-01",
+			WeekEnd:         "2024-01-07",
+			PracticeSummary: dto.PracticeSummary{Sessions: 3, TotalMinutes: 45, LongestMinutes: 20, Days: 3},
+		},
+	}
+
+	for template, templateData := range data {
+		for _, language := range []string{"ja", "en"} {
+			// when
+			err := mailer.Send(context.Background(), &dto.Mail{
+				DedupeKey:        template + ":" + language,
+				To:               dto.Recipient{UserID: "user-1", Address: "user@example.com", Name: "Aoi"},
+				Template:         template,
+				Language:         language,
+				Data:             templateData,
+				UnsubscribeToken: "token",
+			})
+
+			// then
+			if err != nil {
+				t.Errorf("Expected %s.%s to render, got %v", template, language, err)
+			}
+		}
+	}
+	if got := len(store.Messages()); got != 8 {
+		t.Errorf("Expected 8 queued messages, got %d", got)
+	}
+}
+
+func TestTemplateMailer_ShouldAddUnsubscribeHeadersOnlyWithToken(t *testing.T) {
+	// given
+	mailer, store := newTestMailer(t)
+	recipient := dto.Recipient{UserID: "user-1", Address: "user@example.com", Name: "Aoi"}
+
+	// when
+	errWelcome := mailer.Send(context.Background(), &dto.Mail{DedupeKey: "welcome", To: recipient, Template: "welcome"})
+	errDigest := mailer.Send(context.Background(), &dto.Mail{
+		DedupeKey: "digest", To: recipient, Template: "notification_digest",
+		Data: dto.DigestMailData{}, UnsubscribeToken: "a+b",
+	})
+
+	// then
+	if errWelcome != nil || errDigest != nil {
+		t.Fatalf("Expected no errors, got %v and %v", errWelcome, errDigest)
+	}
+	headers := map[string]map[string]string{}
+	for _, queued := range store.Messages() {
+		headers[queued.DedupeKey] = queued.Message.Headers
+		if queued.Message.To.Email != "user@example.com" {
+			t.Errorf("Expected the recipient address, got %q", queued.Message.To.Email)
+		}
+	}
+	if len(headers["welcome"]) != 0 {
+		t.Errorf("Expected no unsubscribe headers on account mail, got %v", headers["welcome"])
+	}
+	want := "<https://api.example.com/notifications/unsubscribe?token=a%2Bb>"
+	if got := headers["digest"]["List-Unsubscribe"]; got != want {
+		t.Errorf("Expected List-Unsubscribe %q, got %q", want, got)
+	}
+	if !strings.Contains(headers["digest"]["List-Unsubscribe-Post"], "One-Click") {
+		t.Errorf("Expected one-click unsubscribe, got %v", headers["digest"])
+	}
+}
@@ -0,0 +1,103 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"zen-connect/internal/notification/domain"
+)
+
+// PostgresDigestRepository implements DigestRepository interface
+type PostgresDigestRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresDigestRepository creates a new PostgreSQL email digest repository
+func NewPostgresDigestRepository(pool *pgxpool.Pool) *PostgresDigestRepository {
+	return &PostgresDigestRepository{
+		pool: pool,
+	}
+}
+
+// Add stores a copy of the notification for the next digest; the inbox
+// keeps its own copy, so reading it there does not remove it from the digest
+func (r *PostgresDigestRepository) Add(ctx context.Context, notification *domain.Notification) error {
+	data, err := json.Marshal(notification.Data())
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO notification_digest_items (id, user_id, type, actor_id, data, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id) DO NOTHING
+	`
+	_, err = r.pool.Exec(ctx, query,
+		notification.ID(),
+		notification.UserID(),
+		string(notification.Type()),
+		nullString(notification.ActorID()),
+		data,
+		notification.CreatedAt(),
+	)
+	return err
+}
+
+// FindDueUserIDs returns the users whose oldest waiting notification was created before createdBefore
+func (r *PostgresDigestRepository) FindDueUserIDs(ctx context.Context, createdBefore time.Time, limit int) ([]string, error) {
+	query := `
+		SELECT user_id FROM notification_digest_items
+		GROUP BY user_id
+		HAVING MIN(created_at) < $1
+		ORDER BY MIN(created_at)
+		LIMIT $2
+	`
+	rows, err := r.pool.Query(ctx, query, createdBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, rows.Err()
+}
+
+// FindByUserID returns the notifications waiting for the user, oldest first
+func (r *PostgresDigestRepository) FindByUserID(ctx context.Context, userID string) ([]*domain.Notification, error) {
+	query := `
+		SELECT id, user_id, type, actor_id, data, created_at, NULL::timestamptz
+		FROM notification_digest_items
+		WHERE user_id = $1
+		ORDER BY created_at, id
+	`
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []*domain.Notification
+	for rows.Next() {
+		notification, err := scanNotification(rows)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, notification)
+	}
+	return notifications, rows.Err()
+}
+
+// Remove deletes mailed notifications
+func (r *PostgresDigestRepository) Remove(ctx context.Context, userID string, ids []string) error {
+	_, err := r.pool.Exec(ctx, `DELETE FROM notification_digest_items WHERE user_id = $1 AND id = ANY($2)`, userID, ids)
+	return err
+}
@@ -0,0 +1,129 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"zen-connect/internal/notification/domain"
+)
+
+// PostgresPushSubscriptionRepository implements PushSubscriptionRepository interface
+type PostgresPushSubscriptionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresPushSubscriptionRepository creates a new PostgreSQL push subscription repository
+func NewPostgresPushSubscriptionRepository(pool *pgxpool.Pool) *PostgresPushSubscriptionRepository {
+	return &PostgresPushSubscriptionRepository{
+		pool: pool,
+	}
+}
+
+const pushSubscriptionColumns = `id, user_id, endpoint, p256dh, auth, user_agent, expires_at, created_at`
+
+// Save inserts the subscription, replacing any stored subscription with the same endpoint
+func (r *PostgresPushSubscriptionRepository) Save(ctx context.Context, subscription *domain.PushSubscription) error {
+	query := `
+		INSERT INTO push_subscriptions (` + pushSubscriptionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (endpoint) DO UPDATE SET
+			id = EXCLUDED.id,
+			user_id = EXCLUDED.user_id,
+			p256dh = EXCLUDED.p256dh,
+			auth = EXCLUDED.auth,
+			user_agent = EXCLUDED.user_agent,
+			expires_at = EXCLUDED.expires_at,
+			created_at = EXCLUDED.created_at
+	`
+	var expiresAt *time.Time
+	if !subscription.ExpiresAt().IsZero() {
+		value := subscription.ExpiresAt()
+		expiresAt = &value
+	}
+	_, err := r.pool.Exec(ctx, query,
+		subscription.ID(),
+		subscription.UserID(),
+		subscription.Endpoint(),
+		subscription.P256dh(),
+		subscription.Auth(),
+		subscription.UserAgent(),
+		expiresAt,
+		subscription.CreatedAt(),
+	)
+	return err
+}
+
+// FindByID returns a subscription by ID
+func (r *PostgresPushSubscriptionRepository) FindByID(ctx context.Context, id string) (*domain.PushSubscription, error) {
+	subscription, err := scanPushSubscription(r.pool.QueryRow(ctx, `SELECT `+pushSubscriptionColumns+` FROM push_subscriptions WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrPushSubscriptionNotFound
+		}
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// FindByUserID returns the user's subscriptions, oldest first
+func (r *PostgresPushSubscriptionRepository) FindByUserID(ctx context.Context, userID string) ([]*domain.PushSubscription, error) {
+	query := `
+		SELECT ` + pushSubscriptionColumns + ` FROM push_subscriptions
+		WHERE user_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subscriptions []*domain.PushSubscription
+	for rows.Next() {
+		subscription, err := scanPushSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, rows.Err()
+}
+
+// Delete removes a subscription
+func (r *PostgresPushSubscriptionRepository) Delete(ctx context.Context, id string) error {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM push_subscriptions WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrPushSubscriptionNotFound
+	}
+	return nil
+}
+
+// DeleteExpired removes subscriptions whose expiration time has passed
+func (r *PostgresPushSubscriptionRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM push_subscriptions WHERE expires_at <= $1`, now)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// scanPushSubscription reconstructs a subscription from a result row
+func scanPushSubscription(row pgx.Row) (*domain.PushSubscription, error) {
+	var id, userID, endpoint, p256dh, auth, userAgent string
+	var expiresAt *time.Time
+	var createdAt time.Time
+	if err := row.Scan(&id, &userID, &endpoint, &p256dh, &auth, &userAgent, &expiresAt, &createdAt); err != nil {
+		return nil, err
+	}
+	var expires time.Time
+	if expiresAt != nil {
+		expires = *expiresAt
+	}
+	return domain.ReconstructPushSubscription(id, userID, endpoint, p256dh, auth, userAgent, expires, createdAt), nil
+}
@@ -0,0 +1,107 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"zen-connect/internal/notification/domain"
+)
+
+// PostgresReminderRepository implements ReminderRepository interface
+type PostgresReminderRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresReminderRepository creates a new PostgreSQL reminder repository
+func NewPostgresReminderRepository(pool *pgxpool.Pool) *PostgresReminderRepository {
+	return &PostgresReminderRepository{
+		pool: pool,
+	}
+}
+
+const reminderColumns = `user_id, time_of_day, time_zone, enabled, next_at`
+
+// FindByUserID returns the user's reminder
+func (r *PostgresReminderRepository) FindByUserID(ctx context.Context, userID string) (*domain.Reminder, error) {
+	reminder, err := scanReminder(r.pool.QueryRow(ctx, `SELECT `+reminderColumns+` FROM reminders WHERE user_id = $1`, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrReminderNotFound
+		}
+		return nil, err
+	}
+	return reminder, nil
+}
+
+// Save upserts the user's reminder
+func (r *PostgresReminderRepository) Save(ctx context.Context, reminder *domain.Reminder) error {
+	query := `
+		INSERT INTO reminders (` + reminderColumns + `, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (user_id) DO UPDATE SET
+			time_of_day = EXCLUDED.time_of_day,
+			time_zone = EXCLUDED.time_zone,
+			enabled = EXCLUDED.enabled,
+			next_at = EXCLUDED.next_at,
+			updated_at = EXCLUDED.updated_at
+	`
+	_, err := r.pool.Exec(ctx, query,
+		reminder.UserID(),
+		reminder.TimeOfDay(),
+		reminder.TimeZone(),
+		reminder.IsEnabled(),
+		reminder.NextAt(),
+	)
+	return err
+}
+
+// Delete removes the user's reminder
+func (r *PostgresReminderRepository) Delete(ctx context.Context, userID string) error {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM reminders WHERE user_id = $1`, userID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrReminderNotFound
+	}
+	return nil
+}
+
+// FindDue returns enabled reminders whose next run is at or before now, earliest first
+func (r *PostgresReminderRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*domain.Reminder, error) {
+	query := `
+		SELECT ` + reminderColumns + ` FROM reminders
+		WHERE enabled AND next_at <= $1
+		ORDER BY next_at
+		LIMIT $2
+	`
+	rows, err := r.pool.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reminders []*domain.Reminder
+	for rows.Next() {
+		reminder, err := scanReminder(rows)
+		if err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, reminder)
+	}
+	return reminders, rows.Err()
+}
+
+// scanReminder reconstructs a reminder from a result row
+func scanReminder(row pgx.Row) (*domain.Reminder, error) {
+	var userID, timeOfDay, timeZone string
+	var enabled bool
+	var nextAt time.Time
+	if err := row.Scan(&userID, &timeOfDay, &timeZone, &enabled, &nextAt); err != nil {
+		return nil, err
+	}
+	return domain.ReconstructReminder(userID, timeOfDay, timeZone, enabled, nextAt)
+}
@@ -0,0 +1,222 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"zen-connect/internal/notification/domain"
+)
+
+// PostgresNotificationRepository implements NotificationRepository interface
+type PostgresNotificationRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresNotificationRepository creates a new PostgreSQL notification repository
+func NewPostgresNotificationRepository(pool *pgxpool.Pool) *PostgresNotificationRepository {
+	return &PostgresNotificationRepository{
+		pool: pool,
+	}
+}
+
+const notificationColumns = `id, user_id, type, actor_id, data, created_at, read_at`
+
+// Save upserts a notification; only the read time can change
+func (r *PostgresNotificationRepository) Save(ctx context.Context, notification *domain.Notification) error {
+	data, err := json.Marshal(notification.Data())
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO notifications (` + notificationColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (id) DO UPDATE SET read_at = EXCLUDED.read_at
+	`
+	_, err = r.pool.Exec(ctx, query,
+		notification.ID(),
+		notification.UserID(),
+		string(notification.Type()),
+		nullString(notification.ActorID()),
+		data,
+		notification.CreatedAt(),
+		nullTime(notification.ReadAt()),
+	)
+	return err
+}
+
+// FindByID finds a notification by ID
+func (r *PostgresNotificationRepository) FindByID(ctx context.Context, id string) (*domain.Notification, error) {
+	notification, err := scanNotification(r.pool.QueryRow(ctx, `SELECT `+notificationColumns+` FROM notifications WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotificationNotFound
+		}
+		return nil, err
+	}
+	return notification, nil
+}
+
+// FindByUserID returns the user's notifications, newest first
+func (r *PostgresNotificationRepository) FindByUserID(ctx context.Context, userID string, unreadOnly bool, limit, offset int) ([]*domain.Notification, error) {
+	query := `
+		SELECT ` + notificationColumns + ` FROM notifications
+		WHERE user_id = $1 AND (NOT $2 OR read_at IS NULL)
+		ORDER BY created_at DESC, id
+		LIMIT $3 OFFSET $4
+	`
+	rows, err := r.pool.Query(ctx, query, userID, unreadOnly, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []*domain.Notification
+	for rows.Next() {
+		notification, err := scanNotification(rows)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, notification)
+	}
+	return notifications, rows.Err()
+}
+
+// CountUnread counts the user's unread notifications
+func (r *PostgresNotificationRepository) CountUnread(ctx context.Context, userID string) (int, error) {
+	var count int
+	err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL`, userID).Scan(&count)
+	return count, err
+}
+
+// MarkAllRead marks every unread notification of the user read
+func (r *PostgresNotificationRepository) MarkAllRead(ctx context.Context, userID string, now time.Time) (int, error) {
+	tag, err := r.pool.Exec(ctx, `UPDATE notifications SET read_at = $2 WHERE user_id = $1 AND read_at IS NULL`, userID, now)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// scanNotification reconstructs a notification from a result row
+func scanNotification(row pgx.Row) (*domain.Notification, error) {
+	var id, userID, kind string
+	var actorID *string
+	var data []byte
+	var createdAt time.Time
+	var readAt *time.Time
+
+	if err := row.Scan(&id, &userID, &kind, &actorID, &data, &createdAt, &readAt); err != nil {
+		return nil, err
+	}
+	values := map[string]string{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return domain.ReconstructNotification(id, userID, domain.Type(kind), stringValue(actorID), values, createdAt, timeValue(readAt)), nil
+}
+
+// PostgresPreferenceRepository implements PreferenceRepository interface
+type PostgresPreferenceRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresPreferenceRepository creates a new PostgreSQL notification preference repository
+func NewPostgresPreferenceRepository(pool *pgxpool.Pool) *PostgresPreferenceRepository {
+	return &PostgresPreferenceRepository{
+		pool: pool,
+	}
+}
+
+// FindByUserID returns the user's preferences; users without stored rows get the defaults
+func (r *PostgresPreferenceRepository) FindByUserID(ctx context.Context, userID string) (*domain.Preferences, error) {
+	rows, err := r.pool.Query(ctx, `SELECT type, channel FROM notification_preferences WHERE user_id = $1`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	disabled := map[domain.Type][]domain.Channel{}
+	for rows.Next() {
+		var kind, channel string
+		if err := rows.Scan(&kind, &channel); err != nil {
+			return nil, err
+		}
+		disabled[domain.Type(kind)] = append(disabled[domain.Type(kind)], domain.Channel(channel))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var language string
+	err = r.pool.QueryRow(ctx, `SELECT language FROM notification_settings WHERE user_id = $1`, userID).Scan(&language)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+	return domain.ReconstructPreferences(userID, language, disabled), nil
+}
+
+// Save replaces the stored preferences and email language in one transaction
+func (r *PostgresPreferenceRepository) Save(ctx context.Context, prefs *domain.Preferences) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM notification_preferences WHERE user_id = $1`, prefs.UserID()); err != nil {
+		return err
+	}
+	for kind, channels := range prefs.Disabled() {
+		for _, channel := range channels {
+			if _, err := tx.Exec(ctx,
+				`INSERT INTO notification_preferences (user_id, type, channel) VALUES ($1, $2, $3)`,
+				prefs.UserID(), string(kind), string(channel),
+			); err != nil {
+				return err
+			}
+		}
+	}
+	if prefs.Language() != "" {
+		query := `
+			INSERT INTO notification_settings (user_id, language) VALUES ($1, $2)
+			ON CONFLICT (user_id) DO UPDATE SET language = EXCLUDED.language
+		`
+		if _, err := tx.Exec(ctx, query, prefs.UserID(), prefs.Language()); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
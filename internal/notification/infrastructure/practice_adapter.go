@@ -0,0 +1,46 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	experienceusecase "zen-connect/internal/experience/application/usecase"
+	"zen-connect/internal/notification/application/dto"
+)
+
+// PracticeAdapter summarizes users' meditation from the experience context
+type PracticeAdapter struct {
+	summaries *experienceusecase.PracticeSummaryUseCase
+}
+
+// NewPracticeAdapter creates a new practice adapter
+func NewPracticeAdapter(summaries *experienceusecase.PracticeSummaryUseCase) *PracticeAdapter {
+	return &PracticeAdapter{
+		summaries: summaries,
+	}
+}
+
+// Summarize returns the practice of every user who meditated in [from, to),
+// counting days in location
+func (a *PracticeAdapter) Summarize(ctx context.Context, from, to time.Time, location *time.Location) ([]dto.PracticeSummary, error) {
+	summaries, err := a.summaries.Execute(ctx, from, to, location)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]dto.PracticeSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		result = append(result, dto.PracticeSummary{
+			UserID:         summary.UserID,
+			Sessions:       summary.Sessions,
+			TotalMinutes:   summary.TotalMinutes,
+			LongestMinutes: summary.LongestMinutes,
+			Days:           summary.Days,
+		})
+	}
+	return result, nil
+}
+
+// HasPracticed reports whether the user meditated in [from, to)
+func (a *PracticeAdapter) HasPracticed(ctx context.Context, userID string, from, to time.Time) (bool, error) {
+	return a.summaries.HasPracticed(ctx, userID, from, to)
+}
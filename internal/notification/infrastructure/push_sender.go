@@ -0,0 +1,46 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"zen-connect/internal/infrastructure/netguard"
+	"zen-connect/internal/infrastructure/webpush"
+	"zen-connect/internal/notification/domain"
+)
+
+// WebPushSender sends notifications to browsers through their push services
+type WebPushSender struct {
+	sender *webpush.Sender
+	ttl    time.Duration
+}
+
+// NewWebPushSender creates a push sender; ttl is how long push services keep
+// a message for a browser that is offline
+func NewWebPushSender(sender *webpush.Sender, ttl time.Duration) *WebPushSender {
+	return &WebPushSender{
+		sender: sender,
+		ttl:    ttl,
+	}
+}
+
+// Send encrypts the payload for the subscription and posts it
+func (s *WebPushSender) Send(ctx context.Context, subscription *domain.PushSubscription, payload []byte) error {
+	err := s.sender.Send(ctx, webpush.Subscription{
+		Endpoint: subscription.Endpoint(),
+		Keys: webpush.Keys{
+			P256dh: subscription.P256dh(),
+			Auth:   subscription.Auth(),
+		},
+	}, webpush.Message{
+		Payload: payload,
+		TTL:     s.ttl,
+		Urgency: webpush.UrgencyNormal,
+	})
+	// An endpoint inside the network is not a push service and never will be
+	if errors.Is(err, webpush.ErrSubscriptionGone) || errors.Is(err, netguard.ErrPrivateAddress) {
+		return domain.ErrPushSubscriptionGone
+	}
+	return err
+}
@@ -0,0 +1,39 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+
+	"zen-connect/internal/notification/application/dto"
+	userservice "zen-connect/internal/user/application/service"
+	userdomain "zen-connect/internal/user/domain"
+)
+
+// RecipientAdapter looks up email recipients in the user context
+type RecipientAdapter struct {
+	userService userservice.UserService
+}
+
+// NewRecipientAdapter creates a new recipient adapter
+func NewRecipientAdapter(userService userservice.UserService) *RecipientAdapter {
+	return &RecipientAdapter{
+		userService: userService,
+	}
+}
+
+// FindRecipient returns the user's address and display name, or nil if the user no longer exists
+func (a *RecipientAdapter) FindRecipient(ctx context.Context, userID string) (*dto.Recipient, error) {
+	user, err := a.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, userdomain.ErrUserNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &dto.Recipient{
+		UserID:   user.ID(),
+		Address:  user.Email().String(),
+		Name:     user.Profile().DisplayName(),
+		Verified: user.EmailVerified(),
+	}, nil
+}
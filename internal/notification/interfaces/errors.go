@@ -0,0 +1,105 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/notification/domain"
+)
+
+// ErrorMappings 通知ドメインのエラーとHTTPレスポンスの対応
+func ErrorMappings() []problem.Mapping {
+	return []problem.Mapping{
+		{
+			Err: domain.ErrNotificationNotFound, Status: http.StatusNotFound, Code: "notification_not_found",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "通知が見つかりません。",
+				problem.LanguageEnglish:  "The notification was not found.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidType, Status: http.StatusBadRequest, Code: "invalid_notification_type",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "通知の種類が正しくありません。",
+				problem.LanguageEnglish:  "The notification type is not valid.",
+			},
+		},
+		{
+			Err: domain.ErrUnknownChannel, Status: http.StatusBadRequest, Code: "unknown_notification_channel",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "通知の配信手段が正しくありません。",
+				problem.LanguageEnglish:  "The notification channel is not available.",
+			},
+		},
+		{
+			Err: domain.ErrPreferenceLocked, Status: http.StatusBadRequest, Code: "notification_preference_locked",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "アカウントに関わる通知は無効にできません。",
+				problem.LanguageEnglish:  "Notifications about your account cannot be turned off.",
+			},
+		},
+		{
+			Err: domain.ErrChannelNotSupported, Status: http.StatusBadRequest, Code: "notification_channel_not_supported",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "この種類の通知はその配信手段では受け取れません。",
+				problem.LanguageEnglish:  "This notification type is not delivered on that channel.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidUnsubscribeToken, Status: http.StatusBadRequest, Code: "invalid_unsubscribe_token",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "配信停止のリンクが正しくありません。",
+				problem.LanguageEnglish:  "The unsubscribe link is not valid.",
+			},
+		},
+		{
+			Err: domain.ErrReminderNotFound, Status: http.StatusNotFound, Code: "reminder_not_found",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "リマインダーが設定されていません。",
+				problem.LanguageEnglish:  "No reminder is set.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidReminderTime, Status: http.StatusBadRequest, Code: "invalid_reminder_time",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "リマインダーの時刻は HH:MM の形式で指定してください。",
+				problem.LanguageEnglish:  "The reminder time must be HH:MM.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidTimeZone, Status: http.StatusBadRequest, Code: "invalid_time_zone",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "タイムゾーンが正しくありません。",
+				problem.LanguageEnglish:  "The time zone is not valid.",
+			},
+		},
+		{
+			Err: domain.ErrPushSubscriptionNotFound, Status: http.StatusNotFound, Code: "push_subscription_not_found",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "プッシュ通知の購読が見つかりません。",
+				problem.LanguageEnglish:  "The push subscription was not found.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidPushSubscription, Status: http.StatusBadRequest, Code: "invalid_push_subscription",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "プッシュ通知の購読が正しくありません。",
+				problem.LanguageEnglish:  "The push subscription is not valid.",
+			},
+		},
+		{
+			Err: domain.ErrTooManyPushSubscriptions, Status: http.StatusConflict, Code: "too_many_push_subscriptions",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "プッシュ通知を受け取るブラウザが多すぎます。使っていないブラウザの購読を削除してください。",
+				problem.LanguageEnglish:  "Too many browsers receive push notifications. Remove the ones you no longer use.",
+			},
+		},
+		{
+			Err: domain.ErrPushNotConfigured, Status: http.StatusServiceUnavailable, Code: "push_not_configured",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "プッシュ通知は現在利用できません。",
+				problem.LanguageEnglish:  "Push notifications are not available.",
+			},
+		},
+	}
+}
@@ -0,0 +1,448 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/notification/application/dto"
+	"zen-connect/internal/notification/application/usecase"
+	"zen-connect/internal/shared/openapi"
+)
+
+// NotificationHandler 通知の受信箱と通知設定のHTTPハンドラー
+type NotificationHandler struct {
+	inboxUseCase       *usecase.InboxUseCase
+	preferencesUseCase *usecase.PreferencesUseCase
+	unsubscribeUseCase *usecase.UnsubscribeUseCase
+	reminderUseCase    *usecase.ReminderUseCase
+	pushUseCase        *usecase.PushSubscriptionUseCase
+}
+
+// NewNotificationHandler コンストラクタ
+func NewNotificationHandler(inboxUseCase *usecase.InboxUseCase, preferencesUseCase *usecase.PreferencesUseCase, unsubscribeUseCase *usecase.UnsubscribeUseCase, reminderUseCase *usecase.ReminderUseCase, pushUseCase *usecase.PushSubscriptionUseCase) *NotificationHandler {
+	return &NotificationHandler{
+		inboxUseCase:       inboxUseCase,
+		preferencesUseCase: preferencesUseCase,
+		unsubscribeUseCase: unsubscribeUseCase,
+		reminderUseCase:    reminderUseCase,
+		pushUseCase:        pushUseCase,
+	}
+}
+
+// SetupRoutes 通知関連のルーティング設定
+func (h *NotificationHandler) SetupRoutes(e *echo.Echo, sessionMiddleware *session.Middleware) {
+	// メールの配信停止リンクはログインせずに使える（トークンで本人を確認）
+	e.POST("/notifications/unsubscribe", h.Unsubscribe)
+
+	notificationGroup := e.Group("/notifications", sessionMiddleware.RequireAuth())
+
+	notificationGroup.GET("", h.ListNotifications)
+	notificationGroup.PUT("/:id/read", h.MarkRead)
+	notificationGroup.POST("/read-all", h.MarkAllRead)
+	// 通知の種類と配信手段ごとの設定
+	notificationGroup.GET("/preferences", h.GetPreferences)
+	notificationGroup.PUT("/preferences", h.UpdatePreferences)
+	// 毎日の瞑想リマインダー
+	notificationGroup.GET("/reminder", h.GetReminder)
+	notificationGroup.PUT("/reminder", h.SetReminder)
+	notificationGroup.DELETE("/reminder", h.DeleteReminder)
+	// ブラウザへのプッシュ通知の購読
+	notificationGroup.GET("/push/key", h.GetPushKey)
+	notificationGroup.GET("/push/subscriptions", h.ListPushSubscriptions)
+	notificationGroup.POST("/push/subscriptions", h.SubscribePush)
+	notificationGroup.DELETE("/push/subscriptions/:id", h.UnsubscribePush)
+}
+
+// Endpoints OpenAPIドキュメント用のエンドポイント定義
+func (h *NotificationHandler) Endpoints() []openapi.Endpoint {
+	tags := []string{"notifications"}
+	security := []string{openapi.SecuritySession}
+	types := "Types are reaction_received, comment_received, comment_replied, report_submitted (moderators), moderation_action, suspension_lifted, weekly_summary (email only) and meditation_reminder (not by email)."
+	return []openapi.Endpoint{
+		{
+			Method: http.MethodGet, Path: "/notifications", Tags: tags,
+			Summary:     "List your notifications, newest first",
+			Description: types + " data holds the IDs to link the notification, such as experience_id and comment_id.",
+			Security:    security,
+			Query: []openapi.Parameter{
+				{Name: "unread", Description: "Only unread notifications", Schema: &openapi.Schema{Type: "boolean"}},
+				{Name: "limit", Description: "1-100, default 20", Schema: &openapi.Schema{Type: "integer"}},
+				{Name: "offset", Description: "next_offset of the previous page", Schema: &openapi.Schema{Type: "integer"}},
+			},
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.ListNotificationsResponse{},
+				http.StatusBadRequest:   nil,
+				http.StatusUnauthorized: nil,
+			},
+		},
+		{
+			Method: http.MethodPut, Path: "/notifications/:id/read", Tags: tags,
+			Summary:  "Mark a notification read",
+			Security: security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.NotificationDTO{},
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+			},
+		},
+		{
+			Method: http.MethodPost, Path: "/notifications/read-all", Tags: tags,
+			Summary:  "Mark all your notifications read",
+			Security: security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.MarkAllReadResponse{},
+				http.StatusUnauthorized: nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/notifications/preferences", Tags: tags,
+			Summary:     "List your notification preferences for every type and channel",
+			Description: types + " Channels are in_app, email and push (when the server has push enabled); email notifications arrive as a periodic digest. Everything is on until you turn it off; locked types cannot be turned off.",
+			Security:    security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.PreferencesResponse{},
+				http.StatusUnauthorized: nil,
+			},
+		},
+		{
+			Method: http.MethodPut, Path: "/notifications/preferences", Tags: tags,
+			Summary:     "Turn notification types on or off per channel",
+			Description: "Preferences that are not listed stay as they are. language (ja or en) sets the language of your emails.",
+			Security:    security,
+			Request:     dto.UpdatePreferencesRequest{},
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.PreferencesResponse{},
+				http.StatusBadRequest:   nil,
+				http.StatusUnauthorized: nil,
+			},
+		},
+		{
+			Method: http.MethodPost, Path: "/notifications/unsubscribe", Tags: tags,
+			Summary: "Unsubscribe from emails with the token of an unsubscribe link",
+			Description: "Needs no session. The token comes from the link in an email, in the body or as the token query parameter " +
+				"(one-click unsubscribe, RFC 8058). A digest token turns off every email notification that can be turned off; " +
+				"a weekly summary token turns off only the weekly summary. Repeating the request changes nothing.",
+			Request: dto.UnsubscribeRequest{},
+			Responses: map[int]interface{}{
+				http.StatusOK:         dto.UnsubscribeResponse{},
+				http.StatusBadRequest: nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/notifications/reminder", Tags: tags,
+			Summary:  "Get your daily meditation reminder",
+			Security: security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.ReminderResponse{},
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+			},
+		},
+		{
+			Method: http.MethodPut, Path: "/notifications/reminder", Tags: tags,
+			Summary: "Set the time of your daily meditation reminder",
+			Description: "time is HH:MM in time_zone, an IANA zone such as Asia/Tokyo. The reminder arrives as a " +
+				"meditation_reminder notification and is skipped on days you have already meditated.",
+			Security: security,
+			Request:  dto.SetReminderRequest{},
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.ReminderResponse{},
+				http.StatusBadRequest:   nil,
+				http.StatusUnauthorized: nil,
+			},
+		},
+		{
+			Method: http.MethodDelete, Path: "/notifications/reminder", Tags: tags,
+			Summary:  "Delete your daily meditation reminder",
+			Security: security,
+			Responses: map[int]interface{}{
+				http.StatusNoContent:    nil,
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/notifications/push/key", Tags: tags,
+			Summary:     "Get the VAPID public key to subscribe a browser to push notifications",
+			Description: "Pass public_key as applicationServerKey to PushManager.subscribe. 503 when the server has push notifications turned off.",
+			Security:    security,
+			Responses: map[int]interface{}{
+				http.StatusOK:                 dto.PushKeyResponse{},
+				http.StatusUnauthorized:       nil,
+				http.StatusServiceUnavailable: nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/notifications/push/subscriptions", Tags: tags,
+			Summary:  "List the browsers you receive push notifications on",
+			Security: security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.ListPushSubscriptionsResponse{},
+				http.StatusUnauthorized: nil,
+			},
+		},
+		{
+			Method: http.MethodPost, Path: "/notifications/push/subscriptions", Tags: tags,
+			Summary: "Subscribe a browser to push notifications",
+			Description: "The body is the browser's PushSubscription.toJSON(). Subscribing the same endpoint again replaces it. " +
+				"Each push message is the notification as JSON, in the shape of the inbox, for the service worker to show.",
+			Security: security,
+			Request:  dto.SubscribePushRequest{},
+			Responses: map[int]interface{}{
+				http.StatusCreated:            dto.PushSubscriptionDTO{},
+				http.StatusBadRequest:         nil,
+				http.StatusUnauthorized:       nil,
+				http.StatusConflict:           nil,
+				http.StatusServiceUnavailable: nil,
+			},
+		},
+		{
+			Method: http.MethodDelete, Path: "/notifications/push/subscriptions/:id", Tags: tags,
+			Summary:  "Stop push notifications to a browser",
+			Security: security,
+			Responses: map[int]interface{}{
+				http.StatusNoContent:    nil,
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+			},
+		},
+	}
+}
+
+// ListNotifications 自分の通知を取得
+func (h *NotificationHandler) ListNotifications(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.ListNotificationsRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.UserID = userID
+
+	response, err := h.inboxUseCase.List(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// MarkRead 通知を既読にする
+func (h *NotificationHandler) MarkRead(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.inboxUseCase.MarkRead(c.Request().Context(), c.Param("id"), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// MarkAllRead 通知をすべて既読にする
+func (h *NotificationHandler) MarkAllRead(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.inboxUseCase.MarkAllRead(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetPreferences 通知設定を取得
+func (h *NotificationHandler) GetPreferences(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.preferencesUseCase.Get(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// UpdatePreferences 通知設定を変更
+func (h *NotificationHandler) UpdatePreferences(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.UpdatePreferencesRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.UserID = userID
+
+	response, err := h.preferencesUseCase.Update(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// Unsubscribe メールの配信停止リンクからの配信停止
+// メールソフトのワンクリック配信停止はトークンをクエリパラメータに付けて送る
+func (h *NotificationHandler) Unsubscribe(c echo.Context) error {
+	var req dto.UnsubscribeRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if req.Token == "" {
+		req.Token = c.QueryParam("token")
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+
+	response, err := h.unsubscribeUseCase.Execute(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetReminder 自分のリマインダーを取得
+func (h *NotificationHandler) GetReminder(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.reminderUseCase.Get(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// SetReminder リマインダーを設定
+func (h *NotificationHandler) SetReminder(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.SetReminderRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.UserID = userID
+
+	response, err := h.reminderUseCase.Set(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeleteReminder リマインダーを削除
+func (h *NotificationHandler) DeleteReminder(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	if err := h.reminderUseCase.Delete(c.Request().Context(), userID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetPushKey ブラウザで購読するための VAPID 公開鍵を取得
+func (h *NotificationHandler) GetPushKey(c echo.Context) error {
+	if _, ok := session.GetUserIDFromContext(c.Request().Context()); !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.pushUseCase.PublicKey()
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ListPushSubscriptions 自分の購読の一覧
+func (h *NotificationHandler) ListPushSubscriptions(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.pushUseCase.List(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// SubscribePush ブラウザの購読を登録
+func (h *NotificationHandler) SubscribePush(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.SubscribePushRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.UserID = userID
+	req.UserAgent = c.Request().UserAgent()
+
+	response, err := h.pushUseCase.Subscribe(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// UnsubscribePush 購読を削除
+func (h *NotificationHandler) UnsubscribePush(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	if err := h.pushUseCase.Unsubscribe(c.Request().Context(), userID, c.Param("id")); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
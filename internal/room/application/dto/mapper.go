@@ -0,0 +1,28 @@
+package dto
+
+import "zen-connect/internal/room/domain"
+
+// FromRoom ドメインのルームをDTOに変換
+func FromRoom(room *domain.Room) RoomDTO {
+	response := RoomDTO{
+		RoomID:              room.ID(),
+		HostID:              room.HostID(),
+		Title:               room.Title(),
+		MeditationType:      room.MeditationType(),
+		CustomType:          room.CustomType(),
+		ScheduledAt:         room.ScheduledAt(),
+		DurationSeconds:     int64(room.Duration().Seconds()),
+		BellIntervalSeconds: int64(room.BellInterval().Seconds()),
+		Status:              string(room.Status()),
+		CreatedAt:           room.CreatedAt(),
+	}
+	if !room.StartedAt().IsZero() {
+		startedAt, endsAt := room.StartedAt(), room.EndsAt()
+		response.StartedAt, response.EndsAt = &startedAt, &endsAt
+	}
+	if !room.EndedAt().IsZero() {
+		endedAt := room.EndedAt()
+		response.EndedAt = &endedAt
+	}
+	return response
+}
@@ -0,0 +1,36 @@
+package dto
+
+import "time"
+
+// CreateRoomRequest グループ瞑想ルーム作成リクエスト
+type CreateRoomRequest struct {
+	HostID              string    `json:"-"`
+	Title               string    `json:"title" validate:"required,max=100"`
+	MeditationType      string    `json:"meditation_type" validate:"required,meditation_type"`
+	CustomType          string    `json:"custom_meditation_type,omitempty" validate:"max=100"`
+	ScheduledAt         time.Time `json:"scheduled_at" validate:"required"`
+	DurationSeconds     int64     `json:"duration_seconds" validate:"gte=60,lte=10800"`
+	BellIntervalSeconds int64     `json:"bell_interval_seconds,omitempty" validate:"omitempty,gte=60,ltfield=DurationSeconds"`
+}
+
+// RoomDTO グループ瞑想ルームのDTO
+type RoomDTO struct {
+	RoomID              string     `json:"room_id"`
+	HostID              string     `json:"host_id"`
+	Title               string     `json:"title"`
+	MeditationType      string     `json:"meditation_type"`
+	CustomType          string     `json:"custom_meditation_type,omitempty"`
+	ScheduledAt         time.Time  `json:"scheduled_at"`
+	DurationSeconds     int64      `json:"duration_seconds"`
+	BellIntervalSeconds int64      `json:"bell_interval_seconds,omitempty"`
+	Status              string     `json:"status"`
+	StartedAt           *time.Time `json:"started_at,omitempty"`
+	EndsAt              *time.Time `json:"ends_at,omitempty"`
+	EndedAt             *time.Time `json:"ended_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+}
+
+// ListRoomsResponse ルーム一覧レスポンス
+type ListRoomsResponse struct {
+	Rooms []RoomDTO `json:"rooms"`
+}
@@ -0,0 +1,56 @@
+package dto
+
+import "time"
+
+// ルームのWebSocketで送受信するイベントの種類
+const (
+	// EventState 接続直後に送るルームの状態
+	EventState = "state"
+	// EventPresence 参加者が入退室した
+	EventPresence = "presence"
+	// EventStart 瞑想が at に開始する
+	EventStart = "start"
+	// EventBell at にベルが鳴る
+	EventBell = "bell"
+	// EventEnd 瞑想が at に終了した
+	EventEnd = "end"
+	// EventRecorded 参加者の瞑想が体験記録の下書きとして保存された
+	EventRecorded = "recorded"
+	// EventCancelled ホストがルームを中止した
+	EventCancelled = "cancelled"
+	// EventTimeSync 時刻同期の応答（クライアントの time_sync に対して返す）
+	EventTimeSync = "time_sync"
+	// EventError クライアントのメッセージを処理できなかった
+	EventError = "error"
+)
+
+// ParticipantDTO 接続中の参加者
+type ParticipantDTO struct {
+	UserID      string `json:"user_id"`
+	DisplayName string `json:"display_name,omitempty"`
+	IsHost      bool   `json:"is_host"`
+}
+
+// RoomEvent サーバーからクライアントへ送るイベント
+// すべてのイベントに server_time が付き、クライアントは時計のずれを補正して at を解釈する
+type RoomEvent struct {
+	Type       string     `json:"type"`
+	RoomID     string     `json:"room_id"`
+	ServerTime time.Time  `json:"server_time"`
+	At         *time.Time `json:"at,omitempty"`
+	// Bell 何回目のベルか（1から）
+	Bell         int              `json:"bell,omitempty"`
+	Room         *RoomDTO         `json:"room,omitempty"`
+	Participants []ParticipantDTO `json:"participants,omitempty"`
+	ExperienceID string           `json:"experience_id,omitempty"`
+	// ClientTime time_sync でクライアントが送った時刻
+	ClientTime *time.Time `json:"client_time,omitempty"`
+	Code       string     `json:"code,omitempty"`
+}
+
+// RoomCommand クライアントからサーバーへ送るメッセージ
+type RoomCommand struct {
+	// Type start（ホストのみ、予定時刻より前に開始）または time_sync
+	Type       string     `json:"type"`
+	ClientTime *time.Time `json:"client_time,omitempty"`
+}
@@ -0,0 +1,427 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"zen-connect/internal/room/application/dto"
+	"zen-connect/internal/room/domain"
+)
+
+// Client 参加者のルームへの接続（本番ではWebSocket、テストではフェイク）
+type Client interface {
+	UserID() string
+	DisplayName() string
+	// Send イベントを送信キューに積む（ブロックしない）。送信が追いつかない場合はエラー
+	Send(event dto.RoomEvent) error
+	// Close 接続を閉じる。ハブのロック中に呼ばれるため、同期的に Leave を呼んではならない
+	Close()
+}
+
+// ExperienceRecorder 参加者の瞑想を体験記録コンテキストに下書きとして記録する
+type ExperienceRecorder interface {
+	RecordDraft(ctx context.Context, userID, meditationType, customType string, startTime, endTime time.Time) (experienceID string, err error)
+}
+
+// HubConfig ハブの設定
+type HubConfig struct {
+	// StartCountdown 開始イベントを送ってから実際に開始するまでの猶予
+	// 全参加者が開始前にイベントを受け取れるようにする
+	StartCountdown time.Duration
+	// MaxParticipants 1ルームに同時に参加できるユーザー数（0は無制限）
+	MaxParticipants int
+	// OnError タイマーから実行される処理など、呼び出し元に返せないエラーの通知先
+	OnError func(roomID string, err error)
+}
+
+// Hub グループ瞑想ルームの進行を管理するインプロセスのハブ
+// ルームの状態と参加者の接続はこのプロセス内に保持されるため、単一インスタンスで動作する。
+// 開始・ベル・終了はサーバーのタイマーで進行し、全参加者に同じ時刻付きで配信される
+type Hub struct {
+	repo     domain.RoomRepository
+	recorder ExperienceRecorder
+	config   HubConfig
+	now      func() time.Time
+
+	mu     sync.Mutex
+	rooms  map[string]*liveRoom
+	closed bool
+}
+
+// liveRoom 接続中の参加者がいる、または進行中のルーム
+type liveRoom struct {
+	mu          sync.Mutex
+	room        *domain.Room
+	clients     map[Client]bool
+	connections map[string]int
+	attendance  map[string]*domain.Attendance
+	names       map[string]string
+	timers      []*time.Timer
+	closed      bool
+}
+
+// NewHub コンストラクタ
+func NewHub(repo domain.RoomRepository, recorder ExperienceRecorder, config HubConfig) *Hub {
+	return &Hub{
+		repo:     repo,
+		recorder: recorder,
+		config:   config,
+		now:      time.Now,
+		rooms:    make(map[string]*liveRoom),
+	}
+}
+
+// Join 参加者をルームに接続し、状態を送信して在室状況を配信する
+// 開始前のルームは予定時刻に自動で開始される
+func (h *Hub) Join(ctx context.Context, roomID string, client Client) error {
+	lr, err := h.lockedRoom(ctx, roomID)
+	if err != nil {
+		return err
+	}
+	defer lr.mu.Unlock()
+
+	now := h.now()
+	if err := lr.room.CanJoin(now); err != nil {
+		return err
+	}
+	userID := client.UserID()
+	if h.config.MaxParticipants > 0 && lr.connections[userID] == 0 && len(lr.connections) >= h.config.MaxParticipants {
+		return domain.ErrRoomFull
+	}
+
+	lr.clients[client] = true
+	lr.connections[userID]++
+	lr.names[userID] = client.DisplayName()
+	if lr.attendance[userID] == nil {
+		lr.attendance[userID] = domain.NewAttendance(userID)
+	}
+	lr.attendance[userID].Join(now)
+
+	room := dto.FromRoom(lr.room)
+	_ = client.Send(dto.RoomEvent{
+		Type:         dto.EventState,
+		RoomID:       roomID,
+		ServerTime:   now,
+		Room:         &room,
+		Participants: lr.participants(),
+	})
+	h.broadcastPresence(lr, now)
+
+	if len(lr.timers) == 0 {
+		h.schedule(lr, now)
+	}
+	return nil
+}
+
+// Leave 参加者の接続を外し、在室状況を配信する
+func (h *Hub) Leave(roomID string, client Client) {
+	h.mu.Lock()
+	lr := h.rooms[roomID]
+	h.mu.Unlock()
+	if lr == nil {
+		return
+	}
+
+	lr.mu.Lock()
+	defer lr.mu.Unlock()
+	if !lr.clients[client] {
+		return
+	}
+
+	now := h.now()
+	userID := client.UserID()
+	delete(lr.clients, client)
+	lr.connections[userID]--
+	if lr.connections[userID] <= 0 {
+		delete(lr.connections, userID)
+		lr.attendance[userID].Leave(now)
+	}
+	h.broadcastPresence(lr, now)
+
+	// 開始前のルームは誰もいなくなったら解放し、次の参加時に読み込み直す
+	if len(lr.clients) == 0 && lr.room.Status() == domain.RoomStatusScheduled {
+		h.release(lr)
+	}
+}
+
+// Start ホストがルームを予定時刻より前に開始する
+func (h *Hub) Start(ctx context.Context, roomID, userID string) error {
+	lr, err := h.lockedRoom(ctx, roomID)
+	if err != nil {
+		return err
+	}
+	defer lr.mu.Unlock()
+
+	if !lr.room.IsHost(userID) {
+		return domain.ErrNotRoomHost
+	}
+	err = h.start(ctx, lr)
+	if len(lr.clients) == 0 && lr.room.Status() == domain.RoomStatusScheduled {
+		h.release(lr)
+	}
+	return err
+}
+
+// Cancel ホストが開始前のルームを中止し、参加者に通知して切断する
+func (h *Hub) Cancel(ctx context.Context, roomID, userID string) (*dto.RoomDTO, error) {
+	lr, err := h.lockedRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	defer lr.mu.Unlock()
+
+	now := h.now()
+	if err := lr.room.Cancel(userID, now); err != nil {
+		return nil, err
+	}
+	if err := h.repo.Save(ctx, lr.room); err != nil {
+		return nil, err
+	}
+
+	h.broadcast(lr, dto.RoomEvent{Type: dto.EventCancelled, RoomID: roomID, ServerTime: now})
+	h.release(lr)
+	response := dto.FromRoom(lr.room)
+	return &response, nil
+}
+
+// Shutdown すべてのタイマーを止めて接続を閉じる
+// 進行中のルームは再起動後の最初の参加時に残り時間から再開される
+func (h *Hub) Shutdown() {
+	h.mu.Lock()
+	h.closed = true
+	rooms := make([]*liveRoom, 0, len(h.rooms))
+	for _, lr := range h.rooms {
+		rooms = append(rooms, lr)
+	}
+	h.mu.Unlock()
+
+	for _, lr := range rooms {
+		lr.mu.Lock()
+		h.release(lr)
+		lr.mu.Unlock()
+	}
+}
+
+// lockedRoom 進行管理中のルームを lr.mu をロックした状態で取得
+// 取得とロックの間に解放されたルームは読み込み直す
+func (h *Hub) lockedRoom(ctx context.Context, roomID string) (*liveRoom, error) {
+	for {
+		lr, err := h.liveRoom(ctx, roomID)
+		if err != nil {
+			return nil, err
+		}
+		lr.mu.Lock()
+		if !lr.closed {
+			return lr, nil
+		}
+		lr.mu.Unlock()
+	}
+}
+
+// liveRoom 進行管理中のルームを取得（なければリポジトリから読み込む）
+func (h *Hub) liveRoom(ctx context.Context, roomID string) (*liveRoom, error) {
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		return nil, errors.New("room hub is shut down")
+	}
+	if lr, ok := h.rooms[roomID]; ok {
+		h.mu.Unlock()
+		return lr, nil
+	}
+	h.mu.Unlock()
+
+	room, err := h.repo.FindByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	// 読み込み中に他の参加者が先に登録した場合はそちらを使う
+	if lr, ok := h.rooms[roomID]; ok {
+		return lr, nil
+	}
+	lr := &liveRoom{
+		room:        room,
+		clients:     make(map[Client]bool),
+		connections: make(map[string]int),
+		attendance:  make(map[string]*domain.Attendance),
+		names:       make(map[string]string),
+	}
+	h.rooms[roomID] = lr
+	return lr, nil
+}
+
+// release ルームのタイマーを止め、接続を閉じてハブから外す（lr.mu を保持して呼ぶ）
+func (h *Hub) release(lr *liveRoom) {
+	for _, timer := range lr.timers {
+		timer.Stop()
+	}
+	lr.timers = nil
+	for client := range lr.clients {
+		client.Close()
+	}
+	lr.clients = make(map[Client]bool)
+	lr.closed = true
+
+	h.mu.Lock()
+	if h.rooms[lr.room.ID()] == lr {
+		delete(h.rooms, lr.room.ID())
+	}
+	h.mu.Unlock()
+}
+
+// schedule ルームの状態に応じて開始・ベル・終了のタイマーを設定（lr.mu を保持して呼ぶ）
+func (h *Hub) schedule(lr *liveRoom, now time.Time) {
+	switch lr.room.Status() {
+	case domain.RoomStatusScheduled:
+		h.after(lr, lr.room.ScheduledAt().Sub(now), func() error {
+			return h.start(context.Background(), lr)
+		})
+	case domain.RoomStatusInProgress:
+		for i, at := range lr.room.BellsAt() {
+			if at.Before(now) {
+				continue
+			}
+			bell, at := i+1, at
+			h.after(lr, at.Sub(now), func() error {
+				h.broadcast(lr, dto.RoomEvent{Type: dto.EventBell, RoomID: lr.room.ID(), ServerTime: h.now(), At: &at, Bell: bell})
+				return nil
+			})
+		}
+		h.after(lr, lr.room.EndsAt().Sub(now), func() error {
+			return h.end(lr)
+		})
+	}
+}
+
+// after d 経過後に fn を lr.mu を保持した状態で実行する
+func (h *Hub) after(lr *liveRoom, d time.Duration, fn func() error) {
+	lr.timers = append(lr.timers, time.AfterFunc(d, func() {
+		lr.mu.Lock()
+		defer lr.mu.Unlock()
+		if lr.closed {
+			return
+		}
+		if err := fn(); err != nil {
+			h.reportError(lr.room.ID(), err)
+		}
+	}))
+}
+
+// start カウントダウン後に開始するようルームを開始状態にして配信（lr.mu を保持して呼ぶ）
+func (h *Hub) start(ctx context.Context, lr *liveRoom) error {
+	now := h.now()
+	startsAt := now.Add(h.config.StartCountdown)
+	if err := lr.room.Start(startsAt, now); err != nil {
+		return err
+	}
+	if err := h.repo.Save(ctx, lr.room); err != nil {
+		return err
+	}
+
+	for _, timer := range lr.timers {
+		timer.Stop()
+	}
+	lr.timers = nil
+
+	room := dto.FromRoom(lr.room)
+	h.broadcast(lr, dto.RoomEvent{Type: dto.EventStart, RoomID: lr.room.ID(), ServerTime: now, At: &startsAt, Room: &room})
+	h.schedule(lr, now)
+	return nil
+}
+
+// end ルームを終了し、参加者ごとの瞑想を体験記録の下書きとして保存して通知する（lr.mu を保持して呼ぶ）
+func (h *Hub) end(lr *liveRoom) error {
+	ctx := context.Background()
+	now := h.now()
+	endsAt := lr.room.EndsAt()
+	if err := lr.room.End(endsAt); err != nil {
+		return err
+	}
+	if err := h.repo.Save(ctx, lr.room); err != nil {
+		return err
+	}
+	h.broadcast(lr, dto.RoomEvent{Type: dto.EventEnd, RoomID: lr.room.ID(), ServerTime: now, At: &endsAt})
+
+	var participations []domain.Participation
+	var errs []error
+	for userID, attendance := range lr.attendance {
+		from, to, ok := attendance.Participation(lr.room.StartedAt(), endsAt)
+		if !ok {
+			continue
+		}
+		experienceID, err := h.recorder.RecordDraft(ctx, userID, lr.room.MeditationType(), lr.room.CustomType(), from, to)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		participations = append(participations, domain.Participation{
+			RoomID:       lr.room.ID(),
+			UserID:       userID,
+			StartTime:    from,
+			EndTime:      to,
+			ExperienceID: experienceID,
+		})
+		for client := range lr.clients {
+			if client.UserID() == userID {
+				_ = client.Send(dto.RoomEvent{Type: dto.EventRecorded, RoomID: lr.room.ID(), ServerTime: h.now(), ExperienceID: experienceID})
+			}
+		}
+	}
+	if len(participations) > 0 {
+		if err := h.repo.SaveParticipations(ctx, participations); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	h.release(lr)
+	return errors.Join(errs...)
+}
+
+// broadcast すべての接続にイベントを送る（lr.mu を保持して呼ぶ）
+// 送信が追いつかない接続は閉じる
+func (h *Hub) broadcast(lr *liveRoom, event dto.RoomEvent) {
+	for client := range lr.clients {
+		if err := client.Send(event); err != nil {
+			client.Close()
+		}
+	}
+}
+
+// broadcastPresence 在室状況を配信（lr.mu を保持して呼ぶ）
+func (h *Hub) broadcastPresence(lr *liveRoom, now time.Time) {
+	h.broadcast(lr, dto.RoomEvent{
+		Type:         dto.EventPresence,
+		RoomID:       lr.room.ID(),
+		ServerTime:   now,
+		Participants: lr.participants(),
+	})
+}
+
+// participants 接続中の参加者（同じユーザーの複数接続は1人として数える）
+func (lr *liveRoom) participants() []dto.ParticipantDTO {
+	participants := make([]dto.ParticipantDTO, 0, len(lr.connections))
+	for userID := range lr.connections {
+		participants = append(participants, dto.ParticipantDTO{
+			UserID:      userID,
+			DisplayName: lr.names[userID],
+			IsHost:      lr.room.IsHost(userID),
+		})
+	}
+	sort.Slice(participants, func(i, j int) bool {
+		return participants[i].UserID < participants[j].UserID
+	})
+	return participants
+}
+
+// reportError 非同期処理のエラーを通知
+func (h *Hub) reportError(roomID string, err error) {
+	if h.config.OnError != nil {
+		h.config.OnError(roomID, err)
+	}
+}
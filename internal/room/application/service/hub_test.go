@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"zen-connect/internal/room/application/dto"
+	"zen-connect/internal/room/domain"
+)
+
+// fakeRoomRepository メモリ上のルームリポジトリ
+type fakeRoomRepository struct {
+	mu             sync.Mutex
+	rooms          map[string]*domain.Room
+	participations []domain.Participation
+}
+
+func (r *fakeRoomRepository) Save(ctx context.Context, room *domain.Room) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rooms[room.ID()] = room
+	return nil
+}
+
+func (r *fakeRoomRepository) FindByID(ctx context.Context, id string) (*domain.Room, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	room, ok := r.rooms[id]
+	if !ok {
+		return nil, domain.ErrRoomNotFound
+	}
+	return room, nil
+}
+
+func (r *fakeRoomRepository) FindUpcoming(ctx context.Context, now time.Time, limit int) ([]*domain.Room, error) {
+	return nil, nil
+}
+
+func (r *fakeRoomRepository) SaveParticipations(ctx context.Context, participations []domain.Participation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.participations = append(r.participations, participations...)
+	return nil
+}
+
+// fakeRecorder 記録した参加者ごとに体験記録IDを払い出す
+type fakeRecorder struct {
+	mu       sync.Mutex
+	recorded map[string]time.Duration
+}
+
+func (r *fakeRecorder) RecordDraft(ctx context.Context, userID, meditationType, customType string, startTime, endTime time.Time) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recorded[userID] = endTime.Sub(startTime)
+	return "experience-" + userID, nil
+}
+
+// fakeClient 受信したイベントを記録する接続
+type fakeClient struct {
+	userID string
+	events chan dto.RoomEvent
+	mu     sync.Mutex
+	closed bool
+}
+
+func newFakeClient(userID string) *fakeClient {
+	return &fakeClient{userID: userID, events: make(chan dto.RoomEvent, 64)}
+}
+
+func (c *fakeClient) UserID() string      { return c.userID }
+func (c *fakeClient) DisplayName() string { return "name of " + c.userID }
+
+func (c *fakeClient) Send(event dto.RoomEvent) error {
+	select {
+	case c.events <- event:
+		return nil
+	default:
+		return errors.New("client is too slow")
+	}
+}
+
+func (c *fakeClient) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+}
+
+func (c *fakeClient) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
+}
+
+// next 指定した種類のイベントを受信するまで待つ
+func (c *fakeClient) next(t *testing.T, eventType string) dto.RoomEvent {
+	t.Helper()
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case event := <-c.events:
+			if event.Type == eventType {
+				return event
+			}
+		case <-timeout:
+			t.Fatalf("Expected %s event for %s", eventType, c.userID)
+			return dto.RoomEvent{}
+		}
+	}
+}
+
+func newTestHub(t *testing.T, config HubConfig) (*Hub, *fakeRoomRepository, *fakeRecorder, *domain.Room) {
+	t.Helper()
+	now := time.Now()
+	room := domain.ReconstructRoom("room-1", "host", "朝の座禅会", "zazen", "",
+		now.Add(time.Hour), 120*time.Millisecond, 40*time.Millisecond,
+		domain.RoomStatusScheduled, time.Time{}, time.Time{}, now, now)
+	repo := &fakeRoomRepository{rooms: map[string]*domain.Room{room.ID(): room}}
+	recorder := &fakeRecorder{recorded: map[string]time.Duration{}}
+	hub := NewHub(repo, recorder, config)
+	t.Cleanup(hub.Shutdown)
+	return hub, repo, recorder, room
+}
+
+func TestHub_ShouldBroadcastPresenceCountingUsersOnce(t *testing.T) {
+	// given
+	hub, _, _, room := newTestHub(t, HubConfig{})
+	host := newFakeClient("host")
+	guest := newFakeClient("guest")
+	guestOtherTab := newFakeClient("guest")
+
+	// when
+	for _, client := range []*fakeClient{host, guest, guestOtherTab} {
+		if err := hub.Join(context.Background(), room.ID(), client); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	hub.Leave(room.ID(), guestOtherTab)
+
+	// then
+	state := guest.next(t, dto.EventState)
+	if state.Room == nil || state.Room.Status != string(domain.RoomStatusScheduled) {
+		t.Errorf("Expected state with the scheduled room, got %+v", state)
+	}
+	var presence dto.RoomEvent
+	for i := 0; i < 3; i++ {
+		presence = host.next(t, dto.EventPresence)
+	}
+	if len(presence.Participants) != 2 || !presence.Participants[1].IsHost {
+		t.Errorf("Expected host and guest once each, got %+v", presence.Participants)
+	}
+}
+
+func TestHub_ShouldRunSessionAndRecordEachParticipant(t *testing.T) {
+	// given
+	hub, repo, recorder, room := newTestHub(t, HubConfig{StartCountdown: 20 * time.Millisecond})
+	host := newFakeClient("host")
+	guest := newFakeClient("guest")
+	_ = hub.Join(context.Background(), room.ID(), host)
+	_ = hub.Join(context.Background(), room.ID(), guest)
+
+	// when
+	err := hub.Start(context.Background(), room.ID(), "host")
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	start := guest.next(t, dto.EventStart)
+	if start.At == nil || !start.At.After(start.ServerTime) {
+		t.Errorf("Expected start in the future after the countdown, got %+v", start)
+	}
+	for bell := 1; bell <= 2; bell++ {
+		event := guest.next(t, dto.EventBell)
+		if event.Bell != bell || !event.At.Equal(start.At.Add(time.Duration(bell)*40*time.Millisecond)) {
+			t.Errorf("Expected bell %d at its scheduled time, got %+v", bell, event)
+		}
+	}
+	end := guest.next(t, dto.EventEnd)
+	if !end.At.Equal(start.At.Add(120 * time.Millisecond)) {
+		t.Errorf("Expected end after the duration, got %s", end.At)
+	}
+	for _, client := range []*fakeClient{host, guest} {
+		recorded := client.next(t, dto.EventRecorded)
+		if recorded.ExperienceID != "experience-"+client.userID {
+			t.Errorf("Expected own experience ID, got %q", recorded.ExperienceID)
+		}
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	if recorder.recorded["guest"] != 120*time.Millisecond || len(recorder.recorded) != 2 {
+		t.Errorf("Expected both participants recorded for the whole session, got %v", recorder.recorded)
+	}
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if len(repo.participations) != 2 || room.Status() != domain.RoomStatusEnded {
+		t.Errorf("Expected ended room with 2 participations, got %s %v", room.Status(), repo.participations)
+	}
+	if !guest.isClosed() {
+		t.Error("Expected connections to be closed after the session")
+	}
+}
+
+func TestHub_OnlyHostShouldStartOrCancel(t *testing.T) {
+	// given
+	hub, _, _, room := newTestHub(t, HubConfig{})
+
+	// when
+	startErr := hub.Start(context.Background(), room.ID(), "guest")
+	_, cancelErr := hub.Cancel(context.Background(), room.ID(), "guest")
+
+	// then
+	if !errors.Is(startErr, domain.ErrNotRoomHost) || !errors.Is(cancelErr, domain.ErrNotRoomHost) {
+		t.Errorf("Expected ErrNotRoomHost, got %v / %v", startErr, cancelErr)
+	}
+}
+
+func TestHub_CancelShouldNotifyAndCloseParticipants(t *testing.T) {
+	// given
+	hub, _, _, room := newTestHub(t, HubConfig{})
+	guest := newFakeClient("guest")
+	_ = hub.Join(context.Background(), room.ID(), guest)
+
+	// when
+	_, err := hub.Cancel(context.Background(), room.ID(), "host")
+	joinErr := hub.Join(context.Background(), room.ID(), newFakeClient("late"))
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	guest.next(t, dto.EventCancelled)
+	if !guest.isClosed() {
+		t.Error("Expected participant to be disconnected")
+	}
+	if !errors.Is(joinErr, domain.ErrRoomCancelled) {
+		t.Errorf("Expected ErrRoomCancelled, got %v", joinErr)
+	}
+}
+
+func TestHub_ShouldLimitParticipants(t *testing.T) {
+	// given
+	hub, _, _, room := newTestHub(t, HubConfig{MaxParticipants: 2})
+	for i := 0; i < 2; i++ {
+		if err := hub.Join(context.Background(), room.ID(), newFakeClient(fmt.Sprintf("user-%d", i))); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	// when
+	fullErr := hub.Join(context.Background(), room.ID(), newFakeClient("user-2"))
+	sameUserErr := hub.Join(context.Background(), room.ID(), newFakeClient("user-0"))
+
+	// then
+	if !errors.Is(fullErr, domain.ErrRoomFull) {
+		t.Errorf("Expected ErrRoomFull, got %v", fullErr)
+	}
+	if sameUserErr != nil {
+		t.Errorf("Expected another connection of a participant to be accepted, got %v", sameUserErr)
+	}
+}
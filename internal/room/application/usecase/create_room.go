@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/room/application/dto"
+	"zen-connect/internal/room/domain"
+)
+
+// MeditationTypeResolver 瞑想タイプを体験記録コンテキストのカタログで正規IDに変換する
+type MeditationTypeResolver interface {
+	ResolveMeditationType(ctx context.Context, meditationType, customType string) (string, string, error)
+}
+
+// CreateRoomUseCase グループ瞑想ルーム作成ユースケース
+type CreateRoomUseCase struct {
+	roomRepo domain.RoomRepository
+	resolver MeditationTypeResolver
+}
+
+// NewCreateRoomUseCase コンストラクタ
+func NewCreateRoomUseCase(roomRepo domain.RoomRepository, resolver MeditationTypeResolver) *CreateRoomUseCase {
+	return &CreateRoomUseCase{
+		roomRepo: roomRepo,
+		resolver: resolver,
+	}
+}
+
+// Execute ルームを予定として作成（作成したユーザーがホストになる）
+func (uc *CreateRoomUseCase) Execute(ctx context.Context, req *dto.CreateRoomRequest) (*dto.RoomDTO, error) {
+	meditationType, customType, err := uc.resolver.ResolveMeditationType(ctx, req.MeditationType, req.CustomType)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := domain.NewRoom(
+		req.HostID,
+		req.Title,
+		meditationType,
+		customType,
+		req.ScheduledAt,
+		time.Duration(req.DurationSeconds)*time.Second,
+		time.Duration(req.BellIntervalSeconds)*time.Second,
+		time.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.roomRepo.Save(ctx, room); err != nil {
+		return nil, err
+	}
+
+	response := dto.FromRoom(room)
+	return &response, nil
+}
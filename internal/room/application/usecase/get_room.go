@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"zen-connect/internal/room/application/dto"
+	"zen-connect/internal/room/domain"
+)
+
+// upcomingRoomsLimit 一覧に表示するルームの上限
+const upcomingRoomsLimit = 50
+
+// GetRoomUseCase ルーム取得ユースケース
+type GetRoomUseCase struct {
+	roomRepo domain.RoomRepository
+}
+
+// NewGetRoomUseCase コンストラクタ
+func NewGetRoomUseCase(roomRepo domain.RoomRepository) *GetRoomUseCase {
+	return &GetRoomUseCase{
+		roomRepo: roomRepo,
+	}
+}
+
+// Execute ルームを取得
+func (uc *GetRoomUseCase) Execute(ctx context.Context, roomID string) (*dto.RoomDTO, error) {
+	room, err := uc.roomRepo.FindByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.FromRoom(room)
+	return &response, nil
+}
+
+// ListRoomsUseCase 参加できるルーム一覧ユースケース
+type ListRoomsUseCase struct {
+	roomRepo domain.RoomRepository
+}
+
+// NewListRoomsUseCase コンストラクタ
+func NewListRoomsUseCase(roomRepo domain.RoomRepository) *ListRoomsUseCase {
+	return &ListRoomsUseCase{
+		roomRepo: roomRepo,
+	}
+}
+
+// Execute 開始前・進行中のルームを開始が近い順に取得（予定時刻を過ぎても開始されなかったものは除く）
+func (uc *ListRoomsUseCase) Execute(ctx context.Context) (*dto.ListRoomsResponse, error) {
+	now := time.Now()
+	rooms, err := uc.roomRepo.FindUpcoming(ctx, now, upcomingRoomsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListRoomsResponse{
+		Rooms: []dto.RoomDTO{},
+	}
+	for _, room := range rooms {
+		if room.IsExpired(now) {
+			continue
+		}
+		response.Rooms = append(response.Rooms, dto.FromRoom(room))
+	}
+	return response, nil
+}
@@ -0,0 +1,70 @@
+package domain
+
+import "time"
+
+// presenceInterval is a span during which a participant was connected;
+// leftAt is zero while still connected
+type presenceInterval struct {
+	joinedAt time.Time
+	leftAt   time.Time
+}
+
+// Attendance tracks when a participant was present in a room
+type Attendance struct {
+	userID    string
+	intervals []presenceInterval
+}
+
+// NewAttendance creates an empty attendance record for a participant
+func NewAttendance(userID string) *Attendance {
+	return &Attendance{userID: userID}
+}
+
+// UserID returns the participant's user ID
+func (a *Attendance) UserID() string {
+	return a.userID
+}
+
+// IsPresent reports whether the participant is connected
+func (a *Attendance) IsPresent() bool {
+	n := len(a.intervals)
+	return n > 0 && a.intervals[n-1].leftAt.IsZero()
+}
+
+// Join records that the participant connected
+func (a *Attendance) Join(now time.Time) {
+	if !a.IsPresent() {
+		a.intervals = append(a.intervals, presenceInterval{joinedAt: now})
+	}
+}
+
+// Leave records that the participant disconnected
+func (a *Attendance) Leave(now time.Time) {
+	if a.IsPresent() {
+		a.intervals[len(a.intervals)-1].leftAt = now
+	}
+}
+
+// Participation returns the part of the session from start to end during
+// which the participant was present: from their first presence to their
+// last. ok is false when they were never present during the session.
+func (a *Attendance) Participation(start, end time.Time) (from, to time.Time, ok bool) {
+	for _, interval := range a.intervals {
+		left := interval.leftAt
+		if left.IsZero() || left.After(end) {
+			left = end
+		}
+		joined := interval.joinedAt
+		if joined.Before(start) {
+			joined = start
+		}
+		if !left.After(joined) {
+			continue
+		}
+		if !ok {
+			from, ok = joined, true
+		}
+		to = left
+	}
+	return from, to, ok
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAttendance_ShouldSpanFirstToLastPresenceWithinSession(t *testing.T) {
+	// given
+	start := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	end := start.Add(20 * time.Minute)
+	attendance := NewAttendance("user-1")
+	attendance.Join(start.Add(-5 * time.Minute))
+	attendance.Leave(start.Add(3 * time.Minute))
+	attendance.Join(start.Add(4 * time.Minute))
+
+	// when
+	from, to, ok := attendance.Participation(start, end)
+
+	// then
+	if !ok || !from.Equal(start) || !to.Equal(end) {
+		t.Errorf("Expected participation from start to end, got %s - %s (%v)", from, to, ok)
+	}
+}
+
+func TestAttendance_ShouldIgnorePresenceOutsideSession(t *testing.T) {
+	// given
+	start := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	end := start.Add(20 * time.Minute)
+	attendance := NewAttendance("user-1")
+	attendance.Join(start.Add(-5 * time.Minute))
+	attendance.Leave(start)
+
+	// when
+	_, _, ok := attendance.Participation(start, end)
+
+	// then
+	if ok {
+		t.Error("Expected no participation for a participant who left before the start")
+	}
+}
@@ -0,0 +1,45 @@
+package domain
+
+import "errors"
+
+// Room domain errors
+var (
+	// ErrRoomNotFound ルームが見つからない
+	ErrRoomNotFound = errors.New("room not found")
+
+	// ErrInvalidRoomTitle タイトルが空または長すぎる
+	ErrInvalidRoomTitle = errors.New("room title must be 1 to 100 characters")
+
+	// ErrEmptyMeditationType 瞑想の種類が空
+	ErrEmptyMeditationType = errors.New("meditation type cannot be empty")
+
+	// ErrInvalidRoomDuration 瞑想時間が範囲外
+	ErrInvalidRoomDuration = errors.New("room duration must be between 1 minute and 3 hours")
+
+	// ErrInvalidBellInterval ベルの間隔が範囲外
+	ErrInvalidBellInterval = errors.New("bell interval must be at least 1 minute and shorter than the duration")
+
+	// ErrRoomScheduledInPast 過去の日時に予定された
+	ErrRoomScheduledInPast = errors.New("room cannot be scheduled in the past")
+
+	// ErrNotRoomHost ホスト以外による操作
+	ErrNotRoomHost = errors.New("only the host can do this")
+
+	// ErrRoomNotScheduled 開始前ではない
+	ErrRoomNotScheduled = errors.New("room is not scheduled")
+
+	// ErrRoomNotInProgress 進行中ではない
+	ErrRoomNotInProgress = errors.New("room is not in progress")
+
+	// ErrRoomEnded 既に終了した
+	ErrRoomEnded = errors.New("room has ended")
+
+	// ErrRoomCancelled 中止された
+	ErrRoomCancelled = errors.New("room was cancelled")
+
+	// ErrRoomExpired 予定時刻を過ぎても開始されなかった
+	ErrRoomExpired = errors.New("room expired without being started")
+
+	// ErrRoomFull 参加者数の上限に達した
+	ErrRoomFull = errors.New("room is full")
+)
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Participation is a participant's part in a finished room session and the
+// experience it was recorded as
+type Participation struct {
+	RoomID       string
+	UserID       string
+	StartTime    time.Time
+	EndTime      time.Time
+	ExperienceID string
+}
+
+// RoomRepository defines the interface for room persistence
+type RoomRepository interface {
+	Save(ctx context.Context, room *Room) error
+	FindByID(ctx context.Context, id string) (*Room, error)
+	// FindUpcoming returns rooms that are scheduled or in progress, soonest first
+	FindUpcoming(ctx context.Context, now time.Time, limit int) ([]*Room, error)
+	SaveParticipations(ctx context.Context, participations []Participation) error
+}
@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// RoomStatus is the lifecycle state of a group meditation room
+type RoomStatus string
+
+const (
+	RoomStatusScheduled  RoomStatus = "scheduled"
+	RoomStatusInProgress RoomStatus = "in_progress"
+	RoomStatusEnded      RoomStatus = "ended"
+	RoomStatusCancelled  RoomStatus = "cancelled"
+)
+
+// Limits of a room's schedule
+const (
+	maxRoomTitleLength = 100
+	minRoomDuration    = time.Minute
+	maxRoomDuration    = 3 * time.Hour
+	minBellInterval    = time.Minute
+	// scheduleTolerance allows a room to be scheduled "now" despite clock skew
+	scheduleTolerance = time.Minute
+)
+
+// Room is a group meditation session scheduled by a host (aggregate root).
+// Participants meditate together from startedAt for duration, with a bell
+// every bellInterval when it is set.
+type Room struct {
+	id             string
+	hostID         string
+	title          string
+	meditationType string
+	customType     string
+	scheduledAt    time.Time
+	duration       time.Duration
+	bellInterval   time.Duration
+	status         RoomStatus
+	startedAt      time.Time
+	endedAt        time.Time
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+// NewRoom schedules a new room with validation. The meditation type is
+// checked against the catalog when participants' sessions are recorded.
+func NewRoom(hostID, title, meditationType, customType string, scheduledAt time.Time, duration, bellInterval time.Duration, now time.Time) (*Room, error) {
+	title = strings.TrimSpace(title)
+	if title == "" || utf8.RuneCountInString(title) > maxRoomTitleLength {
+		return nil, ErrInvalidRoomTitle
+	}
+	if strings.TrimSpace(meditationType) == "" {
+		return nil, ErrEmptyMeditationType
+	}
+	if duration < minRoomDuration || duration > maxRoomDuration {
+		return nil, ErrInvalidRoomDuration
+	}
+	if bellInterval != 0 && (bellInterval < minBellInterval || bellInterval >= duration) {
+		return nil, ErrInvalidBellInterval
+	}
+	if scheduledAt.Before(now.Add(-scheduleTolerance)) {
+		return nil, ErrRoomScheduledInPast
+	}
+
+	return &Room{
+		id:             uuid.New().String(),
+		hostID:         hostID,
+		title:          title,
+		meditationType: meditationType,
+		customType:     strings.TrimSpace(customType),
+		scheduledAt:    scheduledAt,
+		duration:       duration,
+		bellInterval:   bellInterval,
+		status:         RoomStatusScheduled,
+		createdAt:      now,
+		updatedAt:      now,
+	}, nil
+}
+
+// ReconstructRoom restores a room from persistence
+func ReconstructRoom(
+	id string,
+	hostID string,
+	title string,
+	meditationType string,
+	customType string,
+	scheduledAt time.Time,
+	duration time.Duration,
+	bellInterval time.Duration,
+	status RoomStatus,
+	startedAt time.Time,
+	endedAt time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Room {
+	return &Room{
+		id:             id,
+		hostID:         hostID,
+		title:          title,
+		meditationType: meditationType,
+		customType:     customType,
+		scheduledAt:    scheduledAt,
+		duration:       duration,
+		bellInterval:   bellInterval,
+		status:         status,
+		startedAt:      startedAt,
+		endedAt:        endedAt,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}
+}
+
+// Getter methods
+func (r *Room) ID() string                  { return r.id }
+func (r *Room) HostID() string              { return r.hostID }
+func (r *Room) Title() string               { return r.title }
+func (r *Room) MeditationType() string      { return r.meditationType }
+func (r *Room) CustomType() string          { return r.customType }
+func (r *Room) ScheduledAt() time.Time      { return r.scheduledAt }
+func (r *Room) Duration() time.Duration     { return r.duration }
+func (r *Room) BellInterval() time.Duration { return r.bellInterval }
+func (r *Room) Status() RoomStatus          { return r.status }
+func (r *Room) StartedAt() time.Time        { return r.startedAt }
+func (r *Room) EndedAt() time.Time          { return r.endedAt }
+func (r *Room) CreatedAt() time.Time        { return r.createdAt }
+func (r *Room) UpdatedAt() time.Time        { return r.updatedAt }
+
+// IsHost checks if the user is the room's host
+func (r *Room) IsHost(userID string) bool {
+	return r.hostID == userID
+}
+
+// IsExpired reports whether a scheduled room was never started and its
+// whole session would already be over
+func (r *Room) IsExpired(now time.Time) bool {
+	return r.status == RoomStatusScheduled && now.After(r.scheduledAt.Add(r.duration))
+}
+
+// CanJoin checks whether participants can still join the room
+func (r *Room) CanJoin(now time.Time) error {
+	switch {
+	case r.status == RoomStatusEnded:
+		return ErrRoomEnded
+	case r.status == RoomStatusCancelled:
+		return ErrRoomCancelled
+	case r.IsExpired(now):
+		return ErrRoomExpired
+	}
+	return nil
+}
+
+// Start begins the session at startsAt, which may lie slightly in the future
+// so that every participant receives the start before it happens
+func (r *Room) Start(startsAt, now time.Time) error {
+	if r.status != RoomStatusScheduled {
+		return ErrRoomNotScheduled
+	}
+	if r.IsExpired(now) {
+		return ErrRoomExpired
+	}
+	r.status = RoomStatusInProgress
+	r.startedAt = startsAt
+	r.updatedAt = now
+	return nil
+}
+
+// EndsAt returns when a started session ends
+func (r *Room) EndsAt() time.Time {
+	return r.startedAt.Add(r.duration)
+}
+
+// BellsAt returns when the bells ring during a started session; the start and
+// the end have their own events and are not included
+func (r *Room) BellsAt() []time.Time {
+	if r.bellInterval == 0 || r.startedAt.IsZero() {
+		return nil
+	}
+	var bells []time.Time
+	for at := r.startedAt.Add(r.bellInterval); at.Before(r.EndsAt()); at = at.Add(r.bellInterval) {
+		bells = append(bells, at)
+	}
+	return bells
+}
+
+// End finishes a session in progress
+func (r *Room) End(now time.Time) error {
+	if r.status != RoomStatusInProgress {
+		return ErrRoomNotInProgress
+	}
+	r.status = RoomStatusEnded
+	r.endedAt = now
+	r.updatedAt = now
+	return nil
+}
+
+// Cancel calls off a room that has not started; only the host can cancel it
+func (r *Room) Cancel(userID string, now time.Time) error {
+	if !r.IsHost(userID) {
+		return ErrNotRoomHost
+	}
+	if r.status != RoomStatusScheduled {
+		return ErrRoomNotScheduled
+	}
+	r.status = RoomStatusCancelled
+	r.updatedAt = now
+	return nil
+}
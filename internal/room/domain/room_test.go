@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newTestRoom(t *testing.T, now time.Time) *Room {
+	t.Helper()
+	room, err := NewRoom("host-1", "朝の座禅会", "zazen", "", now.Add(time.Hour), 20*time.Minute, 5*time.Minute, now)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return room
+}
+
+func TestNewRoom_ShouldValidateSchedule(t *testing.T) {
+	// given
+	now := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+
+	cases := map[error]func() error{
+		ErrInvalidRoomTitle: func() error {
+			_, err := NewRoom("host-1", "  ", "zazen", "", now, 20*time.Minute, 0, now)
+			return err
+		},
+		ErrEmptyMeditationType: func() error {
+			_, err := NewRoom("host-1", "座禅会", "", "", now, 20*time.Minute, 0, now)
+			return err
+		},
+		ErrInvalidRoomDuration: func() error {
+			_, err := NewRoom("host-1", "座禅会", "zazen", "", now, 30*time.Second, 0, now)
+			return err
+		},
+		ErrInvalidBellInterval: func() error {
+			_, err := NewRoom("host-1", "座禅会", "zazen", "", now, 20*time.Minute, 20*time.Minute, now)
+			return err
+		},
+		ErrRoomScheduledInPast: func() error {
+			_, err := NewRoom("host-1", "座禅会", "zazen", "", now.Add(-time.Hour), 20*time.Minute, 0, now)
+			return err
+		},
+	}
+
+	for expected, create := range cases {
+		// when
+		err := create()
+
+		// then
+		if !errors.Is(err, expected) {
+			t.Errorf("Expected %v, got %v", expected, err)
+		}
+	}
+}
+
+func TestRoom_ShouldRingBellsBetweenStartAndEnd(t *testing.T) {
+	// given
+	now := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	room := newTestRoom(t, now)
+	startsAt := now.Add(time.Hour)
+
+	// when
+	err := room.Start(startsAt, now.Add(time.Hour-5*time.Second))
+
+	// then
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	bells := room.BellsAt()
+	if len(bells) != 3 || !bells[0].Equal(startsAt.Add(5*time.Minute)) || !bells[2].Equal(startsAt.Add(15*time.Minute)) {
+		t.Errorf("Expected bells at 5, 10 and 15 minutes, got %v", bells)
+	}
+	if !room.EndsAt().Equal(startsAt.Add(20 * time.Minute)) {
+		t.Errorf("Expected room to end after 20 minutes, got %s", room.EndsAt())
+	}
+}
+
+func TestRoom_ShouldFollowLifecycle(t *testing.T) {
+	// given
+	now := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	room := newTestRoom(t, now)
+	expired := newTestRoom(t, now)
+
+	// when
+	cancelByGuestErr := room.Cancel("guest-1", now)
+	_ = room.Start(now, now)
+	cancelStartedErr := room.Cancel("host-1", now)
+	_ = room.End(now.Add(20 * time.Minute))
+	joinEndedErr := room.CanJoin(now.Add(21 * time.Minute))
+	joinExpiredErr := expired.CanJoin(now.Add(2 * time.Hour))
+
+	// then
+	expected := map[error]error{
+		ErrNotRoomHost:      cancelByGuestErr,
+		ErrRoomNotScheduled: cancelStartedErr,
+		ErrRoomEnded:        joinEndedErr,
+		ErrRoomExpired:      joinExpiredErr,
+	}
+	for want, got := range expected {
+		if !errors.Is(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	experienceservice "zen-connect/internal/experience/application/service"
+	experienceusecase "zen-connect/internal/experience/application/usecase"
+	experiencedomain "zen-connect/internal/experience/domain"
+)
+
+// ExperienceAdapter connects rooms to the experience context: it resolves
+// meditation types against the catalog and records each participant's
+// session as a draft experience
+type ExperienceAdapter struct {
+	catalogService *experienceservice.MeditationTypeCatalogService
+	recordDraft    *experienceusecase.RecordDraftExperienceUseCase
+}
+
+// NewExperienceAdapter creates a new experience adapter
+func NewExperienceAdapter(catalogService *experienceservice.MeditationTypeCatalogService, recordDraft *experienceusecase.RecordDraftExperienceUseCase) *ExperienceAdapter {
+	return &ExperienceAdapter{
+		catalogService: catalogService,
+		recordDraft:    recordDraft,
+	}
+}
+
+// ResolveMeditationType returns the canonical catalog ID of a meditation type
+func (a *ExperienceAdapter) ResolveMeditationType(ctx context.Context, meditationType, customType string) (string, string, error) {
+	catalog, err := a.catalogService.Catalog(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	return experiencedomain.ResolveMeditationType(meditationType, customType, catalog)
+}
+
+// RecordDraft records a participant's session and returns the experience ID
+func (a *ExperienceAdapter) RecordDraft(ctx context.Context, userID, meditationType, customType string, startTime, endTime time.Time) (string, error) {
+	experience, err := a.recordDraft.Execute(ctx, userID, meditationType, customType, startTime, endTime)
+	if err != nil {
+		return "", err
+	}
+	return experience.ExperienceID, nil
+}
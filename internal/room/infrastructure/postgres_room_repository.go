@@ -0,0 +1,181 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"zen-connect/internal/room/domain"
+)
+
+// PostgresRoomRepository implements RoomRepository interface
+type PostgresRoomRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresRoomRepository creates a new PostgreSQL room repository
+func NewPostgresRoomRepository(pool *pgxpool.Pool) *PostgresRoomRepository {
+	return &PostgresRoomRepository{
+		pool: pool,
+	}
+}
+
+const roomColumns = `
+	id, host_id, title, meditation_type, custom_meditation_type, scheduled_at,
+	duration_seconds, bell_interval_seconds, status, started_at, ended_at,
+	created_at, updated_at
+`
+
+// Save inserts a room or updates its state
+func (r *PostgresRoomRepository) Save(ctx context.Context, room *domain.Room) error {
+	query := `
+		INSERT INTO rooms (` + roomColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			started_at = EXCLUDED.started_at,
+			ended_at = EXCLUDED.ended_at,
+			updated_at = EXCLUDED.updated_at
+	`
+	_, err := r.pool.Exec(ctx, query,
+		room.ID(),
+		room.HostID(),
+		room.Title(),
+		room.MeditationType(),
+		room.CustomType(),
+		room.ScheduledAt(),
+		int64(room.Duration().Seconds()),
+		int64(room.BellInterval().Seconds()),
+		string(room.Status()),
+		nullTime(room.StartedAt()),
+		nullTime(room.EndedAt()),
+		room.CreatedAt(),
+		room.UpdatedAt(),
+	)
+	return err
+}
+
+// FindByID finds a room by ID
+func (r *PostgresRoomRepository) FindByID(ctx context.Context, id string) (*domain.Room, error) {
+	room, err := scanRoom(r.pool.QueryRow(ctx, `SELECT `+roomColumns+` FROM rooms WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrRoomNotFound
+		}
+		return nil, err
+	}
+	return room, nil
+}
+
+// FindUpcoming returns scheduled and in-progress rooms, soonest first.
+// Rooms still marked in progress long after they should have ended are skipped.
+func (r *PostgresRoomRepository) FindUpcoming(ctx context.Context, now time.Time, limit int) ([]*domain.Room, error) {
+	query := `
+		SELECT ` + roomColumns + ` FROM rooms
+		WHERE status = 'scheduled'
+			OR (status = 'in_progress' AND started_at + duration_seconds * INTERVAL '1 second' > $1)
+		ORDER BY scheduled_at
+		LIMIT $2
+	`
+	rows, err := r.pool.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rooms []*domain.Room
+	for rows.Next() {
+		room, err := scanRoom(rows)
+		if err != nil {
+			return nil, err
+		}
+		rooms = append(rooms, room)
+	}
+	return rooms, rows.Err()
+}
+
+// SaveParticipations records participants of a finished room in one transaction
+func (r *PostgresRoomRepository) SaveParticipations(ctx context.Context, participations []domain.Participation) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO room_participants (room_id, user_id, start_time, end_time, experience_id)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (room_id, user_id) DO NOTHING
+	`
+	for _, p := range participations {
+		var experienceID *string
+		if p.ExperienceID != "" {
+			experienceID = &p.ExperienceID
+		}
+		if _, err := tx.Exec(ctx, query, p.RoomID, p.UserID, p.StartTime, p.EndTime, experienceID); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// scanRoom reconstructs a room from a result row
+func scanRoom(row pgx.Row) (*domain.Room, error) {
+	var id, hostID, title, meditationType, customMeditationType, status string
+	var scheduledAt, createdAt, updatedAt time.Time
+	var durationSeconds, bellIntervalSeconds int64
+	var startedAt, endedAt *time.Time
+
+	err := row.Scan(
+		&id,
+		&hostID,
+		&title,
+		&meditationType,
+		&customMeditationType,
+		&scheduledAt,
+		&durationSeconds,
+		&bellIntervalSeconds,
+		&status,
+		&startedAt,
+		&endedAt,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstructRoom(
+		id,
+		hostID,
+		title,
+		meditationType,
+		customMeditationType,
+		scheduledAt,
+		time.Duration(durationSeconds)*time.Second,
+		time.Duration(bellIntervalSeconds)*time.Second,
+		domain.RoomStatus(status),
+		timeValue(startedAt),
+		timeValue(endedAt),
+		createdAt,
+		updatedAt,
+	), nil
+}
+
+// nullTime maps the zero time to NULL
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// timeValue maps NULL to the zero time
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
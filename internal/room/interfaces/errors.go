@@ -0,0 +1,105 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/room/domain"
+)
+
+// ErrorMappings グループ瞑想ルームのエラーとHTTPレスポンスの対応
+func ErrorMappings() []problem.Mapping {
+	return []problem.Mapping{
+		{
+			Err: domain.ErrRoomNotFound, Status: http.StatusNotFound, Code: "room_not_found",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "ルームが見つかりません。",
+				problem.LanguageEnglish:  "The room was not found.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidRoomTitle, Status: http.StatusBadRequest, Code: "invalid_room_title",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "ルーム名は1〜100文字で入力してください。",
+				problem.LanguageEnglish:  "The room title must be 1 to 100 characters.",
+			},
+		},
+		{
+			Err: domain.ErrEmptyMeditationType, Status: http.StatusBadRequest, Code: "meditation_type_required",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "瞑想の種類を指定してください。",
+				problem.LanguageEnglish:  "The meditation type is required.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidRoomDuration, Status: http.StatusBadRequest, Code: "invalid_room_duration",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "瞑想時間は1分から3時間の間で指定してください。",
+				problem.LanguageEnglish:  "The duration must be between 1 minute and 3 hours.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidBellInterval, Status: http.StatusBadRequest, Code: "invalid_bell_interval",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "ベルの間隔は1分以上、瞑想時間より短く指定してください。",
+				problem.LanguageEnglish:  "The bell interval must be at least 1 minute and shorter than the duration.",
+			},
+		},
+		{
+			Err: domain.ErrRoomScheduledInPast, Status: http.StatusBadRequest, Code: "room_scheduled_in_past",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "開始予定時刻に過去の日時は指定できません。",
+				problem.LanguageEnglish:  "The room cannot be scheduled in the past.",
+			},
+		},
+		{
+			Err: domain.ErrNotRoomHost, Status: http.StatusForbidden, Code: "not_room_host",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "この操作はホストのみ行えます。",
+				problem.LanguageEnglish:  "Only the host can do this.",
+			},
+		},
+		{
+			Err: domain.ErrRoomNotScheduled, Status: http.StatusConflict, Code: "room_not_scheduled",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "このルームは既に開始されています。",
+				problem.LanguageEnglish:  "The room has already started.",
+			},
+		},
+		{
+			Err: domain.ErrRoomNotInProgress, Status: http.StatusConflict, Code: "room_not_in_progress",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "このルームは進行中ではありません。",
+				problem.LanguageEnglish:  "The room is not in progress.",
+			},
+		},
+		{
+			Err: domain.ErrRoomEnded, Status: http.StatusConflict, Code: "room_ended",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "このルームは終了しました。",
+				problem.LanguageEnglish:  "The room has ended.",
+			},
+		},
+		{
+			Err: domain.ErrRoomCancelled, Status: http.StatusConflict, Code: "room_cancelled",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "このルームは中止されました。",
+				problem.LanguageEnglish:  "The room was cancelled.",
+			},
+		},
+		{
+			Err: domain.ErrRoomExpired, Status: http.StatusConflict, Code: "room_expired",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "このルームは予定時刻を過ぎても開始されませんでした。",
+				problem.LanguageEnglish:  "The room expired without being started.",
+			},
+		},
+		{
+			Err: domain.ErrRoomFull, Status: http.StatusConflict, Code: "room_full",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "ルームの参加人数が上限に達しています。",
+				problem.LanguageEnglish:  "The room is full.",
+			},
+		},
+	}
+}
@@ -0,0 +1,265 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/infrastructure/logger"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/room/application/dto"
+	"zen-connect/internal/room/application/service"
+	"zen-connect/internal/room/application/usecase"
+	"zen-connect/internal/shared/openapi"
+
+	"go.uber.org/zap"
+)
+
+// RoomHandler グループ瞑想ルーム関連のHTTP・WebSocketハンドラー
+type RoomHandler struct {
+	createRoomUseCase *usecase.CreateRoomUseCase
+	listRoomsUseCase  *usecase.ListRoomsUseCase
+	getRoomUseCase    *usecase.GetRoomUseCase
+	hub               *service.Hub
+	upgrader          websocket.Upgrader
+}
+
+// NewRoomHandler コンストラクタ
+// checkOrigin はWebSocket接続を許可するOriginの判定（CORSの許可Originと同じものを使う）
+func NewRoomHandler(
+	createRoomUseCase *usecase.CreateRoomUseCase,
+	listRoomsUseCase *usecase.ListRoomsUseCase,
+	getRoomUseCase *usecase.GetRoomUseCase,
+	hub *service.Hub,
+	checkOrigin func(origin string) bool,
+) *RoomHandler {
+	return &RoomHandler{
+		createRoomUseCase: createRoomUseCase,
+		listRoomsUseCase:  listRoomsUseCase,
+		getRoomUseCase:    getRoomUseCase,
+		hub:               hub,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin: func(r *http.Request) bool {
+				// ブラウザ以外のクライアントはOriginを送らない
+				origin := r.Header.Get(echo.HeaderOrigin)
+				return origin == "" || (checkOrigin != nil && checkOrigin(origin))
+			},
+		},
+	}
+}
+
+// SetupRoutes グループ瞑想ルーム関連のルーティング設定
+// idempotency は作成エンドポイントに適用するIdempotency-Keyミドルウェア
+func (h *RoomHandler) SetupRoutes(e *echo.Echo, sessionMiddleware *session.Middleware, idempotency echo.MiddlewareFunc) {
+	roomGroup := e.Group("/rooms", sessionMiddleware.RequireAuth())
+
+	roomGroup.POST("", h.CreateRoom, idempotency)
+	roomGroup.GET("", h.ListRooms)
+	roomGroup.GET("/:id", h.GetRoom)
+	roomGroup.POST("/:id/start", h.StartRoom)
+	roomGroup.POST("/:id/cancel", h.CancelRoom)
+	// 参加者はWebSocketで接続し、開始・ベル・終了・在室状況のイベントを受け取る
+	roomGroup.GET("/:id/ws", h.ConnectRoom)
+}
+
+// Endpoints OpenAPIドキュメント用のエンドポイント定義
+func (h *RoomHandler) Endpoints() []openapi.Endpoint {
+	tags := []string{"rooms"}
+	security := []string{openapi.SecuritySession}
+	return []openapi.Endpoint{
+		{
+			Method: http.MethodPost, Path: "/rooms", Tags: tags,
+			Summary:     "Schedule a group meditation room",
+			Description: "The user who creates the room is its host. The room starts automatically at scheduled_at, or earlier when the host starts it.",
+			Security:    security,
+			Headers:     []openapi.Parameter{openapi.IdempotencyKeyHeader},
+			Request:     dto.CreateRoomRequest{},
+			Responses: map[int]interface{}{
+				http.StatusCreated:             dto.RoomDTO{},
+				http.StatusBadRequest:          nil,
+				http.StatusUnauthorized:        nil,
+				http.StatusConflict:            nil,
+				http.StatusUnprocessableEntity: nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/rooms", Tags: tags,
+			Summary:  "List scheduled and in-progress rooms",
+			Security: security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.ListRoomsResponse{},
+				http.StatusUnauthorized: nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/rooms/:id", Tags: tags,
+			Summary:  "Get a room",
+			Security: security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.RoomDTO{},
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+			},
+		},
+		{
+			Method: http.MethodPost, Path: "/rooms/:id/start", Tags: tags,
+			Summary:     "Start a room before its scheduled time (host only)",
+			Description: "Connected participants receive a start event after a short countdown.",
+			Security:    security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.RoomDTO{},
+				http.StatusUnauthorized: nil,
+				http.StatusForbidden:    nil,
+				http.StatusNotFound:     nil,
+				http.StatusConflict:     nil,
+			},
+		},
+		{
+			Method: http.MethodPost, Path: "/rooms/:id/cancel", Tags: tags,
+			Summary:     "Cancel a scheduled room (host only)",
+			Description: "Connected participants receive a cancelled event and are disconnected.",
+			Security:    security,
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.RoomDTO{},
+				http.StatusUnauthorized: nil,
+				http.StatusForbidden:    nil,
+				http.StatusNotFound:     nil,
+				http.StatusConflict:     nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/rooms/:id/ws", Tags: tags,
+			Summary: "Join a room over WebSocket",
+			Description: "Server events (RoomEvent): state, presence, start, bell, end, recorded, cancelled, time_sync and error. " +
+				"Every event carries server_time; start, bell and end carry the synchronized time at which they happen. " +
+				"Clients send RoomCommand messages: time_sync with client_time to measure their clock offset, and start (host only).",
+			Security: security,
+			Responses: map[int]interface{}{
+				http.StatusSwitchingProtocols: dto.RoomEvent{},
+				http.StatusBadRequest:         nil,
+				http.StatusUnauthorized:       nil,
+				http.StatusForbidden:          nil,
+				http.StatusNotFound:           nil,
+				http.StatusConflict:           nil,
+			},
+		},
+	}
+}
+
+// CreateRoom ルームを作成
+func (h *RoomHandler) CreateRoom(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	var req dto.CreateRoomRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.HostID = userID
+
+	response, err := h.createRoomUseCase.Execute(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// ListRooms 参加できるルーム一覧を取得
+func (h *RoomHandler) ListRooms(c echo.Context) error {
+	response, err := h.listRoomsUseCase.Execute(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetRoom ルームを取得
+func (h *RoomHandler) GetRoom(c echo.Context) error {
+	response, err := h.getRoomUseCase.Execute(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// StartRoom ホストがルームを予定時刻より前に開始
+func (h *RoomHandler) StartRoom(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	ctx := c.Request().Context()
+	if err := h.hub.Start(ctx, c.Param("id"), userID); err != nil {
+		return err
+	}
+	response, err := h.getRoomUseCase.Execute(ctx, c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// CancelRoom ホストが開始前のルームを中止
+func (h *RoomHandler) CancelRoom(c echo.Context) error {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	response, err := h.hub.Cancel(c.Request().Context(), c.Param("id"), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ConnectRoom WebSocketでルームに参加
+// 参加できない場合はアップグレード前に通常のエラーレスポンスを返す
+func (h *RoomHandler) ConnectRoom(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := session.GetUserIDFromContext(ctx)
+	if !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+	if !h.upgrader.CheckOrigin(c.Request()) {
+		logger.GetGlobalLogger().LogSecurityEvent(ctx, "websocket_origin_rejected", "medium",
+			"WebSocket connection from a disallowed origin",
+			zap.String("origin", c.Request().Header.Get(echo.HeaderOrigin)),
+			zap.String("user_id", userID),
+		)
+		return problem.New(http.StatusForbidden, problem.CodeForbidden)
+	}
+
+	roomID := c.Param("id")
+	displayName, _ := session.GetUserNameFromContext(ctx)
+	client := newWSClient(roomID, userID, displayName)
+	// 参加時の状態イベントは送信キューに積まれ、接続後に書き込まれる
+	if err := h.hub.Join(ctx, roomID, client); err != nil {
+		return err
+	}
+
+	conn, err := h.upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		// Upgrade がエラーレスポンスを書き込み済み
+		client.Close()
+		h.hub.Leave(roomID, client)
+		return nil
+	}
+	client.conn = conn
+	client.run(h.hub)
+	return nil
+}
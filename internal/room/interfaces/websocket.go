@@ -0,0 +1,185 @@
+package interfaces
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/room/application/dto"
+	"zen-connect/internal/room/application/service"
+)
+
+// WebSocket接続の設定
+const (
+	// wsSendBuffer 送信待ちにできるイベント数（超えた接続は遅すぎるとして閉じる）
+	wsSendBuffer = 32
+	// wsWriteWait 1回の書き込みの期限
+	wsWriteWait = 10 * time.Second
+	// wsPongWait クライアントからの応答がない場合に切断するまでの時間
+	wsPongWait = 60 * time.Second
+	// wsPingPeriod ping の送信間隔（wsPongWait より短くする）
+	wsPingPeriod = wsPongWait * 9 / 10
+	// wsMaxMessageSize クライアントから受け付けるメッセージの上限
+	wsMaxMessageSize = 1024
+)
+
+var (
+	errClientClosed = errors.New("client connection is closed")
+	errClientSlow   = errors.New("client is not reading events fast enough")
+)
+
+// wsClient ルームへのWebSocket接続（service.Client の実装）
+type wsClient struct {
+	conn        *websocket.Conn
+	roomID      string
+	userID      string
+	displayName string
+	send        chan dto.RoomEvent
+	done        chan struct{}
+	closeOnce   sync.Once
+}
+
+func newWSClient(roomID, userID, displayName string) *wsClient {
+	return &wsClient{
+		roomID:      roomID,
+		userID:      userID,
+		displayName: displayName,
+		send:        make(chan dto.RoomEvent, wsSendBuffer),
+		done:        make(chan struct{}),
+	}
+}
+
+// UserID 接続しているユーザーのID
+func (c *wsClient) UserID() string { return c.userID }
+
+// DisplayName 在室状況に表示する名前
+func (c *wsClient) DisplayName() string { return c.displayName }
+
+// Send イベントを送信キューに積む
+func (c *wsClient) Send(event dto.RoomEvent) error {
+	select {
+	case <-c.done:
+		return errClientClosed
+	default:
+	}
+	select {
+	case c.send <- event:
+		return nil
+	default:
+		return errClientSlow
+	}
+}
+
+// Close 送信を止めて接続を閉じる（実際の切断は writePump が行う）
+func (c *wsClient) Close() {
+	c.closeOnce.Do(func() { close(c.done) })
+}
+
+// run 接続の読み書きを開始し、切断されるまでブロックする
+func (c *wsClient) run(hub *service.Hub) {
+	go c.writePump()
+	c.readPump(hub)
+	c.Close()
+	hub.Leave(c.roomID, c)
+}
+
+// writePump キューのイベントと ping を書き込む
+// 閉じられたときは送信済みのイベントを書き出してから切断する
+func (c *wsClient) writePump() {
+	ticker := time.NewTicker(wsPingPeriod)
+	defer func() {
+		ticker.Stop()
+		_ = c.conn.Close()
+	}()
+
+	for {
+		select {
+		case event := <-c.send:
+			if err := c.write(event); err != nil {
+				return
+			}
+		case <-ticker.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-c.done:
+			for {
+				select {
+				case event := <-c.send:
+					if err := c.write(event); err != nil {
+						return
+					}
+				default:
+					_ = c.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+					_ = c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+					return
+				}
+			}
+		}
+	}
+}
+
+func (c *wsClient) write(event dto.RoomEvent) error {
+	_ = c.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+	return c.conn.WriteJSON(event)
+}
+
+// readPump クライアントからのメッセージを処理する
+func (c *wsClient) readPump(hub *service.Hub) {
+	c.conn.SetReadLimit(wsMaxMessageSize)
+	_ = c.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	for {
+		_, message, err := c.conn.ReadMessage()
+		if err != nil {
+			// 切断・タイムアウト・上限超過
+			return
+		}
+		_ = c.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+
+		var command dto.RoomCommand
+		if err := json.Unmarshal(message, &command); err != nil {
+			_ = c.Send(c.errorEvent(problem.CodeInvalidRequestBody))
+			continue
+		}
+
+		switch command.Type {
+		case "time_sync":
+			// クライアントは往復時間の半分を補正して時計のずれを求める
+			_ = c.Send(dto.RoomEvent{
+				Type:       dto.EventTimeSync,
+				RoomID:     c.roomID,
+				ServerTime: time.Now(),
+				ClientTime: command.ClientTime,
+			})
+		case "start":
+			if err := hub.Start(context.Background(), c.roomID, c.userID); err != nil {
+				_ = c.Send(c.errorEvent(errorCode(err)))
+			}
+		default:
+			_ = c.Send(c.errorEvent(problem.CodeBadRequest))
+		}
+	}
+}
+
+func (c *wsClient) errorEvent(code string) dto.RoomEvent {
+	return dto.RoomEvent{Type: dto.EventError, RoomID: c.roomID, ServerTime: time.Now(), Code: code}
+}
+
+// errorCode ドメインエラーをHTTP APIと同じエラーコードに変換
+func errorCode(err error) string {
+	for _, mapping := range ErrorMappings() {
+		if errors.Is(err, mapping.Err) {
+			return mapping.Code
+		}
+	}
+	return problem.CodeInternal
+}
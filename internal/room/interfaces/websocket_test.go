@@ -0,0 +1,137 @@
+package interfaces
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/room/application/dto"
+	"zen-connect/internal/room/application/service"
+	"zen-connect/internal/room/domain"
+)
+
+// fakeRoomRepository 1件のルームだけを持つリポジトリ
+type fakeRoomRepository struct {
+	room *domain.Room
+}
+
+func (r *fakeRoomRepository) Save(ctx context.Context, room *domain.Room) error { return nil }
+
+func (r *fakeRoomRepository) FindByID(ctx context.Context, id string) (*domain.Room, error) {
+	if id != r.room.ID() {
+		return nil, domain.ErrRoomNotFound
+	}
+	return r.room, nil
+}
+
+func (r *fakeRoomRepository) FindUpcoming(ctx context.Context, now time.Time, limit int) ([]*domain.Room, error) {
+	return []*domain.Room{r.room}, nil
+}
+
+func (r *fakeRoomRepository) SaveParticipations(ctx context.Context, participations []domain.Participation) error {
+	return nil
+}
+
+// newTestServer ログイン済みの guest としてルームに接続できるサーバー
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	now := time.Now()
+	room := domain.ReconstructRoom("room-1", "host", "朝の座禅会", "zazen", "",
+		now.Add(time.Hour), 20*time.Minute, 0,
+		domain.RoomStatusScheduled, time.Time{}, time.Time{}, now, now)
+	hub := service.NewHub(&fakeRoomRepository{room: room}, nil, service.HubConfig{})
+	handler := NewRoomHandler(nil, nil, nil, hub, func(origin string) bool {
+		return origin == "https://app.example.com"
+	})
+
+	e := echo.New()
+	e.HTTPErrorHandler = problem.NewErrorHandler(problem.Config{Mappings: ErrorMappings()})
+	e.GET("/rooms/:id/ws", func(c echo.Context) error {
+		ctx := context.WithValue(c.Request().Context(), "user_id", "guest")
+		c.SetRequest(c.Request().WithContext(ctx))
+		return handler.ConnectRoom(c)
+	})
+	server := httptest.NewServer(e)
+	t.Cleanup(func() {
+		hub.Shutdown()
+		server.Close()
+	})
+	return server
+}
+
+func wsURL(server *httptest.Server, roomID string) string {
+	return "ws" + strings.TrimPrefix(server.URL, "http") + "/rooms/" + roomID + "/ws"
+}
+
+func TestConnectRoom_ShouldSendStateAndAnswerTimeSync(t *testing.T) {
+	// given
+	server := newTestServer(t)
+	header := http.Header{echo.HeaderOrigin: []string{"https://app.example.com"}}
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL(server, "room-1"), header)
+	if err != nil {
+		t.Fatalf("Expected connection, got %v", err)
+	}
+	defer conn.Close()
+	_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+
+	var state dto.RoomEvent
+	if err := conn.ReadJSON(&state); err != nil || state.Type != dto.EventState {
+		t.Fatalf("Expected state event, got %+v (%v)", state, err)
+	}
+
+	// when
+	clientTime := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	if err := conn.WriteJSON(dto.RoomCommand{Type: "time_sync", ClientTime: &clientTime}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// then
+	for {
+		var event dto.RoomEvent
+		if err := conn.ReadJSON(&event); err != nil {
+			t.Fatalf("Expected time_sync event, got %v", err)
+		}
+		if event.Type != dto.EventTimeSync {
+			continue
+		}
+		if event.ClientTime == nil || !event.ClientTime.Equal(clientTime) || event.ServerTime.IsZero() {
+			t.Errorf("Expected client time echoed with server time, got %+v", event)
+		}
+		break
+	}
+}
+
+func TestConnectRoom_ShouldRejectBeforeUpgrade(t *testing.T) {
+	tests := []struct {
+		name   string
+		roomID string
+		origin string
+		status int
+	}{
+		{name: "disallowed origin", roomID: "room-1", origin: "https://evil.example.com", status: http.StatusForbidden},
+		{name: "unknown room", roomID: "room-2", origin: "https://app.example.com", status: http.StatusNotFound},
+	}
+	server := newTestServer(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			header := http.Header{echo.HeaderOrigin: []string{tt.origin}}
+			_, resp, err := websocket.DefaultDialer.Dial(wsURL(server, tt.roomID), header)
+
+			// then
+			if err == nil || resp == nil || resp.StatusCode != tt.status {
+				t.Fatalf("Expected handshake to fail with %d, got %v", tt.status, resp)
+			}
+			if resp.Header.Get(echo.HeaderContentType) != problem.MIMEProblemJSON {
+				t.Errorf("Expected problem response, got %q", resp.Header.Get(echo.HeaderContentType))
+			}
+		})
+	}
+}
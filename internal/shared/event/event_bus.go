@@ -1,11 +1,16 @@
 package event
 
-import "context"
+import (
+	"context"
+	"errors"
+	"time"
+)
 
 // DomainEvent ドメインイベントのインターフェース
+// 各コンテキストのドメインイベントはそのままこのインターフェースを満たす
 type DomainEvent interface {
 	EventName() string
-	OccurredAt() string
+	OccurredAt() time.Time
 	AggregateID() string
 }
 
@@ -44,7 +49,9 @@ func (bus *inMemoryEventBus) Register(eventName string, handler EventHandler) {
 }
 
 // Publish イベントを発行
+// あるハンドラーが失敗しても残りのハンドラーは実行し、すべてのエラーをまとめて返す
 func (bus *inMemoryEventBus) Publish(ctx context.Context, events ...DomainEvent) error {
+	var errs []error
 	for _, event := range events {
 		handlers, exists := bus.handlers[event.EventName()]
 		if !exists {
@@ -53,11 +60,11 @@ func (bus *inMemoryEventBus) Publish(ctx context.Context, events ...DomainEvent)
 		
 		for _, handler := range handlers {
 			if err := handler.Handle(ctx, event); err != nil {
-				return err
+				errs = append(errs, err)
 			}
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 // PublishAsync イベントを非同期で発行
@@ -0,0 +1,48 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type testEvent struct{}
+
+func (testEvent) EventName() string     { return "TestEvent" }
+func (testEvent) OccurredAt() time.Time { return time.Time{} }
+func (testEvent) AggregateID() string   { return "aggregate-1" }
+
+type recordingHandler struct {
+	err   error
+	calls int
+}
+
+func (h *recordingHandler) Handle(ctx context.Context, event DomainEvent) error {
+	h.calls++
+	return h.err
+}
+
+func TestPublish_ShouldRunEveryHandlerAndJoinTheirErrors(t *testing.T) {
+	// given
+	bus := NewInMemoryEventBus()
+	errFirst := errors.New("first failed")
+	errLast := errors.New("last failed")
+	handlers := []*recordingHandler{{err: errFirst}, {}, {err: errLast}}
+	for _, handler := range handlers {
+		bus.Register("TestEvent", handler)
+	}
+
+	// when
+	err := bus.Publish(context.Background(), testEvent{}, testEvent{})
+
+	// then
+	for i, handler := range handlers {
+		if handler.calls != 2 {
+			t.Errorf("Expected handler %d to run for both events, ran %d times", i, handler.calls)
+		}
+	}
+	if !errors.Is(err, errFirst) || !errors.Is(err, errLast) {
+		t.Errorf("Expected both handler errors, got %v", err)
+	}
+}
@@ -0,0 +1,37 @@
+package event
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"zen-connect/internal/infrastructure/logger"
+)
+
+// Publisher 各コンテキストのドメインイベントを共有のイベントバスに渡すパブリッシャー
+// E はコンテキストのドメインイベント型で、アプリケーション層の EventPublisher ポートを満たす
+type Publisher[E DomainEvent] struct {
+	bus    EventBus
+	source string
+}
+
+// NewPublisher パブリッシャーのコンストラクタ（source はログに出すコンテキスト名）
+func NewPublisher[E DomainEvent](bus EventBus, source string) *Publisher[E] {
+	return &Publisher[E]{
+		bus:    bus,
+		source: source,
+	}
+}
+
+// Publish イベントをハンドラーに渡す
+// イベントを発生させた変更は保存済みのため、ハンドラーの失敗はリクエストを失敗させずにログに記録する
+func (p *Publisher[E]) Publish(ctx context.Context, events ...E) {
+	for _, e := range events {
+		if err := p.bus.Publish(ctx, e); err != nil {
+			logger.GetGlobalLogger().Error("Failed to publish "+p.source+" event",
+				zap.String("event", e.EventName()),
+				zap.String("aggregate_id", e.AggregateID()),
+				zap.Error(err),
+			)
+		}
+	}
+}
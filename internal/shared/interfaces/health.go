@@ -0,0 +1,138 @@
+package interfaces
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"zen-connect/internal/infrastructure/logger"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/shared/openapi"
+)
+
+// HealthResponse is returned by the health check endpoints
+type HealthResponse struct {
+	Status      string `json:"status"`
+	Service     string `json:"service,omitempty"`
+	DB          string `json:"db,omitempty"`
+	Error       string `json:"error,omitempty"`
+	UserID      string `json:"user_id,omitempty"`
+	Auth0UserID string `json:"auth0_user_id,omitempty"`
+	Email       string `json:"email,omitempty"`
+	Name        string `json:"name,omitempty"`
+}
+
+// HealthHandler provides health check endpoints
+type HealthHandler struct {
+	pingDB func(ctx context.Context) error
+}
+
+// NewHealthHandler creates a new health handler. pingDB checks database connectivity.
+func NewHealthHandler(pingDB func(ctx context.Context) error) *HealthHandler {
+	return &HealthHandler{
+		pingDB: pingDB,
+	}
+}
+
+// SetupRoutes sets up the health check endpoints
+func (h *HealthHandler) SetupRoutes(e *echo.Echo, sessionMiddleware *session.Middleware) {
+	e.GET("/health", h.Health)
+	e.GET("/health/protected", h.Protected, sessionMiddleware.RequireAuth())
+	e.GET("/health/db", h.Database)
+}
+
+// Endpoints describes the health check routes for the OpenAPI document
+func (h *HealthHandler) Endpoints() []openapi.Endpoint {
+	tags := []string{"health"}
+	return []openapi.Endpoint{
+		{
+			Method: http.MethodGet, Path: "/health", Tags: tags,
+			Summary:   "Basic health check",
+			Responses: map[int]interface{}{http.StatusOK: HealthResponse{}},
+		},
+		{
+			Method: http.MethodGet, Path: "/health/protected", Tags: tags,
+			Summary:  "Health check that requires a session",
+			Security: []string{openapi.SecuritySession},
+			Responses: map[int]interface{}{
+				http.StatusOK:           HealthResponse{},
+				http.StatusUnauthorized: nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/health/db", Tags: tags,
+			Summary: "Database connectivity check",
+			Responses: map[int]interface{}{
+				http.StatusOK:                  HealthResponse{},
+				http.StatusInternalServerError: HealthResponse{},
+			},
+		},
+	}
+}
+
+// Health handles GET /health
+func (h *HealthHandler) Health(c echo.Context) error {
+	logCtx := logger.WithContext(c.Request().Context())
+	logCtx.Debug("Health check requested")
+	return c.JSON(http.StatusOK, HealthResponse{
+		Status:  "OK",
+		Service: "zen-connect-api",
+	})
+}
+
+// Protected handles GET /health/protected to test session-based auth
+func (h *HealthHandler) Protected(c echo.Context) error {
+	logCtx := logger.WithContext(c.Request().Context())
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		logCtx.Warn("Protected health check accessed without authentication")
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	email, _ := session.GetUserEmailFromContext(c.Request().Context())
+	name, _ := session.GetUserNameFromContext(c.Request().Context())
+	auth0UserID, _ := session.GetAuth0UserIDFromContext(c.Request().Context())
+
+	logCtx.Info("Protected health check accessed by authenticated user",
+		zap.String("user_id", userID),
+		zap.String("auth0_user_id", auth0UserID),
+	)
+
+	return c.JSON(http.StatusOK, HealthResponse{
+		Status:      "OK",
+		Service:     "zen-connect-api",
+		UserID:      userID,
+		Auth0UserID: auth0UserID,
+		Email:       email,
+		Name:        name,
+	})
+}
+
+// Database handles GET /health/db
+func (h *HealthHandler) Database(c echo.Context) error {
+	logCtx := logger.WithContext(c.Request().Context())
+	logCtx.Debug("Database health check requested")
+
+	start := time.Now()
+	if err := h.pingDB(c.Request().Context()); err != nil {
+		logCtx.Error("Database health check failed",
+			zap.Error(err),
+			zap.Duration("duration", time.Since(start)),
+		)
+		return c.JSON(http.StatusInternalServerError, HealthResponse{
+			Status: "ERROR",
+			Error:  err.Error(),
+		})
+	}
+
+	logCtx.Info("Database health check successful",
+		zap.Duration("duration", time.Since(start)),
+	)
+	return c.JSON(http.StatusOK, HealthResponse{
+		Status: "OK",
+		DB:     "connected",
+	})
+}
@@ -1,53 +1,168 @@
 package interfaces
 
 import (
+	"io/fs"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/labstack/echo/v4"
+	swaggerFiles "github.com/swaggo/files/v2"
+	"zen-connect/internal/shared/openapi"
 )
 
-// RoutesHandler provides API documentation and route listing
-type RoutesHandler struct{}
+// swaggerInitializer points the embedded Swagger UI at the generated document
+const swaggerInitializer = `window.onload = function() {
+  window.ui = SwaggerUIBundle({
+    url: "/openapi.json",
+    dom_id: '#swagger-ui',
+    deepLinking: true,
+    withCredentials: true,
+    presets: [
+      SwaggerUIBundle.presets.apis,
+      SwaggerUIStandalonePreset
+    ],
+    plugins: [
+      SwaggerUIBundle.plugins.DownloadUrl
+    ],
+    layout: "StandaloneLayout"
+  });
+};
+`
+
+// swaggerUIPolicy relaxes the API's default Content-Security-Policy so the
+// Swagger UI assets (scripts, styles, inline SVG images) can load
+const swaggerUIPolicy = "default-src 'self'; img-src 'self' data:; style-src 'self' 'unsafe-inline'; frame-ancestors 'none'"
+
+// RouteSummary is one entry of the route listing
+type RouteSummary struct {
+	Method  string `json:"method"`
+	Path    string `json:"path"`
+	Summary string `json:"summary"`
+}
+
+// RoutesResponse lists the API routes grouped by tag
+type RoutesResponse struct {
+	Service   string                    `json:"service"`
+	Version   string                    `json:"version"`
+	OpenAPI   string                    `json:"openapi"`
+	Endpoints map[string][]RouteSummary `json:"endpoints"`
+}
+
+// RoutesHandler serves the OpenAPI document, Swagger UI and route listing
+type RoutesHandler struct {
+	document *openapi.Document
+}
 
 // NewRoutesHandler creates a new routes handler
 func NewRoutesHandler() *RoutesHandler {
 	return &RoutesHandler{}
 }
 
-// ListRoutes returns all available API endpoints
-func (h *RoutesHandler) ListRoutes(c echo.Context) error {
-	routes := map[string]interface{}{
-		"service": "zen-connect-api",
-		"version": "1.0.0",
-		"endpoints": map[string]interface{}{
-			"health": map[string]string{
-				"GET /health":           "Basic health check",
-				"GET /health/protected": "Protected health check (requires Auth0 token)",
-			},
-			"authentication": map[string]string{
-				"GET /auth/login":         "Redirect to Auth0 login",
-				"GET /auth/callback":      "Auth0 callback handler",
-				"GET /auth/logout":        "Logout and redirect to Auth0",
-				"GET /auth/me":            "Get current user information (404 if not authenticated)",
-				"GET /api/auth/login-url": "Get Auth0 login URL (AJAX)",
-			},
-			"documentation": map[string]string{
-				"GET /api/routes": "This endpoint - list all routes",
+// SetDocument sets the document to serve. It is built after all routes,
+// including these, have been registered.
+func (h *RoutesHandler) SetDocument(document *openapi.Document) {
+	h.document = document
+}
+
+// SetupRoutes sets up the documentation endpoints
+func (h *RoutesHandler) SetupRoutes(e *echo.Echo) {
+	e.GET("/openapi.json", h.OpenAPI)
+	e.GET("/docs", h.SwaggerUIRedirect)
+	e.GET("/docs/*", h.SwaggerUI)
+
+	api := e.Group("/api")
+	api.GET("/routes", h.ListRoutes)
+}
+
+// Endpoints describes the documentation routes for the OpenAPI document
+func (h *RoutesHandler) Endpoints() []openapi.Endpoint {
+	tags := []string{"documentation"}
+	return []openapi.Endpoint{
+		{
+			Method: http.MethodGet, Path: "/openapi.json", Tags: tags,
+			Summary:   "OpenAPI 3.1 document of this API",
+			Responses: map[int]interface{}{http.StatusOK: &openapi.Schema{Type: "object"}},
+		},
+		{
+			Method: http.MethodGet, Path: "/docs", Tags: tags,
+			Summary:   "Redirect to the Swagger UI",
+			Responses: map[int]interface{}{http.StatusMovedPermanently: nil},
+		},
+		{
+			Method: http.MethodGet, Path: "/docs/*", Tags: tags,
+			Summary:     "Swagger UI assets",
+			ContentType: echo.MIMETextHTMLCharsetUTF8,
+			Responses: map[int]interface{}{
+				http.StatusOK:       &openapi.Schema{Type: "string"},
+				http.StatusNotFound: nil,
 			},
 		},
-		"auth": map[string]interface{}{
-			"provider": "Auth0",
-			"domain":   "dev-ie6tlg1ol8xjemia.us.auth0.com",
-			"flow":     "Authorization Code with PKCE",
-			"scopes":   []string{"openid", "profile", "email"},
+		{
+			Method: http.MethodGet, Path: "/api/routes", Tags: tags,
+			Summary:   "List the API routes",
+			Responses: map[int]interface{}{http.StatusOK: RoutesResponse{}},
 		},
 	}
+}
 
-	return c.JSON(http.StatusOK, routes)
+// OpenAPI handles GET /openapi.json
+func (h *RoutesHandler) OpenAPI(c echo.Context) error {
+	return c.JSON(http.StatusOK, h.document)
 }
 
-// SetupRoutesDocumentation sets up the routes documentation endpoint
-func (h *RoutesHandler) SetupRoutes(e *echo.Echo) {
-	api := e.Group("/api")
-	api.GET("/routes", h.ListRoutes)
-}
\ No newline at end of file
+// SwaggerUIRedirect handles GET /docs
+func (h *RoutesHandler) SwaggerUIRedirect(c echo.Context) error {
+	return c.Redirect(http.StatusMovedPermanently, "/docs/index.html")
+}
+
+// SwaggerUI handles GET /docs/* by serving the embedded Swagger UI
+func (h *RoutesHandler) SwaggerUI(c echo.Context) error {
+	c.Response().Header().Set(echo.HeaderContentSecurityPolicy, swaggerUIPolicy)
+
+	name := c.Param("*")
+	if name == "" {
+		name = "index.html"
+	}
+	if name == "swagger-initializer.js" {
+		return c.Blob(http.StatusOK, "text/javascript; charset=utf-8", []byte(swaggerInitializer))
+	}
+	if _, err := fs.Stat(swaggerFiles.FS, name); err != nil {
+		return echo.ErrNotFound
+	}
+	return echo.StaticFileHandler(name, swaggerFiles.FS)(c)
+}
+
+// ListRoutes returns all available API endpoints, derived from the OpenAPI document
+func (h *RoutesHandler) ListRoutes(c echo.Context) error {
+	response := RoutesResponse{
+		Service:   h.document.Info.Title,
+		Version:   h.document.Info.Version,
+		OpenAPI:   "/openapi.json",
+		Endpoints: make(map[string][]RouteSummary),
+	}
+
+	for path, item := range h.document.Paths {
+		for method, op := range *item {
+			tag := "other"
+			if len(op.Tags) > 0 {
+				tag = op.Tags[0]
+			}
+			response.Endpoints[tag] = append(response.Endpoints[tag], RouteSummary{
+				Method:  strings.ToUpper(method),
+				Path:    path,
+				Summary: op.Summary,
+			})
+		}
+	}
+	for _, routes := range response.Endpoints {
+		sort.Slice(routes, func(i, j int) bool {
+			if routes[i].Path != routes[j].Path {
+				return routes[i].Path < routes[j].Path
+			}
+			return routes[i].Method < routes[j].Method
+		})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,236 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Security scheme names used in Endpoint.Security
+const (
+	SecuritySession = "sessionCookie"
+	SecurityBearer  = "bearerAuth"
+)
+
+// IdempotencyKeyHeader documents the optional Idempotency-Key request header
+var IdempotencyKeyHeader = Parameter{
+	Name:        "Idempotency-Key",
+	Description: "Client-generated key; retries with the same key and body replay the first response",
+	Schema:      &Schema{Type: "string"},
+}
+
+// Endpoint documents one route. Handlers declare their endpoints next to
+// their route setup so the document follows the code.
+type Endpoint struct {
+	Method      string
+	Path        string // Echo path, e.g. /experiences/:id
+	Summary     string
+	Description string
+	Tags        []string
+	Query       []Parameter
+	Headers     []Parameter
+	// Request is a value of the request body type, nil when there is no body
+	Request interface{}
+	// Responses maps status codes to a value of the response body type
+	// (nil for responses without a body)
+	Responses map[int]interface{}
+	// ContentType overrides the response media type (default application/json)
+	ContentType string
+	// Security lists the accepted security schemes; empty means public
+	Security []string
+}
+
+// Options configures document generation
+type Options struct {
+	Info              Info
+	ServerURL         string
+	SessionCookieName string
+	// ErrorResponse is used for documented error statuses without a body type
+	ErrorResponse interface{}
+	// ErrorContentType is the media type of error responses (default application/json)
+	ErrorContentType string
+}
+
+// Build creates the document for the registered routes. It also returns a
+// problem for every route without an Endpoint and every Endpoint whose
+// route is not registered, so callers and tests can detect drift.
+func Build(options Options, routes []*echo.Route, endpoints []Endpoint) (*Document, []string) {
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    options.Info,
+		Paths:   make(map[string]*PathItem),
+		Components: Components{
+			SecuritySchemes: map[string]*SecurityScheme{
+				SecuritySession: {
+					Type:        "apiKey",
+					In:          "cookie",
+					Name:        options.SessionCookieName,
+					Description: "Encrypted session cookie set by /auth/callback",
+				},
+				SecurityBearer: {
+					Type:         "http",
+					Scheme:       "bearer",
+					BearerFormat: "JWT",
+					Description:  "Auth0 access token",
+				},
+			},
+		},
+	}
+	if options.ServerURL != "" {
+		doc.Servers = []Server{{URL: options.ServerURL}}
+	}
+
+	registry := newSchemaRegistry()
+	documented := make(map[string]Endpoint, len(endpoints))
+	for _, endpoint := range endpoints {
+		documented[routeKey(endpoint.Method, endpoint.Path)] = endpoint
+	}
+
+	var problems []string
+	registered := make(map[string]bool)
+	tags := make(map[string]bool)
+
+	for _, route := range routes {
+		if route.Method == echo.RouteNotFound {
+			continue
+		}
+		key := routeKey(route.Method, route.Path)
+		if registered[key] {
+			continue
+		}
+		registered[key] = true
+
+		endpoint, ok := documented[key]
+		if !ok {
+			problems = append(problems, "undocumented route "+key)
+			continue
+		}
+
+		path, params := convertPath(route.Path)
+		item, exists := doc.Paths[path]
+		if !exists {
+			item = &PathItem{}
+			doc.Paths[path] = item
+		}
+		(*item)[strings.ToLower(route.Method)] = buildOperation(registry, options, endpoint, params)
+
+		for _, tag := range endpoint.Tags {
+			tags[tag] = true
+		}
+	}
+
+	for key := range documented {
+		if !registered[key] {
+			problems = append(problems, "documented endpoint is not registered "+key)
+		}
+	}
+	sort.Strings(problems)
+
+	for tag := range tags {
+		doc.Tags = append(doc.Tags, Tag{Name: tag})
+	}
+	sort.Slice(doc.Tags, func(i, j int) bool { return doc.Tags[i].Name < doc.Tags[j].Name })
+	doc.Components.Schemas = registry.schemas
+
+	return doc, problems
+}
+
+func buildOperation(registry *schemaRegistry, options Options, endpoint Endpoint, pathParams []string) *Operation {
+	op := &Operation{
+		OperationID: operationID(endpoint.Method, endpoint.Path),
+		Summary:     endpoint.Summary,
+		Description: endpoint.Description,
+		Tags:        endpoint.Tags,
+		Responses:   make(map[string]*Response),
+	}
+
+	for _, name := range pathParams {
+		op.Parameters = append(op.Parameters, Parameter{Name: name, In: "path", Required: true, Schema: &Schema{Type: "string"}})
+	}
+	for _, param := range endpoint.Query {
+		param.In = "query"
+		op.Parameters = append(op.Parameters, param)
+	}
+	for _, param := range endpoint.Headers {
+		param.In = "header"
+		op.Parameters = append(op.Parameters, param)
+	}
+
+	if endpoint.Request != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{echo.MIMEApplicationJSON: {Schema: registry.schemaFor(endpoint.Request)}},
+		}
+	}
+
+	contentType := endpoint.ContentType
+	if contentType == "" {
+		contentType = echo.MIMEApplicationJSON
+	}
+	errorContentType := options.ErrorContentType
+	if errorContentType == "" {
+		errorContentType = echo.MIMEApplicationJSON
+	}
+	for status, body := range endpoint.Responses {
+		response := &Response{Description: http.StatusText(status)}
+		if body == nil && status >= http.StatusBadRequest {
+			body = options.ErrorResponse
+		}
+		if body != nil {
+			mediaType := contentType
+			if status >= http.StatusBadRequest {
+				mediaType = errorContentType
+			}
+			response.Content = map[string]MediaType{mediaType: {Schema: registry.schemaFor(body)}}
+		}
+		op.Responses[strconv.Itoa(status)] = response
+	}
+
+	for _, scheme := range endpoint.Security {
+		op.Security = append(op.Security, map[string][]string{scheme: {}})
+	}
+
+	return op
+}
+
+// convertPath turns an Echo path into an OpenAPI path and its parameter names
+func convertPath(path string) (string, []string) {
+	var params []string
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		switch {
+		case strings.HasPrefix(segment, ":"):
+			params = append(params, segment[1:])
+			segments[i] = "{" + segment[1:] + "}"
+		case segment == "*":
+			params = append(params, "path")
+			segments[i] = "{path}"
+		}
+	}
+	return strings.Join(segments, "/"), params
+}
+
+// operationID derives a stable identifier such as getExperiencesById
+func operationID(method, path string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	for _, segment := range strings.FieldsFunc(path, func(r rune) bool { return r == '/' || r == '-' || r == '_' }) {
+		if strings.HasPrefix(segment, ":") {
+			b.WriteString("By")
+			segment = segment[1:]
+		}
+		if segment == "*" {
+			segment = "path"
+		}
+		b.WriteString(strings.ToUpper(segment[:1]) + segment[1:])
+	}
+	return b.String()
+}
+
+func routeKey(method, path string) string {
+	return fmt.Sprintf("%s %s", method, path)
+}
@@ -0,0 +1,81 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type testItem struct {
+	ID        string    `json:"id"`
+	Count     int       `json:"count"`
+	Note      string    `json:"note,omitempty"`
+	Tags      []string  `json:"tags"`
+	CreatedAt time.Time `json:"created_at"`
+	Parent    *testItem `json:"parent"`
+	Secret    string    `json:"-"`
+	hidden    string
+}
+
+func TestSchemaRegistry_ShouldDescribeStructFields(t *testing.T) {
+	// given
+	registry := newSchemaRegistry()
+
+	// when
+	ref := registry.schemaFor(testItem{})
+
+	// then
+	if ref.Ref != "#/components/schemas/testItem" {
+		t.Fatalf("Expected component reference, got %+v", ref)
+	}
+	schema := registry.schemas["testItem"]
+	expectedTypes := map[string]string{"id": "string", "count": "integer", "note": "string", "tags": "array", "created_at": "string"}
+	for name, typ := range expectedTypes {
+		if schema.Properties[name] == nil || schema.Properties[name].Type != typ {
+			t.Errorf("Expected %s to be %s, got %+v", name, typ, schema.Properties[name])
+		}
+	}
+	if schema.Properties["created_at"].Format != "date-time" {
+		t.Error("Expected time.Time to be a date-time string")
+	}
+	if schema.Properties["parent"].Ref != ref.Ref {
+		t.Error("Expected self reference to resolve to the component")
+	}
+	if _, ok := schema.Properties["Secret"]; ok {
+		t.Error("Expected json:\"-\" fields to be skipped")
+	}
+	if !reflect.DeepEqual(schema.Required, []string{"id", "count", "tags", "created_at"}) {
+		t.Errorf("Unexpected required fields %v", schema.Required)
+	}
+}
+
+func TestBuild_ShouldReportUndocumentedAndStaleRoutes(t *testing.T) {
+	// given
+	e := echo.New()
+	handler := func(c echo.Context) error { return nil }
+	e.GET("/items/:id", handler)
+	e.POST("/items", handler)
+	endpoints := []Endpoint{
+		{Method: http.MethodGet, Path: "/items/:id", Responses: map[int]interface{}{http.StatusOK: testItem{}}},
+		{Method: http.MethodDelete, Path: "/items/:id"},
+	}
+
+	// when
+	doc, problems := Build(Options{}, e.Routes(), endpoints)
+
+	// then
+	expected := []string{"documented endpoint is not registered DELETE /items/:id", "undocumented route POST /items"}
+	if !reflect.DeepEqual(problems, expected) {
+		t.Errorf("Expected %v, got %v", expected, problems)
+	}
+	op := (*doc.Paths["/items/{id}"])["get"]
+	if op == nil || len(op.Parameters) != 1 || op.Parameters[0].Name != "id" || op.Parameters[0].In != "path" {
+		t.Errorf("Expected path parameter id, got %+v", op)
+	}
+	if op.OperationID != "getItemsById" {
+		t.Errorf("Expected operation ID getItemsById, got %s", op.OperationID)
+	}
+}
@@ -52,6 +52,28 @@ type GetUserProfileResponse struct {
 	UpdatedAt time.Time  `json:"updated_at"`
 }
 
+// GetPublicProfileRequest 公開プロフィール取得リクエスト
+type GetPublicProfileRequest struct {
+	UserID string `json:"user_id"`
+}
+
+// PublicProfileResponse 他のユーザーにも見せるプロフィール（メールアドレスは含まない）
+type PublicProfileResponse struct {
+	UserID  string     `json:"user_id"`
+	Profile ProfileDTO `json:"profile"`
+	// Badges 獲得したバッジ（獲得した順）
+	Badges    []BadgeDTO `json:"badges"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// BadgeDTO 獲得したバッジ
+type BadgeDTO struct {
+	BadgeID      string            `json:"badge_id"`
+	Names        map[string]string `json:"names"`
+	Descriptions map[string]string `json:"descriptions"`
+	EarnedAt     time.Time         `json:"earned_at"`
+}
+
 // ProfileDTO プロフィール情報のDTO
 type ProfileDTO struct {
 	DisplayName     string `json:"display_name"`
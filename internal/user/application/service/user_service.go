@@ -6,6 +6,12 @@ import (
 	"zen-connect/internal/user/domain"
 )
 
+// EventPublisher ドメインイベントを他のコンテキストに伝える（登録時のウェルカムメールなど）
+// 保存後に呼ばれるため、配信の失敗はログインの失敗にしない
+type EventPublisher interface {
+	Publish(ctx context.Context, events ...domain.DomainEvent)
+}
+
 //go:generate mockgen -source=user_service.go -destination=../../../mocks/user_service_mock.go -package=mocks
 
 // UserService ユーザーサービスのインターフェース
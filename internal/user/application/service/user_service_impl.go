@@ -2,19 +2,23 @@ package service
 
 import (
 	"context"
+	"errors"
+
 	"zen-connect/internal/user/application/dto"
 	"zen-connect/internal/user/domain"
 )
 
 // userServiceImpl UserServiceの実装
 type userServiceImpl struct {
-	userRepo domain.UserRepository
+	userRepo  domain.UserRepository
+	publisher EventPublisher
 }
 
 // NewUserService UserServiceのコンストラクタ
-func NewUserService(userRepo domain.UserRepository) UserService {
+func NewUserService(userRepo domain.UserRepository, publisher EventPublisher) UserService {
 	return &userServiceImpl{
-		userRepo: userRepo,
+		userRepo:  userRepo,
+		publisher: publisher,
 	}
 }
 
@@ -29,10 +33,15 @@ func (s *userServiceImpl) RegisterOrUpdateUserFromAuth(ctx context.Context, cmd
 	if user != nil {
 		// 既存ユーザーの更新
 		user.UpdateProfile(cmd.Name, "", cmd.Picture)
+		// Auth0で確認済みになったメールアドレスを反映
+		if cmd.EmailVerified {
+			user.VerifyEmail()
+		}
 		
 		if err := s.userRepo.Save(user); err != nil {
 			return nil, err
 		}
+		s.publishEvents(ctx, user)
 		
 		return user, nil
 	}
@@ -46,12 +55,24 @@ func (s *userServiceImpl) RegisterOrUpdateUserFromAuth(ctx context.Context, cmd
 	user = domain.NewUser(cmd.Auth0UserID, email, cmd.Name, cmd.EmailVerified)
 	
 	if err := s.userRepo.Save(user); err != nil {
+		// 同時に届いた別のコールバックが先に登録した場合は、そのユーザーを返す
+		// （登録のイベントは先に登録した側が発行済み）
+		if errors.Is(err, domain.ErrUserAlreadyExists) {
+			return s.userRepo.FindByAuth0UserID(cmd.Auth0UserID)
+		}
 		return nil, err
 	}
+	s.publishEvents(ctx, user)
 	
 	return user, nil
 }
 
+// publishEvents 保存したユーザーのドメインイベントを発行
+func (s *userServiceImpl) publishEvents(ctx context.Context, user *domain.User) {
+	s.publisher.Publish(ctx, user.Events()...)
+	user.ClearEvents()
+}
+
 // GetUserByAuth0ID Auth0 IDでユーザーを取得
 func (s *userServiceImpl) GetUserByAuth0ID(ctx context.Context, auth0UserID string) (*domain.User, error) {
 	return s.userRepo.FindByAuth0UserID(auth0UserID)
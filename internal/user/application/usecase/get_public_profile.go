@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"zen-connect/internal/user/application/dto"
+	"zen-connect/internal/user/application/service"
+	"zen-connect/internal/user/domain"
+)
+
+// BadgeSource 実績コンテキストからユーザーが獲得したバッジを読むポート
+type BadgeSource interface {
+	// Earned ユーザーが獲得したバッジ（獲得した順）
+	Earned(ctx context.Context, userID string) ([]dto.BadgeDTO, error)
+}
+
+// GetPublicProfileUseCase 公開プロフィール取得ユースケース
+type GetPublicProfileUseCase struct {
+	userService service.UserService
+	badges      BadgeSource
+}
+
+// NewGetPublicProfileUseCase コンストラクタ
+func NewGetPublicProfileUseCase(userService service.UserService, badges BadgeSource) *GetPublicProfileUseCase {
+	return &GetPublicProfileUseCase{
+		userService: userService,
+		badges:      badges,
+	}
+}
+
+// Execute 公開プロフィール取得を実行
+func (uc *GetPublicProfileUseCase) Execute(ctx context.Context, req *dto.GetPublicProfileRequest) (*dto.PublicProfileResponse, error) {
+	// ユーザーIDの形式でなければ存在しないユーザーとして扱う
+	if _, err := uuid.Parse(req.UserID); err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+	user, err := uc.userService.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	badges, err := uc.badges.Earned(ctx, user.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PublicProfileResponse{
+		UserID: user.ID(),
+		Profile: dto.ProfileDTO{
+			DisplayName:     user.Profile().DisplayName(),
+			Bio:             user.Profile().Bio(),
+			ProfileImageURL: user.Profile().ProfileImageURL(),
+		},
+		Badges:    badges,
+		CreatedAt: user.CreatedAt(),
+	}, nil
+}
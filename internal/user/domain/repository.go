@@ -5,6 +5,9 @@ import "errors"
 // Domain errors
 var (
 	ErrUserNotFound = errors.New("user not found")
+	// ErrUserAlreadyExists is returned by Save when another user is already
+	// registered with the same Auth0 user ID
+	ErrUserAlreadyExists = errors.New("user already exists")
 )
 
 // UserRepository defines the interface for user persistence
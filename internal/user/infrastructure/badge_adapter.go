@@ -0,0 +1,38 @@
+package infrastructure
+
+import (
+	"context"
+
+	achievementusecase "zen-connect/internal/achievement/application/usecase"
+	"zen-connect/internal/user/application/dto"
+)
+
+// BadgeAdapter reads the badges users have earned from the achievement context
+type BadgeAdapter struct {
+	badges *achievementusecase.BadgeUseCase
+}
+
+// NewBadgeAdapter creates a new badge adapter
+func NewBadgeAdapter(badges *achievementusecase.BadgeUseCase) *BadgeAdapter {
+	return &BadgeAdapter{
+		badges: badges,
+	}
+}
+
+// Earned returns the user's badges, earliest first
+func (a *BadgeAdapter) Earned(ctx context.Context, userID string) ([]dto.BadgeDTO, error) {
+	earned, err := a.badges.Earned(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]dto.BadgeDTO, 0, len(earned))
+	for _, badge := range earned {
+		result = append(result, dto.BadgeDTO{
+			BadgeID:      badge.BadgeID,
+			Names:        badge.Names,
+			Descriptions: badge.Descriptions,
+			EarnedAt:     *badge.EarnedAt,
+		})
+	}
+	return result, nil
+}
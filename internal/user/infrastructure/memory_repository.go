@@ -25,6 +25,12 @@ func (r *InMemoryUserRepository) Save(user *domain.User) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
+	for _, existing := range r.users {
+		if existing.ID() != user.ID() && existing.Auth0UserID() == user.Auth0UserID() {
+			return domain.ErrUserAlreadyExists
+		}
+	}
+
 	// Store user by ID
 	r.users[user.ID()] = user
 
@@ -63,4 +69,17 @@ func (r *InMemoryUserRepository) FindByID(id string) (*domain.User, error) {
 	}
 
 	return user, nil
-}
\ No newline at end of file
+}
+// FindByAuth0UserID finds a user by Auth0 user ID
+func (r *InMemoryUserRepository) FindByAuth0UserID(auth0UserID string) (*domain.User, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, user := range r.users {
+		if user.Auth0UserID() == auth0UserID {
+			return user, nil
+		}
+	}
+
+	return nil, domain.ErrUserNotFound
+}
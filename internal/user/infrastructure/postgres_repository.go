@@ -3,12 +3,18 @@ package infrastructure
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"zen-connect/internal/user/domain"
 )
 
+// uniqueViolation is the PostgreSQL error code for unique constraint violations
+const uniqueViolation = "23505"
+
 // PostgresUserRepository implements UserRepository interface
 type PostgresUserRepository struct {
 	pool *pgxpool.Pool
@@ -54,6 +60,10 @@ func (r *PostgresUserRepository) Save(user *domain.User) error {
 		user.VerifiedAt(),
 		user.UpdatedAt(),
 	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == "users_auth0_user_id_key" {
+		return domain.ErrUserAlreadyExists
+	}
 
 	return err
 }
@@ -89,7 +99,7 @@ func (r *PostgresUserRepository) FindByID(id string) (*domain.User, error) {
 	)
 
 	if err != nil {
-		if err == sql.ErrNoRows {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ErrUserNotFound
 		}
 		return nil, err
@@ -140,7 +150,7 @@ func (r *PostgresUserRepository) FindByEmail(email *domain.Email) (*domain.User,
 	)
 
 	if err != nil {
-		if err == sql.ErrNoRows {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ErrUserNotFound
 		}
 		return nil, err
@@ -191,7 +201,7 @@ func (r *PostgresUserRepository) FindByAuth0UserID(auth0UserID string) (*domain.
 	)
 
 	if err != nil {
-		if err == sql.ErrNoRows {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ErrUserNotFound
 		}
 		return nil, err
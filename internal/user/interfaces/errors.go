@@ -0,0 +1,42 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/user/domain"
+)
+
+// ErrorMappings ユーザードメインのエラーとHTTPレスポンスの対応
+func ErrorMappings() []problem.Mapping {
+	return []problem.Mapping{
+		{
+			Err: domain.ErrUserNotFound, Status: http.StatusNotFound, Code: "user_not_found",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "ユーザーが見つかりません。",
+				problem.LanguageEnglish:  "The user was not found.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidInput, Status: http.StatusBadRequest, Code: "invalid_input",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "入力内容が不正です。",
+				problem.LanguageEnglish:  "The input is invalid.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidEmail, Status: http.StatusBadRequest, Code: "invalid_email",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "メールアドレスの形式が不正です。",
+				problem.LanguageEnglish:  "The email address is invalid.",
+			},
+		},
+		{
+			Err: domain.ErrEmailAlreadyExists, Status: http.StatusConflict, Code: "email_already_exists",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "このメールアドレスは既に登録されています。",
+				problem.LanguageEnglish:  "The email address is already registered.",
+			},
+		},
+	}
+}
@@ -1,8 +1,12 @@
 package interfaces
 
 import (
+	"net/http"
+
 	"github.com/labstack/echo/v4"
+	"zen-connect/internal/infrastructure/problem"
 	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/shared/openapi"
 	"zen-connect/internal/user/application/dto"
 	"zen-connect/internal/user/application/usecase"
 )
@@ -12,6 +16,7 @@ type UserHandler struct {
 	registerUserUseCase     *usecase.RegisterUserUseCase
 	updateProfileUseCase    *usecase.UpdateProfileUseCase
 	getUserProfileUseCase   *usecase.GetUserProfileUseCase
+	getPublicProfileUseCase *usecase.GetPublicProfileUseCase
 }
 
 // NewUserHandler コンストラクタ
@@ -19,20 +24,92 @@ func NewUserHandler(
 	registerUserUseCase *usecase.RegisterUserUseCase,
 	updateProfileUseCase *usecase.UpdateProfileUseCase,
 	getUserProfileUseCase *usecase.GetUserProfileUseCase,
+	getPublicProfileUseCase *usecase.GetPublicProfileUseCase,
 ) *UserHandler {
 	return &UserHandler{
 		registerUserUseCase:     registerUserUseCase,
 		updateProfileUseCase:    updateProfileUseCase,
 		getUserProfileUseCase:   getUserProfileUseCase,
+		getPublicProfileUseCase: getPublicProfileUseCase,
 	}
 }
 
 // SetupRoutes ユーザー関連のルーティング設定
-func (h *UserHandler) SetupRoutes(e *echo.Echo, sessionMiddleware *session.Middleware) {
+// idempotency は作成系エンドポイントに適用するIdempotency-Keyミドルウェア
+func (h *UserHandler) SetupRoutes(e *echo.Echo, sessionMiddleware *session.Middleware, idempotency echo.MiddlewareFunc) {
 	userGroup := e.Group("/users")
 	
+	// ユーザー登録（ユースケースが設定されている場合のみ公開）
+	if h.registerUserUseCase != nil {
+		userGroup.POST("", h.RegisterUser, idempotency)
+	}
+
 	// 現在のユーザー情報取得（認証が必要）
 	userGroup.GET("/me", h.GetCurrentUser, sessionMiddleware.RequireAuth())
+
+	// 他のユーザーの公開プロフィールと獲得したバッジ（認証が必要）
+	userGroup.GET("/:id", h.GetPublicProfile, sessionMiddleware.RequireAuth())
+}
+
+// Endpoints OpenAPIドキュメント用のエンドポイント定義
+func (h *UserHandler) Endpoints() []openapi.Endpoint {
+	tags := []string{"users"}
+	endpoints := []openapi.Endpoint{
+		{
+			Method: http.MethodGet, Path: "/users/me", Tags: tags,
+			Summary:  "Get the profile of the current user",
+			Security: []string{openapi.SecuritySession},
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.GetUserProfileResponse{},
+				http.StatusUnauthorized: nil,
+			},
+		},
+		{
+			Method: http.MethodGet, Path: "/users/:id", Tags: tags,
+			Summary:     "Get the public profile of a user",
+			Description: "The profile without the email address, with the badges the user has earned, earliest first.",
+			Security:    []string{openapi.SecuritySession},
+			Responses: map[int]interface{}{
+				http.StatusOK:           dto.PublicProfileResponse{},
+				http.StatusUnauthorized: nil,
+				http.StatusNotFound:     nil,
+			},
+		},
+	}
+	if h.registerUserUseCase != nil {
+		endpoints = append(endpoints, openapi.Endpoint{
+			Method: http.MethodPost, Path: "/users", Tags: tags,
+			Summary: "Register a user",
+			Headers: []openapi.Parameter{openapi.IdempotencyKeyHeader},
+			Request: dto.RegisterUserRequest{},
+			Responses: map[int]interface{}{
+				http.StatusCreated:             dto.RegisterUserResponse{},
+				http.StatusBadRequest:          nil,
+				http.StatusConflict:            nil,
+				http.StatusUnprocessableEntity: nil,
+			},
+		})
+	}
+	return endpoints
+}
+
+// RegisterUser ユーザー登録
+func (h *UserHandler) RegisterUser(c echo.Context) error {
+	var req dto.RegisterUserRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+
+	response, err := h.registerUserUseCase.Execute(c.Request().Context(), &req)
+	if err != nil {
+		// ドメインエラーはエラーハンドラーでHTTPレスポンスに変換される
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, response)
 }
 
 // GetCurrentUser 現在のユーザー情報取得
@@ -40,9 +117,7 @@ func (h *UserHandler) GetCurrentUser(c echo.Context) error {
 	// セッションからユーザーIDを取得
 	userID, ok := session.GetUserIDFromContext(c.Request().Context())
 	if !ok {
-		return c.JSON(401, map[string]string{
-			"error": "User not authenticated",
-		})
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
 	}
 	
 	// ユーザー情報を取得
@@ -52,10 +127,27 @@ func (h *UserHandler) GetCurrentUser(c echo.Context) error {
 	
 	response, err := h.getUserProfileUseCase.Execute(c.Request().Context(), req)
 	if err != nil {
-		return c.JSON(500, map[string]string{
-			"error": err.Error(),
-		})
+		// ErrUserNotFound は404に変換される
+		return err
 	}
 	
-	return c.JSON(200, response)
-}
\ No newline at end of file
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetPublicProfile 公開プロフィール取得
+func (h *UserHandler) GetPublicProfile(c echo.Context) error {
+	if _, ok := session.GetUserIDFromContext(c.Request().Context()); !ok {
+		return problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+
+	req := &dto.GetPublicProfileRequest{
+		UserID: c.Param("id"),
+	}
+
+	response, err := h.getPublicProfileUseCase.Execute(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,92 @@
+package interfaces
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/user/application/dto"
+	"zen-connect/internal/user/application/service"
+	"zen-connect/internal/user/application/usecase"
+	"zen-connect/internal/user/domain"
+	"zen-connect/internal/user/infrastructure"
+)
+
+func TestGetCurrentUser_ShouldReturnNotFoundForUnknownUser(t *testing.T) {
+	// given
+	userService := service.NewUserService(infrastructure.NewInMemoryUserRepository(), nil)
+	handler := NewUserHandler(nil, nil, usecase.NewGetUserProfileUseCase(userService), nil)
+
+	e := echo.New()
+	e.HTTPErrorHandler = problem.NewErrorHandler(problem.Config{Mappings: ErrorMappings()})
+	e.GET("/users/me", func(c echo.Context) error {
+		ctx := context.WithValue(c.Request().Context(), "user_id", "deleted-user")
+		c.SetRequest(c.Request().WithContext(ctx))
+		return handler.GetCurrentUser(c)
+	})
+	rec := httptest.NewRecorder()
+
+	// when
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users/me", nil))
+
+	// then
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", rec.Code)
+	}
+	if rec.Header().Get(echo.HeaderContentType) != problem.MIMEProblemJSON {
+		t.Errorf("Expected problem response, got %q", rec.Header().Get(echo.HeaderContentType))
+	}
+}
+
+type stubBadgeSource struct {
+	badges []dto.BadgeDTO
+}
+
+func (s stubBadgeSource) Earned(ctx context.Context, userID string) ([]dto.BadgeDTO, error) {
+	return s.badges, nil
+}
+
+func TestGetPublicProfile_ShouldShowBadgesWithoutTheEmailAddress(t *testing.T) {
+	// given
+	repo := infrastructure.NewInMemoryUserRepository()
+	email, _ := domain.NewEmail("taro@example.com")
+	user := domain.NewUser("auth0|taro", email, "Taro", true)
+	repo.Save(user)
+	badges := stubBadgeSource{badges: []dto.BadgeDTO{{BadgeID: "first_sit", EarnedAt: time.Now()}}}
+	handler := NewUserHandler(nil, nil, nil, usecase.NewGetPublicProfileUseCase(service.NewUserService(repo, nil), badges))
+
+	e := echo.New()
+	e.HTTPErrorHandler = problem.NewErrorHandler(problem.Config{Mappings: ErrorMappings()})
+	e.GET("/users/:id", func(c echo.Context) error {
+		ctx := context.WithValue(c.Request().Context(), "user_id", "viewer")
+		c.SetRequest(c.Request().WithContext(ctx))
+		return handler.GetPublicProfile(c)
+	})
+	rec := httptest.NewRecorder()
+	notFound := httptest.NewRecorder()
+
+	// when
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users/"+user.ID(), nil))
+	e.ServeHTTP(notFound, httptest.NewRequest(http.MethodGet, "/users/not-a-user", nil))
+
+	// then
+	var response dto.PublicProfileResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil || rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d (%v)", rec.Code, err)
+	}
+	if response.Profile.DisplayName != "Taro" || len(response.Badges) != 1 || response.Badges[0].BadgeID != "first_sit" {
+		t.Errorf("Expected Taro with the first_sit badge, got %+v", response)
+	}
+	if strings.Contains(rec.Body.String(), "taro@example.com") {
+		t.Errorf("Expected no email address, got %s", rec.Body.String())
+	}
+	if notFound.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404 for an unknown ID, got %d", notFound.Code)
+	}
+}
@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"zen-connect/internal/webhook/domain"
+)
+
+// FromWebhook converts a webhook to DTO
+func FromWebhook(webhook *domain.Webhook) WebhookDTO {
+	events := make([]string, 0, len(webhook.Events()))
+	for _, event := range webhook.Events() {
+		events = append(events, string(event))
+	}
+	return WebhookDTO{
+		WebhookID:   webhook.ID(),
+		Scope:       string(webhook.Scope()),
+		URL:         webhook.URL(),
+		Events:      events,
+		Secret:      webhook.Secret(),
+		Description: webhook.Description(),
+		Active:      webhook.IsActive(),
+		CreatedAt:   webhook.CreatedAt(),
+		UpdatedAt:   webhook.UpdatedAt(),
+	}
+}
+
+// FromDelivery converts a delivery to DTO
+func FromDelivery(delivery *domain.Delivery) DeliveryDTO {
+	response := DeliveryDTO{
+		DeliveryID:     delivery.ID(),
+		EventID:        delivery.EventID(),
+		Event:          string(delivery.EventType()),
+		Status:         string(delivery.Status()),
+		Attempts:       delivery.Attempts(),
+		ResponseStatus: delivery.ResponseStatus(),
+		LastError:      delivery.LastError(),
+		CreatedAt:      delivery.CreatedAt(),
+		Payload:        json.RawMessage(delivery.Payload()),
+	}
+	if delivery.Status() == domain.DeliveryPending {
+		next := delivery.NextAttemptAt()
+		response.NextAttemptAt = &next
+	} else {
+		completed := delivery.CompletedAt()
+		response.CompletedAt = &completed
+	}
+	return response
+}
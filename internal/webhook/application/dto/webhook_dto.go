@@ -0,0 +1,103 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Owner Webhook を管理するユーザー（グローバルな Webhook は管理者全員）
+type Owner struct {
+	// Global 管理者が設定する、全ユーザーのイベントを受け取る Webhook か
+	Global bool
+	UserID string
+}
+
+// WebhookDTO Webhook の設定
+type WebhookDTO struct {
+	WebhookID string   `json:"webhook_id"`
+	Scope     string   `json:"scope"`
+	URL       string   `json:"url"`
+	Events    []string `json:"events"`
+	// Secret 署名の検証に使う共有シークレット
+	Secret      string    `json:"secret"`
+	Description string    `json:"description,omitempty"`
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ListWebhooksResponse Webhook の一覧（古い順）
+type ListWebhooksResponse struct {
+	Webhooks []WebhookDTO `json:"webhooks"`
+}
+
+// CreateWebhookRequest Webhook の作成リクエスト
+type CreateWebhookRequest struct {
+	Owner       Owner    `json:"-"`
+	URL         string   `json:"url" validate:"required,max=2048"`
+	Events      []string `json:"events" validate:"required,min=1,max=10,dive,max=50"`
+	Description string   `json:"description,omitempty" validate:"max=200"`
+}
+
+// UpdateWebhookRequest Webhook の変更リクエスト（指定しなかった項目はそのまま）
+type UpdateWebhookRequest struct {
+	Owner       Owner    `json:"-"`
+	WebhookID   string   `json:"-"`
+	URL         *string  `json:"url,omitempty" validate:"omitempty,max=2048"`
+	Events      []string `json:"events,omitempty" validate:"omitempty,min=1,max=10,dive,max=50"`
+	Description *string  `json:"description,omitempty" validate:"omitempty,max=200"`
+	Active      *bool    `json:"active,omitempty"`
+	// RotateSecret 新しいシークレットを発行する（古いシークレットの署名は検証できなくなる）
+	RotateSecret bool `json:"rotate_secret,omitempty"`
+}
+
+// DeliveryDTO 配信ログの一件
+type DeliveryDTO struct {
+	DeliveryID string `json:"delivery_id"`
+	EventID    string `json:"event_id"`
+	Event      string `json:"event"`
+	// Status pending（送信待ち・再送待ち）、succeeded、failed（再送を諦めた）
+	Status   string `json:"status"`
+	Attempts int    `json:"attempts"`
+	// ResponseStatus 最後の送信での HTTP ステータス（応答がなければ省略）
+	ResponseStatus int        `json:"response_status,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	CompletedAt    *time.Time `json:"completed_at,omitempty"`
+	// Payload 送信した本文
+	Payload json.RawMessage `json:"payload"`
+}
+
+// ListDeliveriesRequest 配信ログの取得条件（クエリパラメータ）
+type ListDeliveriesRequest struct {
+	Owner     Owner  `query:"-"`
+	WebhookID string `query:"-"`
+	Limit     int    `query:"limit" validate:"omitempty,gte=1,lte=100"`
+	Offset    int    `query:"offset" validate:"gte=0"`
+}
+
+// ListDeliveriesResponse 配信ログ（新しい順）
+type ListDeliveriesResponse struct {
+	Deliveries []DeliveryDTO `json:"deliveries"`
+	// NextOffset 続きがある場合に次のページを取得する offset
+	NextOffset *int `json:"next_offset,omitempty"`
+}
+
+// EventPayload Webhook に送る本文
+// 同じイベントは配信先や再送に関わらず同じ ID で届く（受信側での重複排除用）
+type EventPayload struct {
+	EventID    string         `json:"event_id"`
+	Event      string         `json:"event"`
+	OccurredAt time.Time      `json:"occurred_at"`
+	Data       map[string]any `json:"data"`
+}
+
+// PublishEventCommand ドメインイベントを Webhook に配信するコマンド
+type PublishEventCommand struct {
+	Event string
+	// UserID イベントに関わるユーザー（そのユーザーの Webhook にも届く）
+	UserID     string
+	OccurredAt time.Time
+	Data       map[string]any
+}
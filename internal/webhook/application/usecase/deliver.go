@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"zen-connect/internal/webhook/domain"
+)
+
+const (
+	// deliveryBatchSize 一回の実行で送る配信の数
+	deliveryBatchSize = 50
+	// deliveryLease 送信中の配信を他のインスタンスから隠しておく時間
+	deliveryLease = 5 * time.Minute
+)
+
+// errWebhookInactive 送信待ちの間に Webhook が無効にされた
+var errWebhookInactive = errors.New("webhook is inactive")
+
+// DeliverUseCase 送信キューの配信を送り、失敗した配信の再送を予定するユースケース
+type DeliverUseCase struct {
+	webhookRepo  domain.WebhookRepository
+	deliveryRepo domain.DeliveryRepository
+	sender       Sender
+	policy       domain.RetryPolicy
+	// retention 送信が終わった配信を配信ログに残す期間
+	retention time.Duration
+}
+
+// NewDeliverUseCase コンストラクタ
+func NewDeliverUseCase(
+	webhookRepo domain.WebhookRepository,
+	deliveryRepo domain.DeliveryRepository,
+	sender Sender,
+	policy domain.RetryPolicy,
+	retention time.Duration,
+) *DeliverUseCase {
+	return &DeliverUseCase{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		sender:       sender,
+		policy:       policy,
+		retention:    retention,
+	}
+}
+
+// ProcessDue 送信時刻になった配信を送り、成功した数を返す
+func (uc *DeliverUseCase) ProcessDue(ctx context.Context) (int, error) {
+	now := time.Now()
+	deliveries, err := uc.deliveryRepo.ClaimDue(ctx, now, now.Add(deliveryLease), deliveryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	// 同じ Webhook への配信が続くことが多いので、一回の実行の間は使い回す
+	webhooks := map[string]*domain.Webhook{}
+	sent := 0
+	var errs []error
+	for _, delivery := range deliveries {
+		webhook, ok := webhooks[delivery.WebhookID()]
+		if !ok {
+			webhook, err = uc.webhookRepo.FindByID(ctx, delivery.WebhookID())
+			if err != nil && !errors.Is(err, domain.ErrWebhookNotFound) {
+				errs = append(errs, fmt.Errorf("delivery %s: %w", delivery.ID(), err))
+				continue
+			}
+			webhooks[delivery.WebhookID()] = webhook
+		}
+		// 削除された Webhook の配信は配信ログごと消えている
+		if webhook == nil {
+			continue
+		}
+
+		if err := uc.attempt(ctx, webhook, delivery); err != nil {
+			errs = append(errs, fmt.Errorf("delivery %s: %w", delivery.ID(), err))
+			continue
+		}
+		if delivery.Status() == domain.DeliverySucceeded {
+			sent++
+		}
+	}
+	return sent, errors.Join(errs...)
+}
+
+// PurgeCompleted 保存期間を過ぎた配信ログを削除し、削除した数を返す
+func (uc *DeliverUseCase) PurgeCompleted(ctx context.Context) (int, error) {
+	return uc.deliveryRepo.DeleteCompletedBefore(ctx, time.Now().Add(-uc.retention))
+}
+
+// attempt 配信を一回送って結果を保存する
+func (uc *DeliverUseCase) attempt(ctx context.Context, webhook *domain.Webhook, delivery *domain.Delivery) error {
+	uc.send(ctx, webhook, delivery, uc.policy)
+	return uc.deliveryRepo.Update(ctx, delivery)
+}
+
+// send 配信を一回送って結果を記録する
+// 無効にされた Webhook にはテストの Ping 以外は送らず、再送もしない
+func (uc *DeliverUseCase) send(ctx context.Context, webhook *domain.Webhook, delivery *domain.Delivery, policy domain.RetryPolicy) {
+	if !webhook.IsActive() && delivery.EventType() != domain.EventPing {
+		delivery.RecordFailure(0, errWebhookInactive, domain.RetryPolicy{MaxAttempts: 1}, time.Now())
+		return
+	}
+
+	status, err := uc.sender.Send(ctx, webhook, delivery)
+	if err != nil {
+		delivery.RecordFailure(status, err, policy, time.Now())
+		return
+	}
+	delivery.RecordSuccess(status, time.Now())
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+
+	"zen-connect/internal/webhook/domain"
+)
+
+// Sender 署名した本文を Webhook の URL に送る
+type Sender interface {
+	// Send 2xx 以外の応答と接続のエラーは error を返す（応答があった場合はそのステータスも返す）
+	Send(ctx context.Context, webhook *domain.Webhook, delivery *domain.Delivery) (int, error)
+}
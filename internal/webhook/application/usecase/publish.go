@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"zen-connect/internal/webhook/application/dto"
+	"zen-connect/internal/webhook/domain"
+)
+
+// PublishEventUseCase ドメインイベントを購読している Webhook への配信を送信キューに入れるユースケース
+type PublishEventUseCase struct {
+	webhookRepo  domain.WebhookRepository
+	deliveryRepo domain.DeliveryRepository
+}
+
+// NewPublishEventUseCase コンストラクタ
+func NewPublishEventUseCase(webhookRepo domain.WebhookRepository, deliveryRepo domain.DeliveryRepository) *PublishEventUseCase {
+	return &PublishEventUseCase{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+	}
+}
+
+// Execute イベントに関わるユーザーの Webhook とグローバルな Webhook に配信する
+// 送信はバックグラウンドで行い、失敗すると再送される
+func (uc *PublishEventUseCase) Execute(ctx context.Context, cmd *dto.PublishEventCommand) error {
+	eventType, err := domain.ParseEventType(cmd.Event)
+	if err != nil {
+		return err
+	}
+	webhooks, err := uc.webhookRepo.FindSubscribers(ctx, eventType, cmd.UserID)
+	if err != nil || len(webhooks) == 0 {
+		return err
+	}
+
+	eventID := uuid.New().String()
+	payload, err := json.Marshal(dto.EventPayload{
+		EventID:    eventID,
+		Event:      cmd.Event,
+		OccurredAt: cmd.OccurredAt.UTC(),
+		Data:       cmd.Data,
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	deliveries := make([]*domain.Delivery, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		deliveries = append(deliveries, domain.NewDelivery(webhook.ID(), eventID, eventType, payload, now))
+	}
+	return uc.deliveryRepo.Add(ctx, deliveries...)
+}
@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"zen-connect/internal/webhook/application/dto"
+	"zen-connect/internal/webhook/domain"
+)
+
+// defaultDeliveryLimit 配信ログの既定の取得件数
+const defaultDeliveryLimit = 20
+
+// WebhookUseCase Webhook の設定と配信ログのユースケース
+// ユーザーは自分の Webhook を、管理者はグローバルな Webhook を管理する
+type WebhookUseCase struct {
+	webhookRepo  domain.WebhookRepository
+	deliveryRepo domain.DeliveryRepository
+	deliver      *DeliverUseCase
+}
+
+// NewWebhookUseCase コンストラクタ
+func NewWebhookUseCase(webhookRepo domain.WebhookRepository, deliveryRepo domain.DeliveryRepository, deliver *DeliverUseCase) *WebhookUseCase {
+	return &WebhookUseCase{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		deliver:      deliver,
+	}
+}
+
+// scopeOf Webhook の管理者に対応するスコープ
+func scopeOf(owner dto.Owner) domain.Scope {
+	if owner.Global {
+		return domain.ScopeGlobal
+	}
+	return domain.ScopeUser
+}
+
+// findManaged 管理している Webhook を取得（他人の Webhook は見つからない扱い）
+func (uc *WebhookUseCase) findManaged(ctx context.Context, owner dto.Owner, webhookID string) (*domain.Webhook, error) {
+	webhook, err := uc.webhookRepo.FindByID(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if !webhook.ManagedBy(scopeOf(owner), owner.UserID) {
+		return nil, domain.ErrWebhookNotFound
+	}
+	return webhook, nil
+}
+
+// Create Webhook を作成（署名用のシークレットは自動で発行される）
+func (uc *WebhookUseCase) Create(ctx context.Context, req *dto.CreateWebhookRequest) (*dto.WebhookDTO, error) {
+	scope := scopeOf(req.Owner)
+	existing, err := uc.webhookRepo.FindByOwner(ctx, scope, req.Owner.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= domain.MaxWebhooksPerOwner {
+		return nil, domain.ErrTooManyWebhooks
+	}
+
+	webhook, err := domain.NewWebhook(scope, req.Owner.UserID, req.URL, req.Events, req.Description, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.webhookRepo.Save(ctx, webhook); err != nil {
+		return nil, err
+	}
+
+	response := dto.FromWebhook(webhook)
+	return &response, nil
+}
+
+// List 管理している Webhook の一覧
+func (uc *WebhookUseCase) List(ctx context.Context, owner dto.Owner) (*dto.ListWebhooksResponse, error) {
+	webhooks, err := uc.webhookRepo.FindByOwner(ctx, scopeOf(owner), owner.UserID)
+	if err != nil {
+		return nil, err
+	}
+	response := &dto.ListWebhooksResponse{
+		Webhooks: make([]dto.WebhookDTO, 0, len(webhooks)),
+	}
+	for _, webhook := range webhooks {
+		response.Webhooks = append(response.Webhooks, dto.FromWebhook(webhook))
+	}
+	return response, nil
+}
+
+// Get Webhook を取得
+func (uc *WebhookUseCase) Get(ctx context.Context, owner dto.Owner, webhookID string) (*dto.WebhookDTO, error) {
+	webhook, err := uc.findManaged(ctx, owner, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	response := dto.FromWebhook(webhook)
+	return &response, nil
+}
+
+// Update URL・イベント・説明・有効かどうかを変更し、必要ならシークレットを発行し直す
+func (uc *WebhookUseCase) Update(ctx context.Context, req *dto.UpdateWebhookRequest) (*dto.WebhookDTO, error) {
+	webhook, err := uc.findManaged(ctx, req.Owner, req.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err := webhook.Change(req.URL, req.Events, req.Description, req.Active, now); err != nil {
+		return nil, err
+	}
+	if req.RotateSecret {
+		webhook.RotateSecret(now)
+	}
+	if err := uc.webhookRepo.Save(ctx, webhook); err != nil {
+		return nil, err
+	}
+
+	response := dto.FromWebhook(webhook)
+	return &response, nil
+}
+
+// Delete Webhook を配信ログごと削除（送信待ちの配信も送らない）
+func (uc *WebhookUseCase) Delete(ctx context.Context, owner dto.Owner, webhookID string) error {
+	webhook, err := uc.findManaged(ctx, owner, webhookID)
+	if err != nil {
+		return err
+	}
+	return uc.webhookRepo.Delete(ctx, webhook.ID())
+}
+
+// ListDeliveries Webhook の配信ログ（新しい順）
+func (uc *WebhookUseCase) ListDeliveries(ctx context.Context, req *dto.ListDeliveriesRequest) (*dto.ListDeliveriesResponse, error) {
+	webhook, err := uc.findManaged(ctx, req.Owner, req.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultDeliveryLimit
+	}
+	// 一件多く取得して続きがあるかを判定
+	deliveries, err := uc.deliveryRepo.FindByWebhookID(ctx, webhook.ID(), limit+1, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ListDeliveriesResponse{
+		Deliveries: make([]dto.DeliveryDTO, 0, len(deliveries)),
+	}
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+		next := req.Offset + limit
+		response.NextOffset = &next
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, dto.FromDelivery(delivery))
+	}
+	return response, nil
+}
+
+// SendTest Ping イベントをその場で一回だけ送り、結果を返す（失敗しても再送しない）
+// 無効にした Webhook にも送れる
+func (uc *WebhookUseCase) SendTest(ctx context.Context, owner dto.Owner, webhookID string) (*dto.DeliveryDTO, error) {
+	webhook, err := uc.findManaged(ctx, owner, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	eventID := uuid.New().String()
+	payload, err := json.Marshal(dto.EventPayload{
+		EventID:    eventID,
+		Event:      string(domain.EventPing),
+		OccurredAt: now.UTC(),
+		Data: map[string]any{
+			"webhook_id": webhook.ID(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 送り終えてから配信ログに記録する（送信待ちにしないので、バックグラウンドの送信とは重ならない）
+	delivery := domain.NewDelivery(webhook.ID(), eventID, domain.EventPing, payload, now)
+	uc.deliver.send(ctx, webhook, delivery, domain.RetryPolicy{MaxAttempts: 1})
+	if err := uc.deliveryRepo.Add(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	response := dto.FromDelivery(delivery)
+	return &response, nil
+}
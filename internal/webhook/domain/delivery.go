@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeliveryStatus is the state of a webhook delivery
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+// MaxErrorLength is how much of a failure is kept in the delivery log
+const MaxErrorLength = 500
+
+// ErrDeliveryNotFound the delivery does not exist
+var ErrDeliveryNotFound = errors.New("webhook delivery not found")
+
+// RetryPolicy decides when a failed delivery is tried again
+type RetryPolicy struct {
+	// MaxAttempts is how often a delivery is tried before it is given up
+	MaxAttempts int
+	// Backoff is the wait after the first failure; it doubles with every attempt
+	Backoff time.Duration
+	// MaxBackoff caps the wait between attempts
+	MaxBackoff time.Duration
+}
+
+// next returns when to try again after the given number of attempts
+func (p RetryPolicy) next(attempts int, now time.Time) time.Time {
+	backoff := p.Backoff << (attempts - 1)
+	if backoff <= 0 || (p.MaxBackoff > 0 && backoff > p.MaxBackoff) {
+		backoff = p.MaxBackoff
+	}
+	return now.Add(backoff)
+}
+
+// Delivery is one event sent (or to be sent) to one webhook; it is the
+// delivery log entry as well. The payload is stored so every attempt sends
+// the same body.
+type Delivery struct {
+	id             string
+	webhookID      string
+	eventID        string
+	eventType      EventType
+	payload        []byte
+	status         DeliveryStatus
+	attempts       int
+	nextAttemptAt  time.Time
+	responseStatus int
+	lastError      string
+	createdAt      time.Time
+	completedAt    time.Time
+}
+
+// NewDelivery creates a pending delivery due at once
+func NewDelivery(webhookID, eventID string, eventType EventType, payload []byte, now time.Time) *Delivery {
+	return &Delivery{
+		id:            uuid.New().String(),
+		webhookID:     webhookID,
+		eventID:       eventID,
+		eventType:     eventType,
+		payload:       payload,
+		status:        DeliveryPending,
+		nextAttemptAt: now,
+		createdAt:     now,
+	}
+}
+
+// ReconstructDelivery recreates a delivery from persisted data
+func ReconstructDelivery(id, webhookID, eventID string, eventType EventType, payload []byte, status DeliveryStatus, attempts int, nextAttemptAt time.Time, responseStatus int, lastError string, createdAt, completedAt time.Time) *Delivery {
+	return &Delivery{
+		id:             id,
+		webhookID:      webhookID,
+		eventID:        eventID,
+		eventType:      eventType,
+		payload:        payload,
+		status:         status,
+		attempts:       attempts,
+		nextAttemptAt:  nextAttemptAt,
+		responseStatus: responseStatus,
+		lastError:      lastError,
+		createdAt:      createdAt,
+		completedAt:    completedAt,
+	}
+}
+
+func (d *Delivery) ID() string               { return d.id }
+func (d *Delivery) WebhookID() string        { return d.webhookID }
+func (d *Delivery) EventID() string          { return d.eventID }
+func (d *Delivery) EventType() EventType     { return d.eventType }
+func (d *Delivery) Payload() []byte          { return d.payload }
+func (d *Delivery) Status() DeliveryStatus   { return d.status }
+func (d *Delivery) Attempts() int            { return d.attempts }
+func (d *Delivery) NextAttemptAt() time.Time { return d.nextAttemptAt }
+
+// ResponseStatus is the HTTP status of the last attempt, 0 when no response arrived
+func (d *Delivery) ResponseStatus() int    { return d.responseStatus }
+func (d *Delivery) LastError() string      { return d.lastError }
+func (d *Delivery) CreatedAt() time.Time   { return d.createdAt }
+func (d *Delivery) CompletedAt() time.Time { return d.completedAt }
+
+// RecordSuccess marks the delivery as received by the endpoint
+func (d *Delivery) RecordSuccess(responseStatus int, now time.Time) {
+	d.attempts++
+	d.status = DeliverySucceeded
+	d.responseStatus = responseStatus
+	d.lastError = ""
+	d.completedAt = now
+}
+
+// RecordFailure schedules the next attempt, or gives the delivery up once
+// the policy's attempts are used
+func (d *Delivery) RecordFailure(responseStatus int, cause error, policy RetryPolicy, now time.Time) {
+	d.attempts++
+	d.responseStatus = responseStatus
+	d.lastError = cause.Error()
+	if len(d.lastError) > MaxErrorLength {
+		d.lastError = d.lastError[:MaxErrorLength]
+	}
+	if d.attempts >= policy.MaxAttempts {
+		d.status = DeliveryFailed
+		d.completedAt = now
+		return
+	}
+	d.nextAttemptAt = policy.next(d.attempts, now)
+}
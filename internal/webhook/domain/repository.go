@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// WebhookRepository defines the interface for webhook persistence
+type WebhookRepository interface {
+	Save(ctx context.Context, webhook *Webhook) error
+	FindByID(ctx context.Context, id string) (*Webhook, error)
+	// FindByOwner returns the webhooks of a user, or every global webhook, oldest first
+	FindByOwner(ctx context.Context, scope Scope, ownerID string) ([]*Webhook, error)
+	// FindSubscribers returns the active webhooks an event about the user is delivered to
+	FindSubscribers(ctx context.Context, eventType EventType, userID string) ([]*Webhook, error)
+	// Delete removes a webhook and its delivery log
+	Delete(ctx context.Context, id string) error
+}
+
+// DeliveryRepository defines the interface for the delivery queue and log
+type DeliveryRepository interface {
+	Add(ctx context.Context, deliveries ...*Delivery) error
+	// ClaimDue returns up to limit pending deliveries due at now and postpones
+	// them until leaseUntil, so no other instance sends them meanwhile
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*Delivery, error)
+	// Update stores the outcome of a delivery attempt
+	Update(ctx context.Context, delivery *Delivery) error
+	// FindByWebhookID returns a webhook's deliveries, newest first
+	FindByWebhookID(ctx context.Context, webhookID string, limit, offset int) ([]*Delivery, error)
+	// DeleteCompletedBefore removes deliveries that finished before the time and returns how many
+	DeleteCompletedBefore(ctx context.Context, before time.Time) (int, error)
+}
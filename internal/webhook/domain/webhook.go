@@ -0,0 +1,258 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Scope says whose events a webhook receives
+type Scope string
+
+const (
+	// ScopeUser webhooks belong to a user and receive the events about them
+	ScopeUser Scope = "user"
+	// ScopeGlobal webhooks are managed by admins and receive everyone's events
+	ScopeGlobal Scope = "global"
+)
+
+// EventType is a domain event that can be delivered to webhooks
+type EventType string
+
+const (
+	EventExperienceCreated           EventType = "ExperienceCreated"
+	EventExperienceVisibilityChanged EventType = "ExperienceVisibilityChanged"
+	// EventHistoryImported is sent once per history import, in place of
+	// ExperienceCreated for each imported experience
+	EventHistoryImported EventType = "HistoryImported"
+	// EventUserRegistered can only be subscribed to by global webhooks
+	EventUserRegistered EventType = "UserRegistered"
+	// EventPing is only sent on request, to test a webhook
+	EventPing EventType = "Ping"
+)
+
+const (
+	// MaxWebhooksPerOwner is how many webhooks a user (or the admins together) can have
+	MaxWebhooksPerOwner = 10
+	// MaxDescriptionLength is the maximum length of a webhook description
+	MaxDescriptionLength = 200
+	// MaxURLLength is the maximum length of a webhook URL
+	MaxURLLength = 2048
+)
+
+// Domain errors for Webhook
+var (
+	ErrWebhookNotFound     = errors.New("webhook not found")
+	ErrInvalidWebhookURL   = errors.New("webhook URL must be an absolute http or https URL")
+	ErrInvalidEventType    = errors.New("invalid webhook event type")
+	ErrEventNotAllowed     = errors.New("event type cannot be subscribed to by the webhook")
+	ErrNoEvents            = errors.New("webhook must subscribe to at least one event")
+	ErrDescriptionTooLong  = errors.New("webhook description is too long")
+	ErrTooManyWebhooks     = errors.New("too many webhooks")
+	ErrForbiddenWebhookURL = errors.New("webhook URL resolves to a private network address")
+)
+
+// EventTypes returns every event type webhooks can subscribe to
+func EventTypes() []EventType {
+	return []EventType{EventExperienceCreated, EventExperienceVisibilityChanged, EventHistoryImported, EventUserRegistered}
+}
+
+// ParseEventType validates an event type webhooks can subscribe to
+func ParseEventType(s string) (EventType, error) {
+	for _, t := range EventTypes() {
+		if string(t) == s {
+			return t, nil
+		}
+	}
+	return "", ErrInvalidEventType
+}
+
+// AllowedFor reports whether webhooks of the scope may subscribe to the event type.
+// Registrations concern no existing user, so only admins can follow them.
+func (t EventType) AllowedFor(scope Scope) bool {
+	return t != EventUserRegistered || scope == ScopeGlobal
+}
+
+// Webhook is an endpoint of a third-party integration that receives signed
+// event payloads (aggregate root)
+type Webhook struct {
+	id          string
+	scope       Scope
+	ownerID     string
+	url         string
+	secret      string
+	events      []EventType
+	description string
+	active      bool
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewWebhook creates an active webhook with a new signing secret.
+// ownerID is empty for global webhooks.
+func NewWebhook(scope Scope, ownerID, rawURL string, events []string, description string, now time.Time) (*Webhook, error) {
+	w := &Webhook{
+		id:        uuid.New().String(),
+		scope:     scope,
+		ownerID:   ownerID,
+		secret:    newSecret(),
+		active:    true,
+		createdAt: now,
+		updatedAt: now,
+	}
+	if scope == ScopeGlobal {
+		w.ownerID = ""
+	}
+	if err := w.setURL(rawURL); err != nil {
+		return nil, err
+	}
+	if err := w.setEvents(events); err != nil {
+		return nil, err
+	}
+	if err := w.setDescription(description); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// ReconstructWebhook recreates a webhook from persisted data
+func ReconstructWebhook(id string, scope Scope, ownerID, rawURL, secret string, events []EventType, description string, active bool, createdAt, updatedAt time.Time) *Webhook {
+	return &Webhook{
+		id:          id,
+		scope:       scope,
+		ownerID:     ownerID,
+		url:         rawURL,
+		secret:      secret,
+		events:      events,
+		description: description,
+		active:      active,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// newSecret returns a random signing secret
+func newSecret() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return "whsec_" + base64.RawURLEncoding.EncodeToString(b)
+}
+
+func (w *Webhook) ID() string           { return w.id }
+func (w *Webhook) Scope() Scope         { return w.scope }
+func (w *Webhook) OwnerID() string      { return w.ownerID }
+func (w *Webhook) URL() string          { return w.url }
+func (w *Webhook) Secret() string       { return w.secret }
+func (w *Webhook) Events() []EventType  { return w.events }
+func (w *Webhook) Description() string  { return w.description }
+func (w *Webhook) IsActive() bool       { return w.active }
+func (w *Webhook) CreatedAt() time.Time { return w.createdAt }
+func (w *Webhook) UpdatedAt() time.Time { return w.updatedAt }
+
+// ManagedBy reports whether the webhook is one of the owner's; global
+// webhooks are managed by every admin together
+func (w *Webhook) ManagedBy(scope Scope, ownerID string) bool {
+	if w.scope != scope {
+		return false
+	}
+	return scope == ScopeGlobal || w.ownerID == ownerID
+}
+
+// Subscribes reports whether an event about the user is delivered to the webhook.
+// userID is empty for events that concern no user.
+func (w *Webhook) Subscribes(eventType EventType, userID string) bool {
+	if !w.active {
+		return false
+	}
+	if w.scope == ScopeUser && (userID == "" || w.ownerID != userID) {
+		return false
+	}
+	for _, t := range w.events {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Change replaces the given settings; nil leaves a setting as it is
+func (w *Webhook) Change(rawURL *string, events []string, description *string, active *bool, now time.Time) error {
+	if rawURL != nil {
+		if err := w.setURL(*rawURL); err != nil {
+			return err
+		}
+	}
+	if events != nil {
+		if err := w.setEvents(events); err != nil {
+			return err
+		}
+	}
+	if description != nil {
+		if err := w.setDescription(*description); err != nil {
+			return err
+		}
+	}
+	if active != nil {
+		w.active = *active
+	}
+	w.updatedAt = now
+	return nil
+}
+
+// RotateSecret replaces the signing secret; payloads signed with the old one
+// no longer verify
+func (w *Webhook) RotateSecret(now time.Time) {
+	w.secret = newSecret()
+	w.updatedAt = now
+}
+
+func (w *Webhook) setURL(rawURL string) error {
+	rawURL = strings.TrimSpace(rawURL)
+	if len(rawURL) > MaxURLLength {
+		return ErrInvalidWebhookURL
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Hostname() == "" || parsed.User != nil {
+		return ErrInvalidWebhookURL
+	}
+	w.url = rawURL
+	return nil
+}
+
+func (w *Webhook) setEvents(events []string) error {
+	var parsed []EventType
+	seen := map[EventType]bool{}
+	for _, s := range events {
+		t, err := ParseEventType(s)
+		if err != nil {
+			return err
+		}
+		if !t.AllowedFor(w.scope) {
+			return ErrEventNotAllowed
+		}
+		if !seen[t] {
+			seen[t] = true
+			parsed = append(parsed, t)
+		}
+	}
+	if len(parsed) == 0 {
+		return ErrNoEvents
+	}
+	w.events = parsed
+	return nil
+}
+
+func (w *Webhook) setDescription(description string) error {
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > MaxDescriptionLength {
+		return ErrDescriptionTooLong
+	}
+	w.description = description
+	return nil
+}
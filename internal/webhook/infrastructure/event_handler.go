@@ -0,0 +1,98 @@
+package infrastructure
+
+import (
+	"context"
+
+	experiencedomain "zen-connect/internal/experience/domain"
+	"zen-connect/internal/shared/event"
+	userdomain "zen-connect/internal/user/domain"
+	"zen-connect/internal/webhook/application/dto"
+	"zen-connect/internal/webhook/application/usecase"
+	"zen-connect/internal/webhook/domain"
+)
+
+// EventHandler subscribes to the event bus and queues the events webhooks
+// can follow for delivery
+type EventHandler struct {
+	publish *usecase.PublishEventUseCase
+}
+
+// NewEventHandler creates a new webhook event handler
+func NewEventHandler(publish *usecase.PublishEventUseCase) *EventHandler {
+	return &EventHandler{
+		publish: publish,
+	}
+}
+
+// Subscribe registers the handler for every event webhooks can subscribe to
+func (h *EventHandler) Subscribe(bus event.EventBus) {
+	for _, eventType := range domain.EventTypes() {
+		bus.Register(string(eventType), h)
+	}
+}
+
+// Handle implements event.EventHandler
+func (h *EventHandler) Handle(ctx context.Context, e event.DomainEvent) error {
+	cmd := command(e)
+	if cmd == nil {
+		return nil
+	}
+	return h.publish.Execute(ctx, cmd)
+}
+
+// command decides what receivers are told about an event. Payloads carry
+// IDs rather than content, so private notes never leave the service.
+func command(e event.DomainEvent) *dto.PublishEventCommand {
+	switch e := e.(type) {
+	case *experiencedomain.ExperienceCreated:
+		return &dto.PublishEventCommand{
+			Event:      e.EventName(),
+			UserID:     e.UserID(),
+			OccurredAt: e.OccurredAt(),
+			Data: map[string]any{
+				"experience_id": e.AggregateID(),
+				"user_id":       e.UserID(),
+				"created_at":    e.CreatedAt().UTC(),
+			},
+		}
+
+	case *experiencedomain.ExperienceVisibilityChanged:
+		return &dto.PublishEventCommand{
+			Event:      e.EventName(),
+			UserID:     e.UserID(),
+			OccurredAt: e.OccurredAt(),
+			Data: map[string]any{
+				"experience_id": e.AggregateID(),
+				"user_id":       e.UserID(),
+				"is_public":     e.IsPublic(),
+				"changed_at":    e.ChangedAt().UTC(),
+			},
+		}
+
+	case *experiencedomain.HistoryImported:
+		return &dto.PublishEventCommand{
+			Event:      e.EventName(),
+			UserID:     e.UserID(),
+			OccurredAt: e.OccurredAt(),
+			Data: map[string]any{
+				"import_id":   e.AggregateID(),
+				"user_id":     e.UserID(),
+				"imported":    len(e.ExperienceIDs()),
+				"imported_at": e.ImportedAt().UTC(),
+			},
+		}
+
+	case *userdomain.UserRegistered:
+		// Only global webhooks follow registrations, so the address goes to admins only
+		return &dto.PublishEventCommand{
+			Event:      e.EventName(),
+			OccurredAt: e.OccurredAt(),
+			Data: map[string]any{
+				"user_id":       e.AggregateID(),
+				"email":         e.Email(),
+				"registered_at": e.RegisteredAt().UTC(),
+			},
+		}
+	}
+	return nil
+}
@@ -0,0 +1,276 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	experiencedomain "zen-connect/internal/experience/domain"
+	userdomain "zen-connect/internal/user/domain"
+	"zen-connect/internal/webhook/application/dto"
+	"zen-connect/internal/webhook/application/usecase"
+	"zen-connect/internal/webhook/domain"
+)
+
+// memoryWebhooks is a WebhookRepository kept in memory
+type memoryWebhooks struct {
+	webhooks []*domain.Webhook
+}
+
+func (r *memoryWebhooks) Save(ctx context.Context, webhook *domain.Webhook) error {
+	r.webhooks = append(r.webhooks, webhook)
+	return nil
+}
+
+func (r *memoryWebhooks) FindByID(ctx context.Context, id string) (*domain.Webhook, error) {
+	for _, webhook := range r.webhooks {
+		if webhook.ID() == id {
+			return webhook, nil
+		}
+	}
+	return nil, domain.ErrWebhookNotFound
+}
+
+func (r *memoryWebhooks) FindByOwner(ctx context.Context, scope domain.Scope, ownerID string) ([]*domain.Webhook, error) {
+	var found []*domain.Webhook
+	for _, webhook := range r.webhooks {
+		if webhook.ManagedBy(scope, ownerID) {
+			found = append(found, webhook)
+		}
+	}
+	return found, nil
+}
+
+func (r *memoryWebhooks) FindSubscribers(ctx context.Context, eventType domain.EventType, userID string) ([]*domain.Webhook, error) {
+	var found []*domain.Webhook
+	for _, webhook := range r.webhooks {
+		if webhook.Subscribes(eventType, userID) {
+			found = append(found, webhook)
+		}
+	}
+	return found, nil
+}
+
+func (r *memoryWebhooks) Delete(ctx context.Context, id string) error { return nil }
+
+// memoryDeliveries is a DeliveryRepository kept in memory
+type memoryDeliveries struct {
+	mu         sync.Mutex
+	deliveries []*domain.Delivery
+}
+
+func (r *memoryDeliveries) Add(ctx context.Context, deliveries ...*domain.Delivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deliveries = append(r.deliveries, deliveries...)
+	return nil
+}
+
+func (r *memoryDeliveries) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*domain.Delivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var due []*domain.Delivery
+	for _, delivery := range r.deliveries {
+		if delivery.Status() == domain.DeliveryPending && !delivery.NextAttemptAt().After(now) {
+			due = append(due, delivery)
+		}
+	}
+	return due, nil
+}
+
+func (r *memoryDeliveries) Update(ctx context.Context, delivery *domain.Delivery) error { return nil }
+
+func (r *memoryDeliveries) FindByWebhookID(ctx context.Context, webhookID string, limit, offset int) ([]*domain.Delivery, error) {
+	return nil, nil
+}
+
+func (r *memoryDeliveries) DeleteCompletedBefore(ctx context.Context, before time.Time) (int, error) {
+	return 0, nil
+}
+
+// receivedRequest is a webhook request as the receiver saw it
+type receivedRequest struct {
+	path   string
+	header http.Header
+	body   []byte
+}
+
+// receiver is a local endpoint answering with the given statuses in turn, then 204
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	received []receivedRequest
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.received = append(r.received, receivedRequest{path: req.URL.Path, header: req.Header, body: body})
+	status := http.StatusNoContent
+	if len(r.statuses) > 0 {
+		status, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+type testPipeline struct {
+	handler  *EventHandler
+	deliver  *usecase.DeliverUseCase
+	webhooks *memoryWebhooks
+	store    *memoryDeliveries
+	server   *httptest.Server
+	receiver *receiver
+}
+
+func newTestPipeline(t *testing.T, statuses ...int) *testPipeline {
+	t.Helper()
+	rec := &receiver{statuses: statuses}
+	server := httptest.NewServer(rec)
+	t.Cleanup(server.Close)
+
+	webhooks := &memoryWebhooks{}
+	store := &memoryDeliveries{}
+	sender := NewHTTPSender(HTTPSenderConfig{Timeout: 5 * time.Second, AllowPrivateNetworks: true})
+	policy := domain.RetryPolicy{MaxAttempts: 3, Backoff: time.Nanosecond, MaxBackoff: time.Nanosecond}
+	return &testPipeline{
+		handler:  NewEventHandler(usecase.NewPublishEventUseCase(webhooks, store)),
+		deliver:  usecase.NewDeliverUseCase(webhooks, store, sender, policy, time.Hour),
+		webhooks: webhooks,
+		store:    store,
+		server:   server,
+		receiver: rec,
+	}
+}
+
+func (p *testPipeline) addWebhook(t *testing.T, scope domain.Scope, ownerID, path string, events ...string) *domain.Webhook {
+	t.Helper()
+	webhook, err := domain.NewWebhook(scope, ownerID, p.server.URL+path, events, "", time.Now())
+	if err != nil {
+		t.Fatalf("Failed to create webhook: %v", err)
+	}
+	p.webhooks.Save(context.Background(), webhook)
+	return webhook
+}
+
+func TestEventHandler_ShouldDeliverSignedEventsToSubscribedWebhooks(t *testing.T) {
+	// given
+	pipeline := newTestPipeline(t)
+	owner := pipeline.addWebhook(t, domain.ScopeUser, "owner", "/owner", "ExperienceCreated")
+	pipeline.addWebhook(t, domain.ScopeUser, "other", "/other", "ExperienceCreated")
+	pipeline.addWebhook(t, domain.ScopeGlobal, "", "/global", "ExperienceCreated", "UserRegistered")
+	now := time.Now()
+
+	// when
+	err := pipeline.handler.Handle(context.Background(), experiencedomain.NewExperienceCreated("experience-1", "owner", now))
+	_ = pipeline.handler.Handle(context.Background(), userdomain.NewUserRegistered("new-user", "new@example.com", now))
+	_ = pipeline.handler.Handle(context.Background(), experiencedomain.NewExperienceVisibilityChanged("experience-1", "owner", true, now))
+	sent, sendErr := pipeline.deliver.ProcessDue(context.Background())
+
+	// then
+	if err != nil || sendErr != nil {
+		t.Fatalf("Expected no error, got %v and %v", err, sendErr)
+	}
+	var paths []string
+	for _, req := range pipeline.receiver.received {
+		paths = append(paths, req.path)
+	}
+	sort.Strings(paths)
+	if sent != 3 || len(paths) != 3 || paths[0] != "/global" || paths[1] != "/global" || paths[2] != "/owner" {
+		t.Fatalf("Expected the owner's and the global webhook to receive their events, got %v", paths)
+	}
+
+	for _, req := range pipeline.receiver.received {
+		if req.path != "/owner" {
+			continue
+		}
+		if err := VerifySignature(owner.Secret(), req.header.Get(SignatureHeader), req.body, time.Minute, time.Now()); err != nil {
+			t.Errorf("Expected a valid signature, got %v", err)
+		}
+		var payload dto.EventPayload
+		json.Unmarshal(req.body, &payload)
+		if payload.Event != "ExperienceCreated" || payload.Data["experience_id"] != "experience-1" || payload.EventID == "" {
+			t.Errorf("Expected the experience in the payload, got %+v", payload)
+		}
+		if req.header.Get(EventHeader) != "ExperienceCreated" || req.header.Get(DeliveryHeader) == "" {
+			t.Errorf("Expected event and delivery headers, got %v", req.header)
+		}
+	}
+}
+
+func TestDeliverUseCase_ShouldRetryFailedDeliveriesWithTheSameBody(t *testing.T) {
+	// given
+	pipeline := newTestPipeline(t, http.StatusInternalServerError, http.StatusBadGateway)
+	pipeline.addWebhook(t, domain.ScopeUser, "owner", "/hook", "ExperienceCreated")
+	_ = pipeline.handler.Handle(context.Background(), experiencedomain.NewExperienceCreated("experience-1", "owner", time.Now()))
+
+	// when
+	for i := 0; i < 4; i++ {
+		pipeline.deliver.ProcessDue(context.Background())
+	}
+
+	// then
+	delivery := pipeline.store.deliveries[0]
+	if delivery.Status() != domain.DeliverySucceeded || delivery.Attempts() != 3 {
+		t.Errorf("Expected success on the third attempt, got %s after %d attempts", delivery.Status(), delivery.Attempts())
+	}
+	bodies := map[string]bool{}
+	for _, req := range pipeline.receiver.received {
+		bodies[string(req.body)] = true
+	}
+	if len(pipeline.receiver.received) != 3 || len(bodies) != 1 {
+		t.Errorf("Expected 3 attempts of one event body, got %d attempts and %d bodies", len(pipeline.receiver.received), len(bodies))
+	}
+}
+
+func TestDeliverUseCase_ShouldGiveUpAfterMaxAttempts(t *testing.T) {
+	// given
+	pipeline := newTestPipeline(t, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError)
+	pipeline.addWebhook(t, domain.ScopeUser, "owner", "/hook", "ExperienceCreated")
+	_ = pipeline.handler.Handle(context.Background(), experiencedomain.NewExperienceCreated("experience-1", "owner", time.Now()))
+
+	// when
+	for i := 0; i < 4; i++ {
+		pipeline.deliver.ProcessDue(context.Background())
+	}
+
+	// then
+	delivery := pipeline.store.deliveries[0]
+	if delivery.Status() != domain.DeliveryFailed || delivery.ResponseStatus() != http.StatusInternalServerError {
+		t.Errorf("Expected the delivery to be given up with the last status, got %s (%d)", delivery.Status(), delivery.ResponseStatus())
+	}
+	if len(pipeline.receiver.received) != 3 {
+		t.Errorf("Expected no attempt past the maximum, got %d", len(pipeline.receiver.received))
+	}
+}
+
+func TestWebhookUseCase_SendTestShouldPingEvenInactiveWebhooks(t *testing.T) {
+	// given
+	pipeline := newTestPipeline(t, http.StatusNotFound)
+	webhook := pipeline.addWebhook(t, domain.ScopeUser, "owner", "/hook", "ExperienceCreated")
+	inactive := false
+	webhook.Change(nil, nil, nil, &inactive, time.Now())
+	webhooks := usecase.NewWebhookUseCase(pipeline.webhooks, pipeline.store, pipeline.deliver)
+
+	// when
+	failed, _ := webhooks.SendTest(context.Background(), dto.Owner{UserID: "owner"}, webhook.ID())
+	succeeded, _ := webhooks.SendTest(context.Background(), dto.Owner{UserID: "owner"}, webhook.ID())
+	_, otherErr := webhooks.SendTest(context.Background(), dto.Owner{UserID: "other"}, webhook.ID())
+
+	// then
+	if failed.Status != "failed" || failed.ResponseStatus != http.StatusNotFound || failed.Attempts != 1 {
+		t.Errorf("Expected the 404 to be recorded without retry, got %+v", failed)
+	}
+	if succeeded.Status != "succeeded" || succeeded.Event != "Ping" {
+		t.Errorf("Expected a delivered ping, got %+v", succeeded)
+	}
+	if otherErr != domain.ErrWebhookNotFound {
+		t.Errorf("Expected other users not to find the webhook, got %v", otherErr)
+	}
+}
@@ -0,0 +1,86 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"zen-connect/internal/infrastructure/netguard"
+	"zen-connect/internal/webhook/domain"
+)
+
+// maxResponseExcerpt is how much of an error response is kept in the delivery log
+const maxResponseExcerpt = 200
+
+// HTTPSenderConfig controls outgoing webhook requests
+type HTTPSenderConfig struct {
+	// Timeout limits a whole request, including reading the response
+	Timeout time.Duration
+	// AllowPrivateNetworks lets webhooks reach loopback and private addresses,
+	// for development and tests. Otherwise users could probe internal services.
+	AllowPrivateNetworks bool
+	// UserAgent identifies the service to receivers
+	UserAgent string
+}
+
+// HTTPSender posts signed payloads to webhook URLs
+type HTTPSender struct {
+	client    *http.Client
+	userAgent string
+	now       func() time.Time
+}
+
+// NewHTTPSender creates a sender. Redirects are not followed: a receiver has
+// to answer at the URL it was registered with.
+func NewHTTPSender(config HTTPSenderConfig) *HTTPSender {
+	userAgent := config.UserAgent
+	if userAgent == "" {
+		userAgent = "ZenConnect-Webhooks/1.0"
+	}
+	return &HTTPSender{
+		client: &http.Client{
+			Timeout:   config.Timeout,
+			Transport: netguard.NewTransport(config.Timeout, config.AllowPrivateNetworks),
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		userAgent: userAgent,
+		now:       time.Now,
+	}
+}
+
+// Send posts the delivery's payload; any response other than 2xx is an error
+func (s *HTTPSender) Send(ctx context.Context, webhook *domain.Webhook, delivery *domain.Delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL(), bytes.NewReader(delivery.Payload()))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", s.userAgent)
+	req.Header.Set(EventHeader, string(delivery.EventType()))
+	req.Header.Set(DeliveryHeader, delivery.ID())
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret(), s.now(), delivery.Payload()))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		if errors.Is(err, netguard.ErrPrivateAddress) {
+			return 0, domain.ErrForbiddenWebhookURL
+		}
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseExcerpt))
+	// Drain a little more so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint responded %d: %s", resp.StatusCode, strings.TrimSpace(string(excerpt)))
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,58 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"zen-connect/internal/webhook/domain"
+)
+
+func TestHTTPSender_ShouldRefusePrivateAddressesAndRedirects(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.RedirectHandler("https://example.com/", http.StatusFound))
+	defer server.Close()
+	webhook, _ := domain.NewWebhook(domain.ScopeUser, "owner", server.URL, []string{"ExperienceCreated"}, "", time.Now())
+	delivery := domain.NewDelivery(webhook.ID(), "event", domain.EventExperienceCreated, []byte(`{}`), time.Now())
+	guarded := NewHTTPSender(HTTPSenderConfig{Timeout: 5 * time.Second})
+	open := NewHTTPSender(HTTPSenderConfig{Timeout: 5 * time.Second, AllowPrivateNetworks: true})
+
+	// when
+	_, guardedErr := guarded.Send(context.Background(), webhook, delivery)
+	status, redirectErr := open.Send(context.Background(), webhook, delivery)
+
+	// then
+	if !errors.Is(guardedErr, domain.ErrForbiddenWebhookURL) {
+		t.Errorf("Expected a loopback address to be refused, got %v", guardedErr)
+	}
+	if status != http.StatusFound || redirectErr == nil {
+		t.Errorf("Expected the redirect to be reported as a failure, got %d, %v", status, redirectErr)
+	}
+}
+
+func TestVerifySignature_ShouldRejectTamperedOrStaleRequests(t *testing.T) {
+	// given
+	now := time.Now()
+	body := []byte(`{"event":"Ping"}`)
+	header := Sign("whsec_test", now, body)
+
+	// then
+	if err := VerifySignature("whsec_test", header, body, time.Minute, now); err != nil {
+		t.Errorf("Expected the signature to verify, got %v", err)
+	}
+	if err := VerifySignature("whsec_test", header, []byte(`{"event":"Pong"}`), time.Minute, now); !errors.Is(err, ErrSignatureMismatch) {
+		t.Errorf("Expected a tampered body to be rejected, got %v", err)
+	}
+	if err := VerifySignature("whsec_other", header, body, time.Minute, now); !errors.Is(err, ErrSignatureMismatch) {
+		t.Errorf("Expected another secret to be rejected, got %v", err)
+	}
+	if err := VerifySignature("whsec_test", header, body, time.Minute, now.Add(2*time.Minute)); !errors.Is(err, ErrSignatureExpired) {
+		t.Errorf("Expected a replayed request to be rejected, got %v", err)
+	}
+	if err := VerifySignature("whsec_test", "v1=zz", body, time.Minute, now); !errors.Is(err, ErrMalformedSignature) {
+		t.Errorf("Expected a malformed header to be rejected, got %v", err)
+	}
+}
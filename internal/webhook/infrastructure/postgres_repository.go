@@ -0,0 +1,302 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"zen-connect/internal/webhook/domain"
+)
+
+// PostgresWebhookRepository implements WebhookRepository interface
+type PostgresWebhookRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresWebhookRepository creates a new PostgreSQL webhook repository
+func NewPostgresWebhookRepository(pool *pgxpool.Pool) *PostgresWebhookRepository {
+	return &PostgresWebhookRepository{
+		pool: pool,
+	}
+}
+
+const webhookColumns = `id, scope, owner_id, url, secret, events, description, active, created_at, updated_at`
+
+// Save upserts a webhook
+func (r *PostgresWebhookRepository) Save(ctx context.Context, webhook *domain.Webhook) error {
+	query := `
+		INSERT INTO webhooks (` + webhookColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE SET
+			url = EXCLUDED.url,
+			secret = EXCLUDED.secret,
+			events = EXCLUDED.events,
+			description = EXCLUDED.description,
+			active = EXCLUDED.active,
+			updated_at = EXCLUDED.updated_at
+	`
+	events := make([]string, 0, len(webhook.Events()))
+	for _, event := range webhook.Events() {
+		events = append(events, string(event))
+	}
+	_, err := r.pool.Exec(ctx, query,
+		webhook.ID(),
+		string(webhook.Scope()),
+		nullString(webhook.OwnerID()),
+		webhook.URL(),
+		webhook.Secret(),
+		events,
+		webhook.Description(),
+		webhook.IsActive(),
+		webhook.CreatedAt(),
+		webhook.UpdatedAt(),
+	)
+	return err
+}
+
+// FindByID returns a webhook by ID
+func (r *PostgresWebhookRepository) FindByID(ctx context.Context, id string) (*domain.Webhook, error) {
+	webhook, err := scanWebhook(r.pool.QueryRow(ctx, `SELECT `+webhookColumns+` FROM webhooks WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// FindByOwner returns the user's webhooks, or every global webhook, oldest first
+func (r *PostgresWebhookRepository) FindByOwner(ctx context.Context, scope domain.Scope, ownerID string) ([]*domain.Webhook, error) {
+	if scope == domain.ScopeGlobal {
+		return r.query(ctx, `SELECT `+webhookColumns+` FROM webhooks WHERE scope = 'global' ORDER BY created_at`)
+	}
+	return r.query(ctx, `SELECT `+webhookColumns+` FROM webhooks WHERE scope = 'user' AND owner_id = $1 ORDER BY created_at`, ownerID)
+}
+
+// FindSubscribers returns the active global webhooks and the user's active
+// webhooks that subscribe to the event type
+func (r *PostgresWebhookRepository) FindSubscribers(ctx context.Context, eventType domain.EventType, userID string) ([]*domain.Webhook, error) {
+	query := `
+		SELECT ` + webhookColumns + ` FROM webhooks
+		WHERE active AND $1 = ANY(events) AND (scope = 'global' OR owner_id = $2)
+		ORDER BY created_at
+	`
+	return r.query(ctx, query, string(eventType), nullString(userID))
+}
+
+// Delete removes a webhook; its deliveries are removed with it
+func (r *PostgresWebhookRepository) Delete(ctx context.Context, id string) error {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM webhooks WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *PostgresWebhookRepository) query(ctx context.Context, query string, args ...any) ([]*domain.Webhook, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var webhooks []*domain.Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+// scanWebhook reconstructs a webhook from a result row
+func scanWebhook(row pgx.Row) (*domain.Webhook, error) {
+	var id, scope, rawURL, secret, description string
+	var ownerID *string
+	var events []string
+	var active bool
+	var createdAt, updatedAt time.Time
+	if err := row.Scan(&id, &scope, &ownerID, &rawURL, &secret, &events, &description, &active, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	eventTypes := make([]domain.EventType, 0, len(events))
+	for _, event := range events {
+		eventTypes = append(eventTypes, domain.EventType(event))
+	}
+	return domain.ReconstructWebhook(id, domain.Scope(scope), stringValue(ownerID), rawURL, secret, eventTypes, description, active, createdAt, updatedAt), nil
+}
+
+// PostgresDeliveryRepository implements DeliveryRepository interface
+type PostgresDeliveryRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresDeliveryRepository creates a new PostgreSQL delivery repository
+func NewPostgresDeliveryRepository(pool *pgxpool.Pool) *PostgresDeliveryRepository {
+	return &PostgresDeliveryRepository{
+		pool: pool,
+	}
+}
+
+const deliveryColumns = `
+	id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at,
+	response_status, last_error, created_at, completed_at
+`
+
+// Add queues deliveries in one transaction
+func (r *PostgresDeliveryRepository) Add(ctx context.Context, deliveries ...*domain.Delivery) error {
+	query := `
+		INSERT INTO webhook_deliveries (` + deliveryColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		for _, delivery := range deliveries {
+			_, err := tx.Exec(ctx, query,
+				delivery.ID(),
+				delivery.WebhookID(),
+				delivery.EventID(),
+				string(delivery.EventType()),
+				string(delivery.Payload()),
+				string(delivery.Status()),
+				delivery.Attempts(),
+				delivery.NextAttemptAt(),
+				delivery.ResponseStatus(),
+				delivery.LastError(),
+				delivery.CreatedAt(),
+				nullTime(delivery.CompletedAt()),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ClaimDue leases the pending deliveries due at now, oldest first. Rows
+// locked by another instance are skipped instead of waited for.
+func (r *PostgresDeliveryRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*domain.Delivery, error) {
+	query := `
+		UPDATE webhook_deliveries SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + deliveryColumns
+	return r.query(ctx, query, now, leaseUntil, limit)
+}
+
+// Update stores the outcome of a delivery attempt
+func (r *PostgresDeliveryRepository) Update(ctx context.Context, delivery *domain.Delivery) error {
+	query := `
+		UPDATE webhook_deliveries SET
+			status = $2,
+			attempts = $3,
+			next_attempt_at = $4,
+			response_status = $5,
+			last_error = $6,
+			completed_at = $7
+		WHERE id = $1
+	`
+	_, err := r.pool.Exec(ctx, query,
+		delivery.ID(),
+		string(delivery.Status()),
+		delivery.Attempts(),
+		delivery.NextAttemptAt(),
+		delivery.ResponseStatus(),
+		delivery.LastError(),
+		nullTime(delivery.CompletedAt()),
+	)
+	return err
+}
+
+// FindByWebhookID returns a webhook's deliveries, newest first
+func (r *PostgresDeliveryRepository) FindByWebhookID(ctx context.Context, webhookID string, limit, offset int) ([]*domain.Delivery, error) {
+	query := `
+		SELECT ` + deliveryColumns + ` FROM webhook_deliveries
+		WHERE webhook_id = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+	return r.query(ctx, query, webhookID, limit, offset)
+}
+
+// DeleteCompletedBefore removes deliveries that succeeded or were given up before the time
+func (r *PostgresDeliveryRepository) DeleteCompletedBefore(ctx context.Context, before time.Time) (int, error) {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM webhook_deliveries WHERE status <> 'pending' AND completed_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (r *PostgresDeliveryRepository) query(ctx context.Context, query string, args ...any) ([]*domain.Delivery, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*domain.Delivery
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+// scanDelivery reconstructs a delivery from a result row
+func scanDelivery(row pgx.Row) (*domain.Delivery, error) {
+	var id, webhookID, eventID, eventType, payload, status, lastError string
+	var attempts, responseStatus int
+	var nextAttemptAt, createdAt time.Time
+	var completedAt *time.Time
+	if err := row.Scan(&id, &webhookID, &eventID, &eventType, &payload, &status, &attempts, &nextAttemptAt,
+		&responseStatus, &lastError, &createdAt, &completedAt); err != nil {
+		return nil, err
+	}
+	return domain.ReconstructDelivery(id, webhookID, eventID, domain.EventType(eventType), []byte(payload),
+		domain.DeliveryStatus(status), attempts, nextAttemptAt, responseStatus, lastError, createdAt, timeValue(completedAt)), nil
+}
+
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
@@ -0,0 +1,63 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/webhook/domain"
+)
+
+// ErrorMappings Webhook ドメインのエラーとHTTPレスポンスの対応
+func ErrorMappings() []problem.Mapping {
+	return []problem.Mapping{
+		{
+			Err: domain.ErrWebhookNotFound, Status: http.StatusNotFound, Code: "webhook_not_found",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "Webhook が見つかりません。",
+				problem.LanguageEnglish:  "The webhook was not found.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidWebhookURL, Status: http.StatusBadRequest, Code: "invalid_webhook_url",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "Webhook の URL は http または https の URL で指定してください。",
+				problem.LanguageEnglish:  "The webhook URL must be an http or https URL.",
+			},
+		},
+		{
+			Err: domain.ErrInvalidEventType, Status: http.StatusBadRequest, Code: "invalid_webhook_event",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "Webhook のイベントの種類が正しくありません。",
+				problem.LanguageEnglish:  "The webhook event type is not valid.",
+			},
+		},
+		{
+			Err: domain.ErrEventNotAllowed, Status: http.StatusBadRequest, Code: "webhook_event_not_allowed",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "このイベントは管理者の Webhook でのみ受け取れます。",
+				problem.LanguageEnglish:  "This event is only available to admin webhooks.",
+			},
+		},
+		{
+			Err: domain.ErrNoEvents, Status: http.StatusBadRequest, Code: "webhook_events_required",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "受け取るイベントを一つ以上指定してください。",
+				problem.LanguageEnglish:  "Choose at least one event to receive.",
+			},
+		},
+		{
+			Err: domain.ErrDescriptionTooLong, Status: http.StatusBadRequest, Code: "webhook_description_too_long",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "Webhook の説明が長すぎます。",
+				problem.LanguageEnglish:  "The webhook description is too long.",
+			},
+		},
+		{
+			Err: domain.ErrTooManyWebhooks, Status: http.StatusConflict, Code: "too_many_webhooks",
+			Messages: problem.Messages{
+				problem.LanguageJapanese: "Webhook が多すぎます。使っていない Webhook を削除してください。",
+				problem.LanguageEnglish:  "Too many webhooks. Remove the ones you no longer use.",
+			},
+		},
+	}
+}
@@ -0,0 +1,306 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"zen-connect/internal/infrastructure/problem"
+	"zen-connect/internal/infrastructure/session"
+	"zen-connect/internal/shared/openapi"
+	"zen-connect/internal/webhook/application/dto"
+	"zen-connect/internal/webhook/application/usecase"
+)
+
+// globalScopeKey 管理者の Webhook API かどうかを echo.Context に保持するキー
+const globalScopeKey = "webhook.global"
+
+// WebhookHandler Webhook の設定と配信ログのHTTPハンドラー
+type WebhookHandler struct {
+	webhookUseCase *usecase.WebhookUseCase
+}
+
+// NewWebhookHandler コンストラクタ
+func NewWebhookHandler(webhookUseCase *usecase.WebhookUseCase) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUseCase: webhookUseCase,
+	}
+}
+
+// SetupRoutes Webhook 関連のルーティング設定
+// requireAdmin は全ユーザーのイベントを受け取る Webhook の管理APIに適用する管理者チェックミドルウェア
+func (h *WebhookHandler) SetupRoutes(e *echo.Echo, sessionMiddleware *session.Middleware, requireAdmin echo.MiddlewareFunc) {
+	// 自分の体験記録のイベントを受け取る Webhook
+	h.setupGroup(e.Group("/webhooks", sessionMiddleware.RequireAuth()))
+
+	// 全ユーザーのイベントを受け取る Webhook（管理者のみ、管理者全員で共有）
+	h.setupGroup(e.Group("/admin/webhooks", sessionMiddleware.RequireAuth(), requireAdmin, globalScope))
+}
+
+func (h *WebhookHandler) setupGroup(group *echo.Group) {
+	group.GET("", h.ListWebhooks)
+	group.POST("", h.CreateWebhook)
+	group.GET("/:id", h.GetWebhook)
+	group.PATCH("/:id", h.UpdateWebhook)
+	group.DELETE("/:id", h.DeleteWebhook)
+	group.GET("/:id/deliveries", h.ListDeliveries)
+	group.POST("/:id/test", h.SendTest)
+}
+
+// globalScope 管理者の Webhook API であることを記録するミドルウェア
+func globalScope(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		c.Set(globalScopeKey, true)
+		return next(c)
+	}
+}
+
+// ownerFrom リクエストで操作する Webhook の管理者
+func ownerFrom(c echo.Context) (dto.Owner, error) {
+	userID, ok := session.GetUserIDFromContext(c.Request().Context())
+	if !ok {
+		return dto.Owner{}, problem.New(http.StatusUnauthorized, problem.CodeUnauthenticated)
+	}
+	global, _ := c.Get(globalScopeKey).(bool)
+	return dto.Owner{Global: global, UserID: userID}, nil
+}
+
+// Endpoints OpenAPIドキュメント用のエンドポイント定義
+func (h *WebhookHandler) Endpoints() []openapi.Endpoint {
+	security := []string{openapi.SecuritySession}
+	events := "Events are ExperienceCreated and ExperienceVisibilityChanged for your own experiences" +
+		" and HistoryImported once per history import (admin webhooks receive them for every user, plus UserRegistered)."
+	signature := "Each request is a POST of {event_id, event, occurred_at, data} with the headers X-ZenConnect-Event, " +
+		"X-ZenConnect-Delivery and X-ZenConnect-Signature: t=<unix time>,v1=<hex HMAC-SHA256 of \"<t>.<body>\" keyed with the secret>. " +
+		"Any 2xx response is a success; anything else is retried with exponential backoff, with the same event_id."
+
+	var endpoints []openapi.Endpoint
+	for _, group := range []struct {
+		prefix string
+		tags   []string
+		whose  string
+	}{
+		{prefix: "/webhooks", tags: []string{"webhooks"}, whose: "your"},
+		{prefix: "/admin/webhooks", tags: []string{"admin"}, whose: "the admin"},
+	} {
+		endpoints = append(endpoints,
+			openapi.Endpoint{
+				Method: http.MethodGet, Path: group.prefix, Tags: group.tags,
+				Summary:  "List " + group.whose + " webhooks, oldest first",
+				Security: security,
+				Responses: map[int]interface{}{
+					http.StatusOK:           dto.ListWebhooksResponse{},
+					http.StatusUnauthorized: nil,
+				},
+			},
+			openapi.Endpoint{
+				Method: http.MethodPost, Path: group.prefix, Tags: group.tags,
+				Summary:     "Create a webhook",
+				Description: events + " " + signature + " The response holds the secret to verify signatures with.",
+				Security:    security,
+				Request:     dto.CreateWebhookRequest{},
+				Responses: map[int]interface{}{
+					http.StatusCreated:      dto.WebhookDTO{},
+					http.StatusBadRequest:   nil,
+					http.StatusUnauthorized: nil,
+					http.StatusConflict:     nil,
+				},
+			},
+			openapi.Endpoint{
+				Method: http.MethodGet, Path: group.prefix + "/:id", Tags: group.tags,
+				Summary:  "Get a webhook",
+				Security: security,
+				Responses: map[int]interface{}{
+					http.StatusOK:           dto.WebhookDTO{},
+					http.StatusUnauthorized: nil,
+					http.StatusNotFound:     nil,
+				},
+			},
+			openapi.Endpoint{
+				Method: http.MethodPatch, Path: group.prefix + "/:id", Tags: group.tags,
+				Summary: "Change a webhook",
+				Description: "Fields that are left out stay as they are. active false pauses the webhook: events raised meanwhile are not sent and pending deliveries are logged as failed. " +
+					"rotate_secret issues a new secret and signatures with the old one stop verifying.",
+				Security: security,
+				Request:  dto.UpdateWebhookRequest{},
+				Responses: map[int]interface{}{
+					http.StatusOK:           dto.WebhookDTO{},
+					http.StatusBadRequest:   nil,
+					http.StatusUnauthorized: nil,
+					http.StatusNotFound:     nil,
+				},
+			},
+			openapi.Endpoint{
+				Method: http.MethodDelete, Path: group.prefix + "/:id", Tags: group.tags,
+				Summary:  "Delete a webhook and its delivery log",
+				Security: security,
+				Responses: map[int]interface{}{
+					http.StatusNoContent:    nil,
+					http.StatusUnauthorized: nil,
+					http.StatusNotFound:     nil,
+				},
+			},
+			openapi.Endpoint{
+				Method: http.MethodGet, Path: group.prefix + "/:id/deliveries", Tags: group.tags,
+				Summary:     "List the deliveries of a webhook, newest first",
+				Description: "status is pending (waiting for its first or next attempt), succeeded or failed (given up).",
+				Security:    security,
+				Query: []openapi.Parameter{
+					{Name: "limit", Description: "1-100, default 20", Schema: &openapi.Schema{Type: "integer"}},
+					{Name: "offset", Description: "next_offset of the previous page", Schema: &openapi.Schema{Type: "integer"}},
+				},
+				Responses: map[int]interface{}{
+					http.StatusOK:           dto.ListDeliveriesResponse{},
+					http.StatusBadRequest:   nil,
+					http.StatusUnauthorized: nil,
+					http.StatusNotFound:     nil,
+				},
+			},
+			openapi.Endpoint{
+				Method: http.MethodPost, Path: group.prefix + "/:id/test", Tags: group.tags,
+				Summary: "Send a Ping event to a webhook now",
+				Description: "Sent once, even to a paused webhook, and never retried. The response is the delivery with the outcome; " +
+					"it is also added to the delivery log.",
+				Security: security,
+				Responses: map[int]interface{}{
+					http.StatusOK:           dto.DeliveryDTO{},
+					http.StatusUnauthorized: nil,
+					http.StatusNotFound:     nil,
+				},
+			},
+		)
+	}
+	return endpoints
+}
+
+// ListWebhooks Webhook の一覧
+func (h *WebhookHandler) ListWebhooks(c echo.Context) error {
+	owner, err := ownerFrom(c)
+	if err != nil {
+		return err
+	}
+
+	response, err := h.webhookUseCase.List(c.Request().Context(), owner)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// CreateWebhook Webhook を作成
+func (h *WebhookHandler) CreateWebhook(c echo.Context) error {
+	owner, err := ownerFrom(c)
+	if err != nil {
+		return err
+	}
+
+	var req dto.CreateWebhookRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.Owner = owner
+
+	response, err := h.webhookUseCase.Create(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// GetWebhook Webhook を取得
+func (h *WebhookHandler) GetWebhook(c echo.Context) error {
+	owner, err := ownerFrom(c)
+	if err != nil {
+		return err
+	}
+
+	response, err := h.webhookUseCase.Get(c.Request().Context(), owner, c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// UpdateWebhook Webhook を変更
+func (h *WebhookHandler) UpdateWebhook(c echo.Context) error {
+	owner, err := ownerFrom(c)
+	if err != nil {
+		return err
+	}
+
+	var req dto.UpdateWebhookRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.Owner = owner
+	req.WebhookID = c.Param("id")
+
+	response, err := h.webhookUseCase.Update(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeleteWebhook Webhook を削除
+func (h *WebhookHandler) DeleteWebhook(c echo.Context) error {
+	owner, err := ownerFrom(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.webhookUseCase.Delete(c.Request().Context(), owner, c.Param("id")); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ListDeliveries Webhook の配信ログ
+func (h *WebhookHandler) ListDeliveries(c echo.Context) error {
+	owner, err := ownerFrom(c)
+	if err != nil {
+		return err
+	}
+
+	var req dto.ListDeliveriesRequest
+	if err := c.Bind(&req); err != nil {
+		return problem.Wrap(err, http.StatusBadRequest, problem.CodeInvalidRequestBody)
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	req.Owner = owner
+	req.WebhookID = c.Param("id")
+
+	response, err := h.webhookUseCase.ListDeliveries(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// SendTest Ping イベントを送って結果を返す
+func (h *WebhookHandler) SendTest(c echo.Context) error {
+	owner, err := ownerFrom(c)
+	if err != nil {
+		return err
+	}
+
+	response, err := h.webhookUseCase.SendTest(c.Request().Context(), owner, c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,3 @@
+-- Drop experiences table
+DROP TRIGGER IF EXISTS update_experiences_updated_at ON experiences;
+DROP TABLE IF EXISTS experiences;
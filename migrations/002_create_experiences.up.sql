@@ -0,0 +1,22 @@
+-- Create experiences table for meditation experience records
+CREATE TABLE experiences (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    meditation_type VARCHAR(100) NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    emotion_before VARCHAR(255) NOT NULL,
+    emotion_after VARCHAR(255) NOT NULL,
+    is_public BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT experiences_time_range CHECK (end_time > start_time)
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_experiences_user_id_start_time ON experiences(user_id, start_time DESC);
+CREATE INDEX idx_experiences_public_created_at ON experiences(created_at DESC) WHERE is_public;
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_experiences_updated_at BEFORE UPDATE ON experiences FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,6 @@
+-- Drop meditation_types table
+ALTER TABLE experiences DROP CONSTRAINT IF EXISTS experiences_meditation_type_fkey;
+ALTER TABLE experiences ALTER COLUMN meditation_type TYPE VARCHAR(100);
+ALTER TABLE experiences DROP COLUMN IF EXISTS custom_meditation_type;
+DROP TRIGGER IF EXISTS update_meditation_types_updated_at ON meditation_types;
+DROP TABLE IF EXISTS meditation_types;
@@ -0,0 +1,66 @@
+-- Create meditation_types table for the admin-managed meditation type catalog
+CREATE TABLE meditation_types (
+    id VARCHAR(50) PRIMARY KEY,
+    names JSONB NOT NULL,
+    descriptions JSONB NOT NULL DEFAULT '{}',
+    recommended_duration_seconds INTEGER NOT NULL,
+    categories TEXT[] NOT NULL DEFAULT '{}',
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT meditation_types_id_format CHECK (id ~ '^[a-z][a-z0-9_]{1,49}$'),
+    CONSTRAINT meditation_types_recommended_duration CHECK (recommended_duration_seconds > 0)
+);
+
+CREATE TRIGGER update_meditation_types_updated_at BEFORE UPDATE ON meditation_types FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Seed the initial catalog
+INSERT INTO meditation_types (id, names, descriptions, recommended_duration_seconds, categories, sort_order) VALUES
+    ('mindfulness',
+     '{"ja": "マインドフルネス", "en": "Mindfulness"}',
+     '{"ja": "今この瞬間の体験に、評価せずに注意を向けます。", "en": "Paying attention to the present moment without judgement."}',
+     600, '{beginner,awareness}', 10),
+    ('zazen',
+     '{"ja": "座禅", "en": "Zazen"}',
+     '{"ja": "姿勢と呼吸を整え、ただ座ることに徹する禅の瞑想です。", "en": "Seated Zen meditation focused on posture and breath."}',
+     1500, '{zen,seated}', 20),
+    ('breathing',
+     '{"ja": "呼吸瞑想", "en": "Breathing"}',
+     '{"ja": "呼吸の感覚に注意を向け続けます。", "en": "Keeping attention on the sensations of breathing."}',
+     300, '{beginner,breath}', 30),
+    ('body_scan',
+     '{"ja": "ボディスキャン", "en": "Body scan"}',
+     '{"ja": "体の各部位に順番に注意を向けていきます。", "en": "Moving attention through each part of the body in turn."}',
+     1200, '{relaxation,awareness}', 40),
+    ('loving_kindness',
+     '{"ja": "慈悲の瞑想", "en": "Loving-kindness"}',
+     '{"ja": "自分や他者への思いやりの気持ちを育みます。", "en": "Cultivating goodwill towards oneself and others."}',
+     900, '{compassion}', 50),
+    ('walking',
+     '{"ja": "歩行瞑想", "en": "Walking"}',
+     '{"ja": "歩く動作と足裏の感覚に注意を向けます。", "en": "Attending to the movement and sensations of walking."}',
+     900, '{movement}', 60),
+    ('mantra',
+     '{"ja": "マントラ瞑想", "en": "Mantra"}',
+     '{"ja": "言葉や音を繰り返し唱えて心を集中させます。", "en": "Focusing the mind by repeating a word or sound."}',
+     1200, '{concentration}', 70),
+    ('visualization',
+     '{"ja": "イメージ瞑想", "en": "Visualization"}',
+     '{"ja": "情景やイメージを心に描いて集中します。", "en": "Concentrating on a mental image or scene."}',
+     900, '{concentration,guided}', 80),
+    ('other',
+     '{"ja": "その他", "en": "Other"}',
+     '{"ja": "カタログにない瞑想です。種類を自由に入力してください。", "en": "A meditation not in the catalog; describe it in free text."}',
+     600, '{}', 1000);
+
+-- Sessions of types outside the catalog keep their label as free text
+ALTER TABLE experiences ADD COLUMN custom_meditation_type VARCHAR(100) NOT NULL DEFAULT '';
+
+UPDATE experiences
+SET custom_meditation_type = LEFT(meditation_type, 100), meditation_type = 'other'
+WHERE meditation_type NOT IN (SELECT id FROM meditation_types);
+
+ALTER TABLE experiences
+    ALTER COLUMN meditation_type TYPE VARCHAR(50),
+    ADD CONSTRAINT experiences_meditation_type_fkey FOREIGN KEY (meditation_type) REFERENCES meditation_types(id);
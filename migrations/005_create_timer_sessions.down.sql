@@ -0,0 +1,9 @@
+-- Drop timer_sessions table; draft experiences cannot be kept without an emotional state
+DROP TRIGGER IF EXISTS update_timer_sessions_updated_at ON timer_sessions;
+DROP TABLE IF EXISTS timer_sessions;
+
+DELETE FROM experiences WHERE emotion_before IS NULL;
+ALTER TABLE experiences
+    DROP CONSTRAINT IF EXISTS experiences_emotional_state,
+    ALTER COLUMN emotion_before SET NOT NULL,
+    ALTER COLUMN emotion_after SET NOT NULL;
@@ -0,0 +1,33 @@
+-- Create timer_sessions table for live meditation timers kept on the server
+CREATE TABLE timer_sessions (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    meditation_type VARCHAR(50) NOT NULL REFERENCES meditation_types(id),
+    custom_meditation_type VARCHAR(100) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    interruptions JSONB NOT NULL DEFAULT '[]',
+    last_activity_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ,
+    finish_reason VARCHAR(20),
+    experience_id UUID REFERENCES experiences(id) ON DELETE SET NULL,
+    version INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT timer_sessions_status CHECK (status IN ('running', 'paused', 'finished')),
+    CONSTRAINT timer_sessions_finish_reason CHECK (finish_reason IN ('completed', 'abandoned')),
+    CONSTRAINT timer_sessions_finished CHECK ((status = 'finished') = (finished_at IS NOT NULL))
+);
+
+-- A user has at most one active timer, which clients reconnect to
+CREATE UNIQUE INDEX idx_timer_sessions_active_user ON timer_sessions(user_id) WHERE status <> 'finished';
+-- Used by the sweeper that finishes abandoned timers
+CREATE INDEX idx_timer_sessions_last_activity ON timer_sessions(last_activity_at) WHERE status <> 'finished';
+
+CREATE TRIGGER update_timer_sessions_updated_at BEFORE UPDATE ON timer_sessions FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Finished timers become draft experiences whose emotional state is entered later
+ALTER TABLE experiences
+    ALTER COLUMN emotion_before DROP NOT NULL,
+    ALTER COLUMN emotion_after DROP NOT NULL,
+    ADD CONSTRAINT experiences_emotional_state CHECK ((emotion_before IS NULL) = (emotion_after IS NULL));
@@ -0,0 +1,4 @@
+-- Drop group meditation rooms; experiences recorded from them are kept
+DROP TABLE IF EXISTS room_participants;
+DROP TRIGGER IF EXISTS update_rooms_updated_at ON rooms;
+DROP TABLE IF EXISTS rooms;
@@ -0,0 +1,37 @@
+-- Create rooms table for group meditation sessions scheduled by a host
+CREATE TABLE rooms (
+    id UUID PRIMARY KEY,
+    host_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title VARCHAR(100) NOT NULL,
+    meditation_type VARCHAR(50) NOT NULL REFERENCES meditation_types(id),
+    custom_meditation_type VARCHAR(100) NOT NULL DEFAULT '',
+    scheduled_at TIMESTAMPTZ NOT NULL,
+    duration_seconds INTEGER NOT NULL,
+    bell_interval_seconds INTEGER NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL,
+    started_at TIMESTAMPTZ,
+    ended_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT rooms_status CHECK (status IN ('scheduled', 'in_progress', 'ended', 'cancelled')),
+    CONSTRAINT rooms_duration CHECK (duration_seconds BETWEEN 60 AND 10800),
+    CONSTRAINT rooms_bell_interval CHECK (bell_interval_seconds >= 0 AND bell_interval_seconds < duration_seconds)
+);
+
+-- Used to list the rooms users can join
+CREATE INDEX idx_rooms_upcoming ON rooms(scheduled_at) WHERE status IN ('scheduled', 'in_progress');
+
+CREATE TRIGGER update_rooms_updated_at BEFORE UPDATE ON rooms FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Each participant's part in a finished room, recorded as their own draft experience
+CREATE TABLE room_participants (
+    room_id UUID NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    experience_id UUID REFERENCES experiences(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (room_id, user_id)
+);
+
+CREATE INDEX idx_room_participants_user_id ON room_participants(user_id);
@@ -0,0 +1,7 @@
+-- Drop journal entries and tags; the search extension is left installed
+DROP INDEX IF EXISTS idx_experiences_search_text;
+DROP INDEX IF EXISTS idx_experiences_tags;
+ALTER TABLE experiences
+    DROP COLUMN IF EXISTS search_text,
+    DROP COLUMN IF EXISTS tags,
+    DROP COLUMN IF EXISTS journal;
@@ -0,0 +1,25 @@
+-- Journal entries (Markdown) and user-defined tags on experiences
+ALTER TABLE experiences
+    ADD COLUMN journal TEXT NOT NULL DEFAULT '',
+    ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}',
+    -- Lowercased free text the search matches substrings against
+    ADD COLUMN search_text TEXT GENERATED ALWAYS AS (
+        lower(note || E'\n' || journal || E'\n' || custom_meditation_type)
+    ) STORED;
+
+CREATE INDEX idx_experiences_tags ON experiences USING GIN (tags);
+
+-- Japanese text has no word boundaries, so search matches substrings.
+-- pg_bigm indexes 2-grams, which also covers 2-character Japanese words;
+-- pg_trgm is the fallback where pg_bigm is not available.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_available_extensions WHERE name = 'pg_bigm') THEN
+        CREATE EXTENSION IF NOT EXISTS pg_bigm;
+        CREATE INDEX idx_experiences_search_text ON experiences USING GIN (search_text gin_bigm_ops);
+    ELSE
+        CREATE EXTENSION IF NOT EXISTS pg_trgm;
+        CREATE INDEX idx_experiences_search_text ON experiences USING GIN (search_text gin_trgm_ops);
+    END IF;
+END
+$$;
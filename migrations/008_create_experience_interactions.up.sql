@@ -0,0 +1,30 @@
+-- Reactions to public experiences; a user reacts at most once with each kind
+CREATE TABLE experience_reactions (
+    experience_id UUID NOT NULL REFERENCES experiences(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (experience_id, user_id, kind)
+);
+
+-- Threaded comments on public experiences. Deleted comments keep their row
+-- without a body so that their replies stay in the thread.
+CREATE TABLE experience_comments (
+    id UUID PRIMARY KEY,
+    experience_id UUID NOT NULL REFERENCES experiences(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    author_name VARCHAR(255) NOT NULL DEFAULT '',
+    parent_id UUID REFERENCES experience_comments(id) ON DELETE CASCADE,
+    depth SMALLINT NOT NULL DEFAULT 0,
+    body TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    edited_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT experience_comments_depth CHECK ((parent_id IS NULL) = (depth = 0)),
+    CONSTRAINT experience_comments_deleted_body CHECK (deleted_at IS NULL OR body = '')
+);
+
+CREATE INDEX idx_experience_comments_experience ON experience_comments(experience_id, created_at);
+
+CREATE TRIGGER update_experience_comments_updated_at BEFORE UPDATE ON experience_comments FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS account_suspensions;
+DROP TABLE IF EXISTS moderation_reports;
+DROP TABLE IF EXISTS moderation_actions;
+
+ALTER TABLE experience_comments DROP COLUMN IF EXISTS hidden_at;
+ALTER TABLE experiences DROP COLUMN IF EXISTS hidden_at;
@@ -0,0 +1,53 @@
+-- Moderators can hide experiences and comments; hidden content stays visible to its author only
+ALTER TABLE experiences ADD COLUMN hidden_at TIMESTAMPTZ;
+ALTER TABLE experience_comments ADD COLUMN hidden_at TIMESTAMPTZ;
+
+-- Moderator decisions on reported targets, kept as an audit trail
+CREATE TABLE moderation_actions (
+    id UUID PRIMARY KEY,
+    target_type VARCHAR(20) NOT NULL,
+    target_id UUID NOT NULL,
+    target_owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    action VARCHAR(20) NOT NULL,
+    moderator_id UUID NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    suspended_until TIMESTAMPTZ,
+    resolved_reports INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_moderation_actions_owner ON moderation_actions(target_owner_id, created_at DESC);
+
+-- Reports of experiences, comments and users. The excerpt keeps what the
+-- reporter saw, so moderators can judge content that was edited or deleted later.
+CREATE TABLE moderation_reports (
+    id UUID PRIMARY KEY,
+    reporter_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    target_type VARCHAR(20) NOT NULL,
+    target_id UUID NOT NULL,
+    target_owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason VARCHAR(30) NOT NULL,
+    detail TEXT NOT NULL DEFAULT '',
+    excerpt TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    resolution VARCHAR(20),
+    action_id UUID REFERENCES moderation_actions(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMPTZ,
+    CONSTRAINT moderation_reports_resolution CHECK ((status = 'open') = (resolved_at IS NULL))
+);
+
+-- A user has at most one open report on the same target
+CREATE UNIQUE INDEX idx_moderation_reports_open_reporter ON moderation_reports(reporter_id, target_type, target_id) WHERE status = 'open';
+CREATE INDEX idx_moderation_reports_open_target ON moderation_reports(target_type, target_id) WHERE status = 'open';
+
+-- The latest suspension of each user; suspending again replaces it
+CREATE TABLE account_suspensions (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    action_id UUID REFERENCES moderation_actions(id) ON DELETE SET NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    suspended_at TIMESTAMPTZ NOT NULL,
+    until TIMESTAMPTZ,
+    lifted_at TIMESTAMPTZ,
+    lifted_by UUID
+);
@@ -0,0 +1,33 @@
+-- Outgoing email; messages are kept after sending so dedupe keys stay unique
+CREATE TABLE mail_queue (
+    id UUID PRIMARY KEY,
+    -- Prevents the same mail (e.g. a user's welcome) from being queued twice
+    dedupe_key VARCHAR(255) UNIQUE,
+    message JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_mail_queue_due ON mail_queue(next_attempt_at) WHERE status = 'pending';
+
+-- Notifications waiting for the user's next email digest
+CREATE TABLE notification_digest_items (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(50) NOT NULL,
+    actor_id UUID,
+    data JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_notification_digest_items_user ON notification_digest_items(user_id, created_at);
+
+-- Per-user notification settings other than the per-type preferences
+CREATE TABLE notification_settings (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    language VARCHAR(10) NOT NULL
+);
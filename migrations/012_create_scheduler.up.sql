@@ -0,0 +1,22 @@
+-- Recurring background jobs; only the instance holding the scheduler's
+-- advisory lock runs them
+CREATE TABLE scheduled_jobs (
+    name VARCHAR(100) PRIMARY KEY,
+    next_run_at TIMESTAMPTZ NOT NULL,
+    last_run_at TIMESTAMPTZ,
+    -- Failed runs since the last success; retries back off with each one
+    failures INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT ''
+);
+
+-- Daily meditation reminders at a time of day in the user's time zone
+CREATE TABLE reminders (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    time_of_day VARCHAR(5) NOT NULL,
+    time_zone VARCHAR(64) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    next_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_reminders_due ON reminders(next_at) WHERE enabled;
@@ -0,0 +1,15 @@
+-- Browsers subscribed to Web Push notifications; the endpoint identifies a
+-- browser, so subscribing again replaces the row
+CREATE TABLE push_subscriptions (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    endpoint TEXT NOT NULL UNIQUE,
+    p256dh VARCHAR(128) NOT NULL,
+    auth VARCHAR(64) NOT NULL,
+    user_agent VARCHAR(255) NOT NULL DEFAULT '',
+    expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_push_subscriptions_user_id ON push_subscriptions(user_id, created_at);
+CREATE INDEX idx_push_subscriptions_expires_at ON push_subscriptions(expires_at) WHERE expires_at IS NOT NULL;
@@ -0,0 +1,39 @@
+-- Outgoing webhooks: user webhooks receive their owner's events, global
+-- webhooks (managed by admins) everyone's
+CREATE TABLE webhooks (
+    id UUID PRIMARY KEY,
+    scope VARCHAR(10) NOT NULL,
+    owner_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    -- Signs the payloads; receivers verify with the same secret
+    secret VARCHAR(100) NOT NULL,
+    events TEXT[] NOT NULL,
+    description VARCHAR(200) NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT webhooks_owner CHECK ((scope = 'user') = (owner_id IS NOT NULL))
+);
+
+CREATE INDEX idx_webhooks_owner_id ON webhooks(owner_id, created_at);
+CREATE INDEX idx_webhooks_events ON webhooks USING GIN (events) WHERE active;
+
+-- The delivery queue and log; the payload is kept so retries send the same body
+CREATE TABLE webhook_deliveries (
+    id UUID PRIMARY KEY,
+    webhook_id UUID NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    response_status INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_webhook_deliveries_webhook_id ON webhook_deliveries(webhook_id, created_at DESC);
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_completed_at ON webhook_deliveries(completed_at) WHERE status <> 'pending';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS emotion_rollups;
+DROP TABLE IF EXISTS emotion_samples;
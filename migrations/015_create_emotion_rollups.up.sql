@@ -0,0 +1,26 @@
+-- What each experience adds to the emotion rollups, so that an update can
+-- take back the old scores. Drafts have no scores. version is the
+-- experience's updated_at; older snapshots are not applied.
+CREATE TABLE emotion_samples (
+    experience_id UUID PRIMARY KEY REFERENCES experiences(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    hour TIMESTAMPTZ NOT NULL,
+    meditation_type VARCHAR(100) NOT NULL,
+    length_bucket VARCHAR(20) NOT NULL,
+    before_score SMALLINT,
+    after_score SMALLINT,
+    version TIMESTAMPTZ NOT NULL
+);
+
+-- Emotion scores totalled per user, UTC hour, meditation type and length
+-- bucket; trends are read from here instead of the experiences
+CREATE TABLE emotion_rollups (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    hour TIMESTAMPTZ NOT NULL,
+    meditation_type VARCHAR(100) NOT NULL,
+    length_bucket VARCHAR(20) NOT NULL,
+    sessions INTEGER NOT NULL,
+    before_total INTEGER NOT NULL,
+    after_total INTEGER NOT NULL,
+    PRIMARY KEY (user_id, hour, meditation_type, length_bucket)
+);
@@ -0,0 +1,17 @@
+-- Practice goals such as 20 minutes a day; progress is computed from the
+-- experiences, only the last period the goal was achieved in is stored
+CREATE TABLE goals (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    metric VARCHAR(20) NOT NULL,
+    period VARCHAR(20) NOT NULL,
+    target INTEGER NOT NULL CHECK (target > 0),
+    -- Periods are calendar days, weeks, months and years in this zone
+    time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    last_achieved_period TIMESTAMPTZ,
+    archived_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_goals_user_id ON goals(user_id, created_at);
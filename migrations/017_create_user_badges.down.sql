@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS goal_achievements;
+DROP TABLE IF EXISTS user_badges;
@@ -0,0 +1,19 @@
+-- Badges users have earned; the badge definitions live in the code and are
+-- referred to by ID. A badge is never awarded twice.
+CREATE TABLE user_badges (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    badge_id VARCHAR(50) NOT NULL,
+    earned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, badge_id)
+);
+
+-- Every period or streak a goal was achieved in, counted for goal badges;
+-- goals only remember their last achievement
+CREATE TABLE goal_achievements (
+    goal_id UUID NOT NULL REFERENCES goals(id) ON DELETE CASCADE,
+    period_start TIMESTAMPTZ NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    PRIMARY KEY (goal_id, period_start)
+);
+
+CREATE INDEX idx_goal_achievements_user_id ON goal_achievements(user_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS experience_import_batches;
@@ -0,0 +1,13 @@
+-- Experiences inserted by one history import. The work that follows recorded
+-- experiences (rollups, goals, badges, webhooks) runs for the whole batch in
+-- a background job; processed_at is NULL until it has been started.
+CREATE TABLE experience_import_batches (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    experience_ids UUID[] NOT NULL,
+    imported_at TIMESTAMPTZ NOT NULL,
+    processed_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_experience_import_batches_pending
+    ON experience_import_batches(imported_at) WHERE processed_at IS NULL;
@@ -0,0 +1,13 @@
+// Package migrations embeds the versioned SQL migration files into the binary.
+//
+// Each migration is a pair of files named {version}_{name}.up.sql and
+// {version}_{name}.down.sql. New pairs can be generated with
+// `zen-connect migrate create <name>`.
+package migrations
+
+import "embed"
+
+// FS holds every *.sql file in this directory
+//
+//go:embed *.sql
+var FS embed.FS